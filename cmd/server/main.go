@@ -2,10 +2,18 @@ package main
 
 import (
 	"GoEdu/internal/config"
+	"GoEdu/internal/events"
+	"GoEdu/internal/gateway"
+	"GoEdu/internal/jobs"
 	"GoEdu/internal/logger"
+	"GoEdu/internal/mailer"
 	"GoEdu/internal/middleware"
+	"GoEdu/internal/moderation"
+	"GoEdu/internal/notification"
 	"GoEdu/internal/repository"
 	"GoEdu/internal/service"
+	"GoEdu/internal/storage"
+	"GoEdu/internal/webhook"
 	"GoEdu/proto"
 	"context"
 	"github.com/gorilla/mux"
@@ -16,8 +24,12 @@ import (
 	"google.golang.org/grpc/reflection"
 	"net"
 	"net/http"
+	"time"
 )
 
+// maxGRPCMessageSize ограничивает размер сообщений gRPC; решения заданий передаются вместе с файлами.
+const maxGRPCMessageSize = 64 << 20
+
 func main() {
 
 	zapLogger, err := logger.NewLogger()
@@ -43,7 +55,10 @@ func main() {
 
 	go func() {
 		ctx := context.Background()
-		opts := []grpc.DialOption{grpc.WithInsecure()}
+		opts := []grpc.DialOption{
+			grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxGRPCMessageSize), grpc.MaxCallRecvMsgSize(maxGRPCMessageSize)),
+		}
 
 		muxOptions := []runtime.ServeMuxOption{
 			runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
@@ -60,9 +75,68 @@ func main() {
 		if err != nil {
 			zapLogger.Fatal("Не удалось зарегистрировать EducationService в gRPC Gateway", zap.Error(err))
 		}
+		if err := proto.RegisterEnrollmentServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать EnrollmentService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterStudentServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать StudentService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterCertificateServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать CertificateService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterQuizServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать QuizService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterQuestionBankServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать QuestionBankService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterAssignmentServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать AssignmentService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterPeerReviewServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать PeerReviewService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterGradebookServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать GradebookService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterModerationServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать ModerationService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterLearningPathServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать LearningPathService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterWebhookServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать WebhookService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterEventServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать EventService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterNotificationServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать NotificationService в gRPC Gateway", zap.Error(err))
+		}
+		if err := proto.RegisterAnnouncementServiceHandlerFromEndpoint(ctx, gatewayMux, "localhost:"+cfg.GRPCPort, opts); err != nil {
+			zapLogger.Fatal("Не удалось зарегистрировать AnnouncementService в gRPC Gateway", zap.Error(err))
+		}
+
+		conn, err := grpc.Dial("localhost:"+cfg.GRPCPort, opts...)
+		if err != nil {
+			zapLogger.Fatal("Не удалось подключиться к gRPC серверу", zap.Error(err))
+		}
+		defer conn.Close()
 
 		router := mux.NewRouter()
 
+		router.Handle("/v1/courses/{course_id}/enrollments/import", gateway.NewBulkEnrollHandler(proto.NewEnrollmentServiceClient(conn), zapLogger)).Methods(http.MethodPost)
+		router.Handle("/v1/students/invitations/accept", gateway.NewInvitationHandler(proto.NewStudentServiceClient(conn), zapLogger)).Methods(http.MethodGet)
+		router.Handle("/v1/students/invitations/accept", gateway.NewInvitationHandler(proto.NewStudentServiceClient(conn), zapLogger)).Methods(http.MethodPost).HeadersRegexp("Content-Type", "^application/x-www-form-urlencoded")
+		router.Handle("/v1/notifications/unsubscribe", gateway.NewUnsubscribeHandler(proto.NewNotificationServiceClient(conn), zapLogger)).Methods(http.MethodGet)
+		router.Handle("/v1/notifications/unsubscribe", gateway.NewUnsubscribeHandler(proto.NewNotificationServiceClient(conn), zapLogger)).Methods(http.MethodPost).HeadersRegexp("Content-Type", "^application/x-www-form-urlencoded")
+		router.Handle("/v1/certificates/{certificate_id}/pdf", gateway.NewCertificatePDFHandler(proto.NewCertificateServiceClient(conn), zapLogger)).Methods(http.MethodGet)
+		router.Handle("/v1/assignments/{assignment_id}/submissions/upload", gateway.NewSubmissionUploadHandler(proto.NewAssignmentServiceClient(conn), zapLogger)).Methods(http.MethodPost)
+		router.Handle("/v1/submission-files/{file_id}/download", gateway.NewSubmissionFileHandler(proto.NewAssignmentServiceClient(conn), zapLogger)).Methods(http.MethodGet)
+		router.Handle("/v1/courses/{course_id}/gradebook/download", gateway.NewGradebookDownloadHandler(proto.NewGradebookServiceClient(conn), zapLogger)).Methods(http.MethodGet)
+		router.Handle("/v1/events", gateway.NewEventStreamHandler(proto.NewEventServiceClient(conn), zapLogger)).Methods(http.MethodGet).HeadersRegexp("Accept", "text/event-stream")
+
 		router.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", http.FileServer(http.Dir("R:/ProjectsGo/GoEdu/proto/swagger"))))
 		router.PathPrefix("/swagger-ui/").Handler(http.StripPrefix("/swagger-ui/", http.FileServer(http.Dir("R:/ProjectsGo/GoEdu/proto/swagger-ui"))))
 		router.PathPrefix("/").Handler(gatewayMux)
@@ -76,6 +150,9 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(middleware.AuthInterceptor([]byte(cfg.JWTSecretKey), zapLogger)),
+		grpc.StreamInterceptor(middleware.AuthStreamInterceptor([]byte(cfg.JWTSecretKey), zapLogger)),
+		grpc.MaxRecvMsgSize(maxGRPCMessageSize),
+		grpc.MaxSendMsgSize(maxGRPCMessageSize),
 	)
 
 	reflection.Register(grpcServer)
@@ -86,14 +163,52 @@ func main() {
 	lectureRepo := repository.NewLectureRepository(dbpool)
 	instructorRepo := repository.NewInstructorRepository(dbpool)
 	reviewRepo := repository.NewReviewRepository(dbpool)
+	cohortRepo := repository.NewCohortRepository(dbpool)
+	progressRepo := repository.NewProgressRepository(dbpool)
+	certificateRepo := repository.NewCertificateRepository(dbpool)
+	quizRepo := repository.NewQuizRepository(dbpool)
+	questionBankRepo := repository.NewQuestionBankRepository(dbpool)
+	assignmentRepo := repository.NewAssignmentRepository(dbpool)
+	peerReviewRepo := repository.NewPeerReviewRepository(dbpool)
+	gradebookRepo := repository.NewGradebookRepository(dbpool)
+	adminRepo := repository.NewAdminRepository(dbpool)
+	recommendRepo := repository.NewRecommendationRepository(dbpool)
+	trendingRepo := repository.NewTrendingRepository(dbpool)
+	learningPathRepo := repository.NewLearningPathRepository(dbpool)
+	outboxRepo := repository.NewOutboxRepository(dbpool)
+	webhookRepo := repository.NewWebhookRepository(dbpool)
+	notificationRepo := repository.NewNotificationRepository(dbpool)
+	announcementRepo := repository.NewAnnouncementRepository(dbpool)
+
+	mail := mailer.NewMailer(cfg, zapLogger)
+
+	blobs, err := storage.NewBlobStore(cfg, zapLogger)
+	if err != nil {
+		zapLogger.Fatal("Не удалось инициализировать файловое хранилище", zap.Error(err))
+	}
 
 	// Сервисы
-	enrollmentService := service.NewEnrollmentService(enrollmentRepo, zapLogger)
-	educationService := service.NewEducationService(dbpool, courseRepo, zapLogger)
+	enrollmentService := service.NewEnrollmentService(dbpool, enrollmentRepo, studentRepo, courseRepo, mail, cfg, zapLogger)
+	educationService := service.NewEducationService(dbpool, courseRepo, trendingRepo, cfg.TrendingWindowsDays, zapLogger)
 	studentService := service.NewStudentService(studentRepo, cfg, zapLogger)
-	lectureService := service.NewLectureService(lectureRepo, zapLogger)
+	certificateService := service.NewCertificateService(certificateRepo, lectureRepo, courseRepo, learningPathRepo, cfg, zapLogger)
+	lectureService := service.NewLectureService(lectureRepo, enrollmentRepo, courseRepo, progressRepo, quizRepo, recommendRepo, certificateService, zapLogger)
 	instructorService := service.NewInstructorService(instructorRepo, cfg, zapLogger)
-	reviewService := service.NewReviewService(reviewRepo, zapLogger)
+	reviewFilter := moderation.NewFilter(cfg.ReviewBlocklist, cfg.ReviewBlockLinks)
+	reviewService := service.NewReviewService(reviewRepo, enrollmentRepo, lectureRepo, courseRepo, int32(cfg.ReviewMinProgressPercent), reviewFilter, cfg.ReviewReportLimit, zapLogger)
+	cohortService := service.NewCohortService(cohortRepo, courseRepo, lectureRepo, enrollmentRepo, zapLogger)
+	quizService := service.NewQuizService(quizRepo, questionBankRepo, courseRepo, lectureRepo, enrollmentRepo, zapLogger)
+	questionBankService := service.NewQuestionBankService(questionBankRepo, zapLogger)
+	assignmentService := service.NewAssignmentService(assignmentRepo, courseRepo, enrollmentRepo, peerReviewRepo, blobs, zapLogger)
+	peerReviewService := service.NewPeerReviewService(peerReviewRepo, assignmentRepo, courseRepo, zapLogger)
+	gradebookService := service.NewGradebookService(gradebookRepo, courseRepo, enrollmentRepo, zapLogger)
+	moderationService := service.NewModerationService(adminRepo, reviewRepo, cfg, zapLogger)
+	learningPathService := service.NewLearningPathService(learningPathRepo, courseRepo, lectureRepo, certificateService, zapLogger)
+	webhookService := service.NewWebhookService(webhookRepo, cfg.WebhookAllowPrivateNetworks, zapLogger)
+	eventBroker := events.NewBroker()
+	eventService := service.NewEventService(outboxRepo, courseRepo, eventBroker, zapLogger)
+	notificationService := service.NewNotificationService(notificationRepo, cfg, zapLogger)
+	announcementService := service.NewAnnouncementService(announcementRepo, courseRepo, cohortRepo, enrollmentRepo, zapLogger)
 
 	// Регистрация сервисов
 	proto.RegisterEducationServiceServer(grpcServer, educationService)
@@ -102,6 +217,49 @@ func main() {
 	proto.RegisterLectureServiceServer(grpcServer, lectureService)
 	proto.RegisterInstructorServiceServer(grpcServer, instructorService)
 	proto.RegisterReviewServiceServer(grpcServer, reviewService)
+	proto.RegisterCohortServiceServer(grpcServer, cohortService)
+	proto.RegisterCertificateServiceServer(grpcServer, certificateService)
+	proto.RegisterQuizServiceServer(grpcServer, quizService)
+	proto.RegisterQuestionBankServiceServer(grpcServer, questionBankService)
+	proto.RegisterAssignmentServiceServer(grpcServer, assignmentService)
+	proto.RegisterPeerReviewServiceServer(grpcServer, peerReviewService)
+	proto.RegisterGradebookServiceServer(grpcServer, gradebookService)
+	proto.RegisterModerationServiceServer(grpcServer, moderationService)
+	proto.RegisterLearningPathServiceServer(grpcServer, learningPathService)
+	proto.RegisterWebhookServiceServer(grpcServer, webhookService)
+	proto.RegisterEventServiceServer(grpcServer, eventService)
+	proto.RegisterNotificationServiceServer(grpcServer, notificationService)
+	proto.RegisterAnnouncementServiceServer(grpcServer, announcementService)
+
+	// Фоновые задачи
+	releaseNotifier := jobs.NewLectureReleaseNotifier(lectureRepo, mail, cfg.AppBaseURL, time.Duration(cfg.LectureReleaseCheckMinutes)*time.Minute, zapLogger)
+	go releaseNotifier.Run(context.Background())
+	peerReviewAllocator := jobs.NewPeerReviewAllocator(assignmentRepo, peerReviewRepo, enrollmentRepo, time.Duration(cfg.PeerReviewCheckMinutes)*time.Minute, zapLogger)
+	go peerReviewAllocator.Run(context.Background())
+	recommendationBuilder := jobs.NewRecommendationBuilder(recommendRepo, courseRepo, time.Duration(cfg.RecommendationRefreshMinutes)*time.Minute, zapLogger)
+	go recommendationBuilder.Run(context.Background())
+	trendingBuilder := jobs.NewTrendingBuilder(trendingRepo, cfg.TrendingWindowsDays, time.Duration(cfg.TrendingRefreshMinutes)*time.Minute, zapLogger)
+	go trendingBuilder.Run(context.Background())
+	eventBus := events.NewBus()
+	eventBus.Subscribe(events.NewLogSink(zapLogger))
+	eventBus.Subscribe(webhook.NewSink(webhookRepo))
+	eventBus.Subscribe(eventBroker)
+	eventBus.Subscribe(notification.NewSink(notificationRepo, courseRepo, assignmentRepo))
+	outboxRelay := jobs.NewOutboxRelay(outboxRepo, eventBus, cfg.OutboxBatchSize, time.Duration(cfg.OutboxRetentionDays)*24*time.Hour,
+		time.Duration(cfg.OutboxRelayIntervalSeconds)*time.Second, zapLogger)
+	go outboxRelay.Run(context.Background())
+	webhookDispatcher := jobs.NewWebhookDispatcher(webhookRepo, webhook.NewClient(time.Duration(cfg.WebhookTimeoutSeconds)*time.Second, cfg.WebhookAllowPrivateNetworks),
+		int32(cfg.WebhookMaxAttempts), int32(cfg.WebhookDisableAfterFailures), time.Duration(cfg.WebhookDispatchSeconds)*time.Second, zapLogger)
+	go webhookDispatcher.Run(context.Background())
+	notificationChannel, err := notification.NewChannel(cfg, mail, zapLogger)
+	if err != nil {
+		zapLogger.Fatal("Не удалось инициализировать канал уведомлений", zap.Error(err))
+	}
+	notificationMailer := jobs.NewNotificationMailer(notificationRepo, notificationChannel, cfg.AppBaseURL, cfg.UnsubscribeSecretKey,
+		cfg.NotificationDigestHour, time.Duration(cfg.NotificationEmailSeconds)*time.Second, zapLogger)
+	go notificationMailer.Run(context.Background())
+	announcementPublisher := jobs.NewAnnouncementPublisher(announcementRepo, time.Duration(cfg.AnnouncementPublishSeconds)*time.Second, zapLogger)
+	go announcementPublisher.Run(context.Background())
 
 	// Запуск gRPC сервера
 	go func() {
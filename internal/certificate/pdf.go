@@ -0,0 +1,105 @@
+package certificate
+
+import (
+	"GoEdu/internal/models"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth  = 842 // A4 альбомной ориентации в пунктах
+	pageHeight = 595
+)
+
+// RenderPDF формирует одностраничный PDF сертификата. Используются стандартные шрифты PDF,
+// в которых нет кириллицы, поэтому имена и название курса или программы печатаются в транслитерации;
+// оригинальные значения хранятся в реестре и возвращаются при проверке.
+func RenderPDF(cert *models.Certificate, verifyURL string) []byte {
+	var content bytes.Buffer
+
+	// Рамка
+	content.WriteString("0.2 0.3 0.6 RG 4 w 30 30 782 535 re S\n")
+	content.WriteString("1 w 40 40 762 515 re S\n")
+
+	centered(&content, "F2", 34, 470, "Certificate of Completion")
+	centered(&content, "F1", 16, 420, "This certifies that")
+	centered(&content, "F2", 28, 375, Transliterate(cert.StudentName))
+	completed := "has successfully completed the course"
+	if cert.PathID != 0 {
+		completed = "has successfully completed the learning path"
+	}
+	centered(&content, "F1", 16, 330, completed)
+	centered(&content, "F2", 22, 290, Transliterate(cert.CourseName))
+	centered(&content, "F1", 14, 245, "Instructor: "+Transliterate(cert.InstructorName))
+	centered(&content, "F1", 14, 220, "Issued: "+cert.IssuedAt.UTC().Format("2006-01-02"))
+
+	text(&content, "F1", 10, 60, 110, "Serial: "+cert.Serial)
+	text(&content, "F1", 8, 60, 94, "Signature: "+cert.Signature)
+	text(&content, "F1", 8, 60, 78, "Verify: "+verifyURL)
+
+	return buildPDF(content.Bytes())
+}
+
+func centered(buf *bytes.Buffer, font string, size, y float64, s string) {
+	x := (pageWidth - textWidth(s, size)) / 2
+	if x < 50 {
+		x = 50
+	}
+	text(buf, font, size, x, y, s)
+}
+
+func text(buf *bytes.Buffer, font string, size, x, y float64, s string) {
+	fmt.Fprintf(buf, "0 0 0 rg BT /%s %.0f Tf %.1f %.1f Td (%s) Tj ET\n", font, size, x, y, escapePDFString(s))
+}
+
+// textWidth приблизительно оценивает ширину строки Helvetica: средняя ширина символа около 0.5 кегля.
+func textWidth(s string, size float64) float64 {
+	return float64(len(s)) * size * 0.5
+}
+
+func escapePDFString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// buildPDF собирает документ из каталога, дерева страниц, одной страницы, двух шрифтов и потока содержимого.
+func buildPDF(content []byte) []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents 6 0 R >>", pageWidth, pageHeight),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
@@ -0,0 +1,62 @@
+package certificate
+
+import (
+	"GoEdu/internal/models"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Signer подписывает сертификаты HMAC-SHA256. Подпись покрывает все напечатанные на сертификате
+// данные, поэтому подделка имени или курса обнаруживается при проверке.
+type Signer struct {
+	key []byte
+}
+
+func NewSigner(key string) *Signer {
+	return &Signer{key: []byte(key)}
+}
+
+func (s *Signer) Sign(cert *models.Certificate) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(signedPayload(cert)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify сравнивает подпись с ожидаемой за постоянное время.
+func (s *Signer) Verify(cert *models.Certificate, signature string) bool {
+	return hmac.Equal([]byte(s.Sign(cert)), []byte(strings.ToLower(signature)))
+}
+
+// signedPayload дополняется ID программы только для сертификатов программ,
+// поэтому подписи ранее выданных сертификатов курсов остаются действительными.
+func signedPayload(cert *models.Certificate) string {
+	fields := []string{
+		cert.Serial,
+		strconv.FormatInt(cert.StudentID, 10),
+		strconv.FormatInt(cert.CourseID, 10),
+		cert.StudentName,
+		cert.CourseName,
+		cert.InstructorName,
+		cert.IssuedAt.UTC().Format(time.RFC3339),
+	}
+	if cert.PathID != 0 {
+		fields = append(fields, "path:"+strconv.FormatInt(cert.PathID, 10))
+	}
+	return strings.Join(fields, "\n")
+}
+
+// NewSerial генерирует серийный номер вида GE-XXXX-XXXX-XXXX.
+func NewSerial() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)[:12]
+	return "GE-" + code[0:4] + "-" + code[4:8] + "-" + code[8:12], nil
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"sync"
+)
+
+// brokerBuffer — сколько событий может накопиться у подписчика, прежде чем он будет отключён.
+const brokerBuffer = 256
+
+// Broker раздаёт опубликованные события подписчикам внутри процесса, например открытым потокам
+// SubscribeEvents. Подписчик, который не успевает забирать события, отключается: его канал закрывается,
+// и он должен переподключиться, догнав пропущенное по outbox.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[chan *models.OutboxEvent]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[chan *models.OutboxEvent]struct{})}
+}
+
+// Subscribe возвращает канал новых событий и функцию отписки, которую нужно вызвать по окончании чтения.
+func (b *Broker) Subscribe() (<-chan *models.OutboxEvent, func()) {
+	ch := make(chan *models.OutboxEvent, brokerBuffer)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[ch]; ok {
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (b *Broker) Name() string {
+	return "broker"
+}
+
+// Publish передаёт событие всем подписчикам без ожидания.
+func (b *Broker) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package events
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Sink — получатель доменных событий. Доставка выполняется как минимум один раз: при ошибке любого
+// получателя событие повторяется для всех, поэтому получатели должны отбрасывать повторы по EventID.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, event *models.OutboxEvent) error
+}
+
+type subscription struct {
+	sink       Sink
+	eventTypes []string // Пустой список — все события.
+}
+
+// Bus рассылает события подписанным получателям.
+type Bus struct {
+	mu            sync.RWMutex
+	subscriptions []subscription
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe подписывает получателя на события перечисленных типов или на все события, если типы не заданы.
+func (b *Bus) Subscribe(sink Sink, eventTypes ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions = append(b.subscriptions, subscription{sink: sink, eventTypes: eventTypes})
+}
+
+// Publish передаёт событие всем подписанным на его тип получателям, даже если часть из них вернула ошибку.
+// Событие считается доставленным, только если ошибок не было.
+func (b *Bus) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	b.mu.RLock()
+	subscriptions := slices.Clone(b.subscriptions)
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, sub := range subscriptions {
+		if len(sub.eventTypes) > 0 && !slices.Contains(sub.eventTypes, event.EventType) {
+			continue
+		}
+		if err := sub.sink.Publish(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LogSink записывает события в лог.
+type LogSink struct {
+	logger *zap.Logger
+}
+
+func NewLogSink(logger *zap.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+func (s *LogSink) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	s.logger.Info("Доменное событие",
+		zap.String("event_id", event.EventID),
+		zap.String("event_type", event.EventType),
+		zap.String("aggregate_type", event.AggregateType),
+		zap.Int64("aggregate_id", event.AggregateID),
+		zap.ByteString("payload", event.Payload))
+	return nil
+}
@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"GoEdu/proto"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const maxBulkEnrollUploadSize = 10 << 20
+
+// NewBulkEnrollHandler принимает CSV-файл как multipart/form-data (поле file) и передаёт его в EnrollmentService.BulkEnroll.
+// Параметры dry_run и all_or_nothing передаются полями формы или query-параметрами.
+func NewBulkEnrollHandler(client proto.EnrollmentServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		courseID, err := strconv.ParseInt(mux.Vars(r)["course_id"], 10, 64)
+		if err != nil || courseID <= 0 {
+			http.Error(w, "Некорректный ID курса", http.StatusBadRequest)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxBulkEnrollUploadSize)
+		if err := r.ParseMultipartForm(maxBulkEnrollUploadSize); err != nil {
+			logger.Warn("Не удалось разобрать multipart-запрос", zap.Error(err))
+			http.Error(w, "Ожидается multipart/form-data с полем file", http.StatusBadRequest)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "Файл не передан в поле file", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+		if err != nil {
+			logger.Error("Ошибка чтения загруженного файла", zap.Error(err))
+			http.Error(w, "Ошибка чтения файла", http.StatusBadRequest)
+			return
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+		resp, err := client.BulkEnroll(ctx, &proto.BulkEnrollRequest{
+			CourseId:     courseID,
+			Csv:          data,
+			DryRun:       formBool(r, "dry_run"),
+			AllOrNothing: formBool(r, "all_or_nothing"),
+		})
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		body, err := protojson.Marshal(resp)
+		if err != nil {
+			logger.Error("Ошибка сериализации ответа", zap.Error(err))
+			http.Error(w, "Ошибка сериализации ответа", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}
+}
+
+func formBool(r *http.Request, key string) bool {
+	value, _ := strconv.ParseBool(r.FormValue(key))
+	return value
+}
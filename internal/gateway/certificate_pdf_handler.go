@@ -0,0 +1,37 @@
+package gateway
+
+import (
+	"GoEdu/proto"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NewCertificatePDFHandler отдаёт PDF сертификата файлом, чтобы его можно было скачать по прямой ссылке.
+func NewCertificatePDFHandler(client proto.CertificateServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certificateID, err := strconv.ParseInt(mux.Vars(r)["certificate_id"], 10, 64)
+		if err != nil || certificateID <= 0 {
+			http.Error(w, "Некорректный ID сертификата", http.StatusBadRequest)
+			return
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+		cert, err := client.GetCertificate(ctx, &proto.CertificateIDRequest{CertificateId: certificateID})
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		logger.Info("Скачивание сертификата", zap.Int64("certificate_id", certificateID), zap.String("serial", cert.Serial))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="certificate-`+cert.Serial+`.pdf"`)
+		w.Write(cert.Pdf)
+	}
+}
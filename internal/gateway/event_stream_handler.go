@@ -0,0 +1,124 @@
+package gateway
+
+import (
+	"GoEdu/proto"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// sseHeartbeat — как часто в поток отправляется комментарий, чтобы прокси не закрывали простаивающее соединение.
+const sseHeartbeat = 15 * time.Second
+
+// NewEventStreamHandler отдаёт EventService.SubscribeEvents как Server-Sent Events. Фильтры передаются
+// query-параметрами course_id, student_id и event_types, а место возобновления — заголовком Last-Event-ID,
+// который браузер отправляет сам при переподключении, или параметром last_event_id. Поскольку EventSource
+// не умеет задавать заголовки, токен можно передать параметром access_token.
+func NewEventStreamHandler(client proto.EventServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Потоковая передача не поддерживается", http.StatusInternalServerError)
+			return
+		}
+
+		query := r.URL.Query()
+		req := &proto.SubscribeEventsRequest{EventTypes: query["event_types"], LastEventId: r.Header.Get("Last-Event-ID")}
+		if req.LastEventId == "" {
+			req.LastEventId = query.Get("last_event_id")
+		}
+		var err error
+		if value := query.Get("course_id"); value != "" {
+			if req.CourseId, err = strconv.ParseInt(value, 10, 64); err != nil {
+				http.Error(w, "Некорректный ID курса", http.StatusBadRequest)
+				return
+			}
+		}
+		if value := query.Get("student_id"); value != "" {
+			if req.StudentId, err = strconv.ParseInt(value, 10, 64); err != nil {
+				http.Error(w, "Некорректный ID студента", http.StatusBadRequest)
+				return
+			}
+		}
+
+		authorization := r.Header.Get("Authorization")
+		if authorization == "" && query.Get("access_token") != "" {
+			authorization = "Bearer " + query.Get("access_token")
+		}
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs("authorization", authorization))
+
+		stream, err := client.SubscribeEvents(ctx, req)
+		if err == nil {
+			// Сервер отправляет заголовки после подписки; если их нет, поток завершился ошибкой.
+			if header, _ := stream.Header(); header == nil {
+				_, err = stream.Recv()
+			}
+		}
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		logger.Info("Открыт поток событий SSE", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ": subscribed\n\n")
+		flusher.Flush()
+
+		received := make(chan *proto.DomainEvent)
+		failed := make(chan error, 1)
+		go func() {
+			for {
+				event, err := stream.Recv()
+				if err != nil {
+					failed <- err
+					return
+				}
+				select {
+				case received <- event:
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}()
+
+		heartbeat := time.NewTicker(sseHeartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				logger.Info("Клиент закрыл поток событий SSE")
+				return
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": ping\n\n")
+			case event := <-received:
+				data, err := protojson.Marshal(event)
+				if err != nil {
+					logger.Error("Ошибка сериализации события", zap.Error(err))
+					continue
+				}
+				fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+			case err := <-failed:
+				st := status.Convert(err)
+				logger.Warn("Поток событий SSE прерван", zap.String("code", st.Code().String()), zap.String("message", st.Message()))
+				data, _ := protojson.Marshal(st.Proto())
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+				flusher.Flush()
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
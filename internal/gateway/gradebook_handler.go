@@ -0,0 +1,50 @@
+package gateway
+
+import (
+	"GoEdu/proto"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NewGradebookDownloadHandler отдаёт журнал оценок курса файлом. Формат задаётся параметром format: csv (по умолчанию) или xlsx.
+func NewGradebookDownloadHandler(client proto.GradebookServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		courseID, err := strconv.ParseInt(mux.Vars(r)["course_id"], 10, 64)
+		if err != nil || courseID <= 0 {
+			http.Error(w, "Некорректный ID курса", http.StatusBadRequest)
+			return
+		}
+
+		req := &proto.ExportGradebookRequest{CourseId: courseID}
+		switch r.URL.Query().Get("format") {
+		case "", "csv":
+			req.Format = proto.GradebookFormat_GRADEBOOK_FORMAT_CSV
+		case "xlsx":
+			req.Format = proto.GradebookFormat_GRADEBOOK_FORMAT_XLSX
+		default:
+			http.Error(w, "Неизвестный формат: ожидается csv или xlsx", http.StatusBadRequest)
+			return
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+		file, err := client.ExportGradebook(ctx, req)
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		logger.Info("Скачивание журнала оценок", zap.Int64("course_id", courseID), zap.String("filename", file.Filename))
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Filename}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Write(file.Content)
+	}
+}
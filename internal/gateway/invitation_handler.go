@@ -0,0 +1,76 @@
+package gateway
+
+import (
+	"GoEdu/proto"
+	"html/template"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+var invitationPage = template.Must(template.New("invitation").Parse(`<!DOCTYPE html>
+<html lang="ru">
+<head><meta charset="utf-8"><title>Приглашение в GoEdu</title></head>
+<body>
+{{if .Done}}
+<p>Пароль установлен. Теперь вы можете войти в GoEdu со своим email.</p>
+{{else}}
+<h1>Приглашение в GoEdu</h1>
+{{if .Error}}<p>{{.Error}}</p>{{end}}
+<form method="post">
+  <input type="hidden" name="token" value="{{.Token}}">
+  <label>Пароль: <input type="password" name="password" required></label>
+  <button type="submit">Задать пароль</button>
+</form>
+{{end}}
+</body>
+</html>
+`))
+
+type invitationPageData struct {
+	Token string
+	Error string
+	Done  bool
+}
+
+// NewInvitationHandler обслуживает ссылку из письма-приглашения: GET показывает форму установки пароля,
+// POST формы (application/x-www-form-urlencoded) передаётся в StudentService.AcceptInvitation.
+// JSON-запросы на тот же адрес по-прежнему обрабатывает gRPC Gateway.
+func NewInvitationHandler(client proto.StudentServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+
+		if r.Method == http.MethodGet {
+			token := r.URL.Query().Get("token")
+			if token == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				invitationPage.Execute(w, invitationPageData{Error: "Ссылка приглашения не содержит токен"})
+				return
+			}
+			invitationPage.Execute(w, invitationPageData{Token: token})
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Некорректные данные формы", http.StatusBadRequest)
+			return
+		}
+		token := r.PostForm.Get("token")
+		_, err := client.AcceptInvitation(r.Context(), &proto.AcceptInvitationRequest{
+			Token:    token,
+			Password: r.PostForm.Get("password"),
+		})
+		if err != nil {
+			st := status.Convert(err)
+			logger.Warn("Не удалось принять приглашение", zap.String("code", st.Code().String()))
+			w.WriteHeader(http.StatusBadRequest)
+			invitationPage.Execute(w, invitationPageData{Token: token, Error: st.Message()})
+			return
+		}
+
+		logger.Info("Приглашение принято через форму")
+		invitationPage.Execute(w, invitationPageData{Done: true})
+	}
+}
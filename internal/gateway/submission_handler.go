@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"GoEdu/proto"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const maxSubmissionUploadSize = 64 << 20
+
+// NewSubmissionUploadHandler принимает решение задания как multipart/form-data и передаёт его в AssignmentService.SubmitAssignment.
+// Поля формы: student_id, text и любое число файлов в поле files.
+func NewSubmissionUploadHandler(client proto.AssignmentServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assignmentID, err := strconv.ParseInt(mux.Vars(r)["assignment_id"], 10, 64)
+		if err != nil || assignmentID <= 0 {
+			http.Error(w, "Некорректный ID задания", http.StatusBadRequest)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxSubmissionUploadSize)
+		if err := r.ParseMultipartForm(maxSubmissionUploadSize); err != nil {
+			logger.Warn("Не удалось разобрать multipart-запрос", zap.Error(err))
+			http.Error(w, "Ожидается multipart/form-data с полями student_id, text и files", http.StatusBadRequest)
+			return
+		}
+
+		studentID, err := strconv.ParseInt(r.FormValue("student_id"), 10, 64)
+		if err != nil || studentID <= 0 {
+			http.Error(w, "Некорректный ID студента", http.StatusBadRequest)
+			return
+		}
+
+		req := &proto.SubmitAssignmentRequest{
+			AssignmentId: assignmentID,
+			StudentId:    studentID,
+			Text:         r.FormValue("text"),
+		}
+		for _, header := range r.MultipartForm.File["files"] {
+			file, err := header.Open()
+			if err != nil {
+				logger.Error("Ошибка чтения загруженного файла", zap.Error(err), zap.String("filename", header.Filename))
+				http.Error(w, "Ошибка чтения файла", http.StatusBadRequest)
+				return
+			}
+			data, err := io.ReadAll(file)
+			file.Close()
+			if err != nil {
+				logger.Error("Ошибка чтения загруженного файла", zap.Error(err), zap.String("filename", header.Filename))
+				http.Error(w, "Ошибка чтения файла", http.StatusBadRequest)
+				return
+			}
+			req.Files = append(req.Files, &proto.UploadedFile{
+				Filename:    header.Filename,
+				ContentType: header.Header.Get("Content-Type"),
+				Content:     data,
+			})
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+		resp, err := client.SubmitAssignment(ctx, req)
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		body, err := protojson.Marshal(resp)
+		if err != nil {
+			logger.Error("Ошибка сериализации ответа", zap.Error(err))
+			http.Error(w, "Ошибка сериализации ответа", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body)
+	}
+}
+
+// NewSubmissionFileHandler отдаёт файл решения в исходном виде, чтобы его можно было скачать по прямой ссылке.
+func NewSubmissionFileHandler(client proto.AssignmentServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fileID, err := strconv.ParseInt(mux.Vars(r)["file_id"], 10, 64)
+		if err != nil || fileID <= 0 {
+			http.Error(w, "Некорректный ID файла", http.StatusBadRequest)
+			return
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+		file, err := client.GetSubmissionFile(ctx, &proto.SubmissionFileRequest{FileId: fileID})
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		logger.Info("Скачивание файла решения", zap.Int64("file_id", fileID), zap.String("filename", file.Filename))
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Filename}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Write(file.Content)
+	}
+}
@@ -0,0 +1,73 @@
+package gateway
+
+import (
+	"GoEdu/proto"
+	"html/template"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html lang="ru">
+<head><meta charset="utf-8"><title>Отписка от писем GoEdu</title></head>
+<body>
+{{if .Done}}
+<p>Вы отписались от писем GoEdu. Включить их снова можно в настройках уведомлений.</p>
+{{else}}
+<h1>Отписка от писем GoEdu</h1>
+{{if .Error}}<p>{{.Error}}</p>{{end}}
+{{if .Token}}
+<form method="post">
+  <input type="hidden" name="token" value="{{.Token}}">
+  <button type="submit">Отписаться от писем</button>
+</form>
+{{end}}
+{{end}}
+</body>
+</html>
+`))
+
+type unsubscribePageData struct {
+	Token string
+	Error string
+	Done  bool
+}
+
+// NewUnsubscribeHandler обслуживает ссылку отписки из писем: GET только показывает страницу подтверждения,
+// а отписка выполняется отправкой формы (application/x-www-form-urlencoded) через NotificationService.Unsubscribe.
+// Так ссылку не может «нажать» почтовый сканер или предзагрузка. JSON-запросы обрабатывает gRPC Gateway.
+func NewUnsubscribeHandler(client proto.NotificationServiceClient, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+
+		if r.Method == http.MethodGet {
+			token := r.URL.Query().Get("token")
+			if token == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				unsubscribePage.Execute(w, unsubscribePageData{Error: "Ссылка для отписки не содержит токен"})
+				return
+			}
+			unsubscribePage.Execute(w, unsubscribePageData{Token: token})
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Некорректные данные формы", http.StatusBadRequest)
+			return
+		}
+		resp, err := client.Unsubscribe(r.Context(), &proto.UnsubscribeRequest{Token: r.FormValue("token")})
+		if err != nil {
+			st := status.Convert(err)
+			logger.Warn("Не удалось отписать от писем", zap.String("code", st.Code().String()))
+			w.WriteHeader(http.StatusBadRequest)
+			unsubscribePage.Execute(w, unsubscribePageData{Error: st.Message()})
+			return
+		}
+
+		logger.Info("Отписка от писем через форму", zap.Int64("student_id", resp.StudentId))
+		unsubscribePage.Execute(w, unsubscribePageData{Done: true})
+	}
+}
@@ -0,0 +1,180 @@
+package gradebook
+
+import (
+	"GoEdu/internal/models"
+	"math"
+	"slices"
+)
+
+// DefaultLetters — шкала буквенных оценок для курсов, которые не задали свою.
+var DefaultLetters = []*models.LetterGrade{
+	{Letter: "A", MinPercent: 90},
+	{Letter: "B", MinPercent: 80},
+	{Letter: "C", MinPercent: 70},
+	{Letter: "D", MinPercent: 60},
+	{Letter: "F", MinPercent: 0},
+}
+
+// Course — настройки журнала курса: категории, оцениваемые элементы и шкала буквенных оценок
+// (по убыванию порога).
+type Course struct {
+	Categories []*models.GradeCategory
+	Items      []*models.GradeItem
+	Letters    []*models.LetterGrade
+}
+
+// ItemGrade — оценка студента за элемент курса с учётом ручного изменения.
+type ItemGrade struct {
+	Item     *models.GradeItem
+	Graded   bool
+	Score    float64
+	Adjusted bool
+}
+
+// CategoryGrade — средний процент студента по оценённым элементам категории.
+type CategoryGrade struct {
+	Category *models.GradeCategory
+	Graded   bool
+	Percent  float64
+}
+
+// StudentGrades — оценки студента по элементам и категориям, итоговый процент и буквенная оценка.
+type StudentGrades struct {
+	Student       *models.Student
+	Items         []*ItemGrade
+	Categories    []*CategoryGrade
+	Graded        bool
+	FinalPercent  float64
+	FinalAdjusted bool
+	Letter        string
+}
+
+type itemKey struct {
+	itemType string
+	itemID   int64
+}
+
+// Compute считает оценки студентов курса. Процент за категорию — среднее процентов по оценённым
+// элементам категории; итоговый процент — среднее по категориям с оценками, взвешенное весами категорий.
+// Если категорий нет, все элементы учитываются с равным весом; иначе элементы без категории в итог не входят.
+// Действует последнее ручное изменение каждой оценки (adjustments — в порядке внесения), изменение с
+// Score = nil возвращает рассчитанную оценку. Ручное изменение итоговой оценки заменяет рассчитанный процент.
+func Compute(course *Course, students []*models.Student, scores []*models.ItemScore, adjustments []*models.GradeAdjustment) []*StudentGrades {
+	studentScores := make(map[int64]map[itemKey]float64, len(students))
+	for _, score := range scores {
+		if studentScores[score.StudentID] == nil {
+			studentScores[score.StudentID] = make(map[itemKey]float64)
+		}
+		studentScores[score.StudentID][itemKey{score.ItemType, score.ItemID}] = score.Score
+	}
+
+	studentAdjustments := make(map[int64]map[itemKey]*float64, len(students))
+	for _, adjustment := range adjustments {
+		if studentAdjustments[adjustment.StudentID] == nil {
+			studentAdjustments[adjustment.StudentID] = make(map[itemKey]*float64)
+		}
+		studentAdjustments[adjustment.StudentID][itemKey{adjustment.ItemType, adjustment.ItemID}] = adjustment.Score
+	}
+
+	result := make([]*StudentGrades, 0, len(students))
+	for _, student := range students {
+		result = append(result, computeStudent(course, student, studentScores[student.ID], studentAdjustments[student.ID]))
+	}
+	return result
+}
+
+func computeStudent(course *Course, student *models.Student, scores map[itemKey]float64, adjustments map[itemKey]*float64) *StudentGrades {
+	grades := &StudentGrades{Student: student}
+
+	categoryPercents := make(map[int64][]float64, len(course.Categories))
+	var allPercents []float64
+	for _, item := range course.Items {
+		key := itemKey{item.Type, item.ID}
+		grade := &ItemGrade{Item: item}
+		if score, ok := scores[key]; ok {
+			grade.Graded, grade.Score = true, score
+		}
+		if score := adjustments[key]; score != nil {
+			grade.Graded, grade.Score, grade.Adjusted = true, *score, true
+		}
+		grades.Items = append(grades.Items, grade)
+
+		if !grade.Graded || item.MaxScore <= 0 {
+			continue
+		}
+		percent := grade.Score / item.MaxScore * 100
+		allPercents = append(allPercents, percent)
+		if item.CategoryID != nil {
+			categoryPercents[*item.CategoryID] = append(categoryPercents[*item.CategoryID], percent)
+		}
+	}
+
+	if len(course.Categories) == 0 {
+		if len(allPercents) > 0 {
+			grades.Graded, grades.FinalPercent = true, mean(allPercents)
+		}
+	} else {
+		var weighted, totalWeight float64
+		for _, category := range course.Categories {
+			grade := &CategoryGrade{Category: category}
+			if percents := categoryPercents[category.ID]; len(percents) > 0 {
+				grade.Graded, grade.Percent = true, mean(percents)
+				weighted += grade.Percent * category.Weight
+				totalWeight += category.Weight
+			}
+			grades.Categories = append(grades.Categories, grade)
+		}
+		if totalWeight > 0 {
+			grades.Graded, grades.FinalPercent = true, weighted/totalWeight
+		}
+	}
+
+	if score := adjustments[itemKey{models.GradeItemFinal, 0}]; score != nil {
+		grades.Graded, grades.FinalPercent, grades.FinalAdjusted = true, *score, true
+	}
+	grades.FinalPercent = Round(grades.FinalPercent)
+	if grades.Graded {
+		grades.Letter = Letter(course.Letters, grades.FinalPercent)
+	}
+	return grades
+}
+
+// Letter возвращает буквенную оценку для процента: первую из шкалы (по убыванию порога), порог которой
+// не выше процента. Если процент ниже всех порогов, возвращается последняя оценка шкалы.
+func Letter(letters []*models.LetterGrade, percent float64) string {
+	if len(letters) == 0 {
+		return ""
+	}
+	for _, letter := range letters {
+		if percent >= letter.MinPercent {
+			return letter.Letter
+		}
+	}
+	return letters[len(letters)-1].Letter
+}
+
+// SortLetters упорядочивает шкалу по убыванию порога.
+func SortLetters(letters []*models.LetterGrade) {
+	slices.SortFunc(letters, func(a, b *models.LetterGrade) int {
+		switch {
+		case a.MinPercent > b.MinPercent:
+			return -1
+		case a.MinPercent < b.MinPercent:
+			return 1
+		}
+		return 0
+	})
+}
+
+// Round округляет оценку до сотых.
+func Round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
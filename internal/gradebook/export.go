@@ -0,0 +1,175 @@
+package gradebook
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Table строит таблицу журнала: строка заголовков и по строке на студента. Ячейки — string,
+// float64 или nil для элементов без оценки.
+func Table(course *Course, grades []*StudentGrades) [][]any {
+	header := []any{"ID студента", "Имя", "Email"}
+	for _, item := range course.Items {
+		header = append(header, fmt.Sprintf("%s (%s)", item.Title, formatNumber(item.MaxScore)))
+	}
+	for _, category := range course.Categories {
+		header = append(header, fmt.Sprintf("%s, %% (вес %s)", category.Name, formatNumber(category.Weight)))
+	}
+	header = append(header, "Итог, %", "Оценка")
+
+	rows := [][]any{header}
+	for _, student := range grades {
+		row := []any{float64(student.Student.ID), student.Student.Name, student.Student.Email}
+		for _, item := range student.Items {
+			row = append(row, optionalNumber(item.Graded, item.Score))
+		}
+		for _, category := range student.Categories {
+			row = append(row, optionalNumber(category.Graded, category.Percent))
+		}
+		row = append(row, optionalNumber(student.Graded, student.FinalPercent), student.Letter)
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func optionalNumber(ok bool, value float64) any {
+	if !ok {
+		return nil
+	}
+	return Round(value)
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func cellText(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return formatNumber(v)
+	}
+	return ""
+}
+
+// csvFormulaPrefixes — первые символы, с которых Excel и другие табличные редакторы начинают формулу.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// csvText экранирует текстовую ячейку апострофом, если редактор прочитает её как формулу:
+// имена и email студентов вводят сами студенты.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// WriteCSV записывает таблицу в CSV с меткой порядка байтов UTF-8, чтобы Excel распознал кодировку.
+// Текстовые ячейки, похожие на формулы, экранируются; числа записываются как есть.
+func WriteCSV(w io.Writer, rows [][]any) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			if text, ok := value.(string); ok {
+				record[i] = csvText(text)
+				continue
+			}
+			record[i] = cellText(value)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+</Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Журнал" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`
+)
+
+// WriteXLSX записывает таблицу в книгу XLSX с одним листом. Числа сохраняются числовыми ячейками,
+// остальные значения — строками.
+func WriteXLSX(w io.Writer, rows [][]any) error {
+	archive := zip.NewWriter(w)
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/worksheets/sheet1.xml", sheetXML(rows)},
+	}
+	for _, part := range parts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(file, part.content); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+func sheetXML(rows [][]any) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		fmt.Fprintf(&b, `<row r="%d">`, i+1)
+		for j, value := range row {
+			ref := columnName(j) + strconv.Itoa(i+1)
+			switch v := value.(type) {
+			case float64:
+				fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, formatNumber(v))
+			case string:
+				fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t>`, ref)
+				xml.EscapeText(&b, []byte(v))
+				b.WriteString(`</t></is></c>`)
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// columnName переводит номер столбца (с нуля) в буквенное обозначение: 0 — A, 25 — Z, 26 — AA.
+func columnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"GoEdu/internal/repository"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const announcementPublishBatch = 100
+
+// AnnouncementPublisher периодически публикует объявления, запланированные на будущее.
+type AnnouncementPublisher struct {
+	announcementRepo repository.AnnouncementRepository
+	interval         time.Duration
+	logger           *zap.Logger
+}
+
+func NewAnnouncementPublisher(announcementRepo repository.AnnouncementRepository, interval time.Duration, logger *zap.Logger) *AnnouncementPublisher {
+	return &AnnouncementPublisher{
+		announcementRepo: announcementRepo,
+		interval:         interval,
+		logger:           logger,
+	}
+}
+
+// Run публикует объявления сразу и затем с заданным интервалом до отмены контекста.
+func (p *AnnouncementPublisher) Run(ctx context.Context) {
+	p.logger.Info("Запущена публикация запланированных объявлений", zap.Duration("interval", p.interval))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PublishDue(ctx, time.Now()); err != nil {
+			p.logger.Error("Ошибка публикации запланированных объявлений", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			p.logger.Info("Публикация запланированных объявлений остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishDue публикует объявления, время публикации которых наступило к моменту now, и возвращает их число.
+// Студенты получают уведомления об объявлениях через outbox.
+func (p *AnnouncementPublisher) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	total := 0
+	for {
+		published, err := p.announcementRepo.PublishDue(ctx, now, announcementPublishBatch)
+		if err != nil {
+			return total, fmt.Errorf("не удалось опубликовать объявления: %w", err)
+		}
+		total += published
+		if published < announcementPublishBatch {
+			break
+		}
+	}
+
+	if total > 0 {
+		p.logger.Info("Опубликованы запланированные объявления", zap.Int("count", total))
+	}
+	return total, nil
+}
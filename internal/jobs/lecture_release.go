@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"GoEdu/internal/mailer"
+	"GoEdu/internal/repository"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// releaseNoticeLookback ограничивает, насколько давно открытые лекции попадают в рассылку:
+	// после долгого простоя сервера студенты не получат письма о давно доступных лекциях.
+	releaseNoticeLookback = 7 * 24 * time.Hour
+	releaseNoticeBatch    = 500
+)
+
+// LectureReleaseNotifier периодически уведомляет студентов об открывшихся по расписанию лекциях.
+type LectureReleaseNotifier struct {
+	lectureRepo repository.LectureRepository
+	mailer      mailer.Mailer
+	appBaseURL  string
+	interval    time.Duration
+	logger      *zap.Logger
+}
+
+func NewLectureReleaseNotifier(lectureRepo repository.LectureRepository, mailer mailer.Mailer, appBaseURL string, interval time.Duration, logger *zap.Logger) *LectureReleaseNotifier {
+	return &LectureReleaseNotifier{
+		lectureRepo: lectureRepo,
+		mailer:      mailer,
+		appBaseURL:  strings.TrimRight(appBaseURL, "/"),
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// Run выполняет проверку сразу и затем с заданным интервалом до отмены контекста.
+func (n *LectureReleaseNotifier) Run(ctx context.Context) {
+	n.logger.Info("Запущена рассылка уведомлений об открытии лекций", zap.Duration("interval", n.interval))
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := n.NotifyReleased(ctx); err != nil {
+			n.logger.Error("Ошибка рассылки уведомлений об открытии лекций", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			n.logger.Info("Рассылка уведомлений об открытии лекций остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// NotifyReleased отправляет письма о лекциях, открывшихся с прошлой проверки, и возвращает число отправленных писем.
+// Уведомление отмечается только после успешной отправки, поэтому при ошибке письмо будет повторено.
+func (n *LectureReleaseNotifier) NotifyReleased(ctx context.Context) (int, error) {
+	notices, err := n.lectureRepo.GetPendingReleaseNotices(ctx, time.Now().Add(-releaseNoticeLookback), releaseNoticeBatch)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить открывшиеся лекции: %w", err)
+	}
+
+	sent := 0
+	for _, notice := range notices {
+		link := fmt.Sprintf("%s/courses/%d/lectures/%d", n.appBaseURL, notice.CourseID, notice.LectureID)
+		body := fmt.Sprintf("Здравствуйте, %s!\n\nВ курсе «%s» открылась лекция «%s».\nПерейти к лекции:\n%s", notice.StudentName, notice.CourseName, notice.LectureTitle, link)
+
+		if err := n.mailer.Send(ctx, notice.StudentEmail, "Открыта новая лекция: "+notice.LectureTitle, body); err != nil {
+			n.logger.Error("Не удалось отправить уведомление об открытии лекции", zap.Error(err), zap.Int64("student_id", notice.StudentID), zap.Int64("lecture_id", notice.LectureID))
+			continue
+		}
+
+		if err := n.lectureRepo.MarkReleaseNotified(ctx, notice.StudentID, notice.LectureID); err != nil {
+			return sent, fmt.Errorf("не удалось сохранить отметку об уведомлении: %w", err)
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		n.logger.Info("Отправлены уведомления об открытии лекций", zap.Int("count", sent))
+	}
+	return sent, nil
+}
@@ -0,0 +1,159 @@
+package jobs
+
+import (
+	"GoEdu/internal/models"
+	"GoEdu/internal/notification"
+	"GoEdu/internal/repository"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	notificationEmailBatch = 200
+	// immediateEmailLookback ограничивает, насколько старые уведомления отправляются письмом сразу:
+	// после долгого простоя сервера студенты не получат писем о давних событиях.
+	immediateEmailLookback = 24 * time.Hour
+)
+
+// NotificationMailer периодически отправляет студентам письма с уведомлениями: каждое уведомление отдельно
+// или сводкой раз в день или в неделю, в зависимости от настроек студента.
+type NotificationMailer struct {
+	notificationRepo  repository.NotificationRepository
+	channel           notification.Channel
+	appBaseURL        string
+	unsubscribeSecret string
+	digestHour        int
+	interval          time.Duration
+	logger            *zap.Logger
+}
+
+func NewNotificationMailer(notificationRepo repository.NotificationRepository, channel notification.Channel, appBaseURL, unsubscribeSecret string,
+	digestHour int, interval time.Duration, logger *zap.Logger) *NotificationMailer {
+	return &NotificationMailer{
+		notificationRepo:  notificationRepo,
+		channel:           channel,
+		appBaseURL:        strings.TrimRight(appBaseURL, "/"),
+		unsubscribeSecret: unsubscribeSecret,
+		digestHour:        digestHour,
+		interval:          interval,
+		logger:            logger,
+	}
+}
+
+// Run отправляет письма сразу и затем с заданным интервалом до отмены контекста.
+func (m *NotificationMailer) Run(ctx context.Context) {
+	m.logger.Info("Запущена отправка писем с уведомлениями", zap.Duration("interval", m.interval), zap.String("channel", m.channel.Name()))
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.SendPending(ctx, time.Now()); err != nil {
+			m.logger.Error("Ошибка отправки писем с уведомлениями", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			m.logger.Info("Отправка писем с уведомлениями остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendPending отправляет письма об уведомлениях, которые пора отправить на момент now, и возвращает число писем.
+// Сводки включают непрочитанные уведомления, созданные до последнего наступившего часа сводок: ежедневная —
+// каждый день, еженедельная — по понедельникам. Уведомления отмечаются только после успешной отправки,
+// поэтому при ошибке письмо будет повторено.
+func (m *NotificationMailer) SendPending(ctx context.Context, now time.Time) (int, error) {
+	sent := 0
+	for _, frequency := range []string{models.EmailImmediate, models.EmailDaily, models.EmailWeekly} {
+		since, until := m.window(frequency, now)
+		emails, err := m.notificationRepo.GetPendingEmails(ctx, frequency, since, until, notificationEmailBatch)
+		if err != nil {
+			return sent, fmt.Errorf("не удалось получить уведомления для писем: %w", err)
+		}
+
+		for _, email := range emails {
+			// Каждое уведомление с частотой immediate отправляется отдельным письмом.
+			batches := [][]*models.Notification{email.Notifications}
+			if frequency == models.EmailImmediate {
+				batches = batches[:0]
+				for _, n := range email.Notifications {
+					batches = append(batches, []*models.Notification{n})
+				}
+			}
+
+			for _, batch := range batches {
+				ok, err := m.send(ctx, email, batch, frequency, now)
+				if err != nil {
+					return sent, err
+				}
+				if ok {
+					sent++
+				}
+			}
+		}
+	}
+
+	if sent > 0 {
+		m.logger.Info("Отправлены письма с уведомлениями", zap.Int("count", sent))
+	}
+	return sent, nil
+}
+
+func (m *NotificationMailer) send(ctx context.Context, email *models.NotificationEmail, notifications []*models.Notification, frequency string, now time.Time) (bool, error) {
+	unsubscribeURL := m.appBaseURL + "/v1/notifications/unsubscribe?token=" + url.QueryEscape(notification.UnsubscribeToken(m.unsubscribeSecret, email.StudentID, now))
+	message, err := notification.ComposeEmail(&models.NotificationEmail{
+		StudentID:     email.StudentID,
+		StudentName:   email.StudentName,
+		Email:         email.Email,
+		Locale:        email.Locale,
+		Notifications: notifications,
+	}, frequency, m.appBaseURL, unsubscribeURL)
+	if err != nil {
+		m.logger.Error("Не удалось составить письмо с уведомлениями", zap.Error(err), zap.Int64("student_id", email.StudentID))
+		return false, nil
+	}
+
+	if err := m.channel.Send(ctx, email.Email, message); err != nil {
+		m.logger.Error("Не удалось отправить письмо с уведомлениями", zap.Error(err), zap.Int64("student_id", email.StudentID))
+		return false, nil
+	}
+
+	ids := make([]int64, 0, len(notifications))
+	for _, n := range notifications {
+		ids = append(ids, n.ID)
+	}
+	if err := m.notificationRepo.MarkEmailed(ctx, email.StudentID, ids, now); err != nil {
+		return false, fmt.Errorf("не удалось сохранить отметку об отправке письма: %w", err)
+	}
+	return true, nil
+}
+
+// window возвращает промежуток создания уведомлений, которые попадают в письма с частотой frequency на момент now.
+// Для сводок он заканчивается последним наступившим часом сводок и захватывает два периода, чтобы после
+// простоя сервера не потерять уведомления.
+func (m *NotificationMailer) window(frequency string, now time.Time) (time.Time, time.Time) {
+	if frequency == models.EmailImmediate {
+		return now.Add(-immediateEmailLookback), now
+	}
+
+	utc := now.UTC()
+	until := time.Date(utc.Year(), utc.Month(), utc.Day(), m.digestHour, 0, 0, 0, time.UTC)
+	if until.After(utc) {
+		until = until.AddDate(0, 0, -1)
+	}
+	days := 1
+	if frequency == models.EmailWeekly {
+		days = 7
+		until = until.AddDate(0, 0, -int((until.Weekday()+6)%7))
+	}
+	until = until.In(now.Location())
+	return until.AddDate(0, 0, -2*days), until
+}
@@ -0,0 +1,108 @@
+package jobs
+
+import (
+	"GoEdu/internal/events"
+	"GoEdu/internal/repository"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// outboxLease — на сколько откладывается повтор выбранного события, пока ретранслятор его публикует.
+	outboxLease = time.Minute
+	// Задержка повторной доставки растёт вдвое с каждой неудачей от outboxMinRetry до outboxMaxRetry.
+	outboxMinRetry = 5 * time.Second
+	outboxMaxRetry = time.Hour
+)
+
+// OutboxRelay периодически публикует накопившиеся в outbox доменные события в шину и удаляет
+// опубликованные события старше срока хранения.
+type OutboxRelay struct {
+	outboxRepo repository.OutboxRepository
+	bus        *events.Bus
+	batchSize  int
+	retention  time.Duration
+	interval   time.Duration
+	logger     *zap.Logger
+}
+
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, bus *events.Bus, batchSize int, retention, interval time.Duration, logger *zap.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo: outboxRepo,
+		bus:        bus,
+		batchSize:  batchSize,
+		retention:  retention,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Run публикует события сразу и затем с заданным интервалом до отмены контекста.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	r.logger.Info("Запущена публикация доменных событий", zap.Duration("interval", r.interval), zap.Int("batch_size", r.batchSize))
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RelayPending(ctx); err != nil {
+			r.logger.Error("Ошибка публикации доменных событий", zap.Error(err))
+		}
+		if deleted, err := r.outboxRepo.DeletePublishedEvents(ctx, time.Now().Add(-r.retention)); err != nil {
+			r.logger.Error("Не удалось удалить опубликованные события", zap.Error(err))
+		} else if deleted > 0 {
+			r.logger.Info("Удалены опубликованные события", zap.Int64("count", deleted))
+		}
+
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Публикация доменных событий остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending публикует пачками все события, время доставки которых наступило, и возвращает число
+// успешно опубликованных. Событие, которое не удалось доставить, откладывается с растущей задержкой.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		batch, err := r.outboxRepo.ClaimPendingEvents(ctx, r.batchSize, outboxLease)
+		if err != nil {
+			return published, fmt.Errorf("не удалось выбрать события для публикации: %w", err)
+		}
+
+		for _, event := range batch {
+			if err := r.bus.Publish(ctx, event); err != nil {
+				retryAt := time.Now().Add(outboxRetryDelay(event.Attempts))
+				r.logger.Warn("Не удалось опубликовать событие", zap.Error(err), zap.String("event_id", event.EventID),
+					zap.String("event_type", event.EventType), zap.Int32("attempts", event.Attempts+1), zap.Time("retry_at", retryAt))
+				if err := r.outboxRepo.MarkEventFailed(ctx, event.ID, err.Error(), retryAt); err != nil {
+					return published, fmt.Errorf("не удалось отложить событие %s: %w", event.EventID, err)
+				}
+				continue
+			}
+			if err := r.outboxRepo.MarkEventPublished(ctx, event.ID); err != nil {
+				return published, fmt.Errorf("не удалось отметить событие %s опубликованным: %w", event.EventID, err)
+			}
+			published++
+		}
+
+		if len(batch) < r.batchSize {
+			return published, nil
+		}
+	}
+}
+
+// outboxRetryDelay возвращает задержку перед следующей попыткой после attempts неудачных.
+func outboxRetryDelay(attempts int32) time.Duration {
+	delay := outboxMinRetry
+	for i := int32(0); i < attempts && delay < outboxMaxRetry; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetry)
+}
@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"GoEdu/internal/models"
+	"GoEdu/internal/peerreview"
+	"GoEdu/internal/repository"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PeerReviewAllocator периодически распределяет решения заданий между рецензентами после срока сдачи.
+type PeerReviewAllocator struct {
+	assignmentRepo repository.AssignmentRepository
+	peerReviewRepo repository.PeerReviewRepository
+	enrollmentRepo repository.EnrollmentRepository
+	interval       time.Duration
+	logger         *zap.Logger
+}
+
+func NewPeerReviewAllocator(assignmentRepo repository.AssignmentRepository, peerReviewRepo repository.PeerReviewRepository, enrollmentRepo repository.EnrollmentRepository, interval time.Duration, logger *zap.Logger) *PeerReviewAllocator {
+	return &PeerReviewAllocator{
+		assignmentRepo: assignmentRepo,
+		peerReviewRepo: peerReviewRepo,
+		enrollmentRepo: enrollmentRepo,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+// Run выполняет распределение сразу и затем с заданным интервалом до отмены контекста.
+func (a *PeerReviewAllocator) Run(ctx context.Context) {
+	a.logger.Info("Запущено распределение решений на взаимную проверку", zap.Duration("interval", a.interval))
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.AllocateDue(ctx); err != nil {
+			a.logger.Error("Ошибка распределения решений на взаимную проверку", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			a.logger.Info("Распределение решений на взаимную проверку остановлено")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// AllocateDue распределяет решения всех заданий, срок сдачи которых прошёл, и возвращает число обработанных заданий.
+// Рецензируется последняя версия решения каждого студента на момент распределения; рецензентами
+// становятся студенты, записанные на курс. Ошибка по одному заданию не мешает обработке остальных.
+func (a *PeerReviewAllocator) AllocateDue(ctx context.Context) (int, error) {
+	assignments, err := a.peerReviewRepo.GetAssignmentsAwaitingAllocation(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить задания для взаимной проверки: %w", err)
+	}
+
+	allocated := 0
+	for _, assignment := range assignments {
+		reviews, ok, err := a.allocate(ctx, assignment)
+		if err != nil {
+			a.logger.Error("Не удалось распределить решения задания", zap.Error(err), zap.Int64("assignment_id", assignment.ID))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		allocated++
+		a.logger.Info("Решения распределены на взаимную проверку", zap.Int64("assignment_id", assignment.ID), zap.Int("reviews", reviews))
+	}
+	return allocated, nil
+}
+
+// allocate возвращает число назначенных рецензий; ok = false, если задание уже распределено параллельно.
+func (a *PeerReviewAllocator) allocate(ctx context.Context, assignment *models.Assignment) (int, bool, error) {
+	submissions, err := a.assignmentRepo.GetSubmissions(ctx, assignment.ID, repository.SubmissionFilter{})
+	if err != nil {
+		return 0, false, fmt.Errorf("не удалось получить решения: %w", err)
+	}
+	students, err := a.enrollmentRepo.GetStudentsByCourse(ctx, assignment.CourseID)
+	if err != nil {
+		return 0, false, fmt.Errorf("не удалось получить студентов курса: %w", err)
+	}
+
+	reviewerIDs := make([]int64, 0, len(students))
+	for _, student := range students {
+		reviewerIDs = append(reviewerIDs, student.ID)
+	}
+
+	reviews := peerreview.Allocate(rand.Int64(), submissions, reviewerIDs, int(assignment.PeerReviewCount))
+	ok, err := a.peerReviewRepo.AllocatePeerReviews(ctx, assignment.ID, reviews)
+	if err != nil {
+		return 0, false, fmt.Errorf("не удалось сохранить назначения рецензентов: %w", err)
+	}
+	return len(reviews), ok, nil
+}
@@ -0,0 +1,73 @@
+package jobs
+
+import (
+	"GoEdu/internal/recommend"
+	"GoEdu/internal/repository"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RecommendationBuilder периодически пересчитывает близость курсов для персональных рекомендаций.
+type RecommendationBuilder struct {
+	recommendRepo repository.RecommendationRepository
+	courseRepo    repository.CourseRepository
+	interval      time.Duration
+	logger        *zap.Logger
+}
+
+func NewRecommendationBuilder(recommendRepo repository.RecommendationRepository, courseRepo repository.CourseRepository, interval time.Duration, logger *zap.Logger) *RecommendationBuilder {
+	return &RecommendationBuilder{
+		recommendRepo: recommendRepo,
+		courseRepo:    courseRepo,
+		interval:      interval,
+		logger:        logger,
+	}
+}
+
+// Run выполняет пересчёт сразу и затем с заданным интервалом до отмены контекста.
+func (b *RecommendationBuilder) Run(ctx context.Context) {
+	b.logger.Info("Запущен пересчёт рекомендаций курсов", zap.Duration("interval", b.interval))
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := b.Rebuild(ctx); err != nil {
+			b.logger.Error("Ошибка пересчёта рекомендаций курсов", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			b.logger.Info("Пересчёт рекомендаций курсов остановлен")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rebuild рассчитывает близость всех курсов по записям, прогрессу и отзывам студентов, а также по категориям
+// и тегам, заменяет ею сохранённую и возвращает число сохранённых пар курсов.
+func (b *RecommendationBuilder) Rebuild(ctx context.Context) (int, error) {
+	started := time.Now()
+
+	interactions, err := b.recommendRepo.GetInteractions(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить записи студентов: %w", err)
+	}
+	courses, err := b.courseRepo.GetAllCourses(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить курсы: %w", err)
+	}
+
+	similarities := recommend.Similarities(interactions, courses, recommend.Neighbours)
+	if err := b.recommendRepo.ReplaceSimilarities(ctx, similarities); err != nil {
+		return 0, fmt.Errorf("не удалось сохранить близость курсов: %w", err)
+	}
+
+	b.logger.Info("Рекомендации курсов пересчитаны", zap.Int("courses", len(courses)), zap.Int("interactions", len(interactions)),
+		zap.Int("pairs", len(similarities)), zap.Duration("duration", time.Since(started)))
+	return len(similarities), nil
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"GoEdu/internal/repository"
+	"GoEdu/internal/trending"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// TrendingBuilder периодически пересчитывает рейтинги популярности курсов за настроенные окна.
+type TrendingBuilder struct {
+	trendingRepo repository.TrendingRepository
+	windows      []int32
+	interval     time.Duration
+	logger       *zap.Logger
+}
+
+func NewTrendingBuilder(trendingRepo repository.TrendingRepository, windows []int32, interval time.Duration, logger *zap.Logger) *TrendingBuilder {
+	return &TrendingBuilder{
+		trendingRepo: trendingRepo,
+		windows:      windows,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+// Run выполняет пересчёт сразу и затем с заданным интервалом до отмены контекста.
+func (b *TrendingBuilder) Run(ctx context.Context) {
+	b.logger.Info("Запущен пересчёт популярных курсов", zap.Duration("interval", b.interval), zap.Int32s("windows", b.windows))
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.Rebuild(ctx); err != nil {
+			b.logger.Error("Ошибка пересчёта популярных курсов", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			b.logger.Info("Пересчёт популярных курсов остановлен")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rebuild рассчитывает популярность курсов по записям, завершениям и отзывам за каждое окно
+// и заменяет ею сохранённую. События загружаются один раз за самое длинное окно.
+func (b *TrendingBuilder) Rebuild(ctx context.Context) error {
+	if len(b.windows) == 0 {
+		return nil
+	}
+	now := time.Now()
+	longest := time.Duration(slices.Max(b.windows)) * 24 * time.Hour
+
+	events, err := b.trendingRepo.GetEvents(ctx, now.Add(-longest))
+	if err != nil {
+		return fmt.Errorf("не удалось получить события по курсам: %w", err)
+	}
+
+	for _, windowDays := range b.windows {
+		courses := trending.Rank(events, now, time.Duration(windowDays)*24*time.Hour)
+		if err := b.trendingRepo.ReplaceTrending(ctx, windowDays, courses); err != nil {
+			return fmt.Errorf("не удалось сохранить популярные курсы за %d дн.: %w", windowDays, err)
+		}
+		b.logger.Info("Популярные курсы пересчитаны", zap.Int32("window_days", windowDays),
+			zap.Int("courses", len(courses)), zap.Int("events", len(events)), zap.Duration("duration", time.Since(now)))
+	}
+	return nil
+}
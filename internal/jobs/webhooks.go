@@ -0,0 +1,119 @@
+package jobs
+
+import (
+	"GoEdu/internal/repository"
+	"GoEdu/internal/webhook"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	webhookBatchSize = 50
+	// webhookLease — на сколько откладывается повтор выбранной доставки, пока она отправляется.
+	webhookLease = 5 * time.Minute
+	// Задержка повторной отправки растёт вдвое с каждой неудачей от webhookMinRetry до webhookMaxRetry.
+	webhookMinRetry = 30 * time.Second
+	webhookMaxRetry = 6 * time.Hour
+)
+
+// WebhookDispatcher периодически отправляет ожидающие доставки вебхуков и повторяет неудачные
+// с экспоненциальной задержкой.
+type WebhookDispatcher struct {
+	webhookRepo  repository.WebhookRepository
+	client       *webhook.Client
+	maxAttempts  int32
+	disableAfter int32
+	interval     time.Duration
+	logger       *zap.Logger
+}
+
+func NewWebhookDispatcher(webhookRepo repository.WebhookRepository, client *webhook.Client, maxAttempts, disableAfter int32, interval time.Duration, logger *zap.Logger) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookRepo:  webhookRepo,
+		client:       client,
+		maxAttempts:  maxAttempts,
+		disableAfter: disableAfter,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+// Run отправляет доставки сразу и затем с заданным интервалом до отмены контекста.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	d.logger.Info("Запущена отправка вебхуков", zap.Duration("interval", d.interval))
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.DispatchPending(ctx); err != nil {
+			d.logger.Error("Ошибка отправки вебхуков", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			d.logger.Info("Отправка вебхуков остановлена")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending отправляет все доставки, время которых наступило, и возвращает число успешных.
+// Неудачная доставка повторяется, пока не исчерпано maxAttempts попыток, а подписка выключается
+// после disableAfter неудач подряд.
+func (d *WebhookDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	delivered := 0
+	for {
+		batch, err := d.webhookRepo.ClaimPendingDeliveries(ctx, webhookBatchSize, webhookLease)
+		if err != nil {
+			return delivered, fmt.Errorf("не удалось выбрать доставки вебхуков: %w", err)
+		}
+
+		for _, delivery := range batch {
+			result := d.client.Send(ctx, delivery)
+			if result.Err == nil {
+				if err := d.webhookRepo.RecordDeliverySuccess(ctx, delivery, result.StatusCode); err != nil {
+					return delivered, fmt.Errorf("не удалось сохранить результат доставки %d: %w", delivery.ID, err)
+				}
+				delivered++
+				continue
+			}
+
+			var retryAt *time.Time
+			attempts := delivery.Attempts + 1
+			if attempts < d.maxAttempts {
+				next := time.Now().Add(webhookRetryDelay(attempts))
+				retryAt = &next
+			}
+			d.logger.Warn("Не удалось доставить вебхук", zap.Error(result.Err), zap.Int64("delivery_id", delivery.ID),
+				zap.Int64("webhook_id", delivery.SubscriptionID), zap.Int32("status_code", result.StatusCode),
+				zap.Int32("attempts", attempts), zap.Timep("retry_at", retryAt))
+
+			disabled, err := d.webhookRepo.RecordDeliveryFailure(ctx, delivery, result.StatusCode, result.Err.Error(), retryAt, d.disableAfter)
+			if err != nil {
+				return delivered, fmt.Errorf("не удалось сохранить результат доставки %d: %w", delivery.ID, err)
+			}
+			if disabled {
+				d.logger.Warn("Подписка на вебхуки выключена после неудачных доставок подряд",
+					zap.Int64("webhook_id", delivery.SubscriptionID), zap.Int32("disable_after", d.disableAfter))
+			}
+		}
+
+		if len(batch) < webhookBatchSize {
+			return delivered, nil
+		}
+	}
+}
+
+// webhookRetryDelay возвращает задержку перед следующей попыткой после attempts неудачных.
+func webhookRetryDelay(attempts int32) time.Duration {
+	delay := webhookMinRetry
+	for i := int32(1); i < attempts && delay < webhookMaxRetry; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookMaxRetry)
+}
@@ -0,0 +1,118 @@
+package mailer
+
+import (
+	"GoEdu/internal/config"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// NewMailer возвращает SMTP-отправителя, если SMTP настроен, иначе письма только пишутся в лог.
+func NewMailer(cfg *config.Config, logger *zap.Logger) Mailer {
+	if cfg.SMTPHost == "" {
+		logger.Warn("SMTP не настроен, письма будут записываться в лог")
+		return &logMailer{logger: logger}
+	}
+	return &smtpMailer{
+		addr:     cfg.SMTPHost + ":" + cfg.SMTPPort,
+		host:     cfg.SMTPHost,
+		username: cfg.SMTPUsername,
+		password: cfg.SMTPPassword,
+		from:     cfg.SMTPFrom,
+	}
+}
+
+type smtpMailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// Send отправляет письмо, прерывая соединение с SMTP-сервером при отмене ctx.
+// Заголовок Subject кодируется по RFC 2047, так как темы писем содержат кириллицу.
+func (m *smtpMailer) Send(ctx context.Context, to, subject, body string) error {
+	msg := strings.Join([]string{
+		"From: " + m.from,
+		"To: " + to,
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	if err := m.send(ctx, to, []byte(msg)); err != nil {
+		return fmt.Errorf("не удалось отправить письмо на %s: %w", to, err)
+	}
+	return nil
+}
+
+// send повторяет smtp.SendMail, но устанавливает соединение с учётом ctx.
+func (m *smtpMailer) send(ctx context.Context, to string, msg []byte) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(m.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+type logMailer struct {
+	logger *zap.Logger
+}
+
+func (m *logMailer) Send(ctx context.Context, to, subject, body string) error {
+	m.logger.Info("Письмо (SMTP отключен)", zap.String("to", to), zap.String("subject", subject), zap.String("body", body))
+	return nil
+}
@@ -14,93 +14,267 @@ import (
 )
 
 func AuthInterceptor(jwtSecretKey []byte, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	authenticate := newAuthenticator(jwtSecretKey, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor применяет к потоковым методам те же правила, что и AuthInterceptor.
+func AuthStreamInterceptor(jwtSecretKey []byte, logger *zap.Logger) grpc.StreamServerInterceptor {
+	authenticate := newAuthenticator(jwtSecretKey, logger)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream подменяет контекст потока контекстом с пользователем.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// newAuthenticator возвращает проверку доступа к методу: публичные методы пропускаются, для остальных
+// проверяется JWT-токен и роль. Возвращает контекст с пользователем из токена.
+//
+// Ключи whitelist и roleProtectedMethods — полные имена gRPC-методов вида /<package>.<Service>/<Method>,
+// где package — пакет из proto/education.proto, то есть GoEdu. Ключи с префиксом /education. не совпадали
+// ни с одним методом: публичные методы требовали токен, а проверка ролей не применялась.
+func newAuthenticator(jwtSecretKey []byte, logger *zap.Logger) func(ctx context.Context, method string) (context.Context, error) {
 	whitelist := map[string]bool{
-		"/education.StudentService/RegisterStudent":       true,
-		"/education.StudentService/LoginStudent":          true,
-		"/education.InstructorService/RegisterInstructor": true,
+		"/GoEdu.StudentService/RegisterStudent":       true,
+		"/GoEdu.StudentService/LoginStudent":          true,
+		"/GoEdu.StudentService/AcceptInvitation":      true,
+		"/GoEdu.InstructorService/RegisterInstructor": true,
+		"/GoEdu.InstructorService/LoginInstructor":    true,
+		"/GoEdu.ModerationService/LoginAdmin":         true,
+
+		"/GoEdu.EducationService/GetCourses":         true,
+		"/GoEdu.EducationService/GetCourseByID":      true,
+		"/GoEdu.EducationService/SearchCourses":      true,
+		"/GoEdu.EducationService/GetTrendingCourses": true,
+		"/GoEdu.EducationService/GetSimilarCourses":  true,
+		"/GoEdu.ReviewService/GetReviewsByCourse":    true,
 
-		"/education.EducationService/GetCourses":      true,
-		"/education.EducationService/GetCourseByID":   true,
-		"/education.EducationService/SearchCourses":   true,
-		"/education.ReviewService/GetReviewsByCourse": true,
+		"/GoEdu.LectureService/GetLecturesByCourse": true,
 
-		"/education.LectureService/GetLecturesByCourse": true,
-		"/education.LectureService/GetLectureContent":   true,
+		"/GoEdu.CertificateService/VerifyCertificate": true,
+		"/GoEdu.QuizService/ListQuizzes":              true,
+
+		"/GoEdu.AssignmentService/GetAssignment":   true,
+		"/GoEdu.AssignmentService/ListAssignments": true,
+
+		"/GoEdu.LearningPathService/GetLearningPath":   true,
+		"/GoEdu.LearningPathService/ListLearningPaths": true,
+
+		"/GoEdu.NotificationService/Unsubscribe": true,
 	}
 
 	roleProtectedMethods := map[string]string{
-		"/education.EducationService/CreateCourseByInstructor": "instructor",
-		"/education.StudentService/GetStudentProfile":          "student",
-		"/education.StudentService/UpdateStudentProfile":       "student",
-		"/education.EnrollmentService/EnrollStudent":           "student",
-		"/education.EnrollmentService/GetStudentsByCourse":     "instructor",
-		"/education.EnrollmentService/GetCoursesByStudent":     "student",
-		"/education.EnrollmentService/UnEnrollStudent":         "student",
-		"/education.LectureService/UpdateLecture":              "instructor",
-		"/education.LectureService/DeleteLecture":              "instructor",
-		"/education.LectureService/MarkLectureAsCompleted":     "student",
-		"/education.LectureService/GetCourseProgress":          "student",
-		"/education.LectureService/GetRecommendedCourses":      "student",
+		"/GoEdu.EducationService/CreateCourseByInstructor":         "instructor",
+		"/GoEdu.StudentService/GetStudentProfile":                  "student",
+		"/GoEdu.StudentService/UpdateStudentProfile":               "student",
+		"/GoEdu.EnrollmentService/EnrollStudent":                   "student",
+		"/GoEdu.EnrollmentService/GetStudentsByCourse":             "instructor",
+		"/GoEdu.EnrollmentService/GetCoursesByStudent":             "student",
+		"/GoEdu.EnrollmentService/UnEnrollStudent":                 "student",
+		"/GoEdu.EnrollmentService/BulkEnroll":                      "instructor",
+		"/GoEdu.LectureService/UpdateLecture":                      "instructor",
+		"/GoEdu.LectureService/DeleteLecture":                      "instructor",
+		"/GoEdu.LectureService/MarkLectureAsCompleted":             "student",
+		"/GoEdu.LectureService/GetCourseProgress":                  "student",
+		"/GoEdu.LectureService/GetRecommendedCourses":              "student",
+		"/GoEdu.LectureService/GetCourseRecommendations":           "student",
+		"/GoEdu.LectureService/RecordLectureProgress":              "student",
+		"/GoEdu.LectureService/GetDetailedCourseProgress":          "student",
+		"/GoEdu.LectureService/GetStudentDashboard":                "student",
+		"/GoEdu.CertificateService/ListMyCertificates":             "student",
+		"/GoEdu.CohortService/CreateCohort":                        "instructor",
+		"/GoEdu.CohortService/UpdateCohort":                        "instructor",
+		"/GoEdu.CohortService/DeleteCohort":                        "instructor",
+		"/GoEdu.CohortService/ListCohorts":                         "instructor",
+		"/GoEdu.CohortService/AssignStudentsToCohort":              "instructor",
+		"/GoEdu.CohortService/RemoveStudentsFromCohort":            "instructor",
+		"/GoEdu.CohortService/GetCohortStudents":                   "instructor",
+		"/GoEdu.CohortService/GetCohortProgress":                   "instructor",
+		"/GoEdu.QuizService/CreateQuiz":                            "instructor",
+		"/GoEdu.QuizService/UpdateQuiz":                            "instructor",
+		"/GoEdu.QuizService/DeleteQuiz":                            "instructor",
+		"/GoEdu.QuizService/GetQuiz":                               "instructor",
+		"/GoEdu.QuizService/StartQuizAttempt":                      "student",
+		"/GoEdu.QuizService/SubmitQuizAttempt":                     "student",
+		"/GoEdu.QuestionBankService/CreateBankQuestion":            "instructor",
+		"/GoEdu.QuestionBankService/UpdateBankQuestion":            "instructor",
+		"/GoEdu.QuestionBankService/DeleteBankQuestion":            "instructor",
+		"/GoEdu.QuestionBankService/ListBankQuestions":             "instructor",
+		"/GoEdu.QuestionBankService/ImportQuestionBank":            "instructor",
+		"/GoEdu.QuestionBankService/ExportQuestionBank":            "instructor",
+		"/GoEdu.AssignmentService/CreateAssignment":                "instructor",
+		"/GoEdu.AssignmentService/UpdateAssignment":                "instructor",
+		"/GoEdu.AssignmentService/DeleteAssignment":                "instructor",
+		"/GoEdu.AssignmentService/SubmitAssignment":                "student",
+		"/GoEdu.AssignmentService/ListSubmissions":                 "instructor",
+		"/GoEdu.AssignmentService/GradeSubmission":                 "instructor",
+		"/GoEdu.ReviewService/AddReviewToCourse":                   "student",
+		"/GoEdu.ReviewService/CreateReview":                        "student",
+		"/GoEdu.ReviewService/ReplyToReview":                       "instructor",
+		"/GoEdu.ReviewService/DeleteReviewReply":                   "instructor",
+		"/GoEdu.ModerationService/GetModerationQueue":              "admin",
+		"/GoEdu.ModerationService/ApproveReview":                   "admin",
+		"/GoEdu.ModerationService/RejectReview":                    "admin",
+		"/GoEdu.ReviewService/UpdateReview":                        "student",
+		"/GoEdu.ReviewService/DeleteReview":                        "student",
+		"/GoEdu.PeerReviewService/ListPeerReviewTasks":             "student",
+		"/GoEdu.PeerReviewService/SubmitPeerReview":                "student",
+		"/GoEdu.GradebookService/CreateGradeCategory":              "instructor",
+		"/GoEdu.GradebookService/UpdateGradeCategory":              "instructor",
+		"/GoEdu.GradebookService/DeleteGradeCategory":              "instructor",
+		"/GoEdu.GradebookService/ListGradeCategories":              "instructor",
+		"/GoEdu.GradebookService/SetGradeItemCategory":             "instructor",
+		"/GoEdu.GradebookService/GetLetterScheme":                  "instructor",
+		"/GoEdu.GradebookService/SetLetterScheme":                  "instructor",
+		"/GoEdu.GradebookService/GetGradebook":                     "instructor",
+		"/GoEdu.GradebookService/AdjustGrade":                      "instructor",
+		"/GoEdu.GradebookService/ListGradeAdjustments":             "instructor",
+		"/GoEdu.GradebookService/ExportGradebook":                  "instructor",
+		"/GoEdu.LearningPathService/CreateLearningPath":            "instructor",
+		"/GoEdu.LearningPathService/UpdateLearningPath":            "instructor",
+		"/GoEdu.LearningPathService/DeleteLearningPath":            "instructor",
+		"/GoEdu.LearningPathService/EnrollInLearningPath":          "student",
+		"/GoEdu.NotificationService/ListNotifications":             "student",
+		"/GoEdu.NotificationService/MarkRead":                      "student",
+		"/GoEdu.NotificationService/MarkAllRead":                   "student",
+		"/GoEdu.NotificationService/GetNotificationPreferences":    "student",
+		"/GoEdu.NotificationService/UpdateNotificationPreferences": "student",
+		"/GoEdu.AnnouncementService/CreateAnnouncement":            "instructor",
+		"/GoEdu.AnnouncementService/DeleteAnnouncement":            "instructor",
 	}
 
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		logger.Info("Запрос метода", zap.String("method", info.FullMethod))
+	return func(ctx context.Context, method string) (context.Context, error) {
+		logger.Info("Запрос метода", zap.String("method", method))
 
-		if whitelist[info.FullMethod] {
-			logger.Info("Метод находится в whitelist, пропуск аутентификации", zap.String("method", info.FullMethod))
-			return handler(ctx, req)
+		if whitelist[method] {
+			logger.Info("Метод находится в whitelist, пропуск аутентификации", zap.String("method", method))
+			if user, ok := optionalUser(ctx, jwtSecretKey); ok {
+				ctx = ContextWithUser(ctx, user.ID, user.Role)
+			}
+			return ctx, nil
 		}
 
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
-			logger.Warn("Метаданные отсутствуют", zap.String("method", info.FullMethod))
+			logger.Warn("Метаданные отсутствуют", zap.String("method", method))
 			return nil, status.Errorf(codes.Unauthenticated, "Метаданные отсутствуют")
 		}
 
 		authHeader := md["authorization"]
 		if len(authHeader) == 0 {
-			logger.Warn("Токен отсутствует", zap.String("method", info.FullMethod))
+			logger.Warn("Токен отсутствует", zap.String("method", method))
 			return nil, status.Errorf(codes.Unauthenticated, "Токен отсутствует")
 		}
 
 		tokenString := strings.TrimPrefix(authHeader[0], "Bearer ")
 		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				logger.Error("Некорректный токен", zap.String("method", info.FullMethod))
+				logger.Error("Некорректный токен", zap.String("method", method))
 				return nil, status.Errorf(codes.Unauthenticated, "Некорректный токен")
 			}
 			return jwtSecretKey, nil
 		})
 
 		if err != nil || !token.Valid {
-			logger.Warn("Недействительный токен", zap.Error(err), zap.String("method", info.FullMethod))
+			logger.Warn("Недействительный токен", zap.Error(err), zap.String("method", method))
 			return nil, status.Errorf(codes.Unauthenticated, "Недействительный токен")
 		}
 
 		claims, ok := token.Claims.(jwt.MapClaims)
 		if !ok {
-			logger.Warn("Невозможно получить claims из токена", zap.String("method", info.FullMethod))
+			logger.Warn("Невозможно получить claims из токена", zap.String("method", method))
 			return nil, status.Errorf(codes.Unauthenticated, "Невозможно получить claims из токена")
 		}
 
 		userRole, ok := claims["role"].(string)
 		if !ok {
-			logger.Warn("Роль пользователя отсутствует в токене", zap.String("method", info.FullMethod))
+			logger.Warn("Роль пользователя отсутствует в токене", zap.String("method", method))
 			return nil, status.Errorf(codes.PermissionDenied, "Роль пользователя отсутствует в токене")
 		}
 
-		if requiredRole, exists := roleProtectedMethods[info.FullMethod]; exists {
+		if requiredRole, exists := roleProtectedMethods[method]; exists {
 			if userRole != requiredRole {
-				logger.Warn("Роль пользователя не соответствует требованиям метода", zap.String("method", info.FullMethod), zap.String("required_role", requiredRole), zap.String("user_role", userRole))
+				logger.Warn("Роль пользователя не соответствует требованиям метода", zap.String("method", method), zap.String("required_role", requiredRole), zap.String("user_role", userRole))
 				return nil, status.Errorf(codes.PermissionDenied, "Доступ запрещён для вашей роли: требуется %s", requiredRole)
 			}
 		}
 
-		logger.Info("Аутентификация успешна", zap.String("method", info.FullMethod), zap.String("user_role", userRole))
-		return handler(ctx, req)
+		userID, _ := claims["user_id"].(float64)
+
+		logger.Info("Аутентификация успешна", zap.String("method", method), zap.String("user_role", userRole))
+		return ContextWithUser(ctx, int64(userID), userRole), nil
 	}
 }
 
+type userContextKey struct{}
+
+// User — аутентифицированный пользователь, извлечённый из JWT-токена.
+type User struct {
+	ID   int64
+	Role string
+}
+
+// ContextWithUser сохраняет пользователя в контексте запроса.
+func ContextWithUser(ctx context.Context, userID int64, role string) context.Context {
+	return context.WithValue(ctx, userContextKey{}, User{ID: userID, Role: role})
+}
+
+// optionalUser извлекает пользователя из токена для публичных методов, которые
+// отвечают по-разному анонимным и авторизованным пользователям. Ошибки токена игнорируются.
+func optionalUser(ctx context.Context, jwtSecretKey []byte) (User, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["authorization"]) == 0 {
+		return User{}, false
+	}
+
+	tokenString := strings.TrimPrefix(md["authorization"][0], "Bearer ")
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "Некорректный токен")
+		}
+		return jwtSecretKey, nil
+	})
+	if err != nil || !token.Valid {
+		return User{}, false
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return User{}, false
+	}
+	role, _ := claims["role"].(string)
+	userID, _ := claims["user_id"].(float64)
+	if role == "" {
+		return User{}, false
+	}
+	return User{ID: int64(userID), Role: role}, true
+}
+
+// UserFromContext возвращает пользователя, если запрос прошёл аутентификацию.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(User)
+	return user, ok
+}
+
 func GenerateJWTToken(userID int64, email, role string, secretKey []byte, tokenExpirationHours int, logger *zap.Logger) (string, error) {
 	expirationTime := time.Now().Add(time.Duration(tokenExpirationHours) * time.Hour)
 
@@ -0,0 +1,8 @@
+package models
+
+type Admin struct {
+	ID       int64  `db:"id"`
+	Name     string `db:"name"`
+	Email    string `db:"email"`
+	Password string `db:"password"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Announcement — объявление преподавателя. CohortID = 0 — объявление для всех студентов курса.
+type Announcement struct {
+	ID           int64      `db:"id"`
+	CourseID     int64      `db:"course_id"`
+	CohortID     int64      `db:"cohort_id"`
+	InstructorID int64      `db:"instructor_id"` // Автор объявления, 0 — создано без преподавателя в токене.
+	Title        string     `db:"title"`
+	Body         string     `db:"body"`
+	PublishAt    time.Time  `db:"publish_at"`
+	PublishedAt  *time.Time `db:"published_at"` // nil — объявление ещё ждёт публикации.
+	CreatedAt    time.Time  `db:"created_at"`
+}
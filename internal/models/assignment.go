@@ -0,0 +1,95 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	LatePolicyAccept  = "accept"
+	LatePolicyPenalty = "penalty"
+	LatePolicyReject  = "reject"
+)
+
+// Assignment — задание курса, на которое студенты отправляют решения текстом и файлами.
+type Assignment struct {
+	ID                 int64      `db:"id"`
+	CourseID           int64      `db:"course_id"`
+	Title              string     `db:"title"`
+	Description        string     `db:"description"`
+	DueAt              *time.Time `db:"due_at"`
+	LatePolicy         string     `db:"late_policy"`
+	LatePenaltyPercent int32      `db:"late_penalty_percent"`
+	MaxScore           int32      `db:"max_score"`
+	MaxSubmissions     *int32     `db:"max_submissions"`
+	MaxFiles           int32      `db:"max_files"`
+	Rubric             []*RubricCriterion
+
+	PeerReviewCount        int32      `db:"peer_review_count"`
+	PeerReviewDueAt        *time.Time `db:"peer_review_due_at"`
+	PeerReviewsAllocatedAt *time.Time `db:"peer_reviews_allocated_at"`
+}
+
+// RubricCriterion — критерий оценивания задания.
+type RubricCriterion struct {
+	ID          int64  `db:"id"`
+	Position    int32  `db:"position"`
+	Title       string `db:"title"`
+	Description string `db:"description"`
+	MaxPoints   int32  `db:"max_points"`
+}
+
+// LatePenalty сообщает, просрочено ли решение, отправленное в момент at, и какой штраф к нему применяется.
+// При политике penalty штраф начисляется за каждые начатые сутки просрочки и не превышает 100%.
+func (a *Assignment) LatePenalty(at time.Time) (late bool, penaltyPercent int32) {
+	if a.DueAt == nil || !at.After(*a.DueAt) {
+		return false, 0
+	}
+	if a.LatePolicy != LatePolicyPenalty {
+		return true, 0
+	}
+	days := int32(math.Ceil(at.Sub(*a.DueAt).Hours() / 24))
+	return true, min(days*a.LatePenaltyPercent, 100)
+}
+
+// Submission — версия решения студента. Каждая повторная отправка создаёт новую версию.
+type Submission struct {
+	ID             int64      `db:"id"`
+	AssignmentID   int64      `db:"assignment_id"`
+	StudentID      int64      `db:"student_id"`
+	Version        int32      `db:"version"`
+	Text           string     `db:"text"`
+	SubmittedAt    time.Time  `db:"submitted_at"`
+	Late           bool       `db:"late"`
+	PenaltyPercent int32      `db:"penalty_percent"`
+	RawScore       *int32     `db:"raw_score"`
+	Score          *int32     `db:"score"`
+	Feedback       string     `db:"feedback"`
+	GradedAt       *time.Time `db:"graded_at"`
+	GradedBy       *int64     `db:"graded_by"`
+	PeerGraded     bool       `db:"peer_graded"`
+	Files          []*SubmissionFile
+	RubricScores   []*RubricScore
+}
+
+// ApplyPenalty возвращает итоговую оценку с учётом штрафа за просрочку.
+func (s *Submission) ApplyPenalty(rawScore int32) int32 {
+	return rawScore * (100 - s.PenaltyPercent) / 100
+}
+
+// SubmissionFile — файл решения. Содержимое лежит в файловом хранилище под ключом StorageKey.
+type SubmissionFile struct {
+	ID           int64  `db:"id"`
+	SubmissionID int64  `db:"submission_id"`
+	Filename     string `db:"filename"`
+	ContentType  string `db:"content_type"`
+	Size         int64  `db:"size"`
+	StorageKey   string `db:"storage_key"`
+}
+
+// RubricScore — баллы по критерию рубрики.
+type RubricScore struct {
+	CriterionID int64  `db:"criterion_id"`
+	Points      int32  `db:"points"`
+	Comment     string `db:"comment"`
+}
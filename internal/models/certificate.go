@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Certificate — сертификат об окончании курса или учебной программы. Имена сохраняются на момент выдачи,
+// чтобы последующие переименования не делали подпись недействительной. У сертификата программы
+// CourseID = 0, а в CourseName записано название программы.
+type Certificate struct {
+	ID             int64     `db:"id"`
+	Serial         string    `db:"serial"`
+	StudentID      int64     `db:"student_id"`
+	CourseID       int64     `db:"course_id"`
+	PathID         int64     `db:"path_id"`
+	StudentName    string    `db:"student_name"`
+	CourseName     string    `db:"course_name"`
+	InstructorName string    `db:"instructor_name"`
+	IssuedAt       time.Time `db:"issued_at"`
+	Signature      string    `db:"signature"`
+	PDF            []byte    `db:"pdf"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type Cohort struct {
+	ID            int64     `db:"id"`
+	CourseID      int64     `db:"course_id"`
+	Name          string    `db:"name"`
+	StartDate     time.Time `db:"start_date"`
+	EndDate       time.Time `db:"end_date"`
+	InstructorIDs []int64   `db:"instructor_ids"`
+	StudentCount  int32     `db:"student_count"`
+}
+
+type StudentProgress struct {
+	StudentID         int64  `db:"student_id"`
+	Name              string `db:"name"`
+	CompletedLectures int32  `db:"completed_lectures"`
+	TotalLectures     int32  `db:"total_lectures"`
+}
+
+// Percent возвращает процент завершения курса; курс без лекций считается незавершённым.
+func (p *StudentProgress) Percent() int32 {
+	if p.TotalLectures == 0 {
+		return 0
+	}
+	return p.CompletedLectures * 100 / p.TotalLectures
+}
@@ -1,8 +1,33 @@
 package models
 
+// RatingPriorWeight — сколько «виртуальных» отзывов со средней по всем курсам оценкой добавляется
+// к отзывам курса при расчёте байесовской оценки. Чем больше вес, тем сильнее курсы с малым числом
+// отзывов притягиваются к средней.
+const RatingPriorWeight = 5
+
 type Course struct {
-	ID           int64  `db:"id"`
-	Name         string `db:"name"`
-	Description  string `db:"description"`
-	InstructorID int64  `db:"instructorID"`
+	ID           int64    `db:"id"`
+	Name         string   `db:"name"`
+	Description  string   `db:"description"`
+	InstructorID int64    `db:"instructorID"`
+	Sequential   bool     `db:"sequential"`
+	RatingCount  int32    `db:"rating_count"`
+	RatingSum    int64    `db:"rating_sum"`
+	RatingCounts []int32  `db:"rating_counts"` // Число отзывов с оценками 1–5.
+	Category     string   `db:"category"`
+	Tags         []string `db:"tags"`
+}
+
+// AverageRating возвращает среднюю оценку курса или 0, если отзывов нет.
+func (c *Course) AverageRating() float64 {
+	if c.RatingCount == 0 {
+		return 0
+	}
+	return float64(c.RatingSum) / float64(c.RatingCount)
+}
+
+// BayesianRating возвращает среднюю оценку курса, сглаженную к средней по всем курсам globalMean:
+// (RatingPriorWeight·globalMean + сумма оценок) / (RatingPriorWeight + число отзывов).
+func (c *Course) BayesianRating(globalMean float64) float64 {
+	return (RatingPriorWeight*globalMean + float64(c.RatingSum)) / float64(RatingPriorWeight+c.RatingCount)
 }
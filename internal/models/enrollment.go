@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type Enrollment struct {
+	StudentID       int64      `db:"student_id"`
+	CourseID        int64      `db:"course_id"`
+	EnrolledAt      time.Time  `db:"enrolled_at"`
+	CohortID        *int64     `db:"cohort_id"`
+	CohortStartDate *time.Time `db:"cohort_start_date"`
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы доменных событий.
+const (
+	EventStudentEnrolled       = "StudentEnrolled"
+	EventStudentUnenrolled     = "StudentUnenrolled"
+	EventLectureCompleted      = "LectureCompleted"
+	EventCourseCompleted       = "CourseCompleted"
+	EventLearningPathCompleted = "LearningPathCompleted"
+	EventReviewPosted          = "ReviewPosted"
+	EventCourseCreated         = "CourseCreated"
+	EventCourseUpdated         = "CourseUpdated"
+	EventCourseDeleted         = "CourseDeleted"
+	EventLectureAdded          = "LectureAdded"
+	EventReviewReplied         = "ReviewReplied"
+	EventAssignmentGraded      = "AssignmentGraded"
+	EventAnnouncementPublished = "AnnouncementPublished"
+)
+
+// EventTypes — все типы доменных событий.
+var EventTypes = []string{
+	EventStudentEnrolled, EventStudentUnenrolled, EventLectureCompleted, EventCourseCompleted, EventLearningPathCompleted,
+	EventReviewPosted, EventCourseCreated, EventCourseUpdated, EventCourseDeleted, EventLectureAdded, EventReviewReplied,
+	EventAssignmentGraded, EventAnnouncementPublished,
+}
+
+// Типы агрегатов, к которым относятся события.
+const (
+	AggregateCourse       = "course"
+	AggregateLecture      = "lecture"
+	AggregateReview       = "review"
+	AggregateLearningPath = "learning_path"
+	AggregateSubmission   = "submission"
+	AggregateAnnouncement = "announcement"
+)
+
+// OutboxEvent — доменное событие из outbox. EventID не меняется между попытками доставки
+// и служит получателям ключом идемпотентности.
+type OutboxEvent struct {
+	ID            int64           `db:"id"`
+	EventID       string          `db:"event_id"`
+	EventType     string          `db:"event_type"`
+	AggregateType string          `db:"aggregate_type"`
+	AggregateID   int64           `db:"aggregate_id"`
+	Payload       json.RawMessage `db:"payload"`
+	CreatedAt     time.Time       `db:"created_at"`
+	Attempts      int32           `db:"attempts"`
+}
+
+// EventScope — курс, студент и программа, к которым относится событие; берутся из одноимённых
+// полей данных события, 0 — поля нет.
+type EventScope struct {
+	CourseID  int64 `json:"course_id"`
+	StudentID int64 `json:"student_id"`
+	PathID    int64 `json:"path_id"`
+}
+
+// Scope разбирает из данных события, к каким курсу, студенту и программе оно относится.
+func (e *OutboxEvent) Scope() (EventScope, error) {
+	var scope EventScope
+	err := json.Unmarshal(e.Payload, &scope)
+	return scope, err
+}
+
+// IsPublicEvent сообщает, доступно ли событие любому пользователю: отзывы, изменения курсов и новые лекции
+// видны всем, а события о записях, прогрессе и оценках — только самому студенту и преподавателю курса.
+func IsPublicEvent(eventType string) bool {
+	switch eventType {
+	case EventReviewPosted, EventCourseCreated, EventCourseUpdated, EventCourseDeleted, EventLectureAdded:
+		return true
+	}
+	return false
+}
+
+// StudentEnrollmentPayload — данные событий StudentEnrolled и StudentUnenrolled.
+type StudentEnrollmentPayload struct {
+	StudentID int64 `json:"student_id"`
+	CourseID  int64 `json:"course_id"`
+	PathID    int64 `json:"path_id,omitempty"` // Программа, при записи на которую студент записан на курс.
+}
+
+// LectureCompletedPayload — данные события LectureCompleted.
+type LectureCompletedPayload struct {
+	StudentID int64 `json:"student_id"`
+	LectureID int64 `json:"lecture_id"`
+	CourseID  int64 `json:"course_id"`
+}
+
+// CertificateIssuedPayload — данные событий CourseCompleted и LearningPathCompleted.
+type CertificateIssuedPayload struct {
+	StudentID     int64     `json:"student_id"`
+	CourseID      int64     `json:"course_id,omitempty"`
+	PathID        int64     `json:"path_id,omitempty"`
+	CertificateID int64     `json:"certificate_id"`
+	Serial        string    `json:"serial"`
+	IssuedAt      time.Time `json:"issued_at"`
+}
+
+// ReviewPostedPayload — данные события ReviewPosted.
+type ReviewPostedPayload struct {
+	ReviewID  int64  `json:"review_id"`
+	StudentID int64  `json:"student_id"`
+	CourseID  int64  `json:"course_id"`
+	Rating    int32  `json:"rating"`
+	Comment   string `json:"comment"`
+}
+
+// CoursePayload — данные событий CourseCreated, CourseUpdated и CourseDeleted.
+type CoursePayload struct {
+	CourseID     int64  `json:"course_id"`
+	InstructorID int64  `json:"instructor_id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Category     string `json:"category,omitempty"`
+}
+
+// LectureAddedPayload — данные события LectureAdded.
+type LectureAddedPayload struct {
+	LectureID int64  `json:"lecture_id"`
+	CourseID  int64  `json:"course_id"`
+	Title     string `json:"title"`
+	Scheduled bool   `json:"scheduled"` // Лекция открывается по расписанию, а не сразу.
+}
+
+// ReviewRepliedPayload — данные события ReviewReplied.
+type ReviewRepliedPayload struct {
+	ReviewID     int64  `json:"review_id"`
+	StudentID    int64  `json:"student_id"`
+	CourseID     int64  `json:"course_id"`
+	InstructorID int64  `json:"instructor_id"`
+	Comment      string `json:"comment"`
+}
+
+// AssignmentGradedPayload — данные события AssignmentGraded.
+type AssignmentGradedPayload struct {
+	SubmissionID int64 `json:"submission_id"`
+	AssignmentID int64 `json:"assignment_id"`
+	StudentID    int64 `json:"student_id"`
+	CourseID     int64 `json:"course_id"`
+	Score        int32 `json:"score"`
+	PeerGraded   bool  `json:"peer_graded"`
+}
+
+// AnnouncementPublishedPayload — данные события AnnouncementPublished.
+type AnnouncementPublishedPayload struct {
+	AnnouncementID int64  `json:"announcement_id"`
+	CourseID       int64  `json:"course_id"`
+	CohortID       int64  `json:"cohort_id,omitempty"` // Поток, студентам которого адресовано объявление.
+	Title          string `json:"title"`
+	Body           string `json:"body"`
+}
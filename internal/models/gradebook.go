@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+const (
+	GradeItemQuiz       = "quiz"
+	GradeItemAssignment = "assignment"
+	GradeItemFinal      = "final"
+)
+
+// GradeCategory — категория оценок курса (например, «Тесты» или «Домашние задания») с весом в итоговой оценке.
+type GradeCategory struct {
+	ID       int64   `db:"id"`
+	CourseID int64   `db:"course_id"`
+	Name     string  `db:"name"`
+	Weight   float64 `db:"weight"`
+}
+
+// GradeItem — оцениваемый элемент курса: тест или задание.
+type GradeItem struct {
+	Type       string  `db:"type"`
+	ID         int64   `db:"id"`
+	Title      string  `db:"title"`
+	CategoryID *int64  `db:"grade_category_id"`
+	MaxScore   float64 `db:"max_score"`
+}
+
+// ItemScore — оценка студента за элемент курса до ручных изменений.
+type ItemScore struct {
+	StudentID int64   `db:"student_id"`
+	ItemType  string  `db:"item_type"`
+	ItemID    int64   `db:"item_id"`
+	Score     float64 `db:"score"`
+}
+
+// GradeAdjustment — запись истории ручных изменений оценки. Score = nil отменяет ручное изменение.
+type GradeAdjustment struct {
+	ID            int64     `db:"id"`
+	CourseID      int64     `db:"course_id"`
+	StudentID     int64     `db:"student_id"`
+	ItemType      string    `db:"item_type"`
+	ItemID        int64     `db:"item_id"`
+	Score         *float64  `db:"score"`
+	PreviousScore *float64  `db:"previous_score"`
+	Reason        string    `db:"reason"`
+	InstructorID  *int64    `db:"instructor_id"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// LetterGrade — буквенная оценка, которая ставится при итоговом проценте не ниже MinPercent.
+type LetterGrade struct {
+	Letter     string  `db:"letter"`
+	MinPercent float64 `db:"min_percent"`
+}
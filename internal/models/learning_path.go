@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// LearningPath — учебная программа: упорядоченный список курсов.
+type LearningPath struct {
+	ID           int64               `db:"id"`
+	Name         string              `db:"name"`
+	Description  string              `db:"description"`
+	InstructorID int64               `db:"instructor_id"`
+	CreatedAt    time.Time           `db:"created_at"`
+	Items        []*LearningPathItem `db:"-"`
+}
+
+// LearningPathItem — курс в учебной программе.
+type LearningPathItem struct {
+	CourseID int64   `db:"course_id"`
+	Position int32   `db:"position"`
+	Required bool    `db:"required"` // Необязательные курсы не влияют на завершение программы.
+	Course   *Course `db:"-"`
+}
@@ -1,8 +1,61 @@
 package models
 
+import "time"
+
 type Lecture struct {
-	ID       int64  `db:"id"`
-	CourseID int64  `db:"course_id"`
-	Title    string `db:"title"`
-	Content  string `db:"content"`
+	ID               int64      `db:"id"`
+	CourseID         int64      `db:"course_id"`
+	Title            string     `db:"title"`
+	Content          string     `db:"content"`
+	CohortOffsetDays *int32     `db:"cohort_offset_days"`
+	ReleaseAt        *time.Time `db:"release_at"`
+	ReleaseAfterDays *int32     `db:"release_after_days"`
+	Position         int32      `db:"position"`
+}
+
+// HasReleaseRules сообщает, ограничено ли открытие лекции по времени.
+func (l *Lecture) HasReleaseRules() bool {
+	return l.ReleaseAt != nil || l.ReleaseAfterDays != nil || l.CohortOffsetDays != nil
+}
+
+// AvailableAt возвращает момент открытия лекции для студента — самое позднее из абсолютной даты,
+// срока после записи на курс и смещения от старта потока. Второе значение равно false, если
+// момент нельзя определить: лекция открывается относительно записи, а студент не записан на курс.
+func (l *Lecture) AvailableAt(enrollment *Enrollment) (time.Time, bool) {
+	var availableAt time.Time
+	if l.ReleaseAt != nil {
+		availableAt = *l.ReleaseAt
+	}
+
+	if l.ReleaseAfterDays != nil {
+		if enrollment == nil {
+			return availableAt, false
+		}
+		availableAt = latest(availableAt, enrollment.EnrolledAt.AddDate(0, 0, int(*l.ReleaseAfterDays)))
+	}
+
+	if l.CohortOffsetDays != nil && enrollment != nil && enrollment.CohortStartDate != nil {
+		availableAt = latest(availableAt, enrollment.CohortStartDate.AddDate(0, 0, int(*l.CohortOffsetDays)))
+	}
+
+	return availableAt, true
+}
+
+// LectureReleaseNotice — открывшаяся для студента лекция, о которой ещё не было уведомления.
+type LectureReleaseNotice struct {
+	StudentID    int64     `db:"student_id"`
+	StudentName  string    `db:"student_name"`
+	StudentEmail string    `db:"student_email"`
+	LectureID    int64     `db:"lecture_id"`
+	LectureTitle string    `db:"lecture_title"`
+	CourseID     int64     `db:"course_id"`
+	CourseName   string    `db:"course_name"`
+	AvailableAt  time.Time `db:"available_at"`
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
 }
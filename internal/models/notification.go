@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// Типы уведомлений; студент может отключить любой из них.
+const (
+	NotificationLectureAdded     = "lecture_added"
+	NotificationReviewReply      = "review_reply"
+	NotificationEnrollment       = "enrollment_approved"
+	NotificationAssignmentGraded = "assignment_graded"
+	NotificationAnnouncement     = "announcement"
+)
+
+// NotificationTypes — все типы уведомлений.
+var NotificationTypes = []string{
+	NotificationLectureAdded, NotificationReviewReply, NotificationEnrollment, NotificationAssignmentGraded, NotificationAnnouncement,
+}
+
+// Notification — уведомление студента. EventID — событие, из которого создано уведомление.
+type Notification struct {
+	ID        int64          `db:"id"`
+	StudentID int64          `db:"student_id"`
+	Type      string         `db:"type"`
+	EventID   string         `db:"event_id"`
+	CourseID  int64          `db:"course_id"`
+	Title     string         `db:"title"`
+	Body      string         `db:"body"`
+	Link      string         `db:"link"` // Относительный адрес страницы, к которой относится уведомление.
+	Data      map[string]any `db:"data"` // Параметры шаблона уведомления: названия курса, лекции и т. п.
+	CreatedAt time.Time      `db:"created_at"`
+	ReadAt    *time.Time     `db:"read_at"`
+	EmailedAt *time.Time     `db:"emailed_at"`
+}
+
+// Частота почтовых уведомлений.
+const (
+	EmailOff       = "off"
+	EmailImmediate = "immediate"
+	EmailDaily     = "daily"
+	EmailWeekly    = "weekly"
+)
+
+// EmailFrequencies — все варианты частоты почтовых уведомлений.
+var EmailFrequencies = []string{EmailOff, EmailImmediate, EmailDaily, EmailWeekly}
+
+// Языки писем.
+const (
+	LocaleRussian = "ru"
+	LocaleEnglish = "en"
+)
+
+// Locales — поддерживаемые языки писем.
+var Locales = []string{LocaleRussian, LocaleEnglish}
+
+// NotificationSettings — настройки почтовых уведомлений студента. По умолчанию — ежедневная сводка на русском.
+type NotificationSettings struct {
+	StudentID      int64      `db:"student_id"`
+	EmailFrequency string     `db:"email_frequency"`
+	Locale         string     `db:"locale"`
+	LastEmailedAt  *time.Time `db:"last_emailed_at"`
+	// Ссылки отписки, выданные раньше этого времени, недействительны.
+	UnsubscribeRevokedAt *time.Time `db:"unsubscribe_revoked_at"`
+}
+
+// DefaultNotificationSettings возвращает настройки студента, который их не менял.
+func DefaultNotificationSettings(studentID int64) *NotificationSettings {
+	return &NotificationSettings{StudentID: studentID, EmailFrequency: EmailDaily, Locale: LocaleRussian}
+}
+
+// NotificationEmail — неотправленные по почте уведомления одного студента вместе с его адресом и языком.
+type NotificationEmail struct {
+	StudentID     int64
+	StudentName   string
+	Email         string
+	Locale        string
+	Notifications []*Notification
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// PeerReview — рецензия студента на чужое решение задания.
+type PeerReview struct {
+	ID           int64      `db:"id"`
+	SubmissionID int64      `db:"submission_id"`
+	ReviewerID   int64      `db:"reviewer_id"`
+	AssignedAt   time.Time  `db:"assigned_at"`
+	SubmittedAt  *time.Time `db:"submitted_at"`
+	Comment      string     `db:"comment"`
+	Scores       []*RubricScore
+}
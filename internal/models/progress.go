@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+type LectureProgress struct {
+	LectureID           int64      `db:"lecture_id"`
+	Title               string     `db:"title"`
+	Position            int32      `db:"position"`
+	StartedAt           *time.Time `db:"started_at"`
+	LastActivityAt      *time.Time `db:"last_activity_at"`
+	TimeSpentSeconds    int64      `db:"time_spent_seconds"`
+	LastPositionSeconds int32      `db:"last_position_seconds"`
+	CompletedAt         *time.Time `db:"completed_at"`
+}
+
+// CourseLectureProgress — прогресс студента по всем лекциям одного курса в порядке прохождения.
+type CourseLectureProgress struct {
+	CourseID   int64  `db:"course_id"`
+	CourseName string `db:"course_name"`
+	Lectures   []*LectureProgress
+}
+
+// ProgressSummary — агрегированный прогресс по курсу.
+type ProgressSummary struct {
+	CompletedLectures int32
+	TotalLectures     int32
+	TimeSpentSeconds  int64
+	LastActivityAt    *time.Time
+	ResumeLectureID   int64
+}
+
+// Percent возвращает процент завершения курса; курс без лекций считается незавершённым.
+func (s ProgressSummary) Percent() int32 {
+	if s.TotalLectures == 0 {
+		return 0
+	}
+	return s.CompletedLectures * 100 / s.TotalLectures
+}
+
+// SummarizeProgress агрегирует прогресс по лекциям курса. Продолжить обучение предлагается с
+// незавершённой лекции, открытой последней, а если таких нет — с первой незавершённой по порядку.
+func SummarizeProgress(lectures []*LectureProgress) ProgressSummary {
+	summary := ProgressSummary{TotalLectures: int32(len(lectures))}
+
+	var lastStarted, firstPending *LectureProgress
+	for _, lecture := range lectures {
+		summary.TimeSpentSeconds += lecture.TimeSpentSeconds
+
+		if lecture.LastActivityAt != nil && (summary.LastActivityAt == nil || lecture.LastActivityAt.After(*summary.LastActivityAt)) {
+			summary.LastActivityAt = lecture.LastActivityAt
+		}
+		if lecture.CompletedAt != nil {
+			summary.CompletedLectures++
+			if summary.LastActivityAt == nil || lecture.CompletedAt.After(*summary.LastActivityAt) {
+				summary.LastActivityAt = lecture.CompletedAt
+			}
+			continue
+		}
+
+		if firstPending == nil {
+			firstPending = lecture
+		}
+		if lecture.LastActivityAt != nil && (lastStarted == nil || lecture.LastActivityAt.After(*lastStarted.LastActivityAt)) {
+			lastStarted = lecture
+		}
+	}
+
+	switch {
+	case lastStarted != nil:
+		summary.ResumeLectureID = lastStarted.LectureID
+	case firstPending != nil:
+		summary.ResumeLectureID = firstPending.LectureID
+	}
+	return summary
+}
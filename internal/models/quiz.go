@@ -0,0 +1,185 @@
+package models
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	QuestionSingleChoice   = "single_choice"
+	QuestionMultipleChoice = "multiple_choice"
+	QuestionTrueFalse      = "true_false"
+	QuestionNumeric        = "numeric"
+	QuestionShortText      = "short_text"
+)
+
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
+// Quiz — тест, привязанный к лекции или ко всему курсу (LectureID = nil).
+type Quiz struct {
+	ID                    int64  `db:"id"`
+	CourseID              int64  `db:"course_id"`
+	LectureID             *int64 `db:"lecture_id"`
+	Title                 string `db:"title"`
+	Description           string `db:"description"`
+	TimeLimitSeconds      *int32 `db:"time_limit_seconds"`
+	MaxAttempts           *int32 `db:"max_attempts"`
+	PassingScore          int32  `db:"passing_score"`
+	RequiredForCompletion bool   `db:"required_for_completion"`
+	ShuffleQuestions      bool   `db:"shuffle_questions"`
+	ShuffleOptions        bool   `db:"shuffle_options"`
+	QuestionCount         int32  `db:"question_count"`
+	MaxScore              int32  `db:"max_score"`
+	Questions             []*QuizQuestion
+	DrawRules             []*QuizDrawRule
+}
+
+// QuizDrawRule — правило «взять Count случайных вопросов из банка преподавателя», отобранных по тегам и сложности.
+type QuizDrawRule struct {
+	Count      int32    `db:"count"`
+	Tags       []string `db:"tags"`
+	Difficulty string   `db:"difficulty"`
+}
+
+// Matches сообщает, подходит ли вопрос под правило: он должен иметь хотя бы один из тегов правила
+// и указанную сложность. Пустые условия не ограничивают выбор.
+func (r *QuizDrawRule) Matches(q *QuizQuestion) bool {
+	if r.Difficulty != "" && q.Difficulty != r.Difficulty {
+		return false
+	}
+	if len(r.Tags) == 0 {
+		return true
+	}
+	for _, tag := range r.Tags {
+		for _, questionTag := range q.Tags {
+			if strings.EqualFold(tag, questionTag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// QuizQuestion — вопрос теста или банка вопросов преподавателя (QuizID = 0). Набор заполненных
+// полей ответа зависит от типа вопроса.
+type QuizQuestion struct {
+	ID              int64    `db:"id"`
+	QuizID          int64    `db:"quiz_id"`
+	InstructorID    int64    `db:"instructor_id"`
+	Position        int32    `db:"position"`
+	Type            string   `db:"type"`
+	Text            string   `db:"text"`
+	Points          int32    `db:"points"`
+	Options         []string `db:"options"`
+	CorrectOptions  []int32  `db:"correct_options"`
+	CorrectBool     *bool    `db:"correct_bool"`
+	CorrectNumber   *float64 `db:"correct_number"`
+	Tolerance       float64  `db:"tolerance"`
+	AcceptedAnswers []string `db:"accepted_answers"`
+	Tags            []string `db:"tags"`
+	Difficulty      string   `db:"difficulty"`
+}
+
+// QuizAttempt — попытка прохождения теста студентом.
+type QuizAttempt struct {
+	ID            int64      `db:"id"`
+	QuizID        int64      `db:"quiz_id"`
+	StudentID     int64      `db:"student_id"`
+	AttemptNumber int32      `db:"attempt_number"`
+	StartedAt     time.Time  `db:"started_at"`
+	DeadlineAt    *time.Time `db:"deadline_at"`
+	SubmittedAt   *time.Time `db:"submitted_at"`
+	Score         int32      `db:"score"`
+	MaxScore      int32      `db:"max_score"`
+	Percent       int32      `db:"percent"`
+	Passed        bool       `db:"passed"`
+	Seed          int64      `db:"seed"`
+	QuestionIDs   []int64    `db:"question_ids"`
+}
+
+// Expired сообщает, истекло ли время на незавершённую попытку с учётом допуска grace на задержки сети.
+func (a *QuizAttempt) Expired(now time.Time, grace time.Duration) bool {
+	return a.SubmittedAt == nil && a.DeadlineAt != nil && now.After(a.DeadlineAt.Add(grace))
+}
+
+// QuizAnswer — ответ студента на вопрос и результат его проверки.
+type QuizAnswer struct {
+	QuestionID      int64    `db:"question_id"`
+	SelectedOptions []int32  `db:"selected_options"`
+	BoolAnswer      *bool    `db:"bool_answer"`
+	NumberAnswer    *float64 `db:"number_answer"`
+	TextAnswer      string   `db:"text_answer"`
+	Correct         bool     `db:"correct"`
+	Points          int32    `db:"points"`
+}
+
+// Grade проверяет ответ и заполняет Correct и Points. Вопросы с несколькими вариантами
+// засчитываются только при точном совпадении набора выбранных вариантов.
+func (q *QuizQuestion) Grade(answer *QuizAnswer) {
+	answer.Correct = q.isCorrect(answer)
+	answer.Points = 0
+	if answer.Correct {
+		answer.Points = q.Points
+	}
+}
+
+func (q *QuizQuestion) isCorrect(answer *QuizAnswer) bool {
+	switch q.Type {
+	case QuestionSingleChoice, QuestionMultipleChoice:
+		return sameOptions(q.CorrectOptions, answer.SelectedOptions)
+	case QuestionTrueFalse:
+		return q.CorrectBool != nil && answer.BoolAnswer != nil && *q.CorrectBool == *answer.BoolAnswer
+	case QuestionNumeric:
+		return q.CorrectNumber != nil && answer.NumberAnswer != nil && math.Abs(*q.CorrectNumber-*answer.NumberAnswer) <= q.Tolerance
+	case QuestionShortText:
+		given := NormalizeTextAnswer(answer.TextAnswer)
+		if given == "" {
+			return false
+		}
+		for _, accepted := range q.AcceptedAnswers {
+			if NormalizeTextAnswer(accepted) == given {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NormalizeTextAnswer приводит текстовый ответ к виду для сравнения: без учёта регистра,
+// лишних пробелов и различия между «е» и «ё».
+func NormalizeTextAnswer(s string) string {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	return strings.ReplaceAll(s, "ё", "е")
+}
+
+func sameOptions(expected, selected []int32) bool {
+	if len(expected) == 0 {
+		return false
+	}
+	seen := make(map[int32]bool, len(selected))
+	for _, option := range selected {
+		seen[option] = true
+	}
+	if len(seen) != len(expected) {
+		return false
+	}
+	for _, option := range expected {
+		if !seen[option] {
+			return false
+		}
+	}
+	return true
+}
+
+// ScorePercent возвращает процент набранных баллов; тест без баллов считается пройденным полностью.
+func ScorePercent(score, maxScore int32) int32 {
+	if maxScore == 0 {
+		return 100
+	}
+	return score * 100 / maxScore
+}
@@ -0,0 +1,42 @@
+package models
+
+// CourseInteraction — сигналы о курсе одного студента: запись на курс, доля пройденных лекций
+// и оценка из опубликованного отзыва.
+type CourseInteraction struct {
+	StudentID int64
+	CourseID  int64
+	Progress  float64 // Доля пройденных лекций от 0 до 1.
+	Rating    int32   // Оценка из отзыва, 0 — отзыва нет.
+}
+
+// CourseSimilarity — близость курса SimilarCourseID к курсу CourseID.
+type CourseSimilarity struct {
+	CourseID        int64   `db:"course_id"`
+	SimilarCourseID int64   `db:"similar_course_id"`
+	CFScore         float64 `db:"cf_score"`      // Коллаборативная близость по студентам от 0 до 1.
+	ContentScore    float64 `db:"content_score"` // Близость по категории и тегам от 0 до 1.
+	CommonStudents  int32   `db:"common_students"`
+}
+
+// CourseRecommendation — рекомендованный студенту курс с пояснением.
+type CourseRecommendation struct {
+	CourseID        int64
+	Score           float64
+	BecauseCourseID int64 // Курс студента, давший наибольший вклад; 0 — популярный курс.
+	Collaborative   bool  // Основной вклад дали совместные записи студентов, а не категория и теги.
+}
+
+// CourseDocument — тексты курса для сравнения курсов по содержанию.
+type CourseDocument struct {
+	CourseID      int64
+	Name          string
+	Description   string
+	LectureTitles []string
+}
+
+// SimilarCourse — курс, похожий по текстам на другой курс.
+type SimilarCourse struct {
+	CourseID int64
+	Score    float64 // Косинусная близость TF-IDF-векторов от 0 до 1.
+	Course   *Course
+}
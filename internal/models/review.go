@@ -2,11 +2,51 @@ package models
 
 import "time"
 
+// Статусы модерации отзыва.
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
 type Review struct {
-	ID        int64     `db:"id"`
-	StudentID int64     `db:"student_id"`
-	CourseID  int64     `db:"course_id"`
-	Comment   string    `db:"comment"`
-	Rating    int32     `db:"rating"`
-	CreatedAt time.Time `db:"created_at"`
+	ID             int64      `db:"id"`
+	StudentID      int64      `db:"student_id"`
+	CourseID       int64      `db:"course_id"`
+	Comment        string     `db:"comment"`
+	Rating         int32      `db:"rating"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      *time.Time `db:"updated_at"`
+	Status         string     `db:"status"`
+	ModerationNote string     `db:"moderation_note"` // Причина задержки фильтром или комментарий модератора.
+
+	HelpfulCount    int32        `db:"helpful_count"`
+	NotHelpfulCount int32        `db:"not_helpful_count"`
+	Reply           *ReviewReply // Ответ преподавателя или nil.
+}
+
+// ReviewReply — ответ преподавателя курса на отзыв; к отзыву может быть только один ответ.
+type ReviewReply struct {
+	ReviewID     int64      `db:"review_id"`
+	InstructorID int64      `db:"instructor_id"`
+	Comment      string     `db:"comment"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    *time.Time `db:"updated_at"`
+}
+
+// ReviewReport — жалоба пользователя на отзыв.
+type ReviewReport struct {
+	ID           int64      `db:"id"`
+	ReviewID     int64      `db:"review_id"`
+	ReporterID   int64      `db:"reporter_id"`
+	ReporterRole string     `db:"reporter_role"`
+	Reason       string     `db:"reason"`
+	CreatedAt    time.Time  `db:"created_at"`
+	ResolvedAt   *time.Time `db:"resolved_at"`
+}
+
+// ModerationItem — отзыв в очереди модерации вместе с открытыми жалобами на него.
+type ModerationItem struct {
+	Review        *Review
+	ReportReasons []string
 }
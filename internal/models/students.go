@@ -1,8 +1,18 @@
 package models
 
+import "time"
+
 type Student struct {
 	ID       int64  `db:"id"`
 	Name     string `db:"name"`
 	Email    string `db:"email"`
 	Password string `db:"password"`
 }
+
+type StudentInvitation struct {
+	TokenHash  string     `db:"token_hash"`
+	StudentID  int64      `db:"student_id"`
+	CourseID   int64      `db:"course_id"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	AcceptedAt *time.Time `db:"accepted_at"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// TrendingEventKind — вид события, влияющего на популярность курса.
+type TrendingEventKind int
+
+const (
+	TrendingEnrollment TrendingEventKind = iota // Запись на курс.
+	TrendingCompletion                          // Завершение курса (выдан сертификат).
+	TrendingReview                              // Опубликованный отзыв.
+)
+
+// TrendingEvent — событие по курсу с моментом, когда оно произошло.
+type TrendingEvent struct {
+	CourseID int64
+	Kind     TrendingEventKind
+	At       time.Time
+	Rating   int32 // Оценка отзыва; для остальных событий 0.
+}
+
+// TrendingCourse — место курса в рейтинге популярности за окно WindowDays.
+type TrendingCourse struct {
+	CourseID      int64     `db:"course_id"`
+	WindowDays    int32     `db:"window_days"`
+	Rank          int32     `db:"rank"`
+	Score         float64   `db:"score"`
+	Enrollments   int32     `db:"enrollments"`
+	Completions   int32     `db:"completions"`
+	Reviews       int32     `db:"reviews"`
+	AverageRating float64   `db:"average_rating"` // Средняя оценка отзывов за окно, 0 — отзывов нет.
+	ComputedAt    time.Time `db:"computed_at"`
+	Course        *Course   `db:"-"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Роли владельцев подписок на вебхуки.
+const (
+	WebhookOwnerInstructor = "instructor"
+	WebhookOwnerAdmin      = "admin"
+)
+
+// Статусы доставки вебхука.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookSubscription — подписка преподавателя или администратора на доменные события.
+type WebhookSubscription struct {
+	ID                  int64     `db:"id"`
+	OwnerRole           string    `db:"owner_role"`
+	OwnerID             int64     `db:"owner_id"`
+	URL                 string    `db:"url"`
+	EventTypes          []string  `db:"event_types"`
+	Secret              string    `db:"secret"`
+	Active              bool      `db:"active"`
+	DisabledReason      string    `db:"disabled_reason"`
+	ConsecutiveFailures int32     `db:"consecutive_failures"`
+	CreatedAt           time.Time `db:"created_at"`
+}
+
+// WebhookDelivery — отправка одного события по одной подписке с результатом последней попытки.
+type WebhookDelivery struct {
+	ID             int64           `db:"id"`
+	SubscriptionID int64           `db:"subscription_id"`
+	EventID        string          `db:"event_id"`
+	EventType      string          `db:"event_type"`
+	Payload        json.RawMessage `db:"payload"`
+	Status         string          `db:"status"`
+	Attempts       int32           `db:"attempts"`
+	NextAttemptAt  time.Time       `db:"next_attempt_at"`
+	ResponseCode   int32           `db:"response_code"` // 0 — ответа не было.
+	LastError      string          `db:"last_error"`
+	RedeliveryOf   *int64          `db:"redelivery_of"`
+	CreatedAt      time.Time       `db:"created_at"`
+	DeliveredAt    *time.Time      `db:"delivered_at"`
+
+	URL    string `db:"-"` // Адрес и секрет подписки заполняются при выборке доставок для отправки.
+	Secret string `db:"-"`
+}
@@ -0,0 +1,64 @@
+package moderation
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// linkPattern находит ссылки: адреса со схемой, начинающиеся с www. и голые домены вида example.com.
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+|\b[a-z0-9-]+\.(com|net|org|ru|su|io|info|biz|xyz|top|me|co|ly|to|gg)\b`)
+
+// Filter — автоматическая предмодерация отзывов: отзывы со словами из стоп-листа или ссылками
+// не публикуются сразу, а ждут решения модератора.
+type Filter struct {
+	words   map[string]bool
+	phrases []string
+	links   bool
+}
+
+// NewFilter создаёт фильтр. blocklist — стоп-слова и фразы без учёта регистра; слова и фразы
+// сравниваются целыми словами, пунктуация и лишние пробелы не учитываются. blockLinks включает
+// задержку отзывов со ссылками.
+func NewFilter(blocklist []string, blockLinks bool) *Filter {
+	f := &Filter{words: make(map[string]bool), links: blockLinks}
+	for _, entry := range blocklist {
+		switch parts := words(strings.ToLower(entry)); len(parts) {
+		case 0:
+		case 1:
+			f.words[parts[0]] = true
+		default:
+			f.phrases = append(f.phrases, strings.Join(parts, " "))
+		}
+	}
+	return f
+}
+
+// Check возвращает причину, по которой текст нужно задержать, или пустую строку, если текст чистый.
+func (f *Filter) Check(text string) string {
+	if f == nil {
+		return ""
+	}
+	textWords := words(strings.ToLower(text))
+	for _, word := range textWords {
+		if f.words[word] {
+			return "стоп-слово «" + word + "»"
+		}
+	}
+	joined := " " + strings.Join(textWords, " ") + " "
+	for _, phrase := range f.phrases {
+		if strings.Contains(joined, " "+phrase+" ") {
+			return "стоп-фраза «" + phrase + "»"
+		}
+	}
+	if f.links && linkPattern.MatchString(text) {
+		return "ссылка в тексте"
+	}
+	return ""
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,89 @@
+package notification
+
+import (
+	"GoEdu/internal/config"
+	"GoEdu/internal/mailer"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Message — письмо с уведомлениями.
+type Message struct {
+	Subject string
+	Body    string
+}
+
+// Channel доставляет письма с уведомлениями студентам вне приложения.
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, to string, message Message) error
+}
+
+// NewChannel возвращает канал из NOTIFICATION_CHANNEL: email — отправка через SMTP, file — запись писем
+// в каталог NOTIFICATION_FILE_DIR для разработки и тестов.
+func NewChannel(cfg *config.Config, mail mailer.Mailer, logger *zap.Logger) (Channel, error) {
+	switch cfg.NotificationChannel {
+	case "email":
+		return NewMailChannel(mail), nil
+	case "file":
+		logger.Info("Письма с уведомлениями сохраняются в каталог", zap.String("dir", cfg.NotificationFileDir))
+		return NewFileChannel(cfg.NotificationFileDir)
+	}
+	return nil, fmt.Errorf("неизвестный канал уведомлений %q", cfg.NotificationChannel)
+}
+
+// MailChannel отправляет письма через Mailer.
+type MailChannel struct {
+	mailer mailer.Mailer
+}
+
+func NewMailChannel(mail mailer.Mailer) *MailChannel {
+	return &MailChannel{mailer: mail}
+}
+
+func (c *MailChannel) Name() string {
+	return "email"
+}
+
+func (c *MailChannel) Send(ctx context.Context, to string, message Message) error {
+	return c.mailer.Send(ctx, to, message.Subject, message.Body)
+}
+
+// FileChannel сохраняет каждое письмо отдельным файлом .eml в каталоге.
+type FileChannel struct {
+	dir     string
+	counter atomic.Int64
+}
+
+func NewFileChannel(dir string) (*FileChannel, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("не удалось создать каталог для писем: %w", err)
+	}
+	return &FileChannel{dir: dir}, nil
+}
+
+func (c *FileChannel) Name() string {
+	return "file"
+}
+
+func (c *FileChannel) Send(ctx context.Context, to string, message Message) error {
+	name := fmt.Sprintf("%s-%06d.eml", time.Now().UTC().Format("20060102T150405"), c.counter.Add(1))
+	content := strings.Join([]string{
+		"To: " + to,
+		"Subject: " + message.Subject,
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		message.Body,
+	}, "\r\n")
+	if err := os.WriteFile(filepath.Join(c.dir, name), []byte(content), 0o644); err != nil {
+		return fmt.Errorf("не удалось сохранить письмо для %s: %w", to, err)
+	}
+	return nil
+}
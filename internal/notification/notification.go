@@ -0,0 +1,160 @@
+package notification
+
+import (
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Sink создаёт уведомления студентов из доменных событий.
+type Sink struct {
+	notificationRepo repository.NotificationRepository
+	courseRepo       repository.CourseRepository
+	assignmentRepo   repository.AssignmentRepository
+}
+
+func NewSink(notificationRepo repository.NotificationRepository, courseRepo repository.CourseRepository, assignmentRepo repository.AssignmentRepository) *Sink {
+	return &Sink{notificationRepo: notificationRepo, courseRepo: courseRepo, assignmentRepo: assignmentRepo}
+}
+
+func (s *Sink) Name() string {
+	return "notifications"
+}
+
+// Publish создаёт уведомления о записи на курс, новой лекции, ответе на отзыв, проверке задания и объявлениях;
+// остальные события пропускаются. Уведомления о событии создаются не больше одного раза на студента,
+// поэтому повторная публикация безопасна.
+func (s *Sink) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	switch event.EventType {
+	case models.EventStudentEnrolled:
+		var payload models.StudentEnrollmentPayload
+		if err := decode(event, &payload); err != nil {
+			return err
+		}
+		course, err := s.courseRepo.GetCourseByID(ctx, payload.CourseID)
+		if err != nil || course == nil {
+			return err
+		}
+		return s.notifyStudent(ctx, event, payload.StudentID, &models.Notification{
+			Type:     models.NotificationEnrollment,
+			CourseID: course.ID,
+			Link:     fmt.Sprintf("/courses/%d", course.ID),
+			Data:     map[string]any{"course_name": course.Name, "path": payload.PathID != 0},
+		})
+
+	case models.EventLectureAdded:
+		var payload models.LectureAddedPayload
+		if err := decode(event, &payload); err != nil {
+			return err
+		}
+		// О лекциях с расписанием студенты узнают, когда лекция откроется.
+		if payload.Scheduled {
+			return nil
+		}
+		course, err := s.courseRepo.GetCourseByID(ctx, payload.CourseID)
+		if err != nil || course == nil {
+			return err
+		}
+		notification := &models.Notification{
+			Type:     models.NotificationLectureAdded,
+			EventID:  event.EventID,
+			CourseID: course.ID,
+			Link:     fmt.Sprintf("/courses/%d/lectures/%d", course.ID, payload.LectureID),
+			Data:     map[string]any{"course_name": course.Name, "lecture_title": payload.Title},
+		}
+		if err := render(notification); err != nil {
+			return err
+		}
+		_, err = s.notificationRepo.NotifyCourseStudents(ctx, notification)
+		return err
+
+	case models.EventReviewReplied:
+		var payload models.ReviewRepliedPayload
+		if err := decode(event, &payload); err != nil {
+			return err
+		}
+		course, err := s.courseRepo.GetCourseByID(ctx, payload.CourseID)
+		if err != nil || course == nil {
+			return err
+		}
+		return s.notifyStudent(ctx, event, payload.StudentID, &models.Notification{
+			Type:     models.NotificationReviewReply,
+			CourseID: payload.CourseID,
+			Link:     fmt.Sprintf("/courses/%d/reviews/%d", payload.CourseID, payload.ReviewID),
+			Data:     map[string]any{"course_name": course.Name, "comment": payload.Comment},
+		})
+
+	case models.EventAssignmentGraded:
+		var payload models.AssignmentGradedPayload
+		if err := decode(event, &payload); err != nil {
+			return err
+		}
+		assignment, err := s.assignmentRepo.GetAssignmentByID(ctx, payload.AssignmentID)
+		if err != nil || assignment == nil {
+			return err
+		}
+		return s.notifyStudent(ctx, event, payload.StudentID, &models.Notification{
+			Type:     models.NotificationAssignmentGraded,
+			CourseID: payload.CourseID,
+			Link:     fmt.Sprintf("/courses/%d/assignments/%d", payload.CourseID, payload.AssignmentID),
+			Data:     map[string]any{"assignment_title": assignment.Title, "score": payload.Score, "max_score": assignment.MaxScore},
+		})
+
+	case models.EventAnnouncementPublished:
+		var payload models.AnnouncementPublishedPayload
+		if err := decode(event, &payload); err != nil {
+			return err
+		}
+		course, err := s.courseRepo.GetCourseByID(ctx, payload.CourseID)
+		if err != nil || course == nil {
+			return err
+		}
+		notification := &models.Notification{
+			Type:     models.NotificationAnnouncement,
+			EventID:  event.EventID,
+			CourseID: course.ID,
+			Link:     fmt.Sprintf("/courses/%d/announcements/%d", course.ID, payload.AnnouncementID),
+			Data:     map[string]any{"course_name": course.Name, "title": payload.Title, "body": payload.Body},
+		}
+		if err := render(notification); err != nil {
+			return err
+		}
+		if payload.CohortID != 0 {
+			_, err = s.notificationRepo.NotifyCohortStudents(ctx, payload.CohortID, notification)
+		} else {
+			_, err = s.notificationRepo.NotifyCourseStudents(ctx, notification)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Sink) notifyStudent(ctx context.Context, event *models.OutboxEvent, studentID int64, notification *models.Notification) error {
+	notification.StudentID = studentID
+	notification.EventID = event.EventID
+	if err := render(notification); err != nil {
+		return err
+	}
+	_, err := s.notificationRepo.NotifyStudent(ctx, notification)
+	return err
+}
+
+// render заполняет заголовок и текст уведомления для приложения по русскому шаблону;
+// письма составляются по тем же параметрам на языке студента.
+func render(notification *models.Notification) error {
+	title, body, err := Render(models.LocaleRussian, notification.Type, notification.Data)
+	if err != nil {
+		return err
+	}
+	notification.Title, notification.Body = title, body
+	return nil
+}
+
+func decode(event *models.OutboxEvent, payload any) error {
+	if err := json.Unmarshal(event.Payload, payload); err != nil {
+		return fmt.Errorf("некорректные данные события %s: %w", event.EventID, err)
+	}
+	return nil
+}
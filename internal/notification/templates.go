@@ -0,0 +1,178 @@
+package notification
+
+import (
+	"GoEdu/internal/models"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Заголовки и тексты уведомлений по языкам и типам. Параметры берутся из Notification.Data.
+var notificationTexts = map[string]map[string][2]string{
+	models.LocaleRussian: {
+		models.NotificationEnrollment: {
+			`Вы записаны на курс «{{.course_name}}»`,
+			`{{if .path}}Вы записаны на курс в составе учебной программы.{{else}}Курс доступен в списке ваших курсов.{{end}}`,
+		},
+		models.NotificationLectureAdded:     {`Новая лекция в курсе «{{.course_name}}»`, `{{.lecture_title}}`},
+		models.NotificationReviewReply:      {`Преподаватель ответил на ваш отзыв о курсе «{{.course_name}}»`, `{{.comment}}`},
+		models.NotificationAssignmentGraded: {`Решение задания «{{.assignment_title}}» проверено`, `Оценка: {{.score}} из {{.max_score}}.`},
+		models.NotificationAnnouncement:     {`Объявление в курсе «{{.course_name}}»: {{.title}}`, `{{.body}}`},
+	},
+	models.LocaleEnglish: {
+		models.NotificationEnrollment: {
+			`You are enrolled in "{{.course_name}}"`,
+			`{{if .path}}You were enrolled in the course as part of a learning path.{{else}}The course is now in your course list.{{end}}`,
+		},
+		models.NotificationLectureAdded:     {`New lecture in "{{.course_name}}"`, `{{.lecture_title}}`},
+		models.NotificationReviewReply:      {`The instructor replied to your review of "{{.course_name}}"`, `{{.comment}}`},
+		models.NotificationAssignmentGraded: {`Your submission for "{{.assignment_title}}" has been graded`, `Score: {{.score}} of {{.max_score}}.`},
+		models.NotificationAnnouncement:     {`Announcement in "{{.course_name}}": {{.title}}`, `{{.body}}`},
+	},
+}
+
+// Шаблоны писем по языкам. Письмо о сразу отправляемом уведомлении и сводка отличаются только темой.
+var emailTexts = map[string]struct {
+	immediateSubject string
+	dailySubject     string
+	weeklySubject    string
+	body             string
+}{
+	models.LocaleRussian: {
+		immediateSubject: `{{(index .Items 0).Title}}`,
+		dailySubject:     `GoEdu: новых уведомлений за день — {{len .Items}}`,
+		weeklySubject:    `GoEdu: новых уведомлений за неделю — {{len .Items}}`,
+		body: `Здравствуйте, {{.Name}}!
+{{range .Items}}
+{{.Title}}
+{{if .Body}}{{.Body}}
+{{end}}{{if .Link}}Подробнее: {{.Link}}
+{{end}}{{end}}
+--
+Вы получаете это письмо, потому что подписаны на уведомления GoEdu.
+Отписаться от писем: {{.UnsubscribeURL}}
+`,
+	},
+	models.LocaleEnglish: {
+		immediateSubject: `{{(index .Items 0).Title}}`,
+		dailySubject:     `GoEdu: {{len .Items}} new notification(s) today`,
+		weeklySubject:    `GoEdu: {{len .Items}} new notification(s) this week`,
+		body: `Hello, {{.Name}}!
+{{range .Items}}
+{{.Title}}
+{{if .Body}}{{.Body}}
+{{end}}{{if .Link}}Details: {{.Link}}
+{{end}}{{end}}
+--
+You receive this email because you are subscribed to GoEdu notifications.
+Unsubscribe: {{.UnsubscribeURL}}
+`,
+	},
+}
+
+type textTemplates struct {
+	title *template.Template
+	body  *template.Template
+}
+
+type emailTemplates struct {
+	subjects map[string]*template.Template // По частоте писем.
+	body     *template.Template
+}
+
+var (
+	notificationTemplates  = make(map[string]map[string]textTemplates)
+	emailTemplatesByLocale = make(map[string]emailTemplates)
+)
+
+func init() {
+	for locale, texts := range notificationTexts {
+		notificationTemplates[locale] = make(map[string]textTemplates)
+		for notificationType, text := range texts {
+			notificationTemplates[locale][notificationType] = textTemplates{
+				title: template.Must(template.New(notificationType).Parse(text[0])),
+				body:  template.Must(template.New(notificationType).Parse(text[1])),
+			}
+		}
+	}
+	for locale, texts := range emailTexts {
+		emailTemplatesByLocale[locale] = emailTemplates{
+			subjects: map[string]*template.Template{
+				models.EmailImmediate: template.Must(template.New("subject").Parse(texts.immediateSubject)),
+				models.EmailDaily:     template.Must(template.New("subject").Parse(texts.dailySubject)),
+				models.EmailWeekly:    template.Must(template.New("subject").Parse(texts.weeklySubject)),
+			},
+			body: template.Must(template.New("body").Parse(texts.body)),
+		}
+	}
+}
+
+// Render возвращает заголовок и текст уведомления на языке locale; для неизвестного языка используется русский.
+func Render(locale, notificationType string, data map[string]any) (string, string, error) {
+	templates, ok := notificationTemplates[locale]
+	if !ok {
+		templates = notificationTemplates[models.LocaleRussian]
+	}
+	text, ok := templates[notificationType]
+	if !ok {
+		return "", "", fmt.Errorf("нет шаблона уведомления %q", notificationType)
+	}
+
+	var title, body strings.Builder
+	if err := text.title.Execute(&title, data); err != nil {
+		return "", "", err
+	}
+	if err := text.body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+	return title.String(), body.String(), nil
+}
+
+type emailItem struct {
+	Title string
+	Body  string
+	Link  string
+}
+
+// ComposeEmail составляет письмо с уведомлениями на языке студента: для частоты immediate — письмо
+// об одном уведомлении, для daily и weekly — сводку. Ссылки уведомлений дополняются адресом приложения.
+func ComposeEmail(email *models.NotificationEmail, frequency, appBaseURL, unsubscribeURL string) (Message, error) {
+	templates, ok := emailTemplatesByLocale[email.Locale]
+	if !ok {
+		templates = emailTemplatesByLocale[models.LocaleRussian]
+	}
+	subjectTemplate, ok := templates.subjects[frequency]
+	if !ok {
+		return Message{}, fmt.Errorf("нет шаблона письма для частоты %q", frequency)
+	}
+
+	data := struct {
+		Name           string
+		Items          []emailItem
+		UnsubscribeURL string
+	}{Name: email.StudentName, UnsubscribeURL: unsubscribeURL}
+	for _, notification := range email.Notifications {
+		item := emailItem{Title: notification.Title, Body: notification.Body}
+		// Уведомления без параметров созданы до появления шаблонов и показываются как сохранены.
+		if len(notification.Data) > 0 {
+			title, body, err := Render(email.Locale, notification.Type, notification.Data)
+			if err != nil {
+				return Message{}, err
+			}
+			item.Title, item.Body = title, body
+		}
+		if notification.Link != "" {
+			item.Link = strings.TrimRight(appBaseURL, "/") + notification.Link
+		}
+		data.Items = append(data.Items, item)
+	}
+
+	var subject, body strings.Builder
+	if err := subjectTemplate.Execute(&subject, data); err != nil {
+		return Message{}, err
+	}
+	if err := templates.body.Execute(&body, data); err != nil {
+		return Message{}, err
+	}
+	return Message{Subject: subject.String(), Body: body.String()}, nil
+}
@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnsubscribeTokenLifetime — срок действия ссылки отписки из письма.
+const UnsubscribeTokenLifetime = 90 * 24 * time.Hour
+
+// UnsubscribeToken возвращает токен ссылки отписки от писем вида <ID студента>.<время выдачи>.<HMAC-SHA256>.
+// Время выдачи входит в подпись, чтобы старые ссылки истекали и их можно было отозвать.
+func UnsubscribeToken(secret string, studentID int64, issuedAt time.Time) string {
+	payload := strconv.FormatInt(studentID, 10) + "." + strconv.FormatInt(issuedAt.Unix(), 10)
+	return payload + "." + unsubscribeSignature(secret, payload)
+}
+
+// ParseUnsubscribeToken проверяет подпись токена и возвращает ID студента и время выдачи токена.
+// Срок действия и отзыв токена проверяет вызывающий код.
+func ParseUnsubscribeToken(secret, token string) (int64, time.Time, bool) {
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return 0, time.Time{}, false
+	}
+	payload, signature := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(unsubscribeSignature(secret, payload)), []byte(strings.ToLower(signature))) {
+		return 0, time.Time{}, false
+	}
+
+	id, issued, ok := strings.Cut(payload, ".")
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	studentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || studentID <= 0 {
+		return 0, time.Time{}, false
+	}
+	issuedUnix, err := strconv.ParseInt(issued, 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	return studentID, time.Unix(issuedUnix, 0), true
+}
+
+func unsubscribeSignature(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("unsubscribe:v2:" + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,44 @@
+package peerreview
+
+import (
+	"GoEdu/internal/models"
+	"math/rand/v2"
+	"slices"
+)
+
+// Allocate распределяет решения между рецензентами: каждое решение получает до count рецензентов,
+// не считая автора, а нагрузка на рецензентов выравнивается — следующим назначается тот, у кого
+// меньше всего рецензий. Равные по нагрузке рецензенты выбираются случайно в зависимости от зерна.
+// Если рецензентов не хватает, решение получает столько, сколько есть.
+func Allocate(seed int64, submissions []*models.Submission, reviewerIDs []int64, count int) []*models.PeerReview {
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+
+	order := slices.Clone(submissions)
+	rng.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+	pool := slices.Clone(reviewerIDs)
+	rng.Shuffle(len(pool), func(i, j int) {
+		pool[i], pool[j] = pool[j], pool[i]
+	})
+
+	load := make(map[int64]int, len(pool))
+	var reviews []*models.PeerReview
+	for _, submission := range order {
+		candidates := make([]int64, 0, len(pool))
+		for _, reviewerID := range pool {
+			if reviewerID != submission.StudentID {
+				candidates = append(candidates, reviewerID)
+			}
+		}
+		slices.SortStableFunc(candidates, func(a, b int64) int {
+			return load[a] - load[b]
+		})
+
+		for _, reviewerID := range candidates[:min(count, len(candidates))] {
+			load[reviewerID]++
+			reviews = append(reviews, &models.PeerReview{SubmissionID: submission.ID, ReviewerID: reviewerID})
+		}
+	}
+	return reviews
+}
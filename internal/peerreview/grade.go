@@ -0,0 +1,56 @@
+package peerreview
+
+import (
+	"GoEdu/internal/models"
+	"math"
+	"slices"
+)
+
+// Grade считает оценку решения по отправленным рецензиям. Баллы по каждому критерию усредняются
+// с отбрасыванием выбросов: при трёх и более рецензиях с каждой стороны отбрасывается по 20%
+// самых высоких и самых низких оценок, но не меньше одной. Возвращает сумму баллов и баллы по критериям;
+// ok = false, если отправленных рецензий нет.
+func Grade(rubric []*models.RubricCriterion, reviews []*models.PeerReview) (rawScore int32, scores []*models.RubricScore, ok bool) {
+	points := make(map[int64][]int32, len(rubric))
+	submitted := 0
+	for _, review := range reviews {
+		if review.SubmittedAt == nil {
+			continue
+		}
+		submitted++
+		for _, score := range review.Scores {
+			points[score.CriterionID] = append(points[score.CriterionID], score.Points)
+		}
+	}
+	if submitted == 0 {
+		return 0, nil, false
+	}
+
+	for _, criterion := range rubric {
+		value := int32(math.Round(TrimmedMean(points[criterion.ID])))
+		value = min(value, criterion.MaxPoints)
+		scores = append(scores, &models.RubricScore{CriterionID: criterion.ID, Points: value})
+		rawScore += value
+	}
+	return rawScore, scores, true
+}
+
+// TrimmedMean возвращает среднее значение после отбрасывания выбросов; для пустого списка — 0.
+func TrimmedMean(values []int32) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	if len(sorted) >= 3 {
+		trim := max(1, len(sorted)/5)
+		sorted = sorted[trim : len(sorted)-trim]
+	}
+
+	var sum float64
+	for _, value := range sorted {
+		sum += float64(value)
+	}
+	return sum / float64(len(sorted))
+}
@@ -0,0 +1,80 @@
+package quiz
+
+import (
+	"GoEdu/internal/models"
+	"math/rand/v2"
+)
+
+// NewSeed возвращает случайное зерно для новой попытки.
+func NewSeed() int64 {
+	return rand.Int64()
+}
+
+// Draw собирает вопросы попытки: постоянные вопросы теста и случайные вопросы из банка по правилам.
+// Результат полностью определяется зерном, поэтому попытку можно восстановить по сохранённому seed.
+// Банк должен быть упорядочен по ID; вопрос не выбирается дважды, а при нехватке вопросов
+// правило берёт столько, сколько есть.
+func Draw(seed int64, quiz *models.Quiz, bank []*models.QuizQuestion) []*models.QuizQuestion {
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+
+	questions := append([]*models.QuizQuestion(nil), quiz.Questions...)
+	chosen := make(map[int64]bool)
+	for _, rule := range quiz.DrawRules {
+		var candidates []*models.QuizQuestion
+		for _, q := range bank {
+			if !chosen[q.ID] && rule.Matches(q) {
+				candidates = append(candidates, q)
+			}
+		}
+		rng.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+
+		n := min(int(rule.Count), len(candidates))
+		for _, q := range candidates[:n] {
+			chosen[q.ID] = true
+			questions = append(questions, q)
+		}
+	}
+
+	if quiz.ShuffleQuestions {
+		rng.Shuffle(len(questions), func(i, j int) {
+			questions[i], questions[j] = questions[j], questions[i]
+		})
+	}
+	return questions
+}
+
+// ShuffleOptions возвращает копию вопроса с вариантами в порядке, определяемом зерном попытки и ID вопроса.
+// Номера правильных вариантов пересчитываются под новый порядок, поэтому ответ студента проверяется
+// по номерам вариантов в том виде, в каком он их видел.
+func ShuffleOptions(seed int64, question *models.QuizQuestion) *models.QuizQuestion {
+	shuffled := *question
+	if len(question.Options) < 2 {
+		return &shuffled
+	}
+
+	order := make([]int, len(question.Options))
+	for i := range order {
+		order[i] = i
+	}
+	rng := rand.New(rand.NewPCG(uint64(seed), uint64(question.ID)))
+	rng.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+
+	position := make([]int32, len(order))
+	shuffled.Options = make([]string, len(order))
+	for i, original := range order {
+		shuffled.Options[i] = question.Options[original]
+		position[original] = int32(i)
+	}
+
+	shuffled.CorrectOptions = make([]int32, 0, len(question.CorrectOptions))
+	for _, original := range question.CorrectOptions {
+		if original >= 0 && int(original) < len(position) {
+			shuffled.CorrectOptions = append(shuffled.CorrectOptions, position[original])
+		}
+	}
+	return &shuffled
+}
@@ -0,0 +1,396 @@
+package quiz
+
+import (
+	"GoEdu/internal/models"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Формат GIFT (Moodle). Поддерживаются вопросы с выбором ({=верно ~неверно}, веса %50%),
+// «верно/неверно» ({T}/{F}), числовые ({#3.14:0.01} и {#1..2}) и короткий ответ ({=ответ =вариант}).
+// Теги, сложность и баллы передаются в комментариях перед вопросом: // [tag:go] [difficulty:easy] [points:2].
+
+var giftMetaPattern = regexp.MustCompile(`\[(tag|difficulty|points):([^\]]*)\]`)
+
+const giftSpecial = `\~=#{}:`
+
+// ParseGIFT разбирает вопросы в формате GIFT. Вопросы разделяются пустой строкой.
+func ParseGIFT(data []byte) ([]*models.QuizQuestion, error) {
+	var questions []*models.QuizQuestion
+	var block []string
+	var meta []string
+
+	flush := func() error {
+		text := strings.TrimSpace(strings.Join(block, "\n"))
+		block = block[:0]
+		if text == "" {
+			meta = meta[:0]
+			return nil
+		}
+		q, err := parseGIFTQuestion(text)
+		if err != nil {
+			return fmt.Errorf("вопрос %d: %w", len(questions)+1, err)
+		}
+		applyGIFTMeta(q, meta)
+		meta = meta[:0]
+		questions = append(questions, q)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(trimmed, "//"):
+			meta = append(meta, trimmed)
+		case strings.HasPrefix(trimmed, "$CATEGORY:"):
+			// Категории Moodle не переносятся: вопросы банка группируются тегами.
+		default:
+			block = append(block, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
+
+func applyGIFTMeta(q *models.QuizQuestion, comments []string) {
+	for _, comment := range comments {
+		for _, m := range giftMetaPattern.FindAllStringSubmatch(comment, -1) {
+			value := strings.TrimSpace(m[2])
+			switch m[1] {
+			case "tag":
+				if value != "" {
+					q.Tags = append(q.Tags, value)
+				}
+			case "difficulty":
+				q.Difficulty = strings.ToLower(value)
+			case "points":
+				if points, err := strconv.Atoi(value); err == nil {
+					q.Points = int32(points)
+				}
+			}
+		}
+	}
+}
+
+func parseGIFTQuestion(text string) (*models.QuizQuestion, error) {
+	if strings.HasPrefix(text, "::") {
+		end := indexUnescaped(text[2:], "::")
+		if end < 0 {
+			return nil, errors.New("не закрыт заголовок ::")
+		}
+		text = strings.TrimSpace(text[end+4:])
+	}
+
+	open := indexUnescaped(text, "{")
+	closing := lastIndexUnescaped(text, "}")
+	if open < 0 || closing < open {
+		return nil, errors.New("не найден блок ответов {...}")
+	}
+
+	stem := strings.TrimSpace(text[:open])
+	if tail := strings.TrimSpace(text[closing+1:]); tail != "" {
+		stem = strings.TrimSpace(stem + " _____ " + tail)
+	}
+	q := &models.QuizQuestion{Text: giftUnescape(stem)}
+	answers := strings.TrimSpace(text[open+1 : closing])
+
+	switch upper := strings.ToUpper(strings.TrimSpace(cutUnescaped(answers, "#"))); {
+	case upper == "T" || upper == "TRUE" || upper == "F" || upper == "FALSE":
+		value := upper[0] == 'T'
+		q.Type = models.QuestionTrueFalse
+		q.CorrectBool = &value
+		return q, nil
+	case strings.HasPrefix(answers, "#"):
+		return q, parseGIFTNumeric(q, answers[1:])
+	}
+
+	return q, parseGIFTChoices(q, answers)
+}
+
+func parseGIFTNumeric(q *models.QuizQuestion, answer string) error {
+	answer = strings.TrimPrefix(strings.TrimSpace(answer), "=")
+	answer = strings.TrimSpace(cutUnescaped(cutUnescaped(answer, "="), "#"))
+	q.Type = models.QuestionNumeric
+
+	if from, to, ok := strings.Cut(answer, ".."); ok {
+		min, err1 := strconv.ParseFloat(strings.TrimSpace(from), 64)
+		max, err2 := strconv.ParseFloat(strings.TrimSpace(to), 64)
+		if err1 != nil || err2 != nil || max < min {
+			return fmt.Errorf("некорректный диапазон %q", answer)
+		}
+		value := (min + max) / 2
+		q.CorrectNumber = &value
+		q.Tolerance = (max - min) / 2
+		return nil
+	}
+
+	value, tolerance, _ := strings.Cut(answer, ":")
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return fmt.Errorf("некорректный числовой ответ %q", value)
+	}
+	q.CorrectNumber = &number
+	if strings.TrimSpace(tolerance) != "" {
+		q.Tolerance, err = strconv.ParseFloat(strings.TrimSpace(tolerance), 64)
+		if err != nil {
+			return fmt.Errorf("некорректная погрешность %q", tolerance)
+		}
+	}
+	return nil
+}
+
+type giftAnswer struct {
+	marker   byte
+	weighted bool
+	weight   float64
+	text     string
+}
+
+func parseGIFTChoices(q *models.QuizQuestion, answers string) error {
+	parsed, err := splitGIFTAnswers(answers)
+	if err != nil {
+		return err
+	}
+
+	hasWrong, weighted := false, false
+	for _, a := range parsed {
+		hasWrong = hasWrong || a.marker == '~'
+		weighted = weighted || a.weighted
+	}
+
+	if !hasWrong {
+		q.Type = models.QuestionShortText
+		for _, a := range parsed {
+			q.AcceptedAnswers = append(q.AcceptedAnswers, a.text)
+		}
+		return nil
+	}
+
+	q.Type = models.QuestionSingleChoice
+	if weighted {
+		q.Type = models.QuestionMultipleChoice
+	}
+	for i, a := range parsed {
+		q.Options = append(q.Options, a.text)
+		if (a.marker == '=' && !a.weighted) || (a.weighted && a.weight > 0) {
+			q.CorrectOptions = append(q.CorrectOptions, int32(i))
+		}
+	}
+	return nil
+}
+
+// splitGIFTAnswers делит блок ответов по неэкранированным маркерам = и ~, отбрасывая отзывы после #.
+func splitGIFTAnswers(answers string) ([]giftAnswer, error) {
+	var parsed []giftAnswer
+	var current *giftAnswer
+	var buf strings.Builder
+
+	finish := func() error {
+		if current == nil {
+			if strings.TrimSpace(buf.String()) != "" {
+				return fmt.Errorf("ответ должен начинаться с = или ~: %q", strings.TrimSpace(buf.String()))
+			}
+			return nil
+		}
+		text := strings.TrimSpace(cutUnescaped(buf.String(), "#"))
+		if strings.HasPrefix(text, "%") {
+			end := strings.Index(text[1:], "%")
+			if end < 0 {
+				return fmt.Errorf("не закрыт вес ответа %q", text)
+			}
+			weight, err := strconv.ParseFloat(text[1:end+1], 64)
+			if err != nil {
+				return fmt.Errorf("некорректный вес ответа %q", text[1:end+1])
+			}
+			current.weighted, current.weight = true, weight
+			text = strings.TrimSpace(text[end+2:])
+		}
+		current.text = giftUnescape(text)
+		if current.text == "" {
+			return errors.New("пустой вариант ответа")
+		}
+		parsed = append(parsed, *current)
+		return nil
+	}
+
+	for i := 0; i < len(answers); i++ {
+		c := answers[i]
+		if c == '\\' && i+1 < len(answers) {
+			buf.WriteByte(c)
+			buf.WriteByte(answers[i+1])
+			i++
+			continue
+		}
+		if c == '=' || c == '~' {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			current = &giftAnswer{marker: c}
+			buf.Reset()
+			continue
+		}
+		buf.WriteByte(c)
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	if len(parsed) == 0 {
+		return nil, errors.New("не указаны ответы")
+	}
+	return parsed, nil
+}
+
+// FormatGIFT выгружает вопросы в формате GIFT.
+func FormatGIFT(questions []*models.QuizQuestion) []byte {
+	var b bytes.Buffer
+	for i, q := range questions {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		var meta []string
+		for _, tag := range q.Tags {
+			meta = append(meta, "[tag:"+tag+"]")
+		}
+		if q.Difficulty != "" {
+			meta = append(meta, "[difficulty:"+q.Difficulty+"]")
+		}
+		meta = append(meta, fmt.Sprintf("[points:%d]", q.Points))
+		b.WriteString("// " + strings.Join(meta, " ") + "\n")
+
+		fmt.Fprintf(&b, "::Q%d:: %s {", q.ID, giftEscape(q.Text))
+		switch q.Type {
+		case models.QuestionTrueFalse:
+			if q.CorrectBool != nil && *q.CorrectBool {
+				b.WriteString("TRUE")
+			} else {
+				b.WriteString("FALSE")
+			}
+		case models.QuestionNumeric:
+			var value float64
+			if q.CorrectNumber != nil {
+				value = *q.CorrectNumber
+			}
+			b.WriteString("#" + formatFloat(value))
+			if q.Tolerance > 0 {
+				b.WriteString(":" + formatFloat(q.Tolerance))
+			}
+		case models.QuestionShortText:
+			for _, answer := range q.AcceptedAnswers {
+				b.WriteString(" =" + giftEscape(answer))
+			}
+			b.WriteString(" ")
+		default:
+			correct := make(map[int32]bool, len(q.CorrectOptions))
+			for _, option := range q.CorrectOptions {
+				correct[option] = true
+			}
+			for j, option := range q.Options {
+				switch {
+				case q.Type == models.QuestionMultipleChoice && correct[int32(j)]:
+					b.WriteString(" ~%" + formatFloat(100/float64(len(q.CorrectOptions))) + "%")
+				case q.Type == models.QuestionMultipleChoice:
+					b.WriteString(" ~%-100%")
+				case correct[int32(j)]:
+					b.WriteString(" =")
+				default:
+					b.WriteString(" ~")
+				}
+				b.WriteString(giftEscape(option))
+			}
+			b.WriteString(" ")
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func giftEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\n':
+			b.WriteString(`\n`)
+		case strings.ContainsRune(giftSpecial, r):
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func giftUnescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			if s[i] == 'n' {
+				b.WriteByte('\n')
+			} else {
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// indexUnescaped ищет первое вхождение sep, не экранированное обратной косой чертой.
+func indexUnescaped(s, sep string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(s[i:], sep) {
+			return i
+		}
+	}
+	return -1
+}
+
+func lastIndexUnescaped(s, sep string) int {
+	last := -1
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(s[i:], sep) {
+			last = i
+		}
+	}
+	return last
+}
+
+// cutUnescaped возвращает часть строки до первого неэкранированного sep.
+func cutUnescaped(s, sep string) string {
+	if i := indexUnescaped(s, sep); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
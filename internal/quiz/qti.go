@@ -0,0 +1,167 @@
+package quiz
+
+import (
+	"GoEdu/internal/models"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Упрощённый XML-формат в духе QTI:
+//
+//	<questestinterop>
+//	  <item type="single_choice" points="1" difficulty="easy">
+//	    <text>Вопрос</text>
+//	    <tags><tag>go</tag></tags>
+//	    <choice correct="true">Верный вариант</choice>
+//	    <choice>Неверный вариант</choice>
+//	  </item>
+//	</questestinterop>
+//
+// Для true_false ответ задаётся как <answer>true</answer>, для numeric — <answer tolerance="0.1">3.14</answer>,
+// для short_text — одним или несколькими <answer>.
+
+type qtiDocument struct {
+	XMLName xml.Name  `xml:"questestinterop"`
+	Items   []qtiItem `xml:"item"`
+}
+
+type qtiItem struct {
+	Type       string      `xml:"type,attr"`
+	Points     int32       `xml:"points,attr,omitempty"`
+	Difficulty string      `xml:"difficulty,attr,omitempty"`
+	Text       string      `xml:"text"`
+	Tags       []string    `xml:"tags>tag,omitempty"`
+	Choices    []qtiChoice `xml:"choice"`
+	Answers    []qtiAnswer `xml:"answer"`
+}
+
+type qtiChoice struct {
+	Correct bool   `xml:"correct,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+type qtiAnswer struct {
+	Tolerance string `xml:"tolerance,attr,omitempty"`
+	Text      string `xml:",chardata"`
+}
+
+// ParseQTI разбирает вопросы из XML-файла.
+func ParseQTI(data []byte) ([]*models.QuizQuestion, error) {
+	var doc qtiDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("некорректный XML: %w", err)
+	}
+
+	questions := make([]*models.QuizQuestion, 0, len(doc.Items))
+	for i, item := range doc.Items {
+		q, err := parseQTIItem(item)
+		if err != nil {
+			return nil, fmt.Errorf("вопрос %d: %w", i+1, err)
+		}
+		questions = append(questions, q)
+	}
+	return questions, nil
+}
+
+func parseQTIItem(item qtiItem) (*models.QuizQuestion, error) {
+	q := &models.QuizQuestion{
+		Type:       strings.TrimSpace(item.Type),
+		Text:       strings.TrimSpace(item.Text),
+		Points:     item.Points,
+		Difficulty: strings.ToLower(strings.TrimSpace(item.Difficulty)),
+	}
+	for _, tag := range item.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			q.Tags = append(q.Tags, tag)
+		}
+	}
+
+	switch q.Type {
+	case models.QuestionSingleChoice, models.QuestionMultipleChoice:
+		for i, choice := range item.Choices {
+			q.Options = append(q.Options, strings.TrimSpace(choice.Text))
+			if choice.Correct {
+				q.CorrectOptions = append(q.CorrectOptions, int32(i))
+			}
+		}
+	case models.QuestionTrueFalse:
+		if len(item.Answers) != 1 {
+			return nil, fmt.Errorf("ожидается один <answer>")
+		}
+		value, err := strconv.ParseBool(strings.TrimSpace(item.Answers[0].Text))
+		if err != nil {
+			return nil, fmt.Errorf("некорректный ответ %q", item.Answers[0].Text)
+		}
+		q.CorrectBool = &value
+	case models.QuestionNumeric:
+		if len(item.Answers) != 1 {
+			return nil, fmt.Errorf("ожидается один <answer>")
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(item.Answers[0].Text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("некорректный числовой ответ %q", item.Answers[0].Text)
+		}
+		q.CorrectNumber = &value
+		if tolerance := strings.TrimSpace(item.Answers[0].Tolerance); tolerance != "" {
+			if q.Tolerance, err = strconv.ParseFloat(tolerance, 64); err != nil {
+				return nil, fmt.Errorf("некорректная погрешность %q", tolerance)
+			}
+		}
+	case models.QuestionShortText:
+		for _, answer := range item.Answers {
+			q.AcceptedAnswers = append(q.AcceptedAnswers, strings.TrimSpace(answer.Text))
+		}
+	default:
+		return nil, fmt.Errorf("неизвестный тип вопроса %q", item.Type)
+	}
+	return q, nil
+}
+
+// FormatQTI выгружает вопросы в XML.
+func FormatQTI(questions []*models.QuizQuestion) ([]byte, error) {
+	doc := qtiDocument{Items: make([]qtiItem, 0, len(questions))}
+	for _, q := range questions {
+		item := qtiItem{
+			Type:       q.Type,
+			Points:     q.Points,
+			Difficulty: q.Difficulty,
+			Text:       q.Text,
+			Tags:       q.Tags,
+		}
+
+		switch q.Type {
+		case models.QuestionSingleChoice, models.QuestionMultipleChoice:
+			correct := make(map[int32]bool, len(q.CorrectOptions))
+			for _, option := range q.CorrectOptions {
+				correct[option] = true
+			}
+			for i, option := range q.Options {
+				item.Choices = append(item.Choices, qtiChoice{Correct: correct[int32(i)], Text: option})
+			}
+		case models.QuestionTrueFalse:
+			item.Answers = []qtiAnswer{{Text: strconv.FormatBool(q.CorrectBool != nil && *q.CorrectBool)}}
+		case models.QuestionNumeric:
+			answer := qtiAnswer{}
+			if q.CorrectNumber != nil {
+				answer.Text = formatFloat(*q.CorrectNumber)
+			}
+			if q.Tolerance > 0 {
+				answer.Tolerance = formatFloat(q.Tolerance)
+			}
+			item.Answers = []qtiAnswer{answer}
+		case models.QuestionShortText:
+			for _, accepted := range q.AcceptedAnswers {
+				item.Answers = append(item.Answers, qtiAnswer{Text: accepted})
+			}
+		}
+		doc.Items = append(doc.Items, item)
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
@@ -0,0 +1,214 @@
+package recommend
+
+import (
+	"GoEdu/internal/models"
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+)
+
+const (
+	// Neighbours — сколько похожих курсов хранится для каждого курса.
+	Neighbours = 20
+	// Shrinkage ослабляет коллаборативную близость курсов с малым числом общих студентов:
+	// близость умножается на common / (common + Shrinkage).
+	Shrinkage = 3.0
+	// ColdStartWeight определяет смешивание оценок: при n курсах в истории студента доля
+	// коллаборативной оценки равна n / (n + ColdStartWeight), остальное даёт близость по категории и тегам.
+	ColdStartWeight = 2.0
+
+	categoryWeight = 0.4
+	tagsWeight     = 0.6
+)
+
+// Weight возвращает силу интереса студента к курсу: запись даёт 1, полностью пройденный курс — ещё 1,
+// а отзыв сдвигает вес на (оценка − 3) / 2, так что курс с оценкой 1 почти не влияет на рекомендации.
+func Weight(interaction *models.CourseInteraction) float64 {
+	weight := 1 + interaction.Progress
+	if interaction.Rating > 0 {
+		weight += float64(interaction.Rating-3) / 2
+	}
+	return max(weight, 0)
+}
+
+// Similarities рассчитывает для каждого курса до neighbours похожих курсов. Коллаборативная близость —
+// косинусная мера между векторами весов студентов с поправкой Shrinkage; близость по содержанию —
+// совпадение категории и мера Жаккара по тегам.
+func Similarities(interactions []*models.CourseInteraction, courses []*models.Course, neighbours int) []*models.CourseSimilarity {
+	byStudent := make(map[int64][]*models.CourseInteraction)
+	norms := make(map[int64]float64)
+	for _, interaction := range interactions {
+		weight := Weight(interaction)
+		byStudent[interaction.StudentID] = append(byStudent[interaction.StudentID], interaction)
+		norms[interaction.CourseID] += weight * weight
+	}
+
+	type pair struct{ a, b int64 }
+	dots := make(map[pair]float64)
+	common := make(map[pair]int32)
+	for _, history := range byStudent {
+		for _, first := range history {
+			for _, second := range history {
+				if first.CourseID == second.CourseID {
+					continue
+				}
+				key := pair{first.CourseID, second.CourseID}
+				dots[key] += Weight(first) * Weight(second)
+				common[key]++
+			}
+		}
+	}
+
+	candidates := make(map[int64][]*models.CourseSimilarity)
+	for key, dot := range dots {
+		if dot <= 0 {
+			continue
+		}
+		shared := float64(common[key])
+		score := dot / math.Sqrt(norms[key.a]*norms[key.b]) * shared / (shared + Shrinkage)
+		candidates[key.a] = append(candidates[key.a], &models.CourseSimilarity{
+			CourseID:        key.a,
+			SimilarCourseID: key.b,
+			CFScore:         score,
+			CommonStudents:  common[key],
+		})
+	}
+
+	for _, course := range courses {
+		existing := make(map[int64]*models.CourseSimilarity, len(candidates[course.ID]))
+		for _, similarity := range candidates[course.ID] {
+			existing[similarity.SimilarCourseID] = similarity
+		}
+		for _, other := range courses {
+			if other.ID == course.ID {
+				continue
+			}
+			score := ContentSimilarity(course, other)
+			if score == 0 {
+				continue
+			}
+			if similarity, ok := existing[other.ID]; ok {
+				similarity.ContentScore = score
+				continue
+			}
+			candidates[course.ID] = append(candidates[course.ID], &models.CourseSimilarity{
+				CourseID:        course.ID,
+				SimilarCourseID: other.ID,
+				ContentScore:    score,
+			})
+		}
+	}
+
+	var result []*models.CourseSimilarity
+	for _, list := range candidates {
+		slices.SortFunc(list, func(a, b *models.CourseSimilarity) int {
+			if c := cmp.Compare(max(b.CFScore, b.ContentScore), max(a.CFScore, a.ContentScore)); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.SimilarCourseID, b.SimilarCourseID)
+		})
+		result = append(result, list[:min(len(list), neighbours)]...)
+	}
+	slices.SortFunc(result, func(a, b *models.CourseSimilarity) int {
+		if c := cmp.Compare(a.CourseID, b.CourseID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SimilarCourseID, b.SimilarCourseID)
+	})
+	return result
+}
+
+// ContentSimilarity возвращает близость курсов по содержанию от 0 до 1: совпадение непустой категории
+// и мера Жаккара по тегам без учёта регистра.
+func ContentSimilarity(a, b *models.Course) float64 {
+	var score float64
+	if a.Category != "" && strings.EqualFold(a.Category, b.Category) {
+		score += categoryWeight
+	}
+
+	tags := make(map[string]bool, len(a.Tags))
+	for _, tag := range a.Tags {
+		tags[strings.ToLower(tag)] = true
+	}
+	union := len(tags)
+	shared := 0
+	seen := make(map[string]bool, len(b.Tags))
+	for _, tag := range b.Tags {
+		tag = strings.ToLower(tag)
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		if tags[tag] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	if union > 0 {
+		score += tagsWeight * float64(shared) / float64(union)
+	}
+	return score
+}
+
+// Recommend ранжирует курсы для студента с историей history по заранее рассчитанной близости similarities.
+// Оценка курса — средняя по истории (с весами Weight) смесь коллаборативной близости и близости по
+// содержанию; чем короче история, тем больше доля близости по содержанию. Курсы из exclude пропускаются.
+// Каждая рекомендация указывает курс из истории, давший наибольший вклад.
+func Recommend(history []*models.CourseInteraction, similarities []*models.CourseSimilarity, exclude map[int64]bool, limit int) []*models.CourseRecommendation {
+	weights := make(map[int64]float64, len(history))
+	var total float64
+	for _, interaction := range history {
+		weight := Weight(interaction)
+		weights[interaction.CourseID] = weight
+		total += weight
+	}
+	if total == 0 {
+		return nil
+	}
+	alpha := float64(len(history)) / (float64(len(history)) + ColdStartWeight)
+
+	type contribution struct {
+		value         float64
+		collaborative bool
+	}
+	scores := make(map[int64]float64)
+	best := make(map[int64]contribution)
+	because := make(map[int64]int64)
+	for _, similarity := range similarities {
+		weight, ok := weights[similarity.CourseID]
+		if !ok || exclude[similarity.SimilarCourseID] {
+			continue
+		}
+		cf := alpha * weight * similarity.CFScore
+		content := (1 - alpha) * weight * similarity.ContentScore
+		if cf+content <= 0 {
+			continue
+		}
+		candidate := similarity.SimilarCourseID
+		scores[candidate] += cf + content
+		if current, ok := best[candidate]; !ok || cf+content > current.value ||
+			(cf+content == current.value && similarity.CourseID < because[candidate]) {
+			best[candidate] = contribution{value: cf + content, collaborative: cf >= content}
+			because[candidate] = similarity.CourseID
+		}
+	}
+
+	recommendations := make([]*models.CourseRecommendation, 0, len(scores))
+	for courseID, score := range scores {
+		recommendations = append(recommendations, &models.CourseRecommendation{
+			CourseID:        courseID,
+			Score:           score / total,
+			BecauseCourseID: because[courseID],
+			Collaborative:   best[courseID].collaborative,
+		})
+	}
+	slices.SortFunc(recommendations, func(a, b *models.CourseRecommendation) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.CourseID, b.CourseID)
+	})
+	return recommendations[:min(len(recommendations), limit)]
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AdminRepository interface {
+	GetAdminByEmail(ctx context.Context, email string) (*models.Admin, error)
+}
+
+type adminRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAdminRepository(db *pgxpool.Pool) AdminRepository {
+	return &adminRepository{db: db}
+}
+
+var ErrAdminNotFound = errors.New("администратор не найден")
+
+func (r *adminRepository) GetAdminByEmail(ctx context.Context, email string) (*models.Admin, error) {
+	query := `SELECT id, name, email, password FROM admins WHERE email = $1;`
+
+	var admin models.Admin
+	err := r.db.QueryRow(ctx, query, email).Scan(&admin.ID, &admin.Name, &admin.Email, &admin.Password)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAdminNotFound
+		}
+		return nil, err
+	}
+	return &admin, nil
+}
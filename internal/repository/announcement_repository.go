@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AnnouncementRepository interface {
+	CreateAnnouncement(ctx context.Context, announcement *models.Announcement) error
+	DeleteAnnouncement(ctx context.Context, id int64) (bool, error)
+	GetAnnouncementByID(ctx context.Context, id int64) (*models.Announcement, error)
+	GetAnnouncements(ctx context.Context, courseID int64, filter AnnouncementFilter) ([]*models.Announcement, error)
+	PublishDue(ctx context.Context, until time.Time, limit int) (int, error)
+}
+
+// AnnouncementFilter задаёт выборку объявлений курса. По умолчанию возвращаются все опубликованные объявления.
+type AnnouncementFilter struct {
+	CohortID         int64 // Только объявления для всего курса и для этого потока.
+	StudentID        int64 // Только объявления для всего курса и для потока, в котором учится студент.
+	IncludeScheduled bool  // Вместе с ожидающими публикации.
+}
+
+type announcementRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAnnouncementRepository(db *pgxpool.Pool) AnnouncementRepository {
+	return &announcementRepository{db: db}
+}
+
+// CreateAnnouncement сохраняет объявление и заполняет ID и даты. Объявление, время публикации которого
+// уже наступило, публикуется сразу: событие AnnouncementPublished записывается в той же транзакции.
+func (r *announcementRepository) CreateAnnouncement(ctx context.Context, a *models.Announcement) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO announcements (course_id, cohort_id, instructor_id, title, body, publish_at, published_at)
+        VALUES ($1, NULLIF($2, 0), NULLIF($3, 0), $4, $5, $6, CASE WHEN $6 <= NOW() THEN NOW() END)
+        RETURNING id, published_at, created_at;
+    `
+	err = tx.QueryRow(ctx, query, a.CourseID, a.CohortID, a.InstructorID, a.Title, a.Body, a.PublishAt).Scan(&a.ID, &a.PublishedAt, &a.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if a.PublishedAt != nil {
+		if err := enqueueAnnouncement(ctx, tx, a); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func enqueueAnnouncement(ctx context.Context, tx pgx.Tx, a *models.Announcement) error {
+	payload := models.AnnouncementPublishedPayload{AnnouncementID: a.ID, CourseID: a.CourseID, CohortID: a.CohortID, Title: a.Title, Body: a.Body}
+	return enqueueEvent(ctx, tx, models.EventAnnouncementPublished, models.AggregateAnnouncement, a.ID, payload)
+}
+
+// DeleteAnnouncement удаляет объявление; объявление, ожидающее публикации, так и не будет опубликовано.
+func (r *announcementRepository) DeleteAnnouncement(ctx context.Context, id int64) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM announcements WHERE id = $1;`, id)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+const announcementColumns = `a.id, a.course_id, COALESCE(a.cohort_id, 0), COALESCE(a.instructor_id, 0), a.title, a.body, a.publish_at, a.published_at, a.created_at`
+
+func scanAnnouncement(row pgx.Row) (*models.Announcement, error) {
+	var a models.Announcement
+	if err := row.Scan(&a.ID, &a.CourseID, &a.CohortID, &a.InstructorID, &a.Title, &a.Body, &a.PublishAt, &a.PublishedAt, &a.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// GetAnnouncementByID возвращает объявление или nil, если оно не найдено.
+func (r *announcementRepository) GetAnnouncementByID(ctx context.Context, id int64) (*models.Announcement, error) {
+	announcement, err := scanAnnouncement(r.db.QueryRow(ctx, `SELECT `+announcementColumns+` FROM announcements a WHERE a.id = $1;`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return announcement, nil
+}
+
+// GetAnnouncements возвращает объявления курса от новых к старым по времени публикации.
+func (r *announcementRepository) GetAnnouncements(ctx context.Context, courseID int64, filter AnnouncementFilter) ([]*models.Announcement, error) {
+	query := `
+        SELECT ` + announcementColumns + `
+        FROM announcements a
+        WHERE a.course_id = $1
+          AND ($2 OR a.published_at IS NOT NULL)
+          AND ($3 = 0 OR a.cohort_id IS NULL OR a.cohort_id = $3)
+          AND ($4 = 0 OR a.cohort_id IS NULL OR a.cohort_id = (
+              SELECT e.cohort_id FROM enrollments e WHERE e.course_id = a.course_id AND e.student_id = $4
+          ))
+        ORDER BY a.publish_at DESC, a.id DESC;
+    `
+	rows, err := r.db.Query(ctx, query, courseID, filter.IncludeScheduled, filter.CohortID, filter.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var announcements []*models.Announcement
+	for rows.Next() {
+		announcement, err := scanAnnouncement(rows)
+		if err != nil {
+			return nil, err
+		}
+		announcements = append(announcements, announcement)
+	}
+	return announcements, rows.Err()
+}
+
+// PublishDue публикует до limit объявлений, время публикации которых не позже until, и возвращает их число.
+// Параллельные вызовы пропускают объявления, которые уже публикуются, поэтому каждое публикуется один раз.
+func (r *announcementRepository) PublishDue(ctx context.Context, until time.Time, limit int) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+        UPDATE announcements a SET published_at = NOW()
+        WHERE a.id IN (
+            SELECT id FROM announcements
+            WHERE published_at IS NULL AND publish_at <= $1
+            ORDER BY publish_at, id
+            LIMIT $2
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING `+announcementColumns+`;
+    `, until, limit)
+	if err != nil {
+		return 0, err
+	}
+	announcements, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*models.Announcement, error) {
+		return scanAnnouncement(row)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, announcement := range announcements {
+		if err := enqueueAnnouncement(ctx, tx, announcement); err != nil {
+			return 0, err
+		}
+	}
+	return len(announcements), tx.Commit(ctx)
+}
@@ -0,0 +1,464 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AssignmentRepository interface {
+	CreateAssignment(ctx context.Context, assignment *models.Assignment) (int64, error)
+	UpdateAssignment(ctx context.Context, assignment *models.Assignment, replaceRubric bool) error
+	DeleteAssignment(ctx context.Context, id int64) ([]string, bool, error)
+	GetAssignmentByID(ctx context.Context, id int64) (*models.Assignment, error)
+	GetAssignmentsByCourse(ctx context.Context, courseID int64) ([]*models.Assignment, error)
+	HasGradedSubmissions(ctx context.Context, assignmentID int64) (bool, error)
+	CreateSubmission(ctx context.Context, assignment *models.Assignment, submission *models.Submission) error
+	GetSubmissionByID(ctx context.Context, id int64) (*models.Submission, error)
+	GetSubmissions(ctx context.Context, assignmentID int64, filter SubmissionFilter) ([]*models.Submission, error)
+	GradeSubmission(ctx context.Context, submission *models.Submission) error
+	GetSubmissionFile(ctx context.Context, id int64) (*models.SubmissionFile, error)
+}
+
+// SubmissionFilter задаёт выборку решений задания. По умолчанию возвращается последняя версия решения каждого студента.
+type SubmissionFilter struct {
+	StudentID    int64
+	UngradedOnly bool
+	LateOnly     bool
+	AllVersions  bool
+}
+
+var (
+	ErrSubmissionLimitReached = errors.New("исчерпано количество отправок решения")
+	ErrSubmissionConflict     = errors.New("решение уже отправляется параллельным запросом")
+)
+
+type assignmentRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAssignmentRepository(db *pgxpool.Pool) AssignmentRepository {
+	return &assignmentRepository{db: db}
+}
+
+func (r *assignmentRepository) CreateAssignment(ctx context.Context, a *models.Assignment) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO assignments (course_id, title, description, due_at, late_policy, late_penalty_percent, max_score,
+                                 max_submissions, max_files, peer_review_count, peer_review_due_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        RETURNING id;
+    `
+	var id int64
+	err = tx.QueryRow(ctx, query, a.CourseID, a.Title, a.Description, a.DueAt, a.LatePolicy, a.LatePenaltyPercent, a.MaxScore,
+		a.MaxSubmissions, a.MaxFiles, a.PeerReviewCount, a.PeerReviewDueAt).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := insertRubric(ctx, tx, id, a.Rubric); err != nil {
+		return 0, err
+	}
+	return id, tx.Commit(ctx)
+}
+
+// UpdateAssignment обновляет задание; рубрика заменяется целиком, только если replaceRubric = true,
+// потому что вместе со старыми критериями удаляются и выставленные по ним баллы.
+func (r *assignmentRepository) UpdateAssignment(ctx context.Context, a *models.Assignment, replaceRubric bool) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE assignments
+        SET title = $1, description = $2, due_at = $3, late_policy = $4, late_penalty_percent = $5, max_score = $6,
+            max_submissions = $7, max_files = $8, peer_review_count = $9, peer_review_due_at = $10
+        WHERE id = $11;
+    `
+	commandTag, err := tx.Exec(ctx, query, a.Title, a.Description, a.DueAt, a.LatePolicy, a.LatePenaltyPercent, a.MaxScore,
+		a.MaxSubmissions, a.MaxFiles, a.PeerReviewCount, a.PeerReviewDueAt, a.ID)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if replaceRubric {
+		if _, err := tx.Exec(ctx, `DELETE FROM assignment_rubric_criteria WHERE assignment_id = $1;`, a.ID); err != nil {
+			return err
+		}
+		if err := insertRubric(ctx, tx, a.ID, a.Rubric); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func insertRubric(ctx context.Context, tx pgx.Tx, assignmentID int64, rubric []*models.RubricCriterion) error {
+	query := `
+        INSERT INTO assignment_rubric_criteria (assignment_id, position, title, description, max_points)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id;
+    `
+	for i, criterion := range rubric {
+		criterion.Position = int32(i + 1)
+		err := tx.QueryRow(ctx, query, assignmentID, criterion.Position, criterion.Title, criterion.Description, criterion.MaxPoints).Scan(&criterion.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAssignment удаляет задание вместе с решениями и возвращает ключи файлов решений,
+// чтобы их можно было удалить из файлового хранилища.
+func (r *assignmentRepository) DeleteAssignment(ctx context.Context, id int64) ([]string, bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+        SELECT f.storage_key
+        FROM submission_files f
+        JOIN assignment_submissions s ON s.id = f.submission_id
+        WHERE s.assignment_id = $1;
+    `, id)
+	if err != nil {
+		return nil, false, err
+	}
+	keys, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, false, err
+	}
+
+	commandTag, err := tx.Exec(ctx, `DELETE FROM assignments WHERE id = $1;`, id)
+	if err != nil {
+		return nil, false, err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return nil, false, nil
+	}
+	return keys, true, tx.Commit(ctx)
+}
+
+const assignmentColumns = `id, course_id, title, description, due_at, late_policy, late_penalty_percent, max_score, max_submissions, max_files,
+        peer_review_count, peer_review_due_at, peer_reviews_allocated_at`
+
+func scanAssignment(row pgx.Row) (*models.Assignment, error) {
+	var a models.Assignment
+	err := row.Scan(&a.ID, &a.CourseID, &a.Title, &a.Description, &a.DueAt, &a.LatePolicy, &a.LatePenaltyPercent, &a.MaxScore,
+		&a.MaxSubmissions, &a.MaxFiles, &a.PeerReviewCount, &a.PeerReviewDueAt, &a.PeerReviewsAllocatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// GetAssignmentByID возвращает задание с рубрикой или nil, если задание не найдено.
+func (r *assignmentRepository) GetAssignmentByID(ctx context.Context, id int64) (*models.Assignment, error) {
+	query := `SELECT ` + assignmentColumns + ` FROM assignments WHERE id = $1;`
+
+	assignment, err := scanAssignment(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT id, position, title, description, max_points
+        FROM assignment_rubric_criteria
+        WHERE assignment_id = $1
+        ORDER BY position;
+    `, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var criterion models.RubricCriterion
+		if err := rows.Scan(&criterion.ID, &criterion.Position, &criterion.Title, &criterion.Description, &criterion.MaxPoints); err != nil {
+			return nil, err
+		}
+		assignment.Rubric = append(assignment.Rubric, &criterion)
+	}
+	return assignment, rows.Err()
+}
+
+// GetAssignmentsByCourse возвращает задания курса без рубрик, ближайшие по сроку сдачи — первыми.
+func (r *assignmentRepository) GetAssignmentsByCourse(ctx context.Context, courseID int64) ([]*models.Assignment, error) {
+	query := `SELECT ` + assignmentColumns + ` FROM assignments WHERE course_id = $1 ORDER BY due_at NULLS LAST, id;`
+
+	rows, err := r.db.Query(ctx, query, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*models.Assignment
+	for rows.Next() {
+		assignment, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+// HasGradedSubmissions сообщает, есть ли у задания оценённые решения или отправленные рецензии студентов.
+func (r *assignmentRepository) HasGradedSubmissions(ctx context.Context, assignmentID int64) (bool, error) {
+	var graded bool
+	err := r.db.QueryRow(ctx, `
+        SELECT EXISTS (SELECT 1 FROM assignment_submissions WHERE assignment_id = $1 AND graded_at IS NOT NULL)
+            OR EXISTS (
+                SELECT 1 FROM peer_reviews pr
+                JOIN assignment_submissions s ON s.id = pr.submission_id
+                WHERE s.assignment_id = $1 AND pr.submitted_at IS NOT NULL
+            );
+    `, assignmentID).Scan(&graded)
+	return graded, err
+}
+
+// CreateSubmission сохраняет новую версию решения вместе с описанием файлов. Если отправки исчерпаны,
+// возвращается ErrSubmissionLimitReached; одновременная отправка двух версий — ErrSubmissionConflict.
+func (r *assignmentRepository) CreateSubmission(ctx context.Context, assignment *models.Assignment, s *models.Submission) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO assignment_submissions (assignment_id, student_id, version, text, submitted_at, late, penalty_percent)
+        SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, $4, $5, $6
+        FROM assignment_submissions
+        WHERE assignment_id = $1 AND student_id = $2
+        HAVING $7::int IS NULL OR COUNT(*) < $7::int
+        RETURNING id, version;
+    `
+	err = tx.QueryRow(ctx, query, assignment.ID, s.StudentID, s.Text, s.SubmittedAt, s.Late, s.PenaltyPercent,
+		assignment.MaxSubmissions).Scan(&s.ID, &s.Version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrSubmissionLimitReached
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrSubmissionConflict
+		}
+		return err
+	}
+	s.AssignmentID = assignment.ID
+
+	fileQuery := `
+        INSERT INTO submission_files (submission_id, filename, content_type, size, storage_key)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id;
+    `
+	for _, file := range s.Files {
+		file.SubmissionID = s.ID
+		if err := tx.QueryRow(ctx, fileQuery, s.ID, file.Filename, file.ContentType, file.Size, file.StorageKey).Scan(&file.ID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+const submissionColumns = `
+        s.id, s.assignment_id, s.student_id, s.version, s.text, s.submitted_at, s.late, s.penalty_percent,
+        s.raw_score, s.score, s.feedback, s.graded_at, s.graded_by, s.peer_graded
+`
+
+func scanSubmission(row pgx.Row) (*models.Submission, error) {
+	var s models.Submission
+	err := row.Scan(&s.ID, &s.AssignmentID, &s.StudentID, &s.Version, &s.Text, &s.SubmittedAt, &s.Late, &s.PenaltyPercent,
+		&s.RawScore, &s.Score, &s.Feedback, &s.GradedAt, &s.GradedBy, &s.PeerGraded)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// GetSubmissionByID возвращает решение с файлами и баллами по рубрике или nil, если решение не найдено.
+func (r *assignmentRepository) GetSubmissionByID(ctx context.Context, id int64) (*models.Submission, error) {
+	query := `SELECT ` + submissionColumns + ` FROM assignment_submissions s WHERE s.id = $1;`
+
+	submission, err := scanSubmission(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadFiles(ctx, []*models.Submission{submission}); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT rs.criterion_id, rs.points, rs.comment
+        FROM submission_rubric_scores rs
+        JOIN assignment_rubric_criteria c ON c.id = rs.criterion_id
+        WHERE rs.submission_id = $1
+        ORDER BY c.position;
+    `, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var score models.RubricScore
+		if err := rows.Scan(&score.CriterionID, &score.Points, &score.Comment); err != nil {
+			return nil, err
+		}
+		submission.RubricScores = append(submission.RubricScores, &score)
+	}
+	return submission, rows.Err()
+}
+
+// GetSubmissions возвращает решения задания с файлами, упорядоченные по времени отправки.
+func (r *assignmentRepository) GetSubmissions(ctx context.Context, assignmentID int64, filter SubmissionFilter) ([]*models.Submission, error) {
+	query := `
+        SELECT ` + submissionColumns + `
+        FROM assignment_submissions s
+        WHERE s.assignment_id = $1
+          AND ($2 = 0 OR s.student_id = $2)
+          AND ($3 OR s.version = (
+              SELECT MAX(latest.version) FROM assignment_submissions latest
+              WHERE latest.assignment_id = s.assignment_id AND latest.student_id = s.student_id
+          ))
+          AND (NOT $4 OR s.graded_at IS NULL)
+          AND (NOT $5 OR s.late)
+        ORDER BY s.submitted_at, s.id;
+    `
+
+	rows, err := r.db.Query(ctx, query, assignmentID, filter.StudentID, filter.AllVersions, filter.UngradedOnly, filter.LateOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []*models.Submission
+	for rows.Next() {
+		submission, err := scanSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return submissions, r.loadFiles(ctx, submissions)
+}
+
+func (r *assignmentRepository) loadFiles(ctx context.Context, submissions []*models.Submission) error {
+	if len(submissions) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*models.Submission, len(submissions))
+	ids := make([]int64, 0, len(submissions))
+	for _, submission := range submissions {
+		byID[submission.ID] = submission
+		ids = append(ids, submission.ID)
+	}
+
+	rows, err := r.db.Query(ctx, `SELECT `+fileColumns+` FROM submission_files WHERE submission_id = ANY($1) ORDER BY id;`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		file, err := scanSubmissionFile(rows)
+		if err != nil {
+			return err
+		}
+		byID[file.SubmissionID].Files = append(byID[file.SubmissionID].Files, file)
+	}
+	return rows.Err()
+}
+
+// GradeSubmission сохраняет оценку, отзыв и баллы по рубрике. Повторная проверка заменяет прежнюю оценку.
+// Если s.PeerGraded = true, оценка считается выставленной по рецензиям и сохраняется, только пока
+// преподаватель не оценил решение сам; в этом случае возвращается pgx.ErrNoRows.
+func (r *assignmentRepository) GradeSubmission(ctx context.Context, s *models.Submission) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE assignment_submissions
+        SET raw_score = $2, score = $3, feedback = $4, graded_at = NOW(), graded_by = $5, peer_graded = $6
+        WHERE id = $1 AND (NOT $6 OR graded_at IS NULL OR peer_graded)
+        RETURNING graded_at, (SELECT course_id FROM assignments WHERE id = assignment_id);
+    `
+	var courseID int64
+	if err := tx.QueryRow(ctx, query, s.ID, s.RawScore, s.Score, s.Feedback, s.GradedBy, s.PeerGraded).Scan(&s.GradedAt, &courseID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM submission_rubric_scores WHERE submission_id = $1;`, s.ID); err != nil {
+		return err
+	}
+	scoreQuery := `
+        INSERT INTO submission_rubric_scores (submission_id, criterion_id, points, comment)
+        VALUES ($1, $2, $3, $4);
+    `
+	for _, score := range s.RubricScores {
+		if _, err := tx.Exec(ctx, scoreQuery, s.ID, score.CriterionID, score.Points, score.Comment); err != nil {
+			return err
+		}
+	}
+
+	payload := models.AssignmentGradedPayload{SubmissionID: s.ID, AssignmentID: s.AssignmentID, StudentID: s.StudentID, CourseID: courseID, PeerGraded: s.PeerGraded}
+	if s.Score != nil {
+		payload.Score = *s.Score
+	}
+	if err := enqueueEvent(ctx, tx, models.EventAssignmentGraded, models.AggregateSubmission, s.ID, payload); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+const fileColumns = `id, submission_id, filename, content_type, size, storage_key`
+
+func scanSubmissionFile(row pgx.Row) (*models.SubmissionFile, error) {
+	var f models.SubmissionFile
+	if err := row.Scan(&f.ID, &f.SubmissionID, &f.Filename, &f.ContentType, &f.Size, &f.StorageKey); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// GetSubmissionFile возвращает описание файла решения или nil, если файл не найден.
+func (r *assignmentRepository) GetSubmissionFile(ctx context.Context, id int64) (*models.SubmissionFile, error) {
+	file, err := scanSubmissionFile(r.db.QueryRow(ctx, `SELECT `+fileColumns+` FROM submission_files WHERE id = $1;`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return file, err
+}
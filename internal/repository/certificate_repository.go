@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CertificateRepository interface {
+	CreateCertificate(ctx context.Context, cert *models.Certificate) (*models.Certificate, error)
+	GetCertificateByID(ctx context.Context, id int64) (*models.Certificate, error)
+	GetCertificateBySerial(ctx context.Context, serial string) (*models.Certificate, error)
+	GetCertificateByStudentCourse(ctx context.Context, studentID, courseID int64) (*models.Certificate, error)
+	GetCertificateByStudentPath(ctx context.Context, studentID, pathID int64) (*models.Certificate, error)
+	GetCertificatesByStudent(ctx context.Context, studentID int64) ([]*models.Certificate, error)
+	GetIssueNames(ctx context.Context, studentID, courseID int64) (studentName, courseName, instructorName string, err error)
+	GetPathIssueNames(ctx context.Context, studentID, pathID int64) (studentName, pathName, instructorName string, err error)
+}
+
+type certificateRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCertificateRepository(db *pgxpool.Pool) CertificateRepository {
+	return &certificateRepository{db: db}
+}
+
+// certificateColumns не включает PDF: он нужен только при скачивании сертификата.
+const certificateColumns = `id, serial, student_id, COALESCE(course_id, 0), COALESCE(path_id, 0), student_name, course_name, instructor_name, issued_at, signature`
+
+func scanCertificate(row pgx.Row, extra ...any) (*models.Certificate, error) {
+	var cert models.Certificate
+	dest := append([]any{
+		&cert.ID, &cert.Serial, &cert.StudentID, &cert.CourseID, &cert.PathID, &cert.StudentName,
+		&cert.CourseName, &cert.InstructorName, &cert.IssuedAt, &cert.Signature,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// CreateCertificate сохраняет сертификат. Если студенту уже выдан сертификат по курсу или программе
+// (например, при параллельном завершении лекций), возвращается существующий. Вместе с новым сертификатом
+// сохраняется событие CourseCompleted или LearningPathCompleted.
+func (r *certificateRepository) CreateCertificate(ctx context.Context, cert *models.Certificate) (*models.Certificate, error) {
+	query := `
+        INSERT INTO certificates (serial, student_id, course_id, path_id, student_name, course_name, instructor_name, issued_at, signature, pdf)
+        VALUES ($1, $2, NULLIF($3, 0), NULLIF($4, 0), $5, $6, $7, $8, $9, $10)
+        ON CONFLICT DO NOTHING
+        RETURNING ` + certificateColumns + `;
+    `
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	created, err := scanCertificate(tx.QueryRow(ctx, query,
+		cert.Serial, cert.StudentID, cert.CourseID, cert.PathID, cert.StudentName, cert.CourseName,
+		cert.InstructorName, cert.IssuedAt, cert.Signature, cert.PDF,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		tx.Rollback(ctx)
+		if cert.PathID != 0 {
+			return r.GetCertificateByStudentPath(ctx, cert.StudentID, cert.PathID)
+		}
+		return r.GetCertificateByStudentCourse(ctx, cert.StudentID, cert.CourseID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	payload := models.CertificateIssuedPayload{
+		StudentID:     created.StudentID,
+		CourseID:      created.CourseID,
+		PathID:        created.PathID,
+		CertificateID: created.ID,
+		Serial:        created.Serial,
+		IssuedAt:      created.IssuedAt,
+	}
+	if created.PathID != 0 {
+		err = enqueueEvent(ctx, tx, models.EventLearningPathCompleted, models.AggregateLearningPath, created.PathID, payload)
+	} else {
+		err = enqueueEvent(ctx, tx, models.EventCourseCompleted, models.AggregateCourse, created.CourseID, payload)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return created, tx.Commit(ctx)
+}
+
+// GetCertificateByID возвращает сертификат вместе с PDF или nil, если он не найден.
+func (r *certificateRepository) GetCertificateByID(ctx context.Context, id int64) (*models.Certificate, error) {
+	query := `SELECT ` + certificateColumns + `, pdf FROM certificates WHERE id = $1;`
+
+	var pdf []byte
+	cert, err := scanCertificate(r.db.QueryRow(ctx, query, id), &pdf)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	cert.PDF = pdf
+	return cert, nil
+}
+
+func (r *certificateRepository) GetCertificateBySerial(ctx context.Context, serial string) (*models.Certificate, error) {
+	query := `SELECT ` + certificateColumns + ` FROM certificates WHERE serial = $1;`
+
+	cert, err := scanCertificate(r.db.QueryRow(ctx, query, serial))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return cert, err
+}
+
+func (r *certificateRepository) GetCertificateByStudentCourse(ctx context.Context, studentID, courseID int64) (*models.Certificate, error) {
+	query := `SELECT ` + certificateColumns + ` FROM certificates WHERE student_id = $1 AND course_id = $2;`
+
+	cert, err := scanCertificate(r.db.QueryRow(ctx, query, studentID, courseID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return cert, err
+}
+
+func (r *certificateRepository) GetCertificateByStudentPath(ctx context.Context, studentID, pathID int64) (*models.Certificate, error) {
+	query := `SELECT ` + certificateColumns + ` FROM certificates WHERE student_id = $1 AND path_id = $2;`
+
+	cert, err := scanCertificate(r.db.QueryRow(ctx, query, studentID, pathID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return cert, err
+}
+
+func (r *certificateRepository) GetCertificatesByStudent(ctx context.Context, studentID int64) ([]*models.Certificate, error) {
+	query := `
+        SELECT ` + certificateColumns + `
+        FROM certificates
+        WHERE student_id = $1
+        ORDER BY issued_at DESC;
+    `
+
+	rows, err := r.db.Query(ctx, query, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var certs []*models.Certificate
+	for rows.Next() {
+		cert, err := scanCertificate(rows)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, rows.Err()
+}
+
+// GetIssueNames возвращает имена, которые печатаются на сертификате.
+func (r *certificateRepository) GetIssueNames(ctx context.Context, studentID, courseID int64) (string, string, string, error) {
+	query := `
+        SELECT s.name, c.name, COALESCE(i.name, '')
+        FROM students s
+        CROSS JOIN courses c
+        LEFT JOIN instructors i ON i.id = c.instructor_id
+        WHERE s.id = $1 AND c.id = $2;
+    `
+
+	var studentName, courseName, instructorName string
+	err := r.db.QueryRow(ctx, query, studentID, courseID).Scan(&studentName, &courseName, &instructorName)
+	return studentName, courseName, instructorName, err
+}
+
+// GetPathIssueNames возвращает имена, которые печатаются на сертификате учебной программы.
+func (r *certificateRepository) GetPathIssueNames(ctx context.Context, studentID, pathID int64) (string, string, string, error) {
+	query := `
+        SELECT s.name, p.name, COALESCE(i.name, '')
+        FROM students s
+        CROSS JOIN learning_paths p
+        LEFT JOIN instructors i ON i.id = p.instructor_id
+        WHERE s.id = $1 AND p.id = $2;
+    `
+
+	var studentName, pathName, instructorName string
+	err := r.db.QueryRow(ctx, query, studentID, pathID).Scan(&studentName, &pathName, &instructorName)
+	return studentName, pathName, instructorName, err
+}
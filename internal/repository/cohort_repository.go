@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CohortRepository interface {
+	CreateCohort(ctx context.Context, cohort *models.Cohort) (int64, error)
+	UpdateCohort(ctx context.Context, cohort *models.Cohort) error
+	DeleteCohort(ctx context.Context, id int64) (bool, error)
+	GetCohortByID(ctx context.Context, id int64) (*models.Cohort, error)
+	GetCohortsByCourse(ctx context.Context, courseID int64) ([]*models.Cohort, error)
+	AssignStudents(ctx context.Context, cohortID, courseID int64, studentIDs []int64) error
+	RemoveStudents(ctx context.Context, cohortID int64, studentIDs []int64) error
+	GetCohortStudents(ctx context.Context, cohortID int64) ([]*models.Student, error)
+	GetCohortProgress(ctx context.Context, cohortID int64) ([]*models.StudentProgress, error)
+}
+
+var (
+	ErrCohortNameTaken        = errors.New("поток с таким названием уже существует")
+	ErrCohortInstructorAbsent = errors.New("преподаватель потока не существует")
+	ErrStudentsNotEnrolled    = errors.New("не все студенты записаны на курс")
+)
+
+type cohortRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCohortRepository(db *pgxpool.Pool) CohortRepository {
+	return &cohortRepository{db: db}
+}
+
+func (r *cohortRepository) CreateCohort(ctx context.Context, cohort *models.Cohort) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO cohorts (course_id, name, start_date, end_date)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id;
+    `
+	var id int64
+	err = tx.QueryRow(ctx, query, cohort.CourseID, cohort.Name, cohort.StartDate, cohort.EndDate).Scan(&id)
+	if err != nil {
+		return 0, mapCohortError(err)
+	}
+
+	if err := replaceCohortInstructors(ctx, tx, id, cohort.InstructorIDs); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *cohortRepository) UpdateCohort(ctx context.Context, cohort *models.Cohort) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE cohorts
+        SET name = $1, start_date = $2, end_date = $3
+        WHERE id = $4;
+    `
+	commandTag, err := tx.Exec(ctx, query, cohort.Name, cohort.StartDate, cohort.EndDate, cohort.ID)
+	if err != nil {
+		return mapCohortError(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := replaceCohortInstructors(ctx, tx, cohort.ID, cohort.InstructorIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func replaceCohortInstructors(ctx context.Context, tx pgx.Tx, cohortID int64, instructorIDs []int64) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM cohort_instructors WHERE cohort_id = $1;`, cohortID); err != nil {
+		return err
+	}
+
+	query := `
+        INSERT INTO cohort_instructors (cohort_id, instructor_id)
+        SELECT $1, unnest($2::int[])
+        ON CONFLICT DO NOTHING;
+    `
+	if _, err := tx.Exec(ctx, query, cohortID, instructorIDs); err != nil {
+		return mapCohortError(err)
+	}
+	return nil
+}
+
+func mapCohortError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == "23505":
+			return ErrCohortNameTaken
+		case pgErr.Code == "23503" && pgErr.ConstraintName == "cohort_instructors_instructor_id_fkey":
+			return ErrCohortInstructorAbsent
+		}
+	}
+	return err
+}
+
+func (r *cohortRepository) DeleteCohort(ctx context.Context, id int64) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM cohorts WHERE id = $1;`, id)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+const cohortColumns = `
+        c.id, c.course_id, c.name, c.start_date, c.end_date,
+        COALESCE((SELECT array_agg(ci.instructor_id ORDER BY ci.instructor_id) FROM cohort_instructors ci WHERE ci.cohort_id = c.id), '{}'),
+        (SELECT COUNT(*)::int FROM enrollments e WHERE e.cohort_id = c.id)
+`
+
+func scanCohort(row pgx.Row) (*models.Cohort, error) {
+	var cohort models.Cohort
+	err := row.Scan(&cohort.ID, &cohort.CourseID, &cohort.Name, &cohort.StartDate, &cohort.EndDate, &cohort.InstructorIDs, &cohort.StudentCount)
+	if err != nil {
+		return nil, err
+	}
+	return &cohort, nil
+}
+
+func (r *cohortRepository) GetCohortByID(ctx context.Context, id int64) (*models.Cohort, error) {
+	query := `SELECT ` + cohortColumns + ` FROM cohorts c WHERE c.id = $1;`
+
+	cohort, err := scanCohort(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return cohort, nil
+}
+
+func (r *cohortRepository) GetCohortsByCourse(ctx context.Context, courseID int64) ([]*models.Cohort, error) {
+	query := `SELECT ` + cohortColumns + ` FROM cohorts c WHERE c.course_id = $1 ORDER BY c.start_date, c.id;`
+
+	rows, err := r.db.Query(ctx, query, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cohorts []*models.Cohort
+	for rows.Next() {
+		cohort, err := scanCohort(rows)
+		if err != nil {
+			return nil, err
+		}
+		cohorts = append(cohorts, cohort)
+	}
+	return cohorts, rows.Err()
+}
+
+// AssignStudents переводит студентов в поток. Если хотя бы один студент не записан на курс, изменения не применяются.
+func (r *cohortRepository) AssignStudents(ctx context.Context, cohortID, courseID int64, studentIDs []int64) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE enrollments
+        SET cohort_id = $1
+        WHERE course_id = $2 AND student_id = ANY($3);
+    `
+	commandTag, err := tx.Exec(ctx, query, cohortID, courseID, studentIDs)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != int64(len(studentIDs)) {
+		return ErrStudentsNotEnrolled
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *cohortRepository) RemoveStudents(ctx context.Context, cohortID int64, studentIDs []int64) error {
+	query := `
+        UPDATE enrollments
+        SET cohort_id = NULL
+        WHERE cohort_id = $1 AND student_id = ANY($2);
+    `
+	_, err := r.db.Exec(ctx, query, cohortID, studentIDs)
+	return err
+}
+
+func (r *cohortRepository) GetCohortStudents(ctx context.Context, cohortID int64) ([]*models.Student, error) {
+	query := `
+        SELECT s.id, s.name, s.email
+        FROM students s
+        JOIN enrollments e ON s.id = e.student_id
+        WHERE e.cohort_id = $1
+        ORDER BY s.id;
+    `
+
+	rows, err := r.db.Query(ctx, query, cohortID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var students []*models.Student
+	for rows.Next() {
+		var student models.Student
+		if err := rows.Scan(&student.ID, &student.Name, &student.Email); err != nil {
+			return nil, err
+		}
+		students = append(students, &student)
+	}
+	return students, rows.Err()
+}
+
+func (r *cohortRepository) GetCohortProgress(ctx context.Context, cohortID int64) ([]*models.StudentProgress, error) {
+	query := `
+        SELECT s.id, s.name,
+               (SELECT COUNT(DISTINCT lc.lecture_id)::int
+                FROM lecture_completions lc
+                JOIN lectures l ON lc.lecture_id = l.id
+                WHERE lc.student_id = s.id AND l.course_id = e.course_id),
+               (SELECT COUNT(*)::int FROM lectures l WHERE l.course_id = e.course_id)
+        FROM enrollments e
+        JOIN students s ON s.id = e.student_id
+        WHERE e.cohort_id = $1
+        ORDER BY s.id;
+    `
+
+	rows, err := r.db.Query(ctx, query, cohortID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var progress []*models.StudentProgress
+	for rows.Next() {
+		var p models.StudentProgress
+		if err := rows.Scan(&p.StudentID, &p.Name, &p.CompletedLectures, &p.TotalLectures); err != nil {
+			return nil, err
+		}
+		progress = append(progress, &p)
+	}
+	return progress, rows.Err()
+}
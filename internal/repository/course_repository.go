@@ -15,9 +15,15 @@ type CourseRepository interface {
 	CreateCourse(ctx context.Context, course *models.Course, tx pgx.Tx) (int, error)
 	GetAllCourses(ctx context.Context) ([]*models.Course, error)
 	GetCourseByID(ctx context.Context, id int64) (*models.Course, error)
-	UpdateCourse(ctx context.Context, tx pgx.Tx, id int64, name, description string) (*models.Course, error)
+	UpdateCourse(ctx context.Context, tx pgx.Tx, id int64, update CourseUpdate) (*models.Course, error)
 	DeleteCourse(ctx context.Context, id int64) (bool, error)
 	SearchCourses(ctx context.Context, keyword string) ([]*models.Course, error)
+	GetRatingMean(ctx context.Context) (float64, error)
+	GetCourseDocuments(ctx context.Context) ([]*models.CourseDocument, error)
+	GetCachedSimilarCourses(ctx context.Context, courseID int64) ([]*models.SimilarCourse, bool, error)
+	GetSimilarCoursesGeneration(ctx context.Context) (int64, error)
+	CacheSimilarCourses(ctx context.Context, courseID, generation int64, similar []*models.SimilarCourse) error
+	InvalidateSimilarCourses(ctx context.Context) error
 }
 
 type courseRepository struct {
@@ -30,12 +36,16 @@ func NewCourseRepository(db *pgxpool.Pool) CourseRepository {
 
 func (r *courseRepository) CreateCourse(ctx context.Context, course *models.Course, tx pgx.Tx) (int, error) {
 	query := `
-        INSERT INTO courses (name, description, instructor_id)
-        VALUES ($1, $2, $3)
+        INSERT INTO courses (name, description, instructor_id, sequential, category, tags)
+        VALUES ($1, $2, $3, $4, $5, $6)
         RETURNING id;
     `
+	tags := course.Tags
+	if tags == nil {
+		tags = []string{}
+	}
 	var id int
-	err := tx.QueryRow(ctx, query, course.Name, course.Description, course.InstructorID).Scan(&id)
+	err := tx.QueryRow(ctx, query, course.Name, course.Description, course.InstructorID, course.Sequential, course.Category, tags).Scan(&id)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -50,11 +60,32 @@ func (r *courseRepository) CreateCourse(ctx context.Context, course *models.Cour
 		return 0, err
 	}
 
+	payload := coursePayload(course)
+	payload.CourseID = int64(id)
+	if err := enqueueEvent(ctx, tx, models.EventCourseCreated, models.AggregateCourse, payload.CourseID, payload); err != nil {
+		return 0, err
+	}
 	return id, nil
 }
 
+const courseColumns = `id, name, description, instructor_id, sequential, rating_count, rating_sum, rating_counts, category, tags`
+
+// courseScanTargets возвращает поля курса в порядке courseColumns.
+func courseScanTargets(course *models.Course) []any {
+	return []any{&course.ID, &course.Name, &course.Description, &course.InstructorID, &course.Sequential,
+		&course.RatingCount, &course.RatingSum, &course.RatingCounts, &course.Category, &course.Tags}
+}
+
+func scanCourse(row pgx.Row) (*models.Course, error) {
+	var course models.Course
+	if err := row.Scan(courseScanTargets(&course)...); err != nil {
+		return nil, err
+	}
+	return &course, nil
+}
+
 func (r *courseRepository) GetAllCourses(ctx context.Context) ([]*models.Course, error) {
-	query := `SELECT id, name, description, instructor_id FROM courses;`
+	query := `SELECT ` + courseColumns + ` FROM courses ORDER BY id;`
 
 	rows, err := r.db.Query(ctx, query)
 	if err != nil {
@@ -64,11 +95,11 @@ func (r *courseRepository) GetAllCourses(ctx context.Context) ([]*models.Course,
 
 	var courses []*models.Course
 	for rows.Next() {
-		var course models.Course
-		if err := rows.Scan(&course.ID, &course.Name, &course.Description, &course.InstructorID); err != nil {
+		course, err := scanCourse(rows)
+		if err != nil {
 			return nil, err
 		}
-		courses = append(courses, &course)
+		courses = append(courses, course)
 	}
 
 	log.Printf("Количество курсов в базе: %d", len(courses))
@@ -77,10 +108,9 @@ func (r *courseRepository) GetAllCourses(ctx context.Context) ([]*models.Course,
 }
 
 func (r *courseRepository) GetCourseByID(ctx context.Context, id int64) (*models.Course, error) {
-	query := `SELECT id, name, description FROM courses WHERE id = $1;`
+	query := `SELECT ` + courseColumns + ` FROM courses WHERE id = $1;`
 
-	var course models.Course
-	err := r.db.QueryRow(ctx, query, id).Scan(&course.ID, &course.Name, &course.Description)
+	course, err := scanCourse(r.db.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -88,13 +118,22 @@ func (r *courseRepository) GetCourseByID(ctx context.Context, id int64) (*models
 		return nil, err
 	}
 
-	return &course, nil
+	return course, nil
 }
 
-func (r *courseRepository) UpdateCourse(ctx context.Context, tx pgx.Tx, id int64, name, description string) (*models.Course, error) {
+// CourseUpdate — новые значения полей курса; nil-поля не изменяются.
+type CourseUpdate struct {
+	Name        string
+	Description string
+	Sequential  *bool
+	Category    *string
+	Tags        []string // nil — теги не изменяются.
+}
+
+func (r *courseRepository) UpdateCourse(ctx context.Context, tx pgx.Tx, id int64, update CourseUpdate) (*models.Course, error) {
 	var existingID int64
 	queryCheck := "SELECT id FROM courses WHERE name = $1 AND id != $2"
-	err := tx.QueryRow(ctx, queryCheck, name, id).Scan(&existingID)
+	err := tx.QueryRow(ctx, queryCheck, update.Name, id).Scan(&existingID)
 
 	if err == nil {
 		return nil, fmt.Errorf("duplicate name: %w", pgx.ErrNoRows)
@@ -104,22 +143,24 @@ func (r *courseRepository) UpdateCourse(ctx context.Context, tx pgx.Tx, id int64
 
 	queryUpdate := `
         UPDATE courses
-        SET name = $1, description = $2
-        WHERE id = $3
-        RETURNING id, name, description;
+        SET name = $1, description = $2, sequential = COALESCE($3, sequential),
+            category = COALESCE($5, category), tags = COALESCE($6, tags)
+        WHERE id = $4
+        RETURNING ` + courseColumns + `;
     `
 
-	var course models.Course
-	err = tx.QueryRow(ctx, queryUpdate, name, description, id).Scan(
-		&course.ID,
-		&course.Name,
-		&course.Description,
-	)
+	course, err := scanCourse(tx.QueryRow(ctx, queryUpdate, update.Name, update.Description, update.Sequential, id, update.Category, update.Tags))
 	if err != nil {
 		return nil, err
 	}
+	if err := enqueueEvent(ctx, tx, models.EventCourseUpdated, models.AggregateCourse, course.ID, coursePayload(course)); err != nil {
+		return nil, err
+	}
+	return course, nil
+}
 
-	return &course, nil
+func coursePayload(course *models.Course) models.CoursePayload {
+	return models.CoursePayload{CourseID: course.ID, InstructorID: course.InstructorID, Name: course.Name, Category: course.Category}
 }
 
 func (r *courseRepository) DeleteCourse(ctx context.Context, id int64) (bool, error) {
@@ -142,6 +183,9 @@ func (r *courseRepository) DeleteCourse(ctx context.Context, id int64) (bool, er
 	if commandTag.RowsAffected() == 0 {
 		return false, nil
 	}
+	if err := enqueueEvent(ctx, tx, models.EventCourseDeleted, models.AggregateCourse, id, models.CoursePayload{CourseID: id}); err != nil {
+		return false, err
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return false, err
@@ -152,9 +196,10 @@ func (r *courseRepository) DeleteCourse(ctx context.Context, id int64) (bool, er
 
 func (r *courseRepository) SearchCourses(ctx context.Context, keyword string) ([]*models.Course, error) {
 	query := `
-        SELECT id, name, description, instructor_id
+        SELECT ` + courseColumns + `
         FROM courses
-        WHERE name ILIKE $1 OR description ILIKE $1;
+        WHERE name ILIKE $1 OR description ILIKE $1
+        ORDER BY id;
     `
 
 	rows, err := r.db.Query(ctx, query, "%"+keyword+"%")
@@ -165,12 +210,129 @@ func (r *courseRepository) SearchCourses(ctx context.Context, keyword string) ([
 
 	var courses []*models.Course
 	for rows.Next() {
-		var course models.Course
-		if err := rows.Scan(&course.ID, &course.Name, &course.Description, &course.InstructorID); err != nil {
+		course, err := scanCourse(rows)
+		if err != nil {
 			return nil, err
 		}
-		courses = append(courses, &course)
+		courses = append(courses, course)
 	}
 
 	return courses, nil
 }
+
+// GetRatingMean возвращает среднюю оценку по отзывам всех курсов или 0, если отзывов нет.
+func (r *courseRepository) GetRatingMean(ctx context.Context) (float64, error) {
+	var mean float64
+	err := r.db.QueryRow(ctx, `
+        SELECT COALESCE(SUM(rating_sum)::DOUBLE PRECISION / NULLIF(SUM(rating_count), 0), 0)
+        FROM courses;
+    `).Scan(&mean)
+	return mean, err
+}
+
+// GetCourseDocuments возвращает названия, описания и названия лекций всех курсов.
+func (r *courseRepository) GetCourseDocuments(ctx context.Context) ([]*models.CourseDocument, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT c.id, c.name, c.description,
+               COALESCE(ARRAY_AGG(l.title ORDER BY l.position) FILTER (WHERE l.id IS NOT NULL), '{}')
+        FROM courses c
+        LEFT JOIN lectures l ON l.course_id = c.id
+        GROUP BY c.id
+        ORDER BY c.id;
+    `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var documents []*models.CourseDocument
+	for rows.Next() {
+		var document models.CourseDocument
+		if err := rows.Scan(&document.CourseID, &document.Name, &document.Description, &document.LectureTitles); err != nil {
+			return nil, err
+		}
+		documents = append(documents, &document)
+	}
+	return documents, rows.Err()
+}
+
+// GetCachedSimilarCourses возвращает сохранённые похожие курсы вместе с самими курсами;
+// cached = false, если для курса ещё ничего не рассчитано в текущем поколении кэша.
+func (r *courseRepository) GetCachedSimilarCourses(ctx context.Context, courseID int64) ([]*models.SimilarCourse, bool, error) {
+	var cached bool
+	err := r.db.QueryRow(ctx, `
+        SELECT EXISTS (
+            SELECT 1 FROM similar_courses_cache
+            WHERE course_id = $1 AND generation = (SELECT generation FROM similar_courses_generation)
+        );
+    `, courseID).Scan(&cached)
+	if err != nil || !cached {
+		return nil, false, err
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT s.score, `+courseColumns+`
+        FROM similar_courses_cache cache
+        CROSS JOIN UNNEST(cache.similar_course_ids, cache.scores) WITH ORDINALITY AS s(course_id, score, position)
+        JOIN courses c ON c.id = s.course_id
+        WHERE cache.course_id = $1
+        ORDER BY s.position;
+    `, courseID)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	similar := []*models.SimilarCourse{}
+	for rows.Next() {
+		var item models.SimilarCourse
+		var course models.Course
+		if err := rows.Scan(append([]any{&item.Score}, courseScanTargets(&course)...)...); err != nil {
+			return nil, false, err
+		}
+		item.CourseID = course.ID
+		item.Course = &course
+		similar = append(similar, &item)
+	}
+	return similar, true, rows.Err()
+}
+
+// GetSimilarCoursesGeneration возвращает текущее поколение кэша похожих курсов. Его нужно прочитать
+// до текстов курсов и передать в CacheSimilarCourses.
+func (r *courseRepository) GetSimilarCoursesGeneration(ctx context.Context) (int64, error) {
+	var generation int64
+	err := r.db.QueryRow(ctx, `SELECT generation FROM similar_courses_generation;`).Scan(&generation)
+	return generation, err
+}
+
+// CacheSimilarCourses сохраняет рассчитанные похожие курсы в кэш, заменяя прежние. Если после чтения
+// поколения generation кэш был сброшен, результат устарел и не сохраняется.
+func (r *courseRepository) CacheSimilarCourses(ctx context.Context, courseID, generation int64, similar []*models.SimilarCourse) error {
+	ids := make([]int64, 0, len(similar))
+	scores := make([]float64, 0, len(similar))
+	for _, item := range similar {
+		ids = append(ids, item.CourseID)
+		scores = append(scores, item.Score)
+	}
+	_, err := r.db.Exec(ctx, `
+        INSERT INTO similar_courses_cache (course_id, similar_course_ids, scores, generation)
+        SELECT $1, $2, $3, generation FROM similar_courses_generation WHERE generation = $4
+        ON CONFLICT (course_id) DO UPDATE
+        SET similar_course_ids = EXCLUDED.similar_course_ids, scores = EXCLUDED.scores,
+            generation = EXCLUDED.generation, computed_at = NOW();
+    `, courseID, ids, scores, generation)
+	return err
+}
+
+// InvalidateSimilarCourses сбрасывает кэш похожих курсов целиком: изменение текстов одного курса
+// меняет веса слов, а значит и близость остальных курсов между собой. Увеличенное поколение отсекает
+// и строки, которые параллельный расчёт запишет уже после удаления.
+func (r *courseRepository) InvalidateSimilarCourses(ctx context.Context) error {
+	_, err := r.db.Exec(ctx, `
+        WITH bumped AS (
+            UPDATE similar_courses_generation SET generation = generation + 1
+        )
+        DELETE FROM similar_courses_cache;
+    `)
+	return err
+}
@@ -3,7 +3,9 @@ package repository
 import (
 	"GoEdu/internal/models"
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -12,6 +14,9 @@ type EnrollmentRepository interface {
 	GetStudentsByCourse(ctx context.Context, courseID int64) ([]*models.Student, error)
 	UnEnrollStudent(ctx context.Context, studentID, courseID int64) error
 	GetCoursesByStudent(ctx context.Context, studentID int64) ([]*models.Course, error)
+	EnrollStudentTx(ctx context.Context, tx pgx.Tx, studentID, courseID int64) (bool, error)
+	IsEnrolled(ctx context.Context, studentID, courseID int64) (bool, error)
+	GetEnrollment(ctx context.Context, studentID, courseID int64) (*models.Enrollment, error)
 }
 
 type enrollmentRepository struct {
@@ -23,14 +28,16 @@ func NewEnrollmentRepository(db *pgxpool.Pool) EnrollmentRepository {
 }
 
 func (r *enrollmentRepository) EnrollStudent(ctx context.Context, studentID, courseID int64) error {
-	query := `
-        INSERT INTO enrollments (student_id, course_id)
-        VALUES ($1, $2)
-        ON CONFLICT (student_id, course_id) DO NOTHING;
-    `
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
 
-	_, err := r.db.Exec(ctx, query, studentID, courseID)
-	return err
+	if _, err := r.EnrollStudentTx(ctx, tx, studentID, courseID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 func (r *enrollmentRepository) GetStudentsByCourse(ctx context.Context, courseID int64) ([]*models.Student, error) {
@@ -63,8 +70,23 @@ func (r *enrollmentRepository) UnEnrollStudent(ctx context.Context, studentID, c
         DELETE FROM enrollments
         WHERE student_id = $1 AND course_id = $2;
     `
-	_, err := r.db.Exec(ctx, query, studentID, courseID)
-	return err
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	commandTag, err := tx.Exec(ctx, query, studentID, courseID)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() > 0 {
+		payload := models.StudentEnrollmentPayload{StudentID: studentID, CourseID: courseID}
+		if err := enqueueEvent(ctx, tx, models.EventStudentUnenrolled, models.AggregateCourse, courseID, payload); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
 }
 
 func (r *enrollmentRepository) GetCoursesByStudent(ctx context.Context, studentID int64) ([]*models.Course, error) {
@@ -91,3 +113,53 @@ func (r *enrollmentRepository) GetCoursesByStudent(ctx context.Context, studentI
 	}
 	return courses, nil
 }
+
+// EnrollStudentTx записывает студента на курс в рамках транзакции и сообщает, была ли создана новая запись.
+// Для новой записи в той же транзакции сохраняется событие StudentEnrolled.
+func (r *enrollmentRepository) EnrollStudentTx(ctx context.Context, tx pgx.Tx, studentID, courseID int64) (bool, error) {
+	query := `
+        INSERT INTO enrollments (student_id, course_id)
+        VALUES ($1, $2)
+        ON CONFLICT (student_id, course_id) DO NOTHING;
+    `
+
+	commandTag, err := tx.Exec(ctx, query, studentID, courseID)
+	if err != nil || commandTag.RowsAffected() == 0 {
+		return false, err
+	}
+	payload := models.StudentEnrollmentPayload{StudentID: studentID, CourseID: courseID}
+	if err := enqueueEvent(ctx, tx, models.EventStudentEnrolled, models.AggregateCourse, courseID, payload); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *enrollmentRepository) IsEnrolled(ctx context.Context, studentID, courseID int64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM enrollments WHERE student_id = $1 AND course_id = $2);`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, studentID, courseID).Scan(&exists)
+	return exists, err
+}
+
+// GetEnrollment возвращает запись студента на курс вместе с датой старта его потока или nil, если студент не записан.
+func (r *enrollmentRepository) GetEnrollment(ctx context.Context, studentID, courseID int64) (*models.Enrollment, error) {
+	query := `
+        SELECT e.student_id, e.course_id, e.enrolled_at, e.cohort_id, c.start_date
+        FROM enrollments e
+        LEFT JOIN cohorts c ON c.id = e.cohort_id
+        WHERE e.student_id = $1 AND e.course_id = $2;
+    `
+
+	var enrollment models.Enrollment
+	err := r.db.QueryRow(ctx, query, studentID, courseID).Scan(
+		&enrollment.StudentID, &enrollment.CourseID, &enrollment.EnrolledAt, &enrollment.CohortID, &enrollment.CohortStartDate,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &enrollment, nil
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GradebookRepository interface {
+	CreateCategory(ctx context.Context, category *models.GradeCategory) error
+	UpdateCategory(ctx context.Context, category *models.GradeCategory) error
+	DeleteCategory(ctx context.Context, id int64) error
+	GetCategoryByID(ctx context.Context, id int64) (*models.GradeCategory, error)
+	GetCategoriesByCourse(ctx context.Context, courseID int64) ([]*models.GradeCategory, error)
+	GetItems(ctx context.Context, courseID int64) ([]*models.GradeItem, error)
+	SetItemCategory(ctx context.Context, courseID int64, itemType string, itemID int64, categoryID *int64) (bool, error)
+	GetScores(ctx context.Context, courseID int64) ([]*models.ItemScore, error)
+	GetLetterScheme(ctx context.Context, courseID int64) ([]*models.LetterGrade, error)
+	SetLetterScheme(ctx context.Context, courseID int64, letters []*models.LetterGrade) error
+	CreateAdjustment(ctx context.Context, adjustment *models.GradeAdjustment) error
+	GetAdjustments(ctx context.Context, courseID, studentID int64) ([]*models.GradeAdjustment, error)
+}
+
+type gradebookRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewGradebookRepository(db *pgxpool.Pool) GradebookRepository {
+	return &gradebookRepository{db: db}
+}
+
+func (r *gradebookRepository) CreateCategory(ctx context.Context, category *models.GradeCategory) error {
+	query := `
+        INSERT INTO grade_categories (course_id, name, weight)
+        VALUES ($1, $2, $3)
+        RETURNING id;
+    `
+	return r.db.QueryRow(ctx, query, category.CourseID, category.Name, category.Weight).Scan(&category.ID)
+}
+
+func (r *gradebookRepository) UpdateCategory(ctx context.Context, category *models.GradeCategory) error {
+	query := `
+        UPDATE grade_categories
+        SET name = $2, weight = $3
+        WHERE id = $1
+        RETURNING course_id;
+    `
+	return r.db.QueryRow(ctx, query, category.ID, category.Name, category.Weight).Scan(&category.CourseID)
+}
+
+// DeleteCategory удаляет категорию; тесты и задания категории остаются без категории.
+func (r *gradebookRepository) DeleteCategory(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM grade_categories WHERE id = $1;`, id)
+	return err
+}
+
+// GetCategoryByID возвращает категорию или nil, если категория не найдена.
+func (r *gradebookRepository) GetCategoryByID(ctx context.Context, id int64) (*models.GradeCategory, error) {
+	var category models.GradeCategory
+	err := r.db.QueryRow(ctx, `SELECT id, course_id, name, weight FROM grade_categories WHERE id = $1;`, id).
+		Scan(&category.ID, &category.CourseID, &category.Name, &category.Weight)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *gradebookRepository) GetCategoriesByCourse(ctx context.Context, courseID int64) ([]*models.GradeCategory, error) {
+	rows, err := r.db.Query(ctx, `SELECT id, course_id, name, weight FROM grade_categories WHERE course_id = $1 ORDER BY id;`, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*models.GradeCategory
+	for rows.Next() {
+		var category models.GradeCategory
+		if err := rows.Scan(&category.ID, &category.CourseID, &category.Name, &category.Weight); err != nil {
+			return nil, err
+		}
+		categories = append(categories, &category)
+	}
+	return categories, rows.Err()
+}
+
+// GetItems возвращает тесты и задания курса: сначала тесты, затем задания, каждые в порядке создания.
+// Максимальная оценка теста — 100%, задания — его max_score.
+func (r *gradebookRepository) GetItems(ctx context.Context, courseID int64) ([]*models.GradeItem, error) {
+	query := `
+        SELECT 'quiz', id, title, grade_category_id, 100::DOUBLE PRECISION
+        FROM quizzes
+        WHERE course_id = $1
+        UNION ALL
+        SELECT 'assignment', id, title, grade_category_id, max_score::DOUBLE PRECISION
+        FROM assignments
+        WHERE course_id = $1
+        ORDER BY 1 DESC, 2;
+    `
+
+	rows, err := r.db.Query(ctx, query, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*models.GradeItem
+	for rows.Next() {
+		var item models.GradeItem
+		if err := rows.Scan(&item.Type, &item.ID, &item.Title, &item.CategoryID, &item.MaxScore); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+	return items, rows.Err()
+}
+
+// SetItemCategory относит тест или задание курса к категории (nil — без категории).
+// Возвращает false, если элемент не найден в курсе.
+func (r *gradebookRepository) SetItemCategory(ctx context.Context, courseID int64, itemType string, itemID int64, categoryID *int64) (bool, error) {
+	var table string
+	switch itemType {
+	case models.GradeItemQuiz:
+		table = "quizzes"
+	case models.GradeItemAssignment:
+		table = "assignments"
+	default:
+		return false, fmt.Errorf("неизвестный тип элемента %q", itemType)
+	}
+
+	commandTag, err := r.db.Exec(ctx, `UPDATE `+table+` SET grade_category_id = $3 WHERE id = $1 AND course_id = $2;`, itemID, courseID, categoryID)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// GetScores возвращает оценки студентов курса без ручных изменений: за тест — лучший процент
+// среди завершённых попыток, за задание — баллы последней проверенной версии решения.
+func (r *gradebookRepository) GetScores(ctx context.Context, courseID int64) ([]*models.ItemScore, error) {
+	query := `
+        SELECT a.student_id, 'quiz', a.quiz_id, MAX(a.percent)::DOUBLE PRECISION
+        FROM quiz_attempts a
+        JOIN quizzes q ON q.id = a.quiz_id
+        WHERE q.course_id = $1 AND a.submitted_at IS NOT NULL
+        GROUP BY a.student_id, a.quiz_id
+        UNION ALL
+        SELECT * FROM (
+            SELECT DISTINCT ON (s.assignment_id, s.student_id) s.student_id, 'assignment', s.assignment_id, s.score::DOUBLE PRECISION
+            FROM assignment_submissions s
+            JOIN assignments a ON a.id = s.assignment_id
+            WHERE a.course_id = $1 AND s.score IS NOT NULL
+            ORDER BY s.assignment_id, s.student_id, s.version DESC
+        ) latest;
+    `
+
+	rows, err := r.db.Query(ctx, query, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scores []*models.ItemScore
+	for rows.Next() {
+		var score models.ItemScore
+		if err := rows.Scan(&score.StudentID, &score.ItemType, &score.ItemID, &score.Score); err != nil {
+			return nil, err
+		}
+		scores = append(scores, &score)
+	}
+	return scores, rows.Err()
+}
+
+// GetLetterScheme возвращает шкалу буквенных оценок курса по убыванию порога или пустой список, если шкала не задана.
+func (r *gradebookRepository) GetLetterScheme(ctx context.Context, courseID int64) ([]*models.LetterGrade, error) {
+	rows, err := r.db.Query(ctx, `SELECT letter, min_percent FROM letter_grades WHERE course_id = $1 ORDER BY min_percent DESC;`, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var letters []*models.LetterGrade
+	for rows.Next() {
+		var letter models.LetterGrade
+		if err := rows.Scan(&letter.Letter, &letter.MinPercent); err != nil {
+			return nil, err
+		}
+		letters = append(letters, &letter)
+	}
+	return letters, rows.Err()
+}
+
+// SetLetterScheme заменяет шкалу буквенных оценок курса; пустой список удаляет шкалу.
+func (r *gradebookRepository) SetLetterScheme(ctx context.Context, courseID int64, letters []*models.LetterGrade) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM letter_grades WHERE course_id = $1;`, courseID); err != nil {
+		return err
+	}
+	for _, letter := range letters {
+		_, err := tx.Exec(ctx, `INSERT INTO letter_grades (course_id, letter, min_percent) VALUES ($1, $2, $3);`,
+			courseID, letter.Letter, letter.MinPercent)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *gradebookRepository) CreateAdjustment(ctx context.Context, adjustment *models.GradeAdjustment) error {
+	query := `
+        INSERT INTO grade_adjustments (course_id, student_id, item_type, item_id, score, previous_score, reason, instructor_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        RETURNING id, created_at;
+    `
+	return r.db.QueryRow(ctx, query, adjustment.CourseID, adjustment.StudentID, adjustment.ItemType, adjustment.ItemID,
+		adjustment.Score, adjustment.PreviousScore, adjustment.Reason, adjustment.InstructorID).Scan(&adjustment.ID, &adjustment.CreatedAt)
+}
+
+// GetAdjustments возвращает историю ручных изменений оценок курса в порядке внесения.
+// studentID = 0 возвращает изменения по всем студентам.
+func (r *gradebookRepository) GetAdjustments(ctx context.Context, courseID, studentID int64) ([]*models.GradeAdjustment, error) {
+	query := `
+        SELECT id, course_id, student_id, item_type, item_id, score, previous_score, reason, instructor_id, created_at
+        FROM grade_adjustments
+        WHERE course_id = $1 AND ($2 = 0 OR student_id = $2)
+        ORDER BY id;
+    `
+
+	rows, err := r.db.Query(ctx, query, courseID, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var adjustments []*models.GradeAdjustment
+	for rows.Next() {
+		var a models.GradeAdjustment
+		err := rows.Scan(&a.ID, &a.CourseID, &a.StudentID, &a.ItemType, &a.ItemID, &a.Score, &a.PreviousScore,
+			&a.Reason, &a.InstructorID, &a.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, &a)
+	}
+	return adjustments, rows.Err()
+}
@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrLearningPathNameTaken = errors.New("учебная программа с таким названием уже существует")
+	ErrLearningPathCourse    = errors.New("курс программы не существует")
+)
+
+type LearningPathRepository interface {
+	CreateLearningPath(ctx context.Context, path *models.LearningPath) error
+	UpdateLearningPath(ctx context.Context, path *models.LearningPath) (bool, error)
+	DeleteLearningPath(ctx context.Context, id int64) (bool, error)
+	GetLearningPathByID(ctx context.Context, id int64) (*models.LearningPath, error)
+	GetLearningPaths(ctx context.Context) ([]*models.LearningPath, error)
+	EnrollInLearningPath(ctx context.Context, pathID, studentID int64) (int, error)
+	IsEnrolledInLearningPath(ctx context.Context, pathID, studentID int64) (bool, error)
+	GetEnrolledPathIDsByCourse(ctx context.Context, studentID, courseID int64) ([]int64, error)
+}
+
+type learningPathRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLearningPathRepository(db *pgxpool.Pool) LearningPathRepository {
+	return &learningPathRepository{db: db}
+}
+
+// CreateLearningPath сохраняет программу вместе со списком курсов и заполняет ID и дату создания.
+func (r *learningPathRepository) CreateLearningPath(ctx context.Context, path *models.LearningPath) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO learning_paths (name, description, instructor_id)
+        VALUES ($1, $2, $3)
+        RETURNING id, created_at;
+    `
+	if err := tx.QueryRow(ctx, query, path.Name, path.Description, path.InstructorID).Scan(&path.ID, &path.CreatedAt); err != nil {
+		return mapLearningPathError(err)
+	}
+	if err := insertLearningPathItems(ctx, tx, path); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// UpdateLearningPath обновляет название и описание программы и заменяет список курсов.
+// Возвращает false, если программа не найдена.
+func (r *learningPathRepository) UpdateLearningPath(ctx context.Context, path *models.LearningPath) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE learning_paths SET name = $2, description = $3
+        WHERE id = $1
+        RETURNING instructor_id, created_at;
+    `
+	err = tx.QueryRow(ctx, query, path.ID, path.Name, path.Description).Scan(&path.InstructorID, &path.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, mapLearningPathError(err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM learning_path_courses WHERE path_id = $1;`, path.ID); err != nil {
+		return false, err
+	}
+	if err := insertLearningPathItems(ctx, tx, path); err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
+
+// insertLearningPathItems сохраняет курсы программы, нумеруя их по порядку с 1.
+func insertLearningPathItems(ctx context.Context, tx pgx.Tx, path *models.LearningPath) error {
+	query := `
+        INSERT INTO learning_path_courses (path_id, course_id, position, required)
+        VALUES ($1, $2, $3, $4);
+    `
+	for i, item := range path.Items {
+		item.Position = int32(i + 1)
+		if _, err := tx.Exec(ctx, query, path.ID, item.CourseID, item.Position, item.Required); err != nil {
+			return mapLearningPathError(err)
+		}
+	}
+	return nil
+}
+
+func mapLearningPathError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == "23505" && pgErr.ConstraintName == "learning_paths_name_key":
+			return ErrLearningPathNameTaken
+		case pgErr.Code == "23503" && pgErr.ConstraintName == "learning_path_courses_course_id_fkey":
+			return ErrLearningPathCourse
+		}
+	}
+	return err
+}
+
+func (r *learningPathRepository) DeleteLearningPath(ctx context.Context, id int64) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM learning_paths WHERE id = $1;`, id)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+const learningPathColumns = `id, name, description, instructor_id, created_at`
+
+func scanLearningPath(row pgx.Row) (*models.LearningPath, error) {
+	var path models.LearningPath
+	if err := row.Scan(&path.ID, &path.Name, &path.Description, &path.InstructorID, &path.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &path, nil
+}
+
+// GetLearningPathByID возвращает программу с курсами или nil, если она не найдена.
+func (r *learningPathRepository) GetLearningPathByID(ctx context.Context, id int64) (*models.LearningPath, error) {
+	path, err := scanLearningPath(r.db.QueryRow(ctx, `SELECT `+learningPathColumns+` FROM learning_paths WHERE id = $1;`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return path, r.loadItems(ctx, []*models.LearningPath{path})
+}
+
+// GetLearningPaths возвращает все программы с курсами в порядке создания.
+func (r *learningPathRepository) GetLearningPaths(ctx context.Context) ([]*models.LearningPath, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+learningPathColumns+` FROM learning_paths ORDER BY id;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []*models.LearningPath
+	for rows.Next() {
+		path, err := scanLearningPath(rows)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return paths, r.loadItems(ctx, paths)
+}
+
+func (r *learningPathRepository) loadItems(ctx context.Context, paths []*models.LearningPath) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*models.LearningPath, len(paths))
+	ids := make([]int64, 0, len(paths))
+	for _, path := range paths {
+		byID[path.ID] = path
+		ids = append(ids, path.ID)
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT pc.path_id, pc.position, pc.required, `+courseColumns+`
+        FROM learning_path_courses pc
+        JOIN courses c ON c.id = pc.course_id
+        WHERE pc.path_id = ANY($1)
+        ORDER BY pc.path_id, pc.position;
+    `, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pathID int64
+		var item models.LearningPathItem
+		var course models.Course
+		if err := rows.Scan(append([]any{&pathID, &item.Position, &item.Required}, courseScanTargets(&course)...)...); err != nil {
+			return err
+		}
+		item.CourseID = course.ID
+		item.Course = &course
+		byID[pathID].Items = append(byID[pathID].Items, &item)
+	}
+	return rows.Err()
+}
+
+// EnrollInLearningPath записывает студента на программу и на все её курсы, на которые он ещё не записан,
+// и возвращает число новых записей на курсы. Повторная запись на программу ничего не меняет.
+func (r *learningPathRepository) EnrollInLearningPath(ctx context.Context, pathID, studentID int64) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO learning_path_enrollments (path_id, student_id)
+        VALUES ($1, $2)
+        ON CONFLICT DO NOTHING;
+    `, pathID, studentID)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.Query(ctx, `
+        INSERT INTO enrollments (student_id, course_id)
+        SELECT $2, course_id FROM learning_path_courses WHERE path_id = $1
+        ON CONFLICT DO NOTHING
+        RETURNING course_id;
+    `, pathID, studentID)
+	if err != nil {
+		return 0, err
+	}
+	courseIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, err
+	}
+
+	for _, courseID := range courseIDs {
+		payload := models.StudentEnrollmentPayload{StudentID: studentID, CourseID: courseID, PathID: pathID}
+		if err := enqueueEvent(ctx, tx, models.EventStudentEnrolled, models.AggregateCourse, courseID, payload); err != nil {
+			return 0, err
+		}
+	}
+	return len(courseIDs), tx.Commit(ctx)
+}
+
+func (r *learningPathRepository) IsEnrolledInLearningPath(ctx context.Context, pathID, studentID int64) (bool, error) {
+	var enrolled bool
+	err := r.db.QueryRow(ctx, `
+        SELECT EXISTS (SELECT 1 FROM learning_path_enrollments WHERE path_id = $1 AND student_id = $2);
+    `, pathID, studentID).Scan(&enrolled)
+	return enrolled, err
+}
+
+// GetEnrolledPathIDsByCourse возвращает программы с курсом courseID, на которые записан студент.
+func (r *learningPathRepository) GetEnrolledPathIDsByCourse(ctx context.Context, studentID, courseID int64) ([]int64, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT pe.path_id
+        FROM learning_path_enrollments pe
+        JOIN learning_path_courses pc ON pc.path_id = pe.path_id
+        WHERE pe.student_id = $1 AND pc.course_id = $2
+        ORDER BY pe.path_id;
+    `, studentID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/jackc/pgx/v5"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -14,11 +15,25 @@ type LectureRepository interface {
 	AddLectureToCourse(ctx context.Context, lecture *models.Lecture) (*models.Lecture, error)
 	GetLecturesByCourse(ctx context.Context, courseID int64) ([]*models.Lecture, error)
 	GetLectureContent(ctx context.Context, lectureID int64) (*models.Lecture, error)
-	UpdateLecture(ctx context.Context, lecture *models.Lecture) (*models.Lecture, error)
+	UpdateLecture(ctx context.Context, lecture *models.Lecture, clearReleaseRules bool) (*models.Lecture, error)
 	DeleteLecture(ctx context.Context, lectureID int64) error
 	MarkLectureAsCompleted(ctx context.Context, studentID, lectureID int64) error
 	GetCourseProgress(ctx context.Context, studentID, courseID int64) (int32, error)
-	GetRecommendedCourses(ctx context.Context, studentID int64) ([]*models.Course, error)
+	GetPendingReleaseNotices(ctx context.Context, since time.Time, limit int) ([]*models.LectureReleaseNotice, error)
+	MarkReleaseNotified(ctx context.Context, studentID, lectureID int64) error
+	GetCompletedLectureIDs(ctx context.Context, studentID, courseID int64) (map[int64]bool, error)
+	GetBlockingLecture(ctx context.Context, studentID int64, lecture *models.Lecture) (int64, error)
+}
+
+const lectureColumns = `id, course_id, title, content, cohort_offset_days, release_at, release_after_days, position`
+
+func scanLecture(row pgx.Row) (*models.Lecture, error) {
+	var lecture models.Lecture
+	err := row.Scan(&lecture.ID, &lecture.CourseID, &lecture.Title, &lecture.Content, &lecture.CohortOffsetDays, &lecture.ReleaseAt, &lecture.ReleaseAfterDays, &lecture.Position)
+	if err != nil {
+		return nil, err
+	}
+	return &lecture, nil
 }
 
 type lectureRepository struct {
@@ -31,26 +46,35 @@ func NewLectureRepository(db *pgxpool.Pool) LectureRepository {
 
 func (r *lectureRepository) AddLectureToCourse(ctx context.Context, lecture *models.Lecture) (*models.Lecture, error) {
 	query := `
-        INSERT INTO lectures (course_id, title, content)
-        VALUES ($1, $2, $3)
-        RETURNING id, course_id, title, content;
+        INSERT INTO lectures (course_id, title, content, cohort_offset_days, release_at, release_after_days, position)
+        VALUES ($1, $2, $3, $4, $5, $6,
+                COALESCE(NULLIF($7, 0), (SELECT COALESCE(MAX(position), 0) + 1 FROM lectures WHERE course_id = $1)))
+        RETURNING ` + lectureColumns + `;
     `
 
-	var newLecture models.Lecture
-	err := r.db.QueryRow(ctx, query, lecture.CourseID, lecture.Title, lecture.Content).
-		Scan(&newLecture.ID, &newLecture.CourseID, &newLecture.Title, &newLecture.Content)
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback(ctx)
 
-	return &newLecture, nil
+	created, err := scanLecture(tx.QueryRow(ctx, query, lecture.CourseID, lecture.Title, lecture.Content, lecture.CohortOffsetDays, lecture.ReleaseAt, lecture.ReleaseAfterDays, lecture.Position))
+	if err != nil {
+		return nil, err
+	}
+	payload := models.LectureAddedPayload{LectureID: created.ID, CourseID: created.CourseID, Title: created.Title, Scheduled: created.HasReleaseRules()}
+	if err := enqueueEvent(ctx, tx, models.EventLectureAdded, models.AggregateLecture, created.ID, payload); err != nil {
+		return nil, err
+	}
+	return created, tx.Commit(ctx)
 }
 
 func (r *lectureRepository) GetLecturesByCourse(ctx context.Context, courseID int64) ([]*models.Lecture, error) {
 	query := `
-        SELECT id, course_id, title, content
+        SELECT ` + lectureColumns + `
         FROM lectures
-        WHERE course_id = $1;
+        WHERE course_id = $1
+        ORDER BY position, id;
     `
 
 	rows, err := r.db.Query(ctx, query, courseID)
@@ -61,52 +85,47 @@ func (r *lectureRepository) GetLecturesByCourse(ctx context.Context, courseID in
 
 	var lectures []*models.Lecture
 	for rows.Next() {
-		var lecture models.Lecture
-		if err := rows.Scan(&lecture.ID, &lecture.CourseID, &lecture.Title, &lecture.Content); err != nil {
+		lecture, err := scanLecture(rows)
+		if err != nil {
 			return nil, err
 		}
-		lectures = append(lectures, &lecture)
+		lectures = append(lectures, lecture)
 	}
 	return lectures, nil
 }
 
 func (r *lectureRepository) GetLectureContent(ctx context.Context, lectureID int64) (*models.Lecture, error) {
 	query := `
-        SELECT id, course_id, title, content
+        SELECT ` + lectureColumns + `
         FROM lectures
         WHERE id = $1;
     `
 
-	var lecture models.Lecture
-	err := r.db.QueryRow(ctx, query, lectureID).Scan(
-		&lecture.ID, &lecture.CourseID, &lecture.Title, &lecture.Content,
-	)
+	lecture, err := scanLecture(r.db.QueryRow(ctx, query, lectureID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
-	return &lecture, nil
+	return lecture, nil
 }
 
-func (r *lectureRepository) UpdateLecture(ctx context.Context, lecture *models.Lecture) (*models.Lecture, error) {
+// UpdateLecture обновляет переданные поля лекции; clearReleaseRules заменяет все правила открытия переданными (пустые снимаются).
+func (r *lectureRepository) UpdateLecture(ctx context.Context, lecture *models.Lecture, clearReleaseRules bool) (*models.Lecture, error) {
 	query := `
         UPDATE lectures
         SET title = COALESCE(NULLIF($1, ''), title),
-            content = COALESCE(NULLIF($2, ''), content)
-        WHERE id = $3
-        RETURNING id, course_id, title, content;
+            content = COALESCE(NULLIF($2, ''), content),
+            cohort_offset_days = CASE WHEN $6 THEN $3 ELSE COALESCE($3, cohort_offset_days) END,
+            release_at = CASE WHEN $6 THEN $4 ELSE COALESCE($4, release_at) END,
+            release_after_days = CASE WHEN $6 THEN $5 ELSE COALESCE($5, release_after_days) END,
+            position = COALESCE(NULLIF($7, 0), position)
+        WHERE id = $8
+        RETURNING ` + lectureColumns + `;
     `
 
-	var updatedLecture models.Lecture
-	err := r.db.QueryRow(ctx, query, lecture.Title, lecture.Content, lecture.ID).
-		Scan(&updatedLecture.ID, &updatedLecture.CourseID, &updatedLecture.Title, &updatedLecture.Content)
-	if err != nil {
-		return nil, err
-	}
-
-	return &updatedLecture, nil
+	return scanLecture(r.db.QueryRow(ctx, query, lecture.Title, lecture.Content, lecture.CohortOffsetDays, lecture.ReleaseAt, lecture.ReleaseAfterDays, clearReleaseRules, lecture.Position, lecture.ID))
 }
 
 func (r *lectureRepository) DeleteLecture(ctx context.Context, lectureID int64) error {
@@ -127,25 +146,43 @@ func (r *lectureRepository) DeleteLecture(ctx context.Context, lectureID int64)
 	return nil
 }
 
+// MarkLectureAsCompleted отмечает лекцию пройденной. При первой отметке в той же транзакции
+// сохраняется событие LectureCompleted.
 func (r *lectureRepository) MarkLectureAsCompleted(ctx context.Context, studentID, lectureID int64) error {
-	var exists bool
-	err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM lectures WHERE id = $1)`, lectureID).Scan(&exists)
-	if err != nil || !exists {
+	var courseID int64
+	err := r.db.QueryRow(ctx, `SELECT course_id FROM lectures WHERE id = $1`, lectureID).Scan(&courseID)
+	if err != nil {
 		return fmt.Errorf("лекция с ID %d не найдена", lectureID)
 	}
 
+	var exists bool
 	err = r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM students WHERE id = $1)`, studentID).Scan(&exists)
 	if err != nil || !exists {
 		return fmt.Errorf("студент с ID %d не найден", studentID)
 	}
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
         INSERT INTO lecture_completions (student_id, lecture_id)
         VALUES ($1, $2)
         ON CONFLICT (student_id, lecture_id) DO NOTHING;
     `
-	_, err = r.db.Exec(ctx, query, studentID, lectureID)
-	return err
+	commandTag, err := tx.Exec(ctx, query, studentID, lectureID)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() > 0 {
+		payload := models.LectureCompletedPayload{StudentID: studentID, LectureID: lectureID, CourseID: courseID}
+		if err := enqueueEvent(ctx, tx, models.EventLectureCompleted, models.AggregateLecture, lectureID, payload); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
 }
 
 func (r *lectureRepository) GetCourseProgress(ctx context.Context, studentID, courseID int64) (int32, error) {
@@ -160,7 +197,7 @@ func (r *lectureRepository) GetCourseProgress(ctx context.Context, studentID, co
 	}
 
 	if totalLectures == 0 {
-		return 0, fmt.Errorf("в курсе нет лекций")
+		return 0, nil
 	}
 
 	err = r.db.QueryRow(ctx, `
@@ -177,29 +214,108 @@ func (r *lectureRepository) GetCourseProgress(ctx context.Context, studentID, co
 	return int32(progress), nil
 }
 
-func (r *lectureRepository) GetRecommendedCourses(ctx context.Context, studentID int64) ([]*models.Course, error) {
+// GetPendingReleaseNotices возвращает лекции, открывшиеся для записанных студентов после момента since
+// (и после записи на курс), о которых студенты ещё не были уведомлены.
+func (r *lectureRepository) GetPendingReleaseNotices(ctx context.Context, since time.Time, limit int) ([]*models.LectureReleaseNotice, error) {
 	query := `
-        SELECT DISTINCT c.id, c.name, c.description, c.instructor_id
-        FROM courses c
-        LEFT JOIN enrollments e ON c.id = e.course_id AND e.student_id = $1
-        WHERE e.student_id IS NULL
-        ORDER BY c.name
-        LIMIT 5;
+        SELECT s.id, s.name, s.email, l.id, l.title, c.id, c.name, r.available_at
+        FROM enrollments e
+        JOIN students s ON s.id = e.student_id
+        JOIN courses c ON c.id = e.course_id
+        JOIN lectures l ON l.course_id = e.course_id
+        LEFT JOIN cohorts ch ON ch.id = e.cohort_id
+        CROSS JOIN LATERAL (
+            SELECT GREATEST(
+                l.release_at,
+                e.enrolled_at + make_interval(days => l.release_after_days),
+                ch.start_date + make_interval(days => l.cohort_offset_days)
+            ) AS available_at
+        ) r
+        WHERE r.available_at IS NOT NULL
+          AND r.available_at <= NOW()
+          AND r.available_at > $1
+          AND r.available_at > e.enrolled_at
+          AND NOT EXISTS (
+              SELECT 1 FROM lecture_release_notifications n
+              WHERE n.student_id = e.student_id AND n.lecture_id = l.id
+          )
+        ORDER BY r.available_at
+        LIMIT $2;
     `
 
-	rows, err := r.db.Query(ctx, query, studentID)
+	rows, err := r.db.Query(ctx, query, since, limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var courses []*models.Course
+	var notices []*models.LectureReleaseNotice
 	for rows.Next() {
-		var course models.Course
-		if err := rows.Scan(&course.ID, &course.Name, &course.Description, &course.InstructorID); err != nil {
+		var n models.LectureReleaseNotice
+		if err := rows.Scan(&n.StudentID, &n.StudentName, &n.StudentEmail, &n.LectureID, &n.LectureTitle, &n.CourseID, &n.CourseName, &n.AvailableAt); err != nil {
 			return nil, err
 		}
-		courses = append(courses, &course)
+		notices = append(notices, &n)
+	}
+	return notices, rows.Err()
+}
+
+func (r *lectureRepository) MarkReleaseNotified(ctx context.Context, studentID, lectureID int64) error {
+	query := `
+        INSERT INTO lecture_release_notifications (student_id, lecture_id)
+        VALUES ($1, $2)
+        ON CONFLICT (student_id, lecture_id) DO NOTHING;
+    `
+	_, err := r.db.Exec(ctx, query, studentID, lectureID)
+	return err
+}
+
+// GetCompletedLectureIDs возвращает множество завершённых студентом лекций курса.
+func (r *lectureRepository) GetCompletedLectureIDs(ctx context.Context, studentID, courseID int64) (map[int64]bool, error) {
+	query := `
+        SELECT lc.lecture_id
+        FROM lecture_completions lc
+        JOIN lectures l ON l.id = lc.lecture_id
+        WHERE lc.student_id = $1 AND l.course_id = $2;
+    `
+
+	rows, err := r.db.Query(ctx, query, studentID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	completed := make(map[int64]bool)
+	for rows.Next() {
+		var lectureID int64
+		if err := rows.Scan(&lectureID); err != nil {
+			return nil, err
+		}
+		completed[lectureID] = true
+	}
+	return completed, rows.Err()
+}
+
+// GetBlockingLecture возвращает первую по порядку незавершённую студентом лекцию, стоящую перед
+// указанной, или 0, если все предыдущие лекции завершены.
+func (r *lectureRepository) GetBlockingLecture(ctx context.Context, studentID int64, lecture *models.Lecture) (int64, error) {
+	query := `
+        SELECT l.id
+        FROM lectures l
+        WHERE l.course_id = $1
+          AND (l.position, l.id) < ($2, $3)
+          AND NOT EXISTS (
+              SELECT 1 FROM lecture_completions lc
+              WHERE lc.lecture_id = l.id AND lc.student_id = $4
+          )
+        ORDER BY l.position, l.id
+        LIMIT 1;
+    `
+
+	var lectureID int64
+	err := r.db.QueryRow(ctx, query, lecture.CourseID, lecture.Position, lecture.ID, studentID).Scan(&lectureID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
 	}
-	return courses, nil
+	return lectureID, err
 }
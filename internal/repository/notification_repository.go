@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotificationRepository interface {
+	NotifyStudent(ctx context.Context, notification *models.Notification) (bool, error)
+	NotifyCourseStudents(ctx context.Context, notification *models.Notification) (int, error)
+	NotifyCohortStudents(ctx context.Context, cohortID int64, notification *models.Notification) (int, error)
+	GetNotifications(ctx context.Context, studentID int64, unreadOnly bool, beforeID int64, limit int) ([]*models.Notification, error)
+	CountUnread(ctx context.Context, studentID int64) (int32, error)
+	MarkRead(ctx context.Context, studentID int64, ids []int64) (int64, error)
+	MarkAllRead(ctx context.Context, studentID int64) (int64, error)
+	GetPreferences(ctx context.Context, studentID int64) (map[string]bool, error)
+	SetPreferences(ctx context.Context, studentID int64, preferences map[string]bool) error
+	GetSettings(ctx context.Context, studentID int64) (*models.NotificationSettings, error)
+	SaveSettings(ctx context.Context, settings *models.NotificationSettings) error
+	GetPendingEmails(ctx context.Context, frequency string, since, until time.Time, limit int) ([]*models.NotificationEmail, error)
+	MarkEmailed(ctx context.Context, studentID int64, ids []int64, emailedAt time.Time) error
+}
+
+var ErrNotificationStudent = errors.New("студент не найден")
+
+type notificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNotificationRepository(db *pgxpool.Pool) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// insertNotifications создаёт уведомление для студентов из подзапроса recipients, кроме отключивших этот тип
+// уведомлений. Повторная вставка уведомления о том же событии пропускается.
+const insertNotifications = `
+    INSERT INTO notifications (student_id, type, event_id, course_id, title, body, link, data)
+    SELECT r.student_id, $1, NULLIF($2, '')::UUID, NULLIF($3, 0), $4, $5, $6, $7
+    FROM (%s) r
+    WHERE NOT EXISTS (
+        SELECT 1 FROM notification_preferences p
+        WHERE p.student_id = r.student_id AND p.type = $1 AND NOT p.enabled
+    )
+    ON CONFLICT (student_id, event_id) DO NOTHING;
+`
+
+func notificationArgs(n *models.Notification) []any {
+	data := n.Data
+	if data == nil {
+		data = map[string]any{}
+	}
+	return []any{n.Type, n.EventID, n.CourseID, n.Title, n.Body, n.Link, data}
+}
+
+// NotifyStudent создаёт уведомление студенту notification.StudentID. Возвращает false, если студент
+// отключил этот тип уведомлений или уведомление о событии уже есть.
+func (r *notificationRepository) NotifyStudent(ctx context.Context, notification *models.Notification) (bool, error) {
+	query := fmt.Sprintf(insertNotifications, `SELECT $8::INT AS student_id`)
+	tag, err := r.db.Exec(ctx, query, append(notificationArgs(notification), notification.StudentID)...)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// NotifyCourseStudents создаёт уведомление всем студентам, записанным на курс notification.CourseID,
+// и возвращает число созданных уведомлений.
+func (r *notificationRepository) NotifyCourseStudents(ctx context.Context, notification *models.Notification) (int, error) {
+	query := fmt.Sprintf(insertNotifications, `SELECT student_id FROM enrollments WHERE course_id = $3`)
+	tag, err := r.db.Exec(ctx, query, notificationArgs(notification)...)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// NotifyCohortStudents создаёт уведомление студентам курса notification.CourseID из потока cohortID
+// и возвращает число созданных уведомлений.
+func (r *notificationRepository) NotifyCohortStudents(ctx context.Context, cohortID int64, notification *models.Notification) (int, error) {
+	query := fmt.Sprintf(insertNotifications, `SELECT student_id FROM enrollments WHERE course_id = $3 AND cohort_id = $8`)
+	tag, err := r.db.Exec(ctx, query, append(notificationArgs(notification), cohortID)...)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+const notificationColumns = `n.id, n.student_id, n.type, COALESCE(n.event_id::TEXT, ''), COALESCE(n.course_id, 0), n.title, n.body, n.link, n.data,
+    n.created_at, n.read_at, n.emailed_at`
+
+func notificationScanTargets(n *models.Notification) []any {
+	return []any{&n.ID, &n.StudentID, &n.Type, &n.EventID, &n.CourseID, &n.Title, &n.Body, &n.Link, &n.Data, &n.CreatedAt, &n.ReadAt, &n.EmailedAt}
+}
+
+// GetNotifications возвращает до limit уведомлений студента от новых к старым. Если beforeID > 0,
+// возвращаются только уведомления старше него.
+func (r *notificationRepository) GetNotifications(ctx context.Context, studentID int64, unreadOnly bool, beforeID int64, limit int) ([]*models.Notification, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT `+notificationColumns+`
+        FROM notifications n
+        WHERE n.student_id = $1 AND (NOT $2 OR n.read_at IS NULL) AND ($3 = 0 OR n.id < $3)
+        ORDER BY n.id DESC
+        LIMIT $4;
+    `, studentID, unreadOnly, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*models.Notification, error) {
+		var n models.Notification
+		err := row.Scan(notificationScanTargets(&n)...)
+		return &n, err
+	})
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, studentID int64) (int32, error) {
+	var count int32
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM notifications WHERE student_id = $1 AND read_at IS NULL;`, studentID).Scan(&count)
+	return count, err
+}
+
+// MarkRead отмечает прочитанными уведомления студента из ids и возвращает число отмеченных.
+// Чужие и уже прочитанные уведомления пропускаются.
+func (r *notificationRepository) MarkRead(ctx context.Context, studentID int64, ids []int64) (int64, error) {
+	tag, err := r.db.Exec(ctx, `
+        UPDATE notifications SET read_at = NOW()
+        WHERE student_id = $1 AND id = ANY($2) AND read_at IS NULL;
+    `, studentID, ids)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// MarkAllRead отмечает прочитанными все уведомления студента и возвращает число отмеченных.
+func (r *notificationRepository) MarkAllRead(ctx context.Context, studentID int64) (int64, error) {
+	tag, err := r.db.Exec(ctx, `UPDATE notifications SET read_at = NOW() WHERE student_id = $1 AND read_at IS NULL;`, studentID)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetPreferences возвращает явно заданные настройки уведомлений студента по типам.
+func (r *notificationRepository) GetPreferences(ctx context.Context, studentID int64) (map[string]bool, error) {
+	rows, err := r.db.Query(ctx, `SELECT type, enabled FROM notification_preferences WHERE student_id = $1;`, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	preferences := make(map[string]bool)
+	for rows.Next() {
+		var notificationType string
+		var enabled bool
+		if err := rows.Scan(&notificationType, &enabled); err != nil {
+			return nil, err
+		}
+		preferences[notificationType] = enabled
+	}
+	return preferences, rows.Err()
+}
+
+// SetPreferences сохраняет настройки студента для переданных типов уведомлений; остальные не меняются.
+func (r *notificationRepository) SetPreferences(ctx context.Context, studentID int64, preferences map[string]bool) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for notificationType, enabled := range preferences {
+		_, err := tx.Exec(ctx, `
+            INSERT INTO notification_preferences (student_id, type, enabled)
+            VALUES ($1, $2, $3)
+            ON CONFLICT (student_id, type) DO UPDATE SET enabled = EXCLUDED.enabled;
+        `, studentID, notificationType, enabled)
+		if err != nil {
+			return mapNotificationError(err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func mapNotificationError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return ErrNotificationStudent
+	}
+	return err
+}
+
+// GetSettings возвращает настройки почтовых уведомлений студента или настройки по умолчанию, если он их не менял.
+func (r *notificationRepository) GetSettings(ctx context.Context, studentID int64) (*models.NotificationSettings, error) {
+	settings := models.NotificationSettings{StudentID: studentID}
+	err := r.db.QueryRow(ctx, `
+        SELECT email_frequency, locale, last_emailed_at, unsubscribe_revoked_at FROM notification_settings WHERE student_id = $1;
+    `, studentID).Scan(&settings.EmailFrequency, &settings.Locale, &settings.LastEmailedAt, &settings.UnsubscribeRevokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.DefaultNotificationSettings(studentID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SaveSettings сохраняет частоту и язык писем студента и время отзыва ссылок отписки.
+// Если студента нет, возвращает ErrNotificationStudent.
+func (r *notificationRepository) SaveSettings(ctx context.Context, settings *models.NotificationSettings) error {
+	_, err := r.db.Exec(ctx, `
+        INSERT INTO notification_settings (student_id, email_frequency, locale, unsubscribe_revoked_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (student_id) DO UPDATE
+        SET email_frequency = EXCLUDED.email_frequency, locale = EXCLUDED.locale, unsubscribe_revoked_at = EXCLUDED.unsubscribe_revoked_at;
+    `, settings.StudentID, settings.EmailFrequency, settings.Locale, settings.UnsubscribeRevokedAt)
+	return mapNotificationError(err)
+}
+
+// GetPendingEmails возвращает не отправленные по почте и не прочитанные уведомления, созданные в промежутке
+// [since, until), для не больше чем limit студентов с частотой писем frequency, которым не отправлялись письма
+// начиная с until.
+func (r *notificationRepository) GetPendingEmails(ctx context.Context, frequency string, since, until time.Time, limit int) ([]*models.NotificationEmail, error) {
+	defaults := models.DefaultNotificationSettings(0)
+	rows, err := r.db.Query(ctx, `
+        WITH due AS (
+            SELECT DISTINCT n.student_id
+            FROM notifications n
+            LEFT JOIN notification_settings ns ON ns.student_id = n.student_id
+            WHERE n.emailed_at IS NULL AND n.read_at IS NULL AND n.created_at >= $2 AND n.created_at < $3
+              AND COALESCE(ns.email_frequency, $4) = $1
+              AND (ns.last_emailed_at IS NULL OR ns.last_emailed_at < $3)
+            LIMIT $6
+        )
+        SELECT s.name, s.email, COALESCE(ns.locale, $5), `+notificationColumns+`
+        FROM due
+        JOIN students s ON s.id = due.student_id
+        LEFT JOIN notification_settings ns ON ns.student_id = due.student_id
+        JOIN notifications n ON n.student_id = due.student_id
+        WHERE n.emailed_at IS NULL AND n.read_at IS NULL AND n.created_at >= $2 AND n.created_at < $3
+        ORDER BY n.student_id, n.id;
+    `, frequency, since, until, defaults.EmailFrequency, defaults.Locale, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []*models.NotificationEmail
+	for rows.Next() {
+		var email models.NotificationEmail
+		var n models.Notification
+		if err := rows.Scan(append([]any{&email.StudentName, &email.Email, &email.Locale}, notificationScanTargets(&n)...)...); err != nil {
+			return nil, err
+		}
+		if len(emails) == 0 || emails[len(emails)-1].StudentID != n.StudentID {
+			email.StudentID = n.StudentID
+			emails = append(emails, &email)
+		}
+		last := emails[len(emails)-1]
+		last.Notifications = append(last.Notifications, &n)
+	}
+	return emails, rows.Err()
+}
+
+// MarkEmailed отмечает уведомления студента отправленными по почте и запоминает время письма.
+func (r *notificationRepository) MarkEmailed(ctx context.Context, studentID int64, ids []int64, emailedAt time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `UPDATE notifications SET emailed_at = $3 WHERE student_id = $1 AND id = ANY($2);`, studentID, ids, emailedAt); err != nil {
+		return err
+	}
+	defaults := models.DefaultNotificationSettings(studentID)
+	_, err = tx.Exec(ctx, `
+        INSERT INTO notification_settings (student_id, email_frequency, locale, last_emailed_at)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (student_id) DO UPDATE SET last_emailed_at = EXCLUDED.last_emailed_at;
+    `, studentID, defaults.EmailFrequency, defaults.Locale, emailedAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
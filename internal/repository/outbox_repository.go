@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OutboxRepository interface {
+	ClaimPendingEvents(ctx context.Context, limit int, lease time.Duration) ([]*models.OutboxEvent, error)
+	MarkEventPublished(ctx context.Context, id int64) error
+	MarkEventFailed(ctx context.Context, id int64, lastError string, retryAt time.Time) error
+	DeletePublishedEvents(ctx context.Context, before time.Time) (int64, error)
+	GetEventsAfter(ctx context.Context, eventID string, limit int) ([]*models.OutboxEvent, bool, error)
+}
+
+type outboxRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepository(db *pgxpool.Pool) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// enqueueEvent записывает доменное событие в outbox в транзакции изменения, которое его породило:
+// событие будет опубликовано тогда и только тогда, когда изменение зафиксировано.
+func enqueueEvent(ctx context.Context, tx pgx.Tx, eventType, aggregateType string, aggregateID int64, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("не удалось сериализовать событие %s: %w", eventType, err)
+	}
+	_, err = tx.Exec(ctx, `
+        INSERT INTO outbox_events (event_type, aggregate_type, aggregate_id, payload)
+        VALUES ($1, $2, $3, $4);
+    `, eventType, aggregateType, aggregateID, data)
+	return err
+}
+
+const outboxColumns = `id, event_id::TEXT, event_type, aggregate_type, aggregate_id, payload, created_at, attempts`
+
+func collectOutboxEvents(rows pgx.Rows) ([]*models.OutboxEvent, error) {
+	defer rows.Close()
+
+	var events []*models.OutboxEvent
+	for rows.Next() {
+		var event models.OutboxEvent
+		if err := rows.Scan(&event.ID, &event.EventID, &event.EventType, &event.AggregateType, &event.AggregateID,
+			&event.Payload, &event.CreatedAt, &event.Attempts); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
+
+// ClaimPendingEvents выбирает до limit неопубликованных событий, время повторной попытки которых наступило,
+// в порядке их создания и откладывает их следующую попытку на lease. Так несколько ретрансляторов
+// не публикуют одно событие одновременно, а события упавшего ретранслятора будут повторены после lease.
+func (r *outboxRepository) ClaimPendingEvents(ctx context.Context, limit int, lease time.Duration) ([]*models.OutboxEvent, error) {
+	rows, err := r.db.Query(ctx, `
+        UPDATE outbox_events
+        SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+        WHERE id IN (
+            SELECT id FROM outbox_events
+            WHERE published_at IS NULL AND next_attempt_at <= NOW()
+            ORDER BY id
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING `+outboxColumns+`;
+    `, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	events, err := collectOutboxEvents(rows)
+	if err != nil {
+		return nil, err
+	}
+	// UPDATE ... RETURNING не гарантирует порядок строк.
+	slices.SortFunc(events, func(a, b *models.OutboxEvent) int { return cmp.Compare(a.ID, b.ID) })
+	return events, nil
+}
+
+func (r *outboxRepository) MarkEventPublished(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, `
+        UPDATE outbox_events SET published_at = NOW(), attempts = attempts + 1, last_error = '' WHERE id = $1;
+    `, id)
+	return err
+}
+
+// MarkEventFailed сохраняет ошибку доставки события и назначает следующую попытку на retryAt.
+func (r *outboxRepository) MarkEventFailed(ctx context.Context, id int64, lastError string, retryAt time.Time) error {
+	_, err := r.db.Exec(ctx, `
+        UPDATE outbox_events SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1;
+    `, id, lastError, retryAt)
+	return err
+}
+
+// DeletePublishedEvents удаляет события, опубликованные раньше before, и возвращает их число.
+func (r *outboxRepository) DeletePublishedEvents(ctx context.Context, before time.Time) (int64, error) {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM outbox_events WHERE published_at < $1;`, before)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// GetEventsAfter возвращает до limit событий, записанных после события eventID, в порядке записи.
+// Второе значение false, если события eventID нет в outbox: оно удалено по сроку хранения, не существовало
+// или eventID — не UUID.
+func (r *outboxRepository) GetEventsAfter(ctx context.Context, eventID string, limit int) ([]*models.OutboxEvent, bool, error) {
+	var cursor int64
+	err := r.db.QueryRow(ctx, `SELECT id FROM outbox_events WHERE event_id = $1::UUID;`, eventID).Scan(&cursor)
+	var pgErr *pgconn.PgError
+	if errors.Is(err, pgx.ErrNoRows) || (errors.As(err, &pgErr) && pgErr.Code == "22P02") {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT `+outboxColumns+` FROM outbox_events
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2;
+    `, cursor, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	events, err := collectOutboxEvents(rows)
+	return events, true, err
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PeerReviewRepository interface {
+	GetAssignmentsAwaitingAllocation(ctx context.Context, now time.Time) ([]*models.Assignment, error)
+	AllocatePeerReviews(ctx context.Context, assignmentID int64, reviews []*models.PeerReview) (bool, error)
+	GetPeerReviewByID(ctx context.Context, id int64) (*models.PeerReview, error)
+	GetPeerReviewsByReviewer(ctx context.Context, reviewerID int64, pendingOnly bool) ([]*models.PeerReview, error)
+	GetPeerReviewsBySubmission(ctx context.Context, submissionID int64, submittedOnly bool) ([]*models.PeerReview, error)
+	SubmitPeerReview(ctx context.Context, review *models.PeerReview) error
+	IsPeerReviewer(ctx context.Context, submissionID, studentID int64) (bool, error)
+}
+
+type peerReviewRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPeerReviewRepository(db *pgxpool.Pool) PeerReviewRepository {
+	return &peerReviewRepository{db: db}
+}
+
+// GetAssignmentsAwaitingAllocation возвращает задания со взаимной проверкой, срок сдачи которых прошёл,
+// а решения ещё не распределены между рецензентами.
+func (r *peerReviewRepository) GetAssignmentsAwaitingAllocation(ctx context.Context, now time.Time) ([]*models.Assignment, error) {
+	query := `
+        SELECT ` + assignmentColumns + `
+        FROM assignments
+        WHERE peer_review_count > 0 AND due_at <= $1 AND peer_reviews_allocated_at IS NULL
+        ORDER BY due_at, id;
+    `
+
+	rows, err := r.db.Query(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*models.Assignment
+	for rows.Next() {
+		assignment, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+// AllocatePeerReviews сохраняет назначения рецензентов и отмечает задание распределённым.
+// Если задание уже распределено (например, параллельным запуском), возвращает false и ничего не меняет.
+func (r *peerReviewRepository) AllocatePeerReviews(ctx context.Context, assignmentID int64, reviews []*models.PeerReview) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	commandTag, err := tx.Exec(ctx, `
+        UPDATE assignments SET peer_reviews_allocated_at = NOW()
+        WHERE id = $1 AND peer_reviews_allocated_at IS NULL;
+    `, assignmentID)
+	if err != nil {
+		return false, err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	query := `
+        INSERT INTO peer_reviews (submission_id, reviewer_id)
+        VALUES ($1, $2)
+        RETURNING id, assigned_at;
+    `
+	for _, review := range reviews {
+		if err := tx.QueryRow(ctx, query, review.SubmissionID, review.ReviewerID).Scan(&review.ID, &review.AssignedAt); err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit(ctx)
+}
+
+const peerReviewColumns = `id, submission_id, reviewer_id, assigned_at, submitted_at, comment`
+
+func scanPeerReview(row pgx.Row) (*models.PeerReview, error) {
+	var review models.PeerReview
+	err := row.Scan(&review.ID, &review.SubmissionID, &review.ReviewerID, &review.AssignedAt, &review.SubmittedAt, &review.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// GetPeerReviewByID возвращает рецензию с баллами или nil, если рецензия не найдена.
+func (r *peerReviewRepository) GetPeerReviewByID(ctx context.Context, id int64) (*models.PeerReview, error) {
+	review, err := scanPeerReview(r.db.QueryRow(ctx, `SELECT `+peerReviewColumns+` FROM peer_reviews WHERE id = $1;`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return review, r.loadScores(ctx, []*models.PeerReview{review})
+}
+
+// GetPeerReviewsByReviewer возвращает рецензии, назначенные студенту, начиная с самых ранних.
+func (r *peerReviewRepository) GetPeerReviewsByReviewer(ctx context.Context, reviewerID int64, pendingOnly bool) ([]*models.PeerReview, error) {
+	query := `
+        SELECT ` + peerReviewColumns + `
+        FROM peer_reviews
+        WHERE reviewer_id = $1 AND (NOT $2 OR submitted_at IS NULL)
+        ORDER BY assigned_at, id;
+    `
+	return r.queryPeerReviews(ctx, query, reviewerID, pendingOnly)
+}
+
+// GetPeerReviewsBySubmission возвращает рецензии на решение в порядке назначения.
+func (r *peerReviewRepository) GetPeerReviewsBySubmission(ctx context.Context, submissionID int64, submittedOnly bool) ([]*models.PeerReview, error) {
+	query := `
+        SELECT ` + peerReviewColumns + `
+        FROM peer_reviews
+        WHERE submission_id = $1 AND (NOT $2 OR submitted_at IS NOT NULL)
+        ORDER BY id;
+    `
+	return r.queryPeerReviews(ctx, query, submissionID, submittedOnly)
+}
+
+func (r *peerReviewRepository) queryPeerReviews(ctx context.Context, query string, args ...any) ([]*models.PeerReview, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []*models.PeerReview
+	for rows.Next() {
+		review, err := scanPeerReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return reviews, r.loadScores(ctx, reviews)
+}
+
+func (r *peerReviewRepository) loadScores(ctx context.Context, reviews []*models.PeerReview) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*models.PeerReview, len(reviews))
+	ids := make([]int64, 0, len(reviews))
+	for _, review := range reviews {
+		byID[review.ID] = review
+		ids = append(ids, review.ID)
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT ps.peer_review_id, ps.criterion_id, ps.points, ps.comment
+        FROM peer_review_scores ps
+        JOIN assignment_rubric_criteria c ON c.id = ps.criterion_id
+        WHERE ps.peer_review_id = ANY($1)
+        ORDER BY c.position;
+    `, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewID int64
+		var score models.RubricScore
+		if err := rows.Scan(&reviewID, &score.CriterionID, &score.Points, &score.Comment); err != nil {
+			return err
+		}
+		byID[reviewID].Scores = append(byID[reviewID].Scores, &score)
+	}
+	return rows.Err()
+}
+
+// SubmitPeerReview сохраняет комментарий и баллы рецензии; повторная отправка заменяет прежние баллы.
+func (r *peerReviewRepository) SubmitPeerReview(ctx context.Context, review *models.PeerReview) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE peer_reviews
+        SET comment = $2, submitted_at = NOW()
+        WHERE id = $1
+        RETURNING submitted_at;
+    `
+	if err := tx.QueryRow(ctx, query, review.ID, review.Comment).Scan(&review.SubmittedAt); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM peer_review_scores WHERE peer_review_id = $1;`, review.ID); err != nil {
+		return err
+	}
+	scoreQuery := `
+        INSERT INTO peer_review_scores (peer_review_id, criterion_id, points, comment)
+        VALUES ($1, $2, $3, $4);
+    `
+	for _, score := range review.Scores {
+		if _, err := tx.Exec(ctx, scoreQuery, review.ID, score.CriterionID, score.Points, score.Comment); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *peerReviewRepository) IsPeerReviewer(ctx context.Context, submissionID, studentID int64) (bool, error) {
+	var reviewer bool
+	err := r.db.QueryRow(ctx, `
+        SELECT EXISTS (SELECT 1 FROM peer_reviews WHERE submission_id = $1 AND reviewer_id = $2);
+    `, submissionID, studentID).Scan(&reviewer)
+	return reviewer, err
+}
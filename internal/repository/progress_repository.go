@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProgressRepository interface {
+	RecordLectureActivity(ctx context.Context, studentID, lectureID int64, positionSeconds int32, maxElapsed time.Duration) (*models.LectureProgress, error)
+	GetCourseLectureProgress(ctx context.Context, studentID, courseID int64) ([]*models.LectureProgress, error)
+	GetStudentProgress(ctx context.Context, studentID int64) ([]*models.CourseLectureProgress, error)
+}
+
+type progressRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProgressRepository(db *pgxpool.Pool) ProgressRepository {
+	return &progressRepository{db: db}
+}
+
+// RecordLectureActivity сохраняет позицию просмотра и добавляет ко времени в лекции промежуток с
+// прошлой активности, но не больше maxElapsed: перерывы в просмотре не считаются временем обучения.
+// При maxElapsed = 0 время не начисляется (например, при повторном открытии лекции).
+func (r *progressRepository) RecordLectureActivity(ctx context.Context, studentID, lectureID int64, positionSeconds int32, maxElapsed time.Duration) (*models.LectureProgress, error) {
+	query := `
+        INSERT INTO lecture_progress (student_id, lecture_id, last_position_seconds)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (student_id, lecture_id) DO UPDATE
+        SET time_spent_seconds = lecture_progress.time_spent_seconds +
+                LEAST(GREATEST(EXTRACT(EPOCH FROM NOW() - lecture_progress.last_activity_at), 0), $4)::BIGINT,
+            last_position_seconds = EXCLUDED.last_position_seconds,
+            last_activity_at = NOW()
+        RETURNING lecture_id, started_at, last_activity_at, time_spent_seconds, last_position_seconds;
+    `
+
+	var progress models.LectureProgress
+	err := r.db.QueryRow(ctx, query, studentID, lectureID, positionSeconds, int64(maxElapsed.Seconds())).Scan(
+		&progress.LectureID, &progress.StartedAt, &progress.LastActivityAt, &progress.TimeSpentSeconds, &progress.LastPositionSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+func (r *progressRepository) GetCourseLectureProgress(ctx context.Context, studentID, courseID int64) ([]*models.LectureProgress, error) {
+	query := `
+        SELECT l.id, l.title, l.position, p.started_at, p.last_activity_at,
+               COALESCE(p.time_spent_seconds, 0), COALESCE(p.last_position_seconds, 0), lc.completed_at
+        FROM lectures l
+        LEFT JOIN lecture_progress p ON p.lecture_id = l.id AND p.student_id = $1
+        LEFT JOIN lecture_completions lc ON lc.lecture_id = l.id AND lc.student_id = $1
+        WHERE l.course_id = $2
+        ORDER BY l.position, l.id;
+    `
+
+	rows, err := r.db.Query(ctx, query, studentID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lectures []*models.LectureProgress
+	for rows.Next() {
+		var p models.LectureProgress
+		if err := rows.Scan(&p.LectureID, &p.Title, &p.Position, &p.StartedAt, &p.LastActivityAt, &p.TimeSpentSeconds, &p.LastPositionSeconds, &p.CompletedAt); err != nil {
+			return nil, err
+		}
+		lectures = append(lectures, &p)
+	}
+	return lectures, rows.Err()
+}
+
+// GetStudentProgress возвращает прогресс по лекциям всех курсов, на которые записан студент.
+func (r *progressRepository) GetStudentProgress(ctx context.Context, studentID int64) ([]*models.CourseLectureProgress, error) {
+	query := `
+        SELECT c.id, c.name, l.id, l.title, l.position, p.started_at, p.last_activity_at,
+               COALESCE(p.time_spent_seconds, 0), COALESCE(p.last_position_seconds, 0), lc.completed_at
+        FROM enrollments e
+        JOIN courses c ON c.id = e.course_id
+        LEFT JOIN lectures l ON l.course_id = c.id
+        LEFT JOIN lecture_progress p ON p.lecture_id = l.id AND p.student_id = e.student_id
+        LEFT JOIN lecture_completions lc ON lc.lecture_id = l.id AND lc.student_id = e.student_id
+        WHERE e.student_id = $1
+        ORDER BY c.id, l.position, l.id;
+    `
+
+	rows, err := r.db.Query(ctx, query, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var courses []*models.CourseLectureProgress
+	var current *models.CourseLectureProgress
+	for rows.Next() {
+		var (
+			courseID   int64
+			courseName string
+			lectureID  *int64
+			title      *string
+			position   *int32
+			p          models.LectureProgress
+		)
+		if err := rows.Scan(&courseID, &courseName, &lectureID, &title, &position, &p.StartedAt, &p.LastActivityAt, &p.TimeSpentSeconds, &p.LastPositionSeconds, &p.CompletedAt); err != nil {
+			return nil, err
+		}
+
+		if current == nil || current.CourseID != courseID {
+			current = &models.CourseLectureProgress{CourseID: courseID, CourseName: courseName}
+			courses = append(courses, current)
+		}
+		if lectureID == nil {
+			continue
+		}
+		p.LectureID, p.Title, p.Position = *lectureID, *title, *position
+		current.Lectures = append(current.Lectures, &p)
+	}
+	return courses, rows.Err()
+}
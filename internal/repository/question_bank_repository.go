@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// QuestionBankRepository хранит вопросы банка преподавателя. Вопросы банка лежат в той же таблице,
+// что и вопросы тестов, но принадлежат преподавателю, а не тесту. На вопросы банка ссылаются попытки
+// тестов, поэтому удалённые вопросы только помечаются deleted_at и остаются доступны по ID.
+type QuestionBankRepository interface {
+	CreateQuestions(ctx context.Context, instructorID int64, questions []*models.QuizQuestion) error
+	UpdateQuestion(ctx context.Context, question *models.QuizQuestion) error
+	DeleteQuestion(ctx context.Context, instructorID, questionID int64) (bool, error)
+	GetQuestions(ctx context.Context, instructorID int64, tags []string, difficulty string) ([]*models.QuizQuestion, error)
+}
+
+type questionBankRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewQuestionBankRepository(db *pgxpool.Pool) QuestionBankRepository {
+	return &questionBankRepository{db: db}
+}
+
+// CreateQuestions добавляет вопросы в банк преподавателя одной транзакцией и заполняет их ID.
+func (r *questionBankRepository) CreateQuestions(ctx context.Context, instructorID int64, questions []*models.QuizQuestion) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, q := range questions {
+		if err := insertBankQuestion(ctx, tx, instructorID, q); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func insertBankQuestion(ctx context.Context, tx pgx.Tx, instructorID int64, q *models.QuizQuestion) error {
+	query := `
+        INSERT INTO quiz_questions (instructor_id, position, type, text, points, options, correct_options, correct_bool,
+                                    correct_number, tolerance, accepted_answers, tags, difficulty)
+        VALUES ($1, 0, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''))
+        RETURNING id;
+    `
+	q.InstructorID = instructorID
+	return tx.QueryRow(ctx, query, instructorID, q.Type, q.Text, q.Points, nonNilStrings(q.Options), nonNilInts(q.CorrectOptions),
+		q.CorrectBool, q.CorrectNumber, q.Tolerance, nonNilStrings(q.AcceptedAnswers), nonNilStrings(q.Tags), q.Difficulty).Scan(&q.ID)
+}
+
+// UpdateQuestion обновляет вопрос банка. Вопрос, который уже выпадал в попытках тестов, не изменяется:
+// он помечается удалённым, а изменённая копия сохраняется под новым ID, который записывается в q.ID.
+// Если вопрос не найден у преподавателя, возвращается pgx.ErrNoRows.
+func (r *questionBankRepository) UpdateQuestion(ctx context.Context, q *models.QuizQuestion) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var used bool
+	query := `
+        SELECT EXISTS (SELECT 1 FROM quiz_attempts a WHERE qq.id = ANY(a.question_ids))
+        FROM quiz_questions qq
+        WHERE qq.id = $1 AND qq.instructor_id = $2 AND qq.quiz_id IS NULL AND qq.deleted_at IS NULL
+        FOR UPDATE;
+    `
+	if err := tx.QueryRow(ctx, query, q.ID, q.InstructorID).Scan(&used); err != nil {
+		return err
+	}
+
+	if used {
+		if _, err := tx.Exec(ctx, `UPDATE quiz_questions SET deleted_at = NOW() WHERE id = $1;`, q.ID); err != nil {
+			return err
+		}
+		if err := insertBankQuestion(ctx, tx, q.InstructorID, q); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	query = `
+        UPDATE quiz_questions
+        SET type = $1, text = $2, points = $3, options = $4, correct_options = $5, correct_bool = $6,
+            correct_number = $7, tolerance = $8, accepted_answers = $9, tags = $10, difficulty = NULLIF($11, '')
+        WHERE id = $12;
+    `
+	_, err = tx.Exec(ctx, query, q.Type, q.Text, q.Points, nonNilStrings(q.Options), nonNilInts(q.CorrectOptions),
+		q.CorrectBool, q.CorrectNumber, q.Tolerance, nonNilStrings(q.AcceptedAnswers), nonNilStrings(q.Tags), q.Difficulty, q.ID)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// DeleteQuestion помечает вопрос банка удалённым: он пропадает из банка и из новых попыток,
+// но завершённые попытки по-прежнему его показывают.
+func (r *questionBankRepository) DeleteQuestion(ctx context.Context, instructorID, questionID int64) (bool, error) {
+	query := `
+        UPDATE quiz_questions SET deleted_at = NOW()
+        WHERE id = $1 AND instructor_id = $2 AND quiz_id IS NULL AND deleted_at IS NULL;
+    `
+	commandTag, err := r.db.Exec(ctx, query, questionID, instructorID)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// GetQuestions возвращает вопросы банка преподавателя в порядке ID. Вопрос попадает в выборку,
+// если у него есть хотя бы один из тегов (без учёта регистра) и совпадает сложность; пустые фильтры не ограничивают выбор.
+func (r *questionBankRepository) GetQuestions(ctx context.Context, instructorID int64, tags []string, difficulty string) ([]*models.QuizQuestion, error) {
+	query := `
+        SELECT ` + questionColumns + `
+        FROM quiz_questions
+        WHERE instructor_id = $1 AND quiz_id IS NULL AND deleted_at IS NULL
+          AND (cardinality($2::text[]) = 0 OR EXISTS (
+              SELECT 1 FROM unnest(tags) t JOIN unnest($2::text[]) f ON lower(t) = lower(f)
+          ))
+          AND ($3 = '' OR difficulty = $3)
+        ORDER BY id;
+    `
+	return queryQuestions(ctx, r.db, query, instructorID, nonNilStrings(tags), difficulty)
+}
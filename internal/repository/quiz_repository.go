@@ -0,0 +1,473 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type QuizRepository interface {
+	CreateQuiz(ctx context.Context, quiz *models.Quiz) (int64, error)
+	UpdateQuiz(ctx context.Context, quiz *models.Quiz) error
+	DeleteQuiz(ctx context.Context, id int64) (bool, error)
+	GetQuizByID(ctx context.Context, id int64) (*models.Quiz, error)
+	GetQuizzesByCourse(ctx context.Context, courseID int64) ([]*models.Quiz, error)
+	GetUnpassedRequiredQuiz(ctx context.Context, studentID, lectureID int64) (*models.Quiz, error)
+	GetQuestionsByIDs(ctx context.Context, ids []int64) ([]*models.QuizQuestion, error)
+	StartAttempt(ctx context.Context, quiz *models.Quiz, studentID, seed int64, questionIDs []int64) (*models.QuizAttempt, error)
+	GetOpenAttempt(ctx context.Context, quizID, studentID int64) (*models.QuizAttempt, error)
+	GetAttemptByID(ctx context.Context, id int64) (*models.QuizAttempt, error)
+	GetAttempts(ctx context.Context, quizID, studentID int64) ([]*models.QuizAttempt, error)
+	SubmitAttempt(ctx context.Context, attempt *models.QuizAttempt, answers []*models.QuizAnswer) error
+}
+
+var (
+	ErrQuizAttemptsExhausted = errors.New("попытки прохождения теста исчерпаны")
+	ErrQuizAttemptConflict   = errors.New("попытка прохождения теста уже начата")
+	ErrQuizAttemptSubmitted  = errors.New("попытка уже завершена")
+	ErrQuizQuestionNotFound  = errors.New("вопрос не относится к тесту")
+)
+
+type quizRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewQuizRepository(db *pgxpool.Pool) QuizRepository {
+	return &quizRepository{db: db}
+}
+
+func (r *quizRepository) CreateQuiz(ctx context.Context, quiz *models.Quiz) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        INSERT INTO quizzes (course_id, lecture_id, title, description, time_limit_seconds, max_attempts, passing_score,
+                             required_for_completion, shuffle_questions, shuffle_options)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+        RETURNING id;
+    `
+	var id int64
+	err = tx.QueryRow(ctx, query, quiz.CourseID, quiz.LectureID, quiz.Title, quiz.Description, quiz.TimeLimitSeconds,
+		quiz.MaxAttempts, quiz.PassingScore, quiz.RequiredForCompletion, quiz.ShuffleQuestions, quiz.ShuffleOptions).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := insertQuizQuestions(ctx, tx, id, quiz.Questions); err != nil {
+		return 0, err
+	}
+	if err := insertDrawRules(ctx, tx, id, quiz.DrawRules); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// UpdateQuiz обновляет настройки теста и его вопросы. Вопросы с ID изменяются на месте, вопросы без ID
+// добавляются, а удаляются только вопросы, которых нет в новом списке: ответы на оставшиеся вопросы
+// в попытках студентов сохраняются. Если вопрос с ID не относится к тесту, возвращается ErrQuizQuestionNotFound.
+func (r *quizRepository) UpdateQuiz(ctx context.Context, quiz *models.Quiz) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE quizzes
+        SET lecture_id = $1, title = $2, description = $3, time_limit_seconds = $4, max_attempts = $5,
+            passing_score = $6, required_for_completion = $7, shuffle_questions = $8, shuffle_options = $9
+        WHERE id = $10;
+    `
+	commandTag, err := tx.Exec(ctx, query, quiz.LectureID, quiz.Title, quiz.Description, quiz.TimeLimitSeconds,
+		quiz.MaxAttempts, quiz.PassingScore, quiz.RequiredForCompletion, quiz.ShuffleQuestions, quiz.ShuffleOptions, quiz.ID)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := upsertQuizQuestions(ctx, tx, quiz.ID, quiz.Questions); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM quiz_draw_rules WHERE quiz_id = $1;`, quiz.ID); err != nil {
+		return err
+	}
+	if err := insertDrawRules(ctx, tx, quiz.ID, quiz.DrawRules); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func insertQuizQuestions(ctx context.Context, tx pgx.Tx, quizID int64, questions []*models.QuizQuestion) error {
+	for i, q := range questions {
+		q.QuizID = quizID
+		q.Position = int32(i + 1)
+		if err := insertQuizQuestion(ctx, tx, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertQuizQuestion(ctx context.Context, tx pgx.Tx, q *models.QuizQuestion) error {
+	query := `
+        INSERT INTO quiz_questions (quiz_id, position, type, text, points, options, correct_options, correct_bool, correct_number, tolerance, accepted_answers)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        RETURNING id;
+    `
+	return tx.QueryRow(ctx, query, q.QuizID, q.Position, q.Type, q.Text, q.Points, nonNilStrings(q.Options), nonNilInts(q.CorrectOptions),
+		q.CorrectBool, q.CorrectNumber, q.Tolerance, nonNilStrings(q.AcceptedAnswers)).Scan(&q.ID)
+}
+
+// upsertQuizQuestions приводит вопросы теста к списку questions, сохраняя ID существующих вопросов.
+func upsertQuizQuestions(ctx context.Context, tx pgx.Tx, quizID int64, questions []*models.QuizQuestion) error {
+	keep := make([]int64, 0, len(questions))
+	for _, q := range questions {
+		if q.ID != 0 {
+			keep = append(keep, q.ID)
+		}
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM quiz_questions WHERE quiz_id = $1 AND NOT (id = ANY($2));`, quizID, keep); err != nil {
+		return err
+	}
+
+	query := `
+        UPDATE quiz_questions
+        SET position = $3, type = $4, text = $5, points = $6, options = $7, correct_options = $8, correct_bool = $9,
+            correct_number = $10, tolerance = $11, accepted_answers = $12
+        WHERE id = $1 AND quiz_id = $2;
+    `
+	for i, q := range questions {
+		q.QuizID = quizID
+		q.Position = int32(i + 1)
+		if q.ID == 0 {
+			if err := insertQuizQuestion(ctx, tx, q); err != nil {
+				return err
+			}
+			continue
+		}
+		commandTag, err := tx.Exec(ctx, query, q.ID, quizID, q.Position, q.Type, q.Text, q.Points, nonNilStrings(q.Options), nonNilInts(q.CorrectOptions),
+			q.CorrectBool, q.CorrectNumber, q.Tolerance, nonNilStrings(q.AcceptedAnswers))
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() == 0 {
+			return ErrQuizQuestionNotFound
+		}
+	}
+	return nil
+}
+
+func insertDrawRules(ctx context.Context, tx pgx.Tx, quizID int64, rules []*models.QuizDrawRule) error {
+	query := `
+        INSERT INTO quiz_draw_rules (quiz_id, position, count, tags, difficulty)
+        VALUES ($1, $2, $3, $4, NULLIF($5, ''));
+    `
+	for i, rule := range rules {
+		if _, err := tx.Exec(ctx, query, quizID, i+1, rule.Count, nonNilStrings(rule.Tags), rule.Difficulty); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *quizRepository) DeleteQuiz(ctx context.Context, id int64) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM quizzes WHERE id = $1;`, id)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+const quizColumns = `
+        q.id, q.course_id, q.lecture_id, q.title, q.description, q.time_limit_seconds, q.max_attempts,
+        q.passing_score, q.required_for_completion, q.shuffle_questions, q.shuffle_options,
+        (SELECT COUNT(*)::int FROM quiz_questions qq WHERE qq.quiz_id = q.id) +
+            (SELECT COALESCE(SUM(dr.count), 0)::int FROM quiz_draw_rules dr WHERE dr.quiz_id = q.id),
+        (SELECT COALESCE(SUM(qq.points), 0)::int FROM quiz_questions qq WHERE qq.quiz_id = q.id)
+`
+
+func scanQuiz(row pgx.Row) (*models.Quiz, error) {
+	var quiz models.Quiz
+	err := row.Scan(&quiz.ID, &quiz.CourseID, &quiz.LectureID, &quiz.Title, &quiz.Description, &quiz.TimeLimitSeconds,
+		&quiz.MaxAttempts, &quiz.PassingScore, &quiz.RequiredForCompletion, &quiz.ShuffleQuestions, &quiz.ShuffleOptions,
+		&quiz.QuestionCount, &quiz.MaxScore)
+	if err != nil {
+		return nil, err
+	}
+	return &quiz, nil
+}
+
+// GetQuizByID возвращает тест вместе с вопросами, правилами выбора из банка и правильными ответами
+// или nil, если тест не найден.
+func (r *quizRepository) GetQuizByID(ctx context.Context, id int64) (*models.Quiz, error) {
+	query := `SELECT ` + quizColumns + ` FROM quizzes q WHERE q.id = $1;`
+
+	quiz, err := scanQuiz(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	questionsQuery := `SELECT ` + questionColumns + ` FROM quiz_questions WHERE quiz_id = $1 ORDER BY position, id;`
+	quiz.Questions, err = queryQuestions(ctx, r.db, questionsQuery, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesQuery := `
+        SELECT count, tags, COALESCE(difficulty, '')
+        FROM quiz_draw_rules
+        WHERE quiz_id = $1
+        ORDER BY position;
+    `
+	rows, err := r.db.Query(ctx, rulesQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rule models.QuizDrawRule
+		if err := rows.Scan(&rule.Count, &rule.Tags, &rule.Difficulty); err != nil {
+			return nil, err
+		}
+		quiz.DrawRules = append(quiz.DrawRules, &rule)
+	}
+	return quiz, rows.Err()
+}
+
+const questionColumns = `
+        id, COALESCE(quiz_id, 0), COALESCE(instructor_id, 0), position, type, text, points, options, correct_options,
+        correct_bool, correct_number, tolerance, accepted_answers, tags, COALESCE(difficulty, '')
+`
+
+func queryQuestions(ctx context.Context, db *pgxpool.Pool, query string, args ...any) ([]*models.QuizQuestion, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []*models.QuizQuestion
+	for rows.Next() {
+		var q models.QuizQuestion
+		err := rows.Scan(&q.ID, &q.QuizID, &q.InstructorID, &q.Position, &q.Type, &q.Text, &q.Points, &q.Options, &q.CorrectOptions,
+			&q.CorrectBool, &q.CorrectNumber, &q.Tolerance, &q.AcceptedAnswers, &q.Tags, &q.Difficulty)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, &q)
+	}
+	return questions, rows.Err()
+}
+
+// GetQuestionsByIDs возвращает вопросы теста или банка по ID в произвольном порядке, включая удалённые
+// из банка; вопросы, удалённые из теста, пропускаются.
+func (r *quizRepository) GetQuestionsByIDs(ctx context.Context, ids []int64) ([]*models.QuizQuestion, error) {
+	query := `SELECT ` + questionColumns + ` FROM quiz_questions WHERE id = ANY($1);`
+	return queryQuestions(ctx, r.db, query, ids)
+}
+
+// GetQuizzesByCourse возвращает тесты курса без вопросов.
+func (r *quizRepository) GetQuizzesByCourse(ctx context.Context, courseID int64) ([]*models.Quiz, error) {
+	query := `SELECT ` + quizColumns + ` FROM quizzes q WHERE q.course_id = $1 ORDER BY q.lecture_id NULLS LAST, q.id;`
+	return r.queryQuizzes(ctx, query, courseID)
+}
+
+// GetUnpassedRequiredQuiz возвращает обязательный тест лекции, который студент ещё не сдал, или nil.
+func (r *quizRepository) GetUnpassedRequiredQuiz(ctx context.Context, studentID, lectureID int64) (*models.Quiz, error) {
+	query := `
+        SELECT ` + quizColumns + `
+        FROM quizzes q
+        WHERE q.lecture_id = $2 AND q.required_for_completion
+          AND NOT EXISTS (
+              SELECT 1 FROM quiz_attempts a
+              WHERE a.quiz_id = q.id AND a.student_id = $1 AND a.passed
+          )
+        ORDER BY q.id
+        LIMIT 1;
+    `
+	quizzes, err := r.queryQuizzes(ctx, query, studentID, lectureID)
+	if err != nil || len(quizzes) == 0 {
+		return nil, err
+	}
+	return quizzes[0], nil
+}
+
+func (r *quizRepository) queryQuizzes(ctx context.Context, query string, args ...any) ([]*models.Quiz, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var quizzes []*models.Quiz
+	for rows.Next() {
+		quiz, err := scanQuiz(rows)
+		if err != nil {
+			return nil, err
+		}
+		quizzes = append(quizzes, quiz)
+	}
+	return quizzes, rows.Err()
+}
+
+// StartAttempt создаёт новую попытку с дедлайном по лимиту времени теста, сохраняя зерно случайного
+// порядка и выбранные вопросы. Если попытки исчерпаны, возвращается ErrQuizAttemptsExhausted;
+// одновременный старт второй попытки — ErrQuizAttemptConflict.
+func (r *quizRepository) StartAttempt(ctx context.Context, quiz *models.Quiz, studentID, seed int64, questionIDs []int64) (*models.QuizAttempt, error) {
+	query := `
+        INSERT INTO quiz_attempts (quiz_id, student_id, attempt_number, deadline_at, seed, question_ids)
+        SELECT $1, $2, COALESCE(MAX(attempt_number), 0) + 1, NOW() + make_interval(secs => $3::int), $5, $6
+        FROM quiz_attempts
+        WHERE quiz_id = $1 AND student_id = $2
+        HAVING $4::int IS NULL OR COUNT(*) < $4::int
+        RETURNING ` + attemptColumns + `;
+    `
+
+	attempt, err := scanAttempt(r.db.QueryRow(ctx, query, quiz.ID, studentID, quiz.TimeLimitSeconds, quiz.MaxAttempts, seed, questionIDs))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuizAttemptsExhausted
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrQuizAttemptConflict
+		}
+		return nil, err
+	}
+	return attempt, nil
+}
+
+const attemptColumns = `id, quiz_id, student_id, attempt_number, started_at, deadline_at, submitted_at, score, max_score, percent, passed, seed, question_ids`
+
+func scanAttempt(row pgx.Row) (*models.QuizAttempt, error) {
+	var a models.QuizAttempt
+	err := row.Scan(&a.ID, &a.QuizID, &a.StudentID, &a.AttemptNumber, &a.StartedAt, &a.DeadlineAt, &a.SubmittedAt,
+		&a.Score, &a.MaxScore, &a.Percent, &a.Passed, &a.Seed, &a.QuestionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// GetOpenAttempt возвращает незавершённую попытку студента или nil.
+func (r *quizRepository) GetOpenAttempt(ctx context.Context, quizID, studentID int64) (*models.QuizAttempt, error) {
+	query := `
+        SELECT ` + attemptColumns + `
+        FROM quiz_attempts
+        WHERE quiz_id = $1 AND student_id = $2 AND submitted_at IS NULL
+        ORDER BY attempt_number DESC
+        LIMIT 1;
+    `
+	attempt, err := scanAttempt(r.db.QueryRow(ctx, query, quizID, studentID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return attempt, err
+}
+
+func (r *quizRepository) GetAttemptByID(ctx context.Context, id int64) (*models.QuizAttempt, error) {
+	query := `SELECT ` + attemptColumns + ` FROM quiz_attempts WHERE id = $1;`
+
+	attempt, err := scanAttempt(r.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return attempt, err
+}
+
+// GetAttempts возвращает попытки прохождения теста; при studentID = 0 — попытки всех студентов.
+func (r *quizRepository) GetAttempts(ctx context.Context, quizID, studentID int64) ([]*models.QuizAttempt, error) {
+	query := `
+        SELECT ` + attemptColumns + `
+        FROM quiz_attempts
+        WHERE quiz_id = $1 AND ($2 = 0 OR student_id = $2)
+        ORDER BY student_id, attempt_number;
+    `
+
+	rows, err := r.db.Query(ctx, query, quizID, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []*models.QuizAttempt
+	for rows.Next() {
+		attempt, err := scanAttempt(rows)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, attempt)
+	}
+	return attempts, rows.Err()
+}
+
+// SubmitAttempt сохраняет результат попытки и ответы студента. Повторное завершение
+// попытки возвращает ErrQuizAttemptSubmitted.
+func (r *quizRepository) SubmitAttempt(ctx context.Context, attempt *models.QuizAttempt, answers []*models.QuizAnswer) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+        UPDATE quiz_attempts
+        SET submitted_at = NOW(), score = $2, max_score = $3, percent = $4, passed = $5
+        WHERE id = $1 AND submitted_at IS NULL
+        RETURNING submitted_at;
+    `
+	err = tx.QueryRow(ctx, query, attempt.ID, attempt.Score, attempt.MaxScore, attempt.Percent, attempt.Passed).Scan(&attempt.SubmittedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrQuizAttemptSubmitted
+		}
+		return err
+	}
+
+	answerQuery := `
+        INSERT INTO quiz_answers (attempt_id, question_id, selected_options, bool_answer, number_answer, text_answer, correct, points)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+    `
+	for _, answer := range answers {
+		_, err := tx.Exec(ctx, answerQuery, attempt.ID, answer.QuestionID, nonNilInts(answer.SelectedOptions), answer.BoolAnswer,
+			answer.NumberAnswer, answer.TextAnswer, answer.Correct, answer.Points)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// nonNilStrings и nonNilInts заменяют nil на пустой срез: pgx передаёт nil как NULL, а колонки массивов NOT NULL.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func nonNilInts(values []int32) []int32 {
+	if values == nil {
+		return []int32{}
+	}
+	return values
+}
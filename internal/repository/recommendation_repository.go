@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RecommendationRepository interface {
+	GetInteractions(ctx context.Context) ([]*models.CourseInteraction, error)
+	GetStudentInteractions(ctx context.Context, studentID int64) ([]*models.CourseInteraction, error)
+	ReplaceSimilarities(ctx context.Context, similarities []*models.CourseSimilarity) error
+	GetSimilarities(ctx context.Context, courseIDs []int64) ([]*models.CourseSimilarity, error)
+	GetPopularCourses(ctx context.Context, studentID int64, limit int) ([]*models.Course, error)
+}
+
+type recommendationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRecommendationRepository(db *pgxpool.Pool) RecommendationRepository {
+	return &recommendationRepository{db: db}
+}
+
+// interactionsQuery собирает по записям на курсы долю пройденных лекций и оценку из опубликованного отзыва.
+const interactionsQuery = `
+        SELECT e.student_id, e.course_id,
+               COALESCE(done.count::DOUBLE PRECISION / NULLIF(total.count, 0), 0),
+               COALESCE(r.rating, 0)
+        FROM enrollments e
+        LEFT JOIN (
+            SELECT l.course_id, lc.student_id, COUNT(*) AS count
+            FROM lecture_completions lc
+            JOIN lectures l ON l.id = lc.lecture_id
+            GROUP BY l.course_id, lc.student_id
+        ) done ON done.course_id = e.course_id AND done.student_id = e.student_id
+        LEFT JOIN (
+            SELECT course_id, COUNT(*) AS count FROM lectures GROUP BY course_id
+        ) total ON total.course_id = e.course_id
+        LEFT JOIN reviews r ON r.student_id = e.student_id AND r.course_id = e.course_id AND r.status = 'approved'
+    `
+
+// GetInteractions возвращает сигналы всех студентов по всем курсам.
+func (r *recommendationRepository) GetInteractions(ctx context.Context) ([]*models.CourseInteraction, error) {
+	return r.queryInteractions(ctx, interactionsQuery+` ORDER BY e.student_id, e.course_id;`)
+}
+
+// GetStudentInteractions возвращает сигналы одного студента по курсам, на которые он записан.
+func (r *recommendationRepository) GetStudentInteractions(ctx context.Context, studentID int64) ([]*models.CourseInteraction, error) {
+	return r.queryInteractions(ctx, interactionsQuery+` WHERE e.student_id = $1 ORDER BY e.course_id;`, studentID)
+}
+
+func (r *recommendationRepository) queryInteractions(ctx context.Context, query string, args ...any) ([]*models.CourseInteraction, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var interactions []*models.CourseInteraction
+	for rows.Next() {
+		var interaction models.CourseInteraction
+		if err := rows.Scan(&interaction.StudentID, &interaction.CourseID, &interaction.Progress, &interaction.Rating); err != nil {
+			return nil, err
+		}
+		interactions = append(interactions, &interaction)
+	}
+	return interactions, rows.Err()
+}
+
+// ReplaceSimilarities заменяет рассчитанную близость курсов целиком в одной транзакции.
+func (r *recommendationRepository) ReplaceSimilarities(ctx context.Context, similarities []*models.CourseSimilarity) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM course_similarities;`); err != nil {
+		return err
+	}
+	rows := make([][]any, 0, len(similarities))
+	for _, similarity := range similarities {
+		rows = append(rows, []any{similarity.CourseID, similarity.SimilarCourseID, similarity.CFScore, similarity.ContentScore, similarity.CommonStudents})
+	}
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"course_similarities"},
+		[]string{"course_id", "similar_course_id", "cf_score", "content_score", "common_students"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// GetSimilarities возвращает похожие курсы для курсов courseIDs.
+func (r *recommendationRepository) GetSimilarities(ctx context.Context, courseIDs []int64) ([]*models.CourseSimilarity, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT course_id, similar_course_id, cf_score, content_score, common_students
+        FROM course_similarities
+        WHERE course_id = ANY($1)
+        ORDER BY course_id, similar_course_id;
+    `, courseIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var similarities []*models.CourseSimilarity
+	for rows.Next() {
+		var similarity models.CourseSimilarity
+		if err := rows.Scan(&similarity.CourseID, &similarity.SimilarCourseID, &similarity.CFScore, &similarity.ContentScore, &similarity.CommonStudents); err != nil {
+			return nil, err
+		}
+		similarities = append(similarities, &similarity)
+	}
+	return similarities, rows.Err()
+}
+
+// GetPopularCourses возвращает курсы, на которые студент не записан, по убыванию числа записавшихся.
+func (r *recommendationRepository) GetPopularCourses(ctx context.Context, studentID int64, limit int) ([]*models.Course, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT `+courseColumns+`
+        FROM courses c
+        WHERE NOT EXISTS (SELECT 1 FROM enrollments e WHERE e.course_id = c.id AND e.student_id = $1)
+        ORDER BY (SELECT COUNT(*) FROM enrollments e WHERE e.course_id = c.id) DESC, c.id
+        LIMIT $2;
+    `, studentID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var courses []*models.Course
+	for rows.Next() {
+		course, err := scanCourse(rows)
+		if err != nil {
+			return nil, err
+		}
+		courses = append(courses, course)
+	}
+	return courses, rows.Err()
+}
@@ -3,15 +3,53 @@ package repository
 import (
 	"GoEdu/internal/models"
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type ReviewRepository interface {
-	AddReview(ctx context.Context, studentID, courseID int64, comment string, rating int32) error
-	GetReviewsByCourse(ctx context.Context, courseID int64) ([]*models.Review, error)
+	AddReview(ctx context.Context, review *models.Review) (*models.Review, error)
+	GetReviewsByCourse(ctx context.Context, courseID int64, order ReviewOrder) ([]*models.Review, error)
+	GetReviewByID(ctx context.Context, id int64) (*models.Review, error)
+	UpdateReview(ctx context.Context, id int64, comment string, rating int32, status, note string) (*models.Review, error)
+	DeleteReview(ctx context.Context, id int64) (bool, error)
+	AddReport(ctx context.Context, report *models.ReviewReport) (int, error)
+	HoldReview(ctx context.Context, id int64, note string) (bool, error)
+	SetReviewStatus(ctx context.Context, id int64, status, note string, moderatorID int64) (*models.Review, error)
+	GetModerationQueue(ctx context.Context) ([]*models.ModerationItem, error)
+	SetReply(ctx context.Context, reply *models.ReviewReply) error
+	DeleteReply(ctx context.Context, reviewID int64) (bool, error)
+	Vote(ctx context.Context, reviewID, voterID int64, voterRole string, helpful bool) error
+	DeleteVote(ctx context.Context, reviewID, voterID int64, voterRole string) (bool, error)
 }
 
+// ReviewOrder — порядок отзывов курса.
+type ReviewOrder int
+
+const (
+	ReviewOrderOldest ReviewOrder = iota
+	ReviewOrderHelpful
+	ReviewOrderNewest
+	ReviewOrderHighest
+	ReviewOrderLowest
+)
+
+var reviewOrderBy = map[ReviewOrder]string{
+	ReviewOrderOldest:  "id",
+	ReviewOrderHelpful: "helpful_count - not_helpful_count DESC, helpful_count DESC, id",
+	ReviewOrderNewest:  "created_at DESC, id DESC",
+	ReviewOrderHighest: "rating DESC, id DESC",
+	ReviewOrderLowest:  "rating, id DESC",
+}
+
+var (
+	ErrReviewExists = errors.New("студент уже оставил отзыв к этому курсу")
+	ErrReportExists = errors.New("пользователь уже пожаловался на этот отзыв")
+)
+
 type reviewRepository struct {
 	db *pgxpool.Pool
 }
@@ -20,20 +58,151 @@ func NewReviewRepository(db *pgxpool.Pool) ReviewRepository {
 	return &reviewRepository{db: db}
 }
 
-func (r *reviewRepository) AddReview(ctx context.Context, studentID, courseID int64, comment string, rating int32) error {
+const reviewColumns = `id, student_id, course_id, COALESCE(comment, ''), rating, created_at, updated_at, status, moderation_note,
+        helpful_count, not_helpful_count`
+
+func scanReview(row pgx.Row) (*models.Review, error) {
+	var review models.Review
+	if err := row.Scan(&review.ID, &review.StudentID, &review.CourseID, &review.Comment, &review.Rating, &review.CreatedAt, &review.UpdatedAt, &review.Status, &review.ModerationNote,
+		&review.HelpfulCount, &review.NotHelpfulCount); err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// loadReplies подставляет в отзывы ответы преподавателей.
+func (r *reviewRepository) loadReplies(ctx context.Context, reviews []*models.Review) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*models.Review, len(reviews))
+	ids := make([]int64, 0, len(reviews))
+	for _, review := range reviews {
+		byID[review.ID] = review
+		ids = append(ids, review.ID)
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT review_id, instructor_id, comment, created_at, updated_at
+        FROM review_replies
+        WHERE review_id = ANY($1);
+    `, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reply models.ReviewReply
+		if err := rows.Scan(&reply.ReviewID, &reply.InstructorID, &reply.Comment, &reply.CreatedAt, &reply.UpdatedAt); err != nil {
+			return err
+		}
+		byID[reply.ReviewID].Reply = &reply
+	}
+	return rows.Err()
+}
+
+// AddReview сохраняет отзыв и, если он сразу одобрен, в той же транзакции учитывает его в рейтинге курса.
+// Если студент уже оставил отзыв к курсу, возвращает ErrReviewExists.
+func (r *reviewRepository) AddReview(ctx context.Context, review *models.Review) (*models.Review, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
-        INSERT INTO reviews (student_id, course_id, comment, rating)
-        VALUES ($1, $2, $3, $4);
+        INSERT INTO reviews (student_id, course_id, comment, rating, status, moderation_note)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING ` + reviewColumns + `;
     `
-	_, err := r.db.Exec(ctx, query, studentID, courseID, comment, rating)
+	created, err := scanReview(tx.QueryRow(ctx, query, review.StudentID, review.CourseID, review.Comment, review.Rating, review.Status, review.ModerationNote))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, ErrReviewExists
+		}
+		return nil, err
+	}
+	if created.Status == models.ReviewApproved {
+		if err := updateCourseRating(ctx, tx, created.CourseID, created.Rating, 1); err != nil {
+			return nil, err
+		}
+		if err := enqueueReviewPosted(ctx, tx, created); err != nil {
+			return nil, err
+		}
+	}
+	return created, tx.Commit(ctx)
+}
+
+// enqueueReviewPosted сохраняет событие ReviewPosted о публикации отзыва: сразу при создании
+// или после одобрения модератором.
+func enqueueReviewPosted(ctx context.Context, tx pgx.Tx, review *models.Review) error {
+	payload := models.ReviewPostedPayload{
+		ReviewID:  review.ID,
+		StudentID: review.StudentID,
+		CourseID:  review.CourseID,
+		Rating:    review.Rating,
+		Comment:   review.Comment,
+	}
+	return enqueueEvent(ctx, tx, models.EventReviewPosted, models.AggregateReview, review.ID, payload)
+}
+
+// updateCourseRating добавляет к рейтингу курса (delta = 1) или убирает из него (delta = -1) одну оценку.
+func updateCourseRating(ctx context.Context, tx pgx.Tx, courseID int64, rating int32, delta int32) error {
+	_, err := tx.Exec(ctx, `
+        UPDATE courses
+        SET rating_count = rating_count + $3,
+            rating_sum = rating_sum + $2 * $3,
+            rating_counts[$2] = rating_counts[$2] + $3
+        WHERE id = $1;
+    `, courseID, rating, delta)
 	return err
 }
 
-func (r *reviewRepository) GetReviewsByCourse(ctx context.Context, courseID int64) ([]*models.Review, error) {
+// replaceCourseRating переносит отзыв в рейтинге курса из состояния before в состояние after:
+// в рейтинге учитываются только одобренные отзывы.
+func replaceCourseRating(ctx context.Context, tx pgx.Tx, before, after *models.Review) error {
+	wasCounted := before.Status == models.ReviewApproved
+	isCounted := after.Status == models.ReviewApproved
+	if wasCounted == isCounted && (!isCounted || before.Rating == after.Rating) {
+		return nil
+	}
+	if wasCounted {
+		if err := updateCourseRating(ctx, tx, before.CourseID, before.Rating, -1); err != nil {
+			return err
+		}
+	}
+	if isCounted {
+		return updateCourseRating(ctx, tx, after.CourseID, after.Rating, 1)
+	}
+	return nil
+}
+
+// lockReview блокирует отзыв до конца транзакции и возвращает его или nil, если отзыв не найден.
+func lockReview(ctx context.Context, tx pgx.Tx, id int64) (*models.Review, error) {
+	review, err := scanReview(tx.QueryRow(ctx, `SELECT `+reviewColumns+` FROM reviews WHERE id = $1 FOR UPDATE;`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+// GetReviewsByCourse возвращает опубликованные (одобренные) отзывы курса с ответами преподавателя в порядке order.
+func (r *reviewRepository) GetReviewsByCourse(ctx context.Context, courseID int64, order ReviewOrder) ([]*models.Review, error) {
+	orderBy, ok := reviewOrderBy[order]
+	if !ok {
+		orderBy = reviewOrderBy[ReviewOrderOldest]
+	}
 	query := `
-        SELECT id, student_id, course_id, comment, rating, created_at
+        SELECT ` + reviewColumns + `
         FROM reviews
-        WHERE course_id = $1;
+        WHERE course_id = $1 AND status = 'approved'
+        ORDER BY ` + orderBy + `;
     `
 
 	rows, err := r.db.Query(ctx, query, courseID)
@@ -43,13 +212,350 @@ func (r *reviewRepository) GetReviewsByCourse(ctx context.Context, courseID int6
 	defer rows.Close()
 
 	var reviews []*models.Review
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return reviews, r.loadReplies(ctx, reviews)
+}
+
+// GetReviewByID возвращает отзыв с ответом преподавателя или nil, если отзыв не найден.
+func (r *reviewRepository) GetReviewByID(ctx context.Context, id int64) (*models.Review, error) {
+	review, err := scanReview(r.db.QueryRow(ctx, `SELECT `+reviewColumns+` FROM reviews WHERE id = $1;`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return review, r.loadReplies(ctx, []*models.Review{review})
+}
+
+// UpdateReview изменяет текст, оценку и статус модерации отзыва и пересчитывает рейтинг курса;
+// возвращает nil, если отзыв не найден.
+func (r *reviewRepository) UpdateReview(ctx context.Context, id int64, comment string, rating int32, status, note string) (*models.Review, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	previous, err := lockReview(ctx, tx, id)
+	if err != nil || previous == nil {
+		return nil, err
+	}
+
+	query := `
+        UPDATE reviews
+        SET comment = $2, rating = $3, status = $4, moderation_note = $5, updated_at = NOW()
+        WHERE id = $1
+        RETURNING ` + reviewColumns + `;
+    `
+	review, err := scanReview(tx.QueryRow(ctx, query, id, comment, rating, status, note))
+	if err != nil {
+		return nil, err
+	}
+	if err := replaceCourseRating(ctx, tx, previous, review); err != nil {
+		return nil, err
+	}
+	if previous.Status != models.ReviewApproved && review.Status == models.ReviewApproved {
+		if err := enqueueReviewPosted(ctx, tx, review); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return review, r.loadReplies(ctx, []*models.Review{review})
+}
+
+// DeleteReview удаляет отзыв и убирает его оценку из рейтинга курса.
+func (r *reviewRepository) DeleteReview(ctx context.Context, id int64) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var courseID int64
+	var rating int32
+	var status string
+	err = tx.QueryRow(ctx, `DELETE FROM reviews WHERE id = $1 RETURNING course_id, rating, status;`, id).Scan(&courseID, &rating, &status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	if status == models.ReviewApproved {
+		if err := updateCourseRating(ctx, tx, courseID, rating, -1); err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit(ctx)
+}
+
+// AddReport сохраняет жалобу на отзыв и возвращает число открытых жалоб на него.
+// Повторная жалоба того же пользователя возвращает ErrReportExists.
+func (r *reviewRepository) AddReport(ctx context.Context, report *models.ReviewReport) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO review_reports (review_id, reporter_id, reporter_role, reason)
+        VALUES ($1, $2, $3, $4);
+    `, report.ReviewID, report.ReporterID, report.ReporterRole, report.Reason)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, ErrReportExists
+		}
+		return 0, err
+	}
+
+	var count int
+	err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM review_reports WHERE review_id = $1 AND resolved_at IS NULL;`, report.ReviewID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, tx.Commit(ctx)
+}
+
+// HoldReview снимает одобренный отзыв с публикации до решения модератора и убирает его из рейтинга курса.
+// Возвращает false, если отзыв не найден или уже не опубликован.
+func (r *reviewRepository) HoldReview(ctx context.Context, id int64, note string) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	previous, err := lockReview(ctx, tx, id)
+	if err != nil || previous == nil || previous.Status != models.ReviewApproved {
+		return false, err
+	}
+
+	review, err := scanReview(tx.QueryRow(ctx, `
+        UPDATE reviews
+        SET status = 'pending', moderation_note = $2
+        WHERE id = $1
+        RETURNING `+reviewColumns+`;
+    `, id, note))
+	if err != nil {
+		return false, err
+	}
+	if err := replaceCourseRating(ctx, tx, previous, review); err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
+
+// SetReviewStatus сохраняет решение модератора, закрывает открытые жалобы на отзыв
+// и пересчитывает рейтинг курса; возвращает nil, если отзыв не найден.
+func (r *reviewRepository) SetReviewStatus(ctx context.Context, id int64, status, note string, moderatorID int64) (*models.Review, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	previous, err := lockReview(ctx, tx, id)
+	if err != nil || previous == nil {
+		return nil, err
+	}
+
+	review, err := scanReview(tx.QueryRow(ctx, `
+        UPDATE reviews
+        SET status = $2, moderation_note = $3, moderated_by = NULLIF($4::BIGINT, 0), moderated_at = NOW()
+        WHERE id = $1
+        RETURNING `+reviewColumns+`;
+    `, id, status, note, moderatorID))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE review_reports SET resolved_at = NOW() WHERE review_id = $1 AND resolved_at IS NULL;`, id); err != nil {
+		return nil, err
+	}
+	if err := replaceCourseRating(ctx, tx, previous, review); err != nil {
+		return nil, err
+	}
+	if previous.Status != models.ReviewApproved && review.Status == models.ReviewApproved {
+		if err := enqueueReviewPosted(ctx, tx, review); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return review, r.loadReplies(ctx, []*models.Review{review})
+}
+
+// GetModerationQueue возвращает отзывы, ожидающие модерации, и опубликованные отзывы с открытыми жалобами,
+// начиная с самых старых.
+func (r *reviewRepository) GetModerationQueue(ctx context.Context) ([]*models.ModerationItem, error) {
+	query := `
+        SELECT ` + reviewColumns + `,
+               COALESCE((SELECT ARRAY_AGG(rr.reason ORDER BY rr.id) FROM review_reports rr
+                         WHERE rr.review_id = reviews.id AND rr.resolved_at IS NULL), '{}')
+        FROM reviews
+        WHERE status = 'pending'
+           OR EXISTS (SELECT 1 FROM review_reports rr WHERE rr.review_id = reviews.id AND rr.resolved_at IS NULL)
+        ORDER BY created_at, id;
+    `
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*models.ModerationItem
 	for rows.Next() {
 		var review models.Review
-		if err := rows.Scan(&review.ID, &review.StudentID, &review.CourseID, &review.Comment, &review.Rating, &review.CreatedAt); err != nil {
+		var item models.ModerationItem
+		if err := rows.Scan(&review.ID, &review.StudentID, &review.CourseID, &review.Comment, &review.Rating, &review.CreatedAt, &review.UpdatedAt, &review.Status, &review.ModerationNote,
+			&review.HelpfulCount, &review.NotHelpfulCount, &item.ReportReasons); err != nil {
 			return nil, err
 		}
-		reviews = append(reviews, &review)
+		item.Review = &review
+		items = append(items, &item)
+	}
+	return items, rows.Err()
+}
+
+// SetReply сохраняет ответ преподавателя на отзыв; существующий ответ заменяется.
+// О новом ответе, но не о его правке, в той же транзакции записывается событие ReviewReplied.
+func (r *reviewRepository) SetReply(ctx context.Context, reply *models.ReviewReply) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var inserted bool
+	err = tx.QueryRow(ctx, `
+        INSERT INTO review_replies (review_id, instructor_id, comment)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (review_id) DO UPDATE
+        SET instructor_id = EXCLUDED.instructor_id, comment = EXCLUDED.comment, updated_at = NOW()
+        RETURNING xmax = 0;
+    `, reply.ReviewID, reply.InstructorID, reply.Comment).Scan(&inserted)
+	if err != nil {
+		return err
+	}
+
+	if inserted {
+		payload := models.ReviewRepliedPayload{ReviewID: reply.ReviewID, InstructorID: reply.InstructorID, Comment: reply.Comment}
+		err := tx.QueryRow(ctx, `SELECT student_id, course_id FROM reviews WHERE id = $1;`, reply.ReviewID).Scan(&payload.StudentID, &payload.CourseID)
+		if err != nil {
+			return err
+		}
+		if err := enqueueEvent(ctx, tx, models.EventReviewReplied, models.AggregateReview, reply.ReviewID, payload); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// DeleteReply удаляет ответ на отзыв; возвращает false, если ответа не было.
+func (r *reviewRepository) DeleteReply(ctx context.Context, reviewID int64) (bool, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM review_replies WHERE review_id = $1;`, reviewID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Vote сохраняет голос пользователя за отзыв и в той же транзакции обновляет счётчики отзыва.
+// Повторный голос заменяет предыдущий.
+func (r *reviewRepository) Vote(ctx context.Context, reviewID, voterID int64, voterRole string, helpful bool) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокировка отзыва упорядочивает одновременные голоса одного пользователя.
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM reviews WHERE id = $1 FOR UPDATE;`, reviewID); err != nil {
+		return err
+	}
+
+	var previous bool
+	err = tx.QueryRow(ctx, `
+        SELECT helpful FROM review_votes
+        WHERE review_id = $1 AND voter_id = $2 AND voter_role = $3;
+    `, reviewID, voterID, voterRole).Scan(&previous)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		if _, err := tx.Exec(ctx, `
+            INSERT INTO review_votes (review_id, voter_id, voter_role, helpful)
+            VALUES ($1, $2, $3, $4);
+        `, reviewID, voterID, voterRole, helpful); err != nil {
+			return err
+		}
+		if err := updateVoteCounts(ctx, tx, reviewID, helpful, 1); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case previous != helpful:
+		if _, err := tx.Exec(ctx, `
+            UPDATE review_votes SET helpful = $4, created_at = NOW()
+            WHERE review_id = $1 AND voter_id = $2 AND voter_role = $3;
+        `, reviewID, voterID, voterRole, helpful); err != nil {
+			return err
+		}
+		if err := updateVoteCounts(ctx, tx, reviewID, previous, -1); err != nil {
+			return err
+		}
+		if err := updateVoteCounts(ctx, tx, reviewID, helpful, 1); err != nil {
+			return err
+		}
 	}
+	return tx.Commit(ctx)
+}
+
+// DeleteVote отзывает голос пользователя; возвращает false, если голоса не было.
+func (r *reviewRepository) DeleteVote(ctx context.Context, reviewID, voterID int64, voterRole string) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var helpful bool
+	err = tx.QueryRow(ctx, `
+        DELETE FROM review_votes
+        WHERE review_id = $1 AND voter_id = $2 AND voter_role = $3
+        RETURNING helpful;
+    `, reviewID, voterID, voterRole).Scan(&helpful)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := updateVoteCounts(ctx, tx, reviewID, helpful, -1); err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
 
-	return reviews, nil
+// updateVoteCounts добавляет (delta = 1) или убирает (delta = -1) голос из счётчиков отзыва.
+func updateVoteCounts(ctx context.Context, tx pgx.Tx, reviewID int64, helpful bool, delta int32) error {
+	column := "not_helpful_count"
+	if helpful {
+		column = "helpful_count"
+	}
+	_, err := tx.Exec(ctx, `UPDATE reviews SET `+column+` = `+column+` + $2 WHERE id = $1;`, reviewID, delta)
+	return err
 }
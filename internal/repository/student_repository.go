@@ -6,6 +6,8 @@ import (
 	"errors"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"strings"
+	"time"
 )
 
 type StudentRepository interface {
@@ -13,8 +15,14 @@ type StudentRepository interface {
 	GetStudentByEmail(ctx context.Context, email string) (*models.Student, error)
 	GetStudentByID(ctx context.Context, id int64) (*models.Student, error)
 	UpdateStudent(ctx context.Context, student *models.Student) (*models.Student, error)
+	GetStudentsByEmails(ctx context.Context, emails []string) (map[string]*models.Student, error)
+	CreateInvitedStudent(ctx context.Context, tx pgx.Tx, student *models.Student) (int64, error)
+	CreateInvitation(ctx context.Context, tx pgx.Tx, invitation *models.StudentInvitation) error
+	AcceptInvitation(ctx context.Context, tokenHash, passwordHash string) (*models.Student, error)
 }
 
+var ErrInvitationNotFound = errors.New("приглашение не найдено или истекло")
+
 type studentRepository struct {
 	db *pgxpool.Pool
 }
@@ -81,3 +89,84 @@ func (r *studentRepository) UpdateStudent(ctx context.Context, student *models.S
 
 	return &updatedStudent, nil
 }
+
+func (r *studentRepository) GetStudentsByEmails(ctx context.Context, emails []string) (map[string]*models.Student, error) {
+	query := `SELECT id, name, email FROM students WHERE lower(email) = ANY($1);`
+
+	rows, err := r.db.Query(ctx, query, emails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	students := make(map[string]*models.Student)
+	for rows.Next() {
+		var student models.Student
+		if err := rows.Scan(&student.ID, &student.Name, &student.Email); err != nil {
+			return nil, err
+		}
+		students[strings.ToLower(student.Email)] = &student
+	}
+	return students, rows.Err()
+}
+
+func (r *studentRepository) CreateInvitedStudent(ctx context.Context, tx pgx.Tx, student *models.Student) (int64, error) {
+	query := `
+        INSERT INTO students (name, email, password)
+        VALUES ($1, $2, '')
+        RETURNING id;
+    `
+	var id int64
+	err := tx.QueryRow(ctx, query, student.Name, student.Email).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *studentRepository) CreateInvitation(ctx context.Context, tx pgx.Tx, invitation *models.StudentInvitation) error {
+	query := `
+        INSERT INTO student_invitations (token_hash, student_id, course_id, expires_at)
+        VALUES ($1, $2, $3, $4);
+    `
+	_, err := tx.Exec(ctx, query, invitation.TokenHash, invitation.StudentID, invitation.CourseID, invitation.ExpiresAt)
+	return err
+}
+
+func (r *studentRepository) AcceptInvitation(ctx context.Context, tokenHash, passwordHash string) (*models.Student, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var studentID int64
+	err = tx.QueryRow(ctx, `
+        UPDATE student_invitations
+        SET accepted_at = NOW()
+        WHERE token_hash = $1 AND accepted_at IS NULL AND expires_at > $2
+        RETURNING student_id;
+    `, tokenHash, time.Now()).Scan(&studentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, err
+	}
+
+	var student models.Student
+	err = tx.QueryRow(ctx, `
+        UPDATE students
+        SET password = $1
+        WHERE id = $2
+        RETURNING id, name, email;
+    `, passwordHash, studentID).Scan(&student.ID, &student.Name, &student.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &student, nil
+}
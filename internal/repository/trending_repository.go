@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TrendingRepository interface {
+	GetEvents(ctx context.Context, since time.Time) ([]*models.TrendingEvent, error)
+	ReplaceTrending(ctx context.Context, windowDays int32, courses []*models.TrendingCourse) error
+	GetTrending(ctx context.Context, windowDays int32, limit int) ([]*models.TrendingCourse, error)
+	GetTrendingScores(ctx context.Context, windowDays int32) (map[int64]float64, error)
+}
+
+type trendingRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTrendingRepository(db *pgxpool.Pool) TrendingRepository {
+	return &trendingRepository{db: db}
+}
+
+// GetEvents возвращает записи на курсы, выданные сертификаты и опубликованные отзывы начиная с since.
+func (r *trendingRepository) GetEvents(ctx context.Context, since time.Time) ([]*models.TrendingEvent, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT course_id, $2::INT, enrolled_at, 0 FROM enrollments WHERE enrolled_at >= $1
+        UNION ALL
+        SELECT course_id, $3::INT, issued_at, 0 FROM certificates WHERE issued_at >= $1 AND course_id IS NOT NULL
+        UNION ALL
+        SELECT course_id, $4::INT, created_at, rating FROM reviews WHERE created_at >= $1 AND status = 'approved';
+    `, since, models.TrendingEnrollment, models.TrendingCompletion, models.TrendingReview)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.TrendingEvent
+	for rows.Next() {
+		var event models.TrendingEvent
+		if err := rows.Scan(&event.CourseID, &event.Kind, &event.At, &event.Rating); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
+
+// ReplaceTrending заменяет рейтинг популярности за окно windowDays целиком в одной транзакции.
+func (r *trendingRepository) ReplaceTrending(ctx context.Context, windowDays int32, courses []*models.TrendingCourse) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM course_trending WHERE window_days = $1;`, windowDays); err != nil {
+		return err
+	}
+	rows := make([][]any, 0, len(courses))
+	for _, course := range courses {
+		rows = append(rows, []any{course.CourseID, windowDays, course.Rank, course.Score, course.Enrollments,
+			course.Completions, course.Reviews, course.AverageRating, course.ComputedAt})
+	}
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"course_trending"},
+		[]string{"course_id", "window_days", "rank", "score", "enrollments", "completions", "reviews", "average_rating", "computed_at"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// GetTrending возвращает до limit самых популярных курсов за окно windowDays вместе с самими курсами.
+func (r *trendingRepository) GetTrending(ctx context.Context, windowDays int32, limit int) ([]*models.TrendingCourse, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT t.rank, t.score, t.enrollments, t.completions, t.reviews, t.average_rating, t.computed_at, `+courseColumns+`
+        FROM course_trending t
+        JOIN courses c ON c.id = t.course_id
+        WHERE t.window_days = $1
+        ORDER BY t.rank
+        LIMIT $2;
+    `, windowDays, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var courses []*models.TrendingCourse
+	for rows.Next() {
+		trending := models.TrendingCourse{WindowDays: windowDays}
+		var course models.Course
+		dest := append([]any{&trending.Rank, &trending.Score, &trending.Enrollments, &trending.Completions,
+			&trending.Reviews, &trending.AverageRating, &trending.ComputedAt}, courseScanTargets(&course)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		trending.CourseID = course.ID
+		trending.Course = &course
+		courses = append(courses, &trending)
+	}
+	return courses, rows.Err()
+}
+
+// GetTrendingScores возвращает оценки популярности курсов за окно windowDays.
+func (r *trendingRepository) GetTrendingScores(ctx context.Context, windowDays int32) (map[int64]float64, error) {
+	rows, err := r.db.Query(ctx, `SELECT course_id, score FROM course_trending WHERE window_days = $1;`, windowDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := make(map[int64]float64)
+	for rows.Next() {
+		var courseID int64
+		var score float64
+		if err := rows.Scan(&courseID, &score); err != nil {
+			return nil, err
+		}
+		scores[courseID] = score
+	}
+	return scores, rows.Err()
+}
@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"GoEdu/internal/models"
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *models.WebhookSubscription) error
+	UpdateWebhook(ctx context.Context, id int64, url string, eventTypes []string, active *bool) (*models.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id int64) (bool, error)
+	GetWebhookByID(ctx context.Context, id int64) (*models.WebhookSubscription, error)
+	GetWebhooksByOwner(ctx context.Context, ownerRole string, ownerID int64) ([]*models.WebhookSubscription, error)
+	CreateDeliveries(ctx context.Context, event *models.OutboxEvent, courseID, pathID int64) (int, error)
+	ClaimPendingDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	RecordDeliverySuccess(ctx context.Context, delivery *models.WebhookDelivery, responseCode int32) error
+	RecordDeliveryFailure(ctx context.Context, delivery *models.WebhookDelivery, responseCode int32, lastError string, retryAt *time.Time, disableAfter int32) (bool, error)
+	GetDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*models.WebhookDelivery, error)
+	GetDeliveryByID(ctx context.Context, id int64) (*models.WebhookDelivery, error)
+	Redeliver(ctx context.Context, id int64) (*models.WebhookDelivery, error)
+}
+
+type webhookRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewWebhookRepository(db *pgxpool.Pool) WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+const webhookColumns = `id, owner_role, owner_id, url, event_types, secret, active, disabled_reason, consecutive_failures, created_at`
+
+func scanWebhook(row pgx.Row) (*models.WebhookSubscription, error) {
+	var webhook models.WebhookSubscription
+	err := row.Scan(&webhook.ID, &webhook.OwnerRole, &webhook.OwnerID, &webhook.URL, &webhook.EventTypes, &webhook.Secret,
+		&webhook.Active, &webhook.DisabledReason, &webhook.ConsecutiveFailures, &webhook.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// CreateWebhook сохраняет подписку и заполняет ID, активность и дату создания.
+func (r *webhookRepository) CreateWebhook(ctx context.Context, webhook *models.WebhookSubscription) error {
+	query := `
+        INSERT INTO webhook_subscriptions (owner_role, owner_id, url, event_types, secret)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id, active, created_at;
+    `
+	return r.db.QueryRow(ctx, query, webhook.OwnerRole, webhook.OwnerID, webhook.URL, webhook.EventTypes, webhook.Secret).
+		Scan(&webhook.ID, &webhook.Active, &webhook.CreatedAt)
+}
+
+// UpdateWebhook меняет адрес и типы событий подписки и, если active задан, включает или выключает её.
+// Включение сбрасывает счётчик неудачных доставок и причину автоматического выключения.
+// Возвращает nil, если подписка не найдена.
+func (r *webhookRepository) UpdateWebhook(ctx context.Context, id int64, url string, eventTypes []string, active *bool) (*models.WebhookSubscription, error) {
+	query := `
+        UPDATE webhook_subscriptions
+        SET url = $2, event_types = $3,
+            active = COALESCE($4, active),
+            consecutive_failures = CASE WHEN $4 THEN 0 ELSE consecutive_failures END,
+            disabled_reason = CASE WHEN $4 IS NOT NULL THEN '' ELSE disabled_reason END
+        WHERE id = $1
+        RETURNING ` + webhookColumns + `;
+    `
+	webhook, err := scanWebhook(r.db.QueryRow(ctx, query, id, url, eventTypes, active))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return webhook, err
+}
+
+func (r *webhookRepository) DeleteWebhook(ctx context.Context, id int64) (bool, error) {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1;`, id)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// GetWebhookByID возвращает подписку или nil, если она не найдена.
+func (r *webhookRepository) GetWebhookByID(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	webhook, err := scanWebhook(r.db.QueryRow(ctx, `SELECT `+webhookColumns+` FROM webhook_subscriptions WHERE id = $1;`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return webhook, err
+}
+
+func (r *webhookRepository) GetWebhooksByOwner(ctx context.Context, ownerRole string, ownerID int64) ([]*models.WebhookSubscription, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT `+webhookColumns+` FROM webhook_subscriptions
+        WHERE owner_role = $1 AND owner_id = $2
+        ORDER BY id;
+    `, ownerRole, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*models.WebhookSubscription
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+// CreateDeliveries ставит событие в очередь отправки по всем активным подпискам на его тип:
+// администраторам — всегда, преподавателям — если событие относится к их курсу courseID или программе pathID.
+// Повторная публикация того же события не создаёт новых доставок. Возвращает число созданных доставок.
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, event *models.OutboxEvent, courseID, pathID int64) (int, error) {
+	commandTag, err := r.db.Exec(ctx, `
+        INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+        SELECT s.id, $1::UUID, $2, $3::JSONB
+        FROM webhook_subscriptions s
+        WHERE s.active AND $2 = ANY(s.event_types)
+          AND (s.owner_role = 'admin'
+               OR s.owner_id = (SELECT instructor_id FROM courses WHERE id = $4)
+               OR s.owner_id = (SELECT instructor_id FROM learning_paths WHERE id = $5))
+        ON CONFLICT (subscription_id, event_id) WHERE redelivery_of IS NULL DO NOTHING;
+    `, event.EventID, event.EventType, event.Payload, courseID, pathID)
+	if err != nil {
+		return 0, err
+	}
+	return int(commandTag.RowsAffected()), nil
+}
+
+const webhookDeliveryColumns = `d.id, d.subscription_id, d.event_id::TEXT, d.event_type, d.payload, d.status, d.attempts,
+        d.next_attempt_at, d.response_code, d.last_error, d.redelivery_of, d.created_at, d.delivered_at`
+
+func webhookDeliveryScanTargets(delivery *models.WebhookDelivery) []any {
+	return []any{&delivery.ID, &delivery.SubscriptionID, &delivery.EventID, &delivery.EventType, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.ResponseCode,
+		&delivery.LastError, &delivery.RedeliveryOf, &delivery.CreatedAt, &delivery.DeliveredAt}
+}
+
+func scanWebhookDelivery(row pgx.Row) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	if err := row.Scan(webhookDeliveryScanTargets(&delivery)...); err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// ClaimPendingDeliveries выбирает до limit ожидающих доставок активных подписок, время которых наступило,
+// вместе с адресом и секретом подписки и откладывает их следующую попытку на lease,
+// чтобы параллельные отправители не отправляли одну доставку одновременно.
+func (r *webhookRepository) ClaimPendingDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	rows, err := r.db.Query(ctx, `
+        UPDATE webhook_deliveries d
+        SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+        FROM webhook_subscriptions s
+        WHERE s.id = d.subscription_id AND d.id IN (
+            SELECT pd.id FROM webhook_deliveries pd
+            JOIN webhook_subscriptions ps ON ps.id = pd.subscription_id
+            WHERE pd.status = 'pending' AND pd.next_attempt_at <= NOW() AND ps.active
+            ORDER BY pd.id
+            LIMIT $1
+            FOR UPDATE OF pd SKIP LOCKED
+        )
+        RETURNING `+webhookDeliveryColumns+`, s.url, s.secret;
+    `, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		var delivery models.WebhookDelivery
+		if err := rows.Scan(append(webhookDeliveryScanTargets(&delivery), &delivery.URL, &delivery.Secret)...); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// UPDATE ... RETURNING не гарантирует порядок строк.
+	slices.SortFunc(deliveries, func(a, b *models.WebhookDelivery) int { return cmp.Compare(a.ID, b.ID) })
+	return deliveries, nil
+}
+
+// RecordDeliverySuccess отмечает доставку успешной и сбрасывает счётчик неудач подписки.
+func (r *webhookRepository) RecordDeliverySuccess(ctx context.Context, delivery *models.WebhookDelivery, responseCode int32) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+        UPDATE webhook_deliveries
+        SET status = 'succeeded', attempts = attempts + 1, response_code = $2,
+            last_error = '', delivered_at = NOW()
+        WHERE id = $1;
+    `, delivery.ID, responseCode)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE webhook_subscriptions SET consecutive_failures = 0 WHERE id = $1;`, delivery.SubscriptionID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// RecordDeliveryFailure сохраняет результат неудачной попытки и назначает следующую на retryAt;
+// если retryAt = nil, доставка помечается окончательно неудачной. Счётчик неудач подписки увеличивается,
+// и при достижении disableAfter (если он больше 0) подписка выключается. Возвращает true, если подписка выключена этим вызовом.
+func (r *webhookRepository) RecordDeliveryFailure(ctx context.Context, delivery *models.WebhookDelivery, responseCode int32, lastError string, retryAt *time.Time, disableAfter int32) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+        UPDATE webhook_deliveries
+        SET status = CASE WHEN $4::TIMESTAMP IS NULL THEN 'failed' ELSE 'pending' END,
+            attempts = attempts + 1, response_code = $2, last_error = $3,
+            next_attempt_at = COALESCE($4, next_attempt_at)
+        WHERE id = $1;
+    `, delivery.ID, responseCode, lastError, retryAt)
+	if err != nil {
+		return false, err
+	}
+
+	var disabled bool
+	err = tx.QueryRow(ctx, `
+        UPDATE webhook_subscriptions
+        SET consecutive_failures = consecutive_failures + 1,
+            active = active AND ($2 <= 0 OR consecutive_failures + 1 < $2),
+            disabled_reason = CASE WHEN active AND $2 > 0 AND consecutive_failures + 1 >= $2
+                                   THEN 'Выключена после ' || (consecutive_failures + 1) || ' неудачных доставок подряд: ' || $3
+                                   ELSE disabled_reason END
+        WHERE id = $1
+        RETURNING active = FALSE AND consecutive_failures = $2;
+    `, delivery.SubscriptionID, disableAfter, lastError).Scan(&disabled)
+	if err != nil {
+		return false, err
+	}
+	return disabled, tx.Commit(ctx)
+}
+
+// GetDeliveries возвращает до limit последних доставок подписки, от новых к старым.
+func (r *webhookRepository) GetDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*models.WebhookDelivery, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT `+webhookDeliveryColumns+`
+        FROM webhook_deliveries d
+        WHERE d.subscription_id = $1
+        ORDER BY d.id DESC
+        LIMIT $2;
+    `, subscriptionID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// GetDeliveryByID возвращает доставку или nil, если она не найдена.
+func (r *webhookRepository) GetDeliveryByID(ctx context.Context, id int64) (*models.WebhookDelivery, error) {
+	delivery, err := scanWebhookDelivery(r.db.QueryRow(ctx, `SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries d WHERE d.id = $1;`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return delivery, err
+}
+
+// Redeliver ставит в очередь новую доставку того же события по той же подписке со ссылкой на исходную.
+// Возвращает nil, если исходная доставка не найдена.
+func (r *webhookRepository) Redeliver(ctx context.Context, id int64) (*models.WebhookDelivery, error) {
+	delivery, err := scanWebhookDelivery(r.db.QueryRow(ctx, `
+        WITH source AS (SELECT id, subscription_id, event_id, event_type, payload FROM webhook_deliveries WHERE id = $1)
+        INSERT INTO webhook_deliveries AS d (subscription_id, event_id, event_type, payload, redelivery_of)
+        SELECT subscription_id, event_id, event_type, payload, id FROM source
+        RETURNING `+webhookDeliveryColumns+`;
+    `, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return delivery, err
+}
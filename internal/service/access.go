@@ -0,0 +1,47 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkInstructorAccess пропускает администратора и преподавателя из токена, если он входит в число
+// допущенных к ресурсу. Запросы без пользователя в контексте и с любой другой ролью отклоняются.
+func checkInstructorAccess(ctx context.Context, allowedInstructorIDs ...int64) error {
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Требуется аутентификация")
+	}
+	switch user.Role {
+	case "admin":
+		return nil
+	case "instructor":
+		if slices.Contains(allowedInstructorIDs, user.ID) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "Действие доступно только преподавателю курса")
+}
+
+// checkStudentAccess пропускает студента только к его собственным данным. Администратор и преподаватель
+// эту проверку проходят: доступ преподавателя ограничивается его курсами в вызывающем методе.
+// Запросы без пользователя в контексте отклоняются.
+func checkStudentAccess(ctx context.Context, studentID int64) error {
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Требуется аутентификация")
+	}
+	switch user.Role {
+	case "admin", "instructor":
+		return nil
+	case "student":
+		if user.ID == studentID {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "Доступ к данным другого студента запрещён")
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AnnouncementService struct {
+	proto.UnimplementedAnnouncementServiceServer
+	announcementRepo repository.AnnouncementRepository
+	courseRepo       repository.CourseRepository
+	cohortRepo       repository.CohortRepository
+	enrollmentRepo   repository.EnrollmentRepository
+	logger           *zap.Logger
+}
+
+func NewAnnouncementService(announcementRepo repository.AnnouncementRepository, courseRepo repository.CourseRepository, cohortRepo repository.CohortRepository,
+	enrollmentRepo repository.EnrollmentRepository, logger *zap.Logger) *AnnouncementService {
+	return &AnnouncementService{
+		announcementRepo: announcementRepo,
+		courseRepo:       courseRepo,
+		cohortRepo:       cohortRepo,
+		enrollmentRepo:   enrollmentRepo,
+		logger:           logger,
+	}
+}
+
+func (s *AnnouncementService) CreateAnnouncement(ctx context.Context, req *proto.CreateAnnouncementRequest) (*proto.Announcement, error) {
+	s.logger.Info("Создание объявления", zap.Int64("course_id", req.CourseId), zap.Int64("cohort_id", req.CohortId))
+
+	title, body := strings.TrimSpace(req.Title), strings.TrimSpace(req.Body)
+	if title == "" || len(title) > 255 {
+		s.logger.Warn("Некорректный заголовок объявления", zap.String("title", title))
+		return nil, status.Errorf(codes.InvalidArgument, "Заголовок объявления должен содержать от 1 до 255 символов")
+	}
+	if body == "" {
+		s.logger.Warn("Пустой текст объявления")
+		return nil, status.Errorf(codes.InvalidArgument, "Текст объявления не может быть пустым")
+	}
+
+	publishAt := time.Now()
+	if req.PublishAt != "" {
+		t, err := time.Parse(time.RFC3339, req.PublishAt)
+		if err != nil {
+			s.logger.Warn("Некорректное время публикации", zap.String("publish_at", req.PublishAt))
+			return nil, status.Errorf(codes.InvalidArgument, "Некорректное время публикации объявления: %s", req.PublishAt)
+		}
+		publishAt = t
+	}
+
+	course, err := s.getCourse(ctx, req.CourseId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstructor(ctx, course, req.CohortId); err != nil {
+		return nil, err
+	}
+
+	announcement := &models.Announcement{
+		CourseID:  course.ID,
+		CohortID:  req.CohortId,
+		Title:     title,
+		Body:      body,
+		PublishAt: publishAt,
+	}
+	if user, ok := middleware.UserFromContext(ctx); ok && user.Role == "instructor" {
+		announcement.InstructorID = user.ID
+	}
+
+	if err := s.announcementRepo.CreateAnnouncement(ctx, announcement); err != nil {
+		s.logger.Error("Ошибка при создании объявления", zap.Error(err), zap.Int64("course_id", course.ID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при создании объявления: %v", err)
+	}
+
+	s.logger.Info("Объявление создано", zap.Int64("announcement_id", announcement.ID), zap.Bool("published", announcement.PublishedAt != nil))
+	return announcementToProto(announcement), nil
+}
+
+func (s *AnnouncementService) ListAnnouncements(ctx context.Context, req *proto.ListAnnouncementsRequest) (*proto.AnnouncementList, error) {
+	s.logger.Info("Получение объявлений курса", zap.Int64("course_id", req.CourseId), zap.Int64("cohort_id", req.CohortId))
+
+	course, err := s.getCourse(ctx, req.CourseId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.AnnouncementFilter{CohortID: req.CohortId, IncludeScheduled: req.IncludeScheduled}
+	if user, ok := middleware.UserFromContext(ctx); ok && user.Role == "student" {
+		if req.IncludeScheduled {
+			return nil, status.Errorf(codes.PermissionDenied, "Запланированные объявления доступны только преподавателям")
+		}
+		enrolled, err := s.enrollmentRepo.IsEnrolled(ctx, user.ID, course.ID)
+		if err != nil {
+			s.logger.Error("Ошибка при проверке записи на курс", zap.Error(err), zap.Int64("course_id", course.ID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при проверке записи на курс: %v", err)
+		}
+		if !enrolled {
+			s.logger.Warn("Студент не записан на курс", zap.Int64("student_id", user.ID), zap.Int64("course_id", course.ID))
+			return nil, status.Errorf(codes.PermissionDenied, "Объявления доступны только студентам курса")
+		}
+		filter.StudentID = user.ID
+	} else if err := s.checkInstructor(ctx, course, req.CohortId); err != nil {
+		return nil, err
+	}
+
+	announcements, err := s.announcementRepo.GetAnnouncements(ctx, course.ID, filter)
+	if err != nil {
+		s.logger.Error("Ошибка при получении объявлений", zap.Error(err), zap.Int64("course_id", course.ID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении объявлений: %v", err)
+	}
+
+	resp := &proto.AnnouncementList{}
+	for _, announcement := range announcements {
+		resp.Announcements = append(resp.Announcements, announcementToProto(announcement))
+	}
+
+	s.logger.Info("Объявления курса получены", zap.Int64("course_id", course.ID), zap.Int("count", len(resp.Announcements)))
+	return resp, nil
+}
+
+func (s *AnnouncementService) DeleteAnnouncement(ctx context.Context, req *proto.AnnouncementIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление объявления", zap.Int64("announcement_id", req.AnnouncementId))
+
+	announcement, err := s.announcementRepo.GetAnnouncementByID(ctx, req.AnnouncementId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении объявления", zap.Error(err), zap.Int64("announcement_id", req.AnnouncementId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении объявления: %v", err)
+	}
+	if announcement == nil {
+		s.logger.Warn("Объявление не найдено", zap.Int64("announcement_id", req.AnnouncementId))
+		return nil, status.Errorf(codes.NotFound, "Объявление с ID %d не найдено", req.AnnouncementId)
+	}
+
+	course, err := s.getCourse(ctx, announcement.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstructor(ctx, course, announcement.CohortID); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.announcementRepo.DeleteAnnouncement(ctx, announcement.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении объявления", zap.Error(err), zap.Int64("announcement_id", announcement.ID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении объявления: %v", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "Объявление с ID %d не найдено", announcement.ID)
+	}
+
+	s.logger.Info("Объявление удалено", zap.Int64("announcement_id", announcement.ID))
+	return &proto.Empty{}, nil
+}
+
+func (s *AnnouncementService) getCourse(ctx context.Context, courseID int64) (*models.Course, error) {
+	if courseID == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", courseID))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	course, err := s.courseRepo.GetCourseByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", courseID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", courseID))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", courseID)
+	}
+	return course, nil
+}
+
+// checkInstructor проверяет, что поток cohortID относится к курсу, и пропускает владельца курса,
+// а для объявлений потока — ещё и его наставников.
+func (s *AnnouncementService) checkInstructor(ctx context.Context, course *models.Course, cohortID int64) error {
+	instructorIDs := []int64{course.InstructorID}
+	if cohortID != 0 {
+		cohort, err := s.cohortRepo.GetCohortByID(ctx, cohortID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении потока", zap.Error(err), zap.Int64("cohort_id", cohortID))
+			return status.Errorf(codes.Internal, "Ошибка при получении потока: %v", err)
+		}
+		if cohort == nil || cohort.CourseID != course.ID {
+			s.logger.Warn("Поток не относится к курсу", zap.Int64("cohort_id", cohortID), zap.Int64("course_id", course.ID))
+			return status.Errorf(codes.InvalidArgument, "Поток с ID %d не относится к курсу %d", cohortID, course.ID)
+		}
+		instructorIDs = append(instructorIDs, cohort.InstructorIDs...)
+	}
+
+	if err := checkInstructorAccess(ctx, instructorIDs...); err != nil {
+		s.logger.Warn("Нет доступа к объявлениям курса", zap.Int64("course_id", course.ID), zap.Int64("cohort_id", cohortID))
+		return err
+	}
+	return nil
+}
+
+func announcementToProto(a *models.Announcement) *proto.Announcement {
+	return &proto.Announcement{
+		Id:           a.ID,
+		CourseId:     a.CourseID,
+		CohortId:     a.CohortID,
+		InstructorId: a.InstructorID,
+		Title:        a.Title,
+		Body:         a.Body,
+		PublishAt:    a.PublishAt.Format(time.RFC3339),
+		PublishedAt:  formatOptionalTime(a.PublishedAt),
+		CreatedAt:    a.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"GoEdu/internal/events"
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/models"
+	"GoEdu/internal/notification"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func prepareAnnouncementTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"announcements", "outbox_events", "notifications", "cohort_instructors", "cohorts"},
+		Instructors: 3,
+		Students:    3,
+		Courses:     []int64{1, 2},
+	})
+
+	_, err := db.Exec(ctx, `
+		INSERT INTO cohorts (id, course_id, name, start_date, end_date)
+		VALUES (1, 1, 'Весенний поток', '2026-03-01', '2026-06-01'),
+		       (2, 2, 'Поток курса 2', '2026-03-01', '2026-06-01')
+	`)
+	require.NoError(t, err, "Не удалось добавить потоки")
+	_, err = db.Exec(ctx, "INSERT INTO cohort_instructors (cohort_id, instructor_id) VALUES (1, 3)")
+	require.NoError(t, err, "Не удалось добавить наставника потока")
+
+	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id, cohort_id) VALUES (1, 1, 1), (2, 1, NULL)")
+	require.NoError(t, err, "Не удалось записать студентов на курс")
+}
+
+func TestAnnouncements(t *testing.T) {
+	ctx := context.Background()
+	prepareAnnouncementTables(t, ctx)
+
+	notificationRepo := repository.NewNotificationRepository(db)
+	bus := events.NewBus()
+	bus.Subscribe(notification.NewSink(notificationRepo, repository.NewCourseRepository(db), repository.NewAssignmentRepository(db)))
+	relay := jobs.NewOutboxRelay(repository.NewOutboxRepository(db), bus, 100, time.Hour, time.Minute, zapLogger)
+	publisher := jobs.NewAnnouncementPublisher(repository.NewAnnouncementRepository(db), time.Minute, zapLogger)
+
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+	countAnnouncements := func(studentID int64) int {
+		var count int
+		err := db.QueryRow(ctx, "SELECT COUNT(*) FROM notifications WHERE student_id = $1 AND type = $2", studentID, models.NotificationAnnouncement).Scan(&count)
+		require.NoError(t, err, "Ошибка проверки данных в базе")
+		return count
+	}
+
+	t.Run("Объявления курса и потока доставляются студентам", func(t *testing.T) {
+		announcement, err := clientAnnouncement.CreateAnnouncement(instructorCtx, &proto.CreateAnnouncementRequest{CourseId: 1, Title: "Начало курса", Body: "Первая лекция в понедельник"})
+		require.NoError(t, err, "Ошибка создания объявления")
+		assert.Equal(t, int64(1), announcement.InstructorId, "Автор объявления не сохранён")
+		assert.NotEmpty(t, announcement.PublishedAt, "Объявление без времени публикации должно публиковаться сразу")
+
+		_, err = clientAnnouncement.CreateAnnouncement(withToken(t, ctx, 3, "instructor"), &proto.CreateAnnouncementRequest{CourseId: 1, CohortId: 1, Title: "Встреча потока", Body: "Созвон в среду"})
+		require.NoError(t, err, "Наставник должен публиковать объявления своего потока")
+
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+
+		assert.Equal(t, 2, countAnnouncements(1), "Студент потока должен получить оба объявления")
+		assert.Equal(t, 1, countAnnouncements(2), "Студент вне потока должен получить только объявление курса")
+		assert.Zero(t, countAnnouncements(3), "Студент не записан на курс")
+
+		list, err := clientNotification.ListNotifications(withToken(t, ctx, 2, "student"), &proto.ListNotificationsRequest{StudentId: 2})
+		require.NoError(t, err, "Ошибка получения уведомлений")
+		require.Len(t, list.Notifications, 1, "Некорректное число уведомлений")
+		assert.Equal(t, "Объявление в курсе «Курс 1»: Начало курса", list.Notifications[0].Title, "Некорректный заголовок уведомления")
+		assert.Equal(t, "Первая лекция в понедельник", list.Notifications[0].Body, "Некорректный текст уведомления")
+	})
+
+	t.Run("Студент видит объявления курса и своего потока", func(t *testing.T) {
+		list, err := clientAnnouncement.ListAnnouncements(withToken(t, ctx, 1, "student"), &proto.ListAnnouncementsRequest{CourseId: 1})
+		require.NoError(t, err, "Ошибка получения объявлений")
+		assert.Len(t, list.Announcements, 2, "Студент потока должен видеть объявление потока")
+
+		list, err = clientAnnouncement.ListAnnouncements(withToken(t, ctx, 2, "student"), &proto.ListAnnouncementsRequest{CourseId: 1})
+		require.NoError(t, err, "Ошибка получения объявлений")
+		require.Len(t, list.Announcements, 1, "Объявление потока не должно быть видно вне потока")
+		assert.Zero(t, list.Announcements[0].CohortId, "Некорректное объявление")
+	})
+
+	t.Run("Запланированное объявление публикуется в срок", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour)
+		scheduled, err := clientAnnouncement.CreateAnnouncement(instructorCtx, &proto.CreateAnnouncementRequest{CourseId: 1, Title: "Экзамен", Body: "Экзамен через неделю", PublishAt: publishAt.Format(time.RFC3339)})
+		require.NoError(t, err, "Ошибка создания запланированного объявления")
+		assert.Empty(t, scheduled.PublishedAt, "Объявление не должно публиковаться досрочно")
+
+		list, err := clientAnnouncement.ListAnnouncements(withToken(t, ctx, 2, "student"), &proto.ListAnnouncementsRequest{CourseId: 1})
+		require.NoError(t, err, "Ошибка получения объявлений")
+		assert.Len(t, list.Announcements, 1, "Студент не должен видеть запланированное объявление")
+
+		list, err = clientAnnouncement.ListAnnouncements(instructorCtx, &proto.ListAnnouncementsRequest{CourseId: 1, IncludeScheduled: true})
+		require.NoError(t, err, "Ошибка получения объявлений")
+		require.Len(t, list.Announcements, 3, "Преподаватель должен видеть запланированные объявления")
+		assert.Equal(t, scheduled.Id, list.Announcements[0].Id, "Объявления должны идти от новых к старым")
+
+		published, err := publisher.PublishDue(ctx, time.Now())
+		require.NoError(t, err, "Ошибка публикации объявлений")
+		assert.Zero(t, published, "Время публикации ещё не наступило")
+
+		published, err = publisher.PublishDue(ctx, publishAt.Add(time.Minute))
+		require.NoError(t, err, "Ошибка публикации объявлений")
+		assert.Equal(t, 1, published, "Запланированное объявление должно быть опубликовано")
+		published, err = publisher.PublishDue(ctx, publishAt.Add(time.Minute))
+		require.NoError(t, err, "Ошибка повторной публикации объявлений")
+		assert.Zero(t, published, "Объявление не должно публиковаться повторно")
+
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+		assert.Equal(t, 2, countAnnouncements(2), "Студент должен получить запланированное объявление")
+	})
+
+	t.Run("Удалённое запланированное объявление не публикуется", func(t *testing.T) {
+		scheduled, err := clientAnnouncement.CreateAnnouncement(instructorCtx, &proto.CreateAnnouncementRequest{CourseId: 1, Title: "Перенос", Body: "Лекция переносится", PublishAt: time.Now().Add(time.Hour).Format(time.RFC3339)})
+		require.NoError(t, err, "Ошибка создания запланированного объявления")
+
+		_, err = clientAnnouncement.DeleteAnnouncement(withToken(t, ctx, 2, "instructor"), &proto.AnnouncementIDRequest{AnnouncementId: scheduled.Id})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "Чужой преподаватель не может удалить объявление")
+
+		_, err = clientAnnouncement.DeleteAnnouncement(instructorCtx, &proto.AnnouncementIDRequest{AnnouncementId: scheduled.Id})
+		require.NoError(t, err, "Ошибка удаления объявления")
+
+		published, err := publisher.PublishDue(ctx, time.Now().Add(2*time.Hour))
+		require.NoError(t, err, "Ошибка публикации объявлений")
+		assert.Zero(t, published, "Удалённое объявление не должно публиковаться")
+	})
+
+	testCases := []struct {
+		Name         string
+		Call         func() error
+		ExpectedCode codes.Code
+	}{
+		{
+			Name: "Объявление в чужом курсе",
+			Call: func() error {
+				_, err := clientAnnouncement.CreateAnnouncement(withToken(t, ctx, 2, "instructor"), &proto.CreateAnnouncementRequest{CourseId: 1, Title: "Заголовок", Body: "Текст"})
+				return err
+			},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Наставник потока пишет всему курсу",
+			Call: func() error {
+				_, err := clientAnnouncement.CreateAnnouncement(withToken(t, ctx, 3, "instructor"), &proto.CreateAnnouncementRequest{CourseId: 1, Title: "Заголовок", Body: "Текст"})
+				return err
+			},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Поток другого курса",
+			Call: func() error {
+				_, err := clientAnnouncement.CreateAnnouncement(instructorCtx, &proto.CreateAnnouncementRequest{CourseId: 1, CohortId: 2, Title: "Заголовок", Body: "Текст"})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Пустой заголовок",
+			Call: func() error {
+				_, err := clientAnnouncement.CreateAnnouncement(instructorCtx, &proto.CreateAnnouncementRequest{CourseId: 1, Title: "  ", Body: "Текст"})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Некорректное время публикации",
+			Call: func() error {
+				_, err := clientAnnouncement.CreateAnnouncement(instructorCtx, &proto.CreateAnnouncementRequest{CourseId: 1, Title: "Заголовок", Body: "Текст", PublishAt: "завтра"})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Создание объявления студентом",
+			Call: func() error {
+				_, err := clientAnnouncement.CreateAnnouncement(withToken(t, ctx, 1, "student"), &proto.CreateAnnouncementRequest{CourseId: 1, Title: "Заголовок", Body: "Текст"})
+				return err
+			},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Объявления курса без записи",
+			Call: func() error {
+				_, err := clientAnnouncement.ListAnnouncements(withToken(t, ctx, 3, "student"), &proto.ListAnnouncementsRequest{CourseId: 1})
+				return err
+			},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Запланированные объявления студенту",
+			Call: func() error {
+				_, err := clientAnnouncement.ListAnnouncements(withToken(t, ctx, 1, "student"), &proto.ListAnnouncementsRequest{CourseId: 1, IncludeScheduled: true})
+				return err
+			},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Объявления несуществующего курса",
+			Call: func() error {
+				_, err := clientAnnouncement.ListAnnouncements(instructorCtx, &proto.ListAnnouncementsRequest{CourseId: 99})
+				return err
+			},
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name: "Удаление несуществующего объявления",
+			Call: func() error {
+				_, err := clientAnnouncement.DeleteAnnouncement(instructorCtx, &proto.AnnouncementIDRequest{AnnouncementId: 99})
+				return err
+			},
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Call()
+			require.Error(t, err, "Ожидалась ошибка, но её не было")
+			st, ok := status.FromError(err)
+			require.True(t, ok, "Ошибка не является статусной")
+			assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			t.Logf("Полученный код ошибки: %v", st.Code())
+		})
+	}
+}
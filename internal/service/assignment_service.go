@@ -0,0 +1,783 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/internal/storage"
+	"GoEdu/proto"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxSubmissionFiles = 5
+	maxSubmissionFiles        = 10
+	maxSubmissionFileSize     = 10 << 20
+	maxPeerReviewCount        = 10
+)
+
+var latePolicies = map[proto.LatePolicy]string{
+	proto.LatePolicy_LATE_POLICY_UNSPECIFIED: models.LatePolicyAccept,
+	proto.LatePolicy_LATE_POLICY_ACCEPT:      models.LatePolicyAccept,
+	proto.LatePolicy_LATE_POLICY_PENALTY:     models.LatePolicyPenalty,
+	proto.LatePolicy_LATE_POLICY_REJECT:      models.LatePolicyReject,
+}
+
+type AssignmentService struct {
+	proto.UnimplementedAssignmentServiceServer
+	assignmentRepo repository.AssignmentRepository
+	courseRepo     repository.CourseRepository
+	enrollmentRepo repository.EnrollmentRepository
+	peerReviewRepo repository.PeerReviewRepository
+	blobs          storage.BlobStore
+	logger         *zap.Logger
+}
+
+func NewAssignmentService(assignmentRepo repository.AssignmentRepository, courseRepo repository.CourseRepository, enrollmentRepo repository.EnrollmentRepository, peerReviewRepo repository.PeerReviewRepository, blobs storage.BlobStore, logger *zap.Logger) *AssignmentService {
+	return &AssignmentService{
+		assignmentRepo: assignmentRepo,
+		courseRepo:     courseRepo,
+		enrollmentRepo: enrollmentRepo,
+		peerReviewRepo: peerReviewRepo,
+		blobs:          blobs,
+		logger:         logger,
+	}
+}
+
+func (s *AssignmentService) CreateAssignment(ctx context.Context, req *proto.CreateAssignmentRequest) (*proto.Assignment, error) {
+	s.logger.Info("Создание задания", zap.Int64("course_id", req.CourseId), zap.String("title", req.Title))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	assignment, err := assignmentFromRequest(req.Title, req.Description, req.DueAt, req.LatePolicy, req.LatePenaltyPercent, req.MaxScore, req.MaxSubmissions, req.MaxFiles, req.Rubric, req.PeerReviewCount, req.PeerReviewDueAt)
+	if err != nil {
+		s.logger.Warn("Некорректные данные задания", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+	assignment.CourseID = req.CourseId
+
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	id, err := s.assignmentRepo.CreateAssignment(ctx, assignment)
+	if err != nil {
+		s.logger.Error("Ошибка при создании задания", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при создании задания: %v", err)
+	}
+	assignment.ID = id
+
+	s.logger.Info("Задание успешно создано", zap.Int64("assignment_id", id), zap.Int64("course_id", req.CourseId))
+	return assignmentToProto(assignment), nil
+}
+
+func (s *AssignmentService) UpdateAssignment(ctx context.Context, req *proto.UpdateAssignmentRequest) (*proto.Assignment, error) {
+	s.logger.Info("Обновление задания", zap.Int64("assignment_id", req.Id))
+
+	if req.Id == 0 {
+		s.logger.Warn("Некорректный ID задания", zap.Int64("assignment_id", req.Id))
+		return nil, status.Errorf(codes.InvalidArgument, "ID задания должен быть указан")
+	}
+
+	assignment, err := assignmentFromRequest(req.Title, req.Description, req.DueAt, req.LatePolicy, req.LatePenaltyPercent, req.MaxScore, req.MaxSubmissions, req.MaxFiles, req.Rubric, req.PeerReviewCount, req.PeerReviewDueAt)
+	if err != nil {
+		s.logger.Warn("Некорректные данные задания", zap.Error(err), zap.Int64("assignment_id", req.Id))
+		return nil, err
+	}
+
+	existing, err := s.getAssignment(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCourseInstructor(ctx, existing.CourseID); err != nil {
+		return nil, err
+	}
+	assignment.ID = existing.ID
+	assignment.CourseID = existing.CourseID
+	assignment.PeerReviewsAllocatedAt = existing.PeerReviewsAllocatedAt
+	if existing.PeerReviewsAllocatedAt != nil && assignment.PeerReviewCount != existing.PeerReviewCount {
+		s.logger.Warn("Изменение числа рецензентов после распределения решений", zap.Int64("assignment_id", req.Id))
+		return nil, status.Errorf(codes.FailedPrecondition, "Число рецензентов нельзя изменить: решения уже распределены на взаимную проверку")
+	}
+
+	replaceRubric := !sameRubric(existing.Rubric, assignment.Rubric)
+	if replaceRubric {
+		graded, err := s.assignmentRepo.HasGradedSubmissions(ctx, existing.ID)
+		if err != nil {
+			s.logger.Error("Ошибка при проверке оценённых решений", zap.Error(err), zap.Int64("assignment_id", req.Id))
+			return nil, status.Errorf(codes.Internal, "Ошибка при обновлении задания: %v", err)
+		}
+		if graded {
+			s.logger.Warn("Изменение рубрики после начала проверки", zap.Int64("assignment_id", req.Id))
+			return nil, status.Errorf(codes.FailedPrecondition, "Рубрику нельзя изменить: по заданию уже есть оценённые решения")
+		}
+	} else {
+		assignment.Rubric = existing.Rubric
+	}
+
+	if err := s.assignmentRepo.UpdateAssignment(ctx, assignment, replaceRubric); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "Задание с ID %d не найдено", req.Id)
+		}
+		s.logger.Error("Ошибка при обновлении задания", zap.Error(err), zap.Int64("assignment_id", req.Id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении задания: %v", err)
+	}
+
+	s.logger.Info("Задание успешно обновлено", zap.Int64("assignment_id", req.Id))
+	return assignmentToProto(assignment), nil
+}
+
+func (s *AssignmentService) DeleteAssignment(ctx context.Context, req *proto.AssignmentIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление задания", zap.Int64("assignment_id", req.AssignmentId))
+
+	assignment, err := s.getAssignment(ctx, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCourseInstructor(ctx, assignment.CourseID); err != nil {
+		return nil, err
+	}
+
+	keys, deleted, err := s.assignmentRepo.DeleteAssignment(ctx, req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении задания", zap.Error(err), zap.Int64("assignment_id", req.AssignmentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении задания: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Задание не найдено", zap.Int64("assignment_id", req.AssignmentId))
+		return nil, status.Errorf(codes.NotFound, "Задание с ID %d не найдено", req.AssignmentId)
+	}
+	s.deleteBlobs(ctx, keys)
+
+	s.logger.Info("Задание успешно удалено", zap.Int64("assignment_id", req.AssignmentId), zap.Int("files", len(keys)))
+	return &proto.Empty{}, nil
+}
+
+func (s *AssignmentService) GetAssignment(ctx context.Context, req *proto.AssignmentIDRequest) (*proto.Assignment, error) {
+	s.logger.Info("Получение задания", zap.Int64("assignment_id", req.AssignmentId))
+
+	assignment, err := s.getAssignment(ctx, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Задание успешно получено", zap.Int64("assignment_id", req.AssignmentId))
+	return assignmentToProto(assignment), nil
+}
+
+func (s *AssignmentService) ListAssignments(ctx context.Context, req *proto.CourseIDRequest) (*proto.AssignmentList, error) {
+	s.logger.Info("Получение заданий курса", zap.Int64("course_id", req.CourseId))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	assignments, err := s.assignmentRepo.GetAssignmentsByCourse(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении заданий", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении заданий: %v", err)
+	}
+
+	resp := &proto.AssignmentList{}
+	for _, assignment := range assignments {
+		resp.Assignments = append(resp.Assignments, assignmentToProto(assignment))
+	}
+
+	s.logger.Info("Задания успешно получены", zap.Int("count", len(assignments)), zap.Int64("course_id", req.CourseId))
+	return resp, nil
+}
+
+// SubmitAssignment сохраняет файлы решения в хранилище и создаёт новую версию решения.
+// Если запись в базу не удалась, сохранённые файлы удаляются.
+func (s *AssignmentService) SubmitAssignment(ctx context.Context, req *proto.SubmitAssignmentRequest) (*proto.Submission, error) {
+	s.logger.Info("Отправка решения задания", zap.Int64("assignment_id", req.AssignmentId), zap.Int64("student_id", req.StudentId), zap.Int("files", len(req.Files)))
+
+	if req.AssignmentId == 0 || req.StudentId == 0 {
+		s.logger.Warn("Некорректные данные для отправки решения", zap.Int64("assignment_id", req.AssignmentId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID задания и ID студента должны быть указаны")
+	}
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка отправить решение от имени другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+	if strings.TrimSpace(req.Text) == "" && len(req.Files) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Решение должно содержать текст или файлы")
+	}
+
+	assignment, err := s.getAssignment(ctx, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Files) > int(assignment.MaxFiles) {
+		return nil, status.Errorf(codes.InvalidArgument, "К решению можно приложить не более %d файлов", assignment.MaxFiles)
+	}
+	for _, file := range req.Files {
+		if len(file.Content) > maxSubmissionFileSize {
+			return nil, status.Errorf(codes.InvalidArgument, "Файл %s больше %d МБ", file.Filename, maxSubmissionFileSize>>20)
+		}
+	}
+
+	enrollment, err := s.enrollmentRepo.GetEnrollment(ctx, req.StudentId, assignment.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке записи на курс", zap.Error(err), zap.Int64("student_id", req.StudentId), zap.Int64("course_id", assignment.CourseID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при проверке записи на курс: %v", err)
+	}
+	if enrollment == nil {
+		s.logger.Warn("Студент не записан на курс", zap.Int64("student_id", req.StudentId), zap.Int64("course_id", assignment.CourseID))
+		return nil, status.Errorf(codes.FailedPrecondition, "Студент с ID %d не записан на курс с ID %d", req.StudentId, assignment.CourseID)
+	}
+
+	submission := &models.Submission{
+		StudentID:   req.StudentId,
+		Text:        req.Text,
+		SubmittedAt: time.Now(),
+	}
+	submission.Late, submission.PenaltyPercent = assignment.LatePenalty(submission.SubmittedAt)
+	if submission.Late && assignment.LatePolicy == models.LatePolicyReject {
+		s.logger.Warn("Срок сдачи задания истёк", zap.Int64("assignment_id", assignment.ID), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.FailedPrecondition, "Срок сдачи задания истёк %s", assignment.DueAt.Format(time.RFC3339))
+	}
+
+	if err := s.storeFiles(ctx, assignment.ID, req.StudentId, req.Files, submission); err != nil {
+		return nil, err
+	}
+
+	if err := s.assignmentRepo.CreateSubmission(ctx, assignment, submission); err != nil {
+		s.deleteBlobs(ctx, submissionKeys(submission))
+		switch {
+		case errors.Is(err, repository.ErrSubmissionLimitReached):
+			s.logger.Warn("Отправки решения исчерпаны", zap.Int64("assignment_id", assignment.ID), zap.Int64("student_id", req.StudentId))
+			return nil, status.Errorf(codes.FailedPrecondition, "Использованы все отправки решения (%d)", *assignment.MaxSubmissions)
+		case errors.Is(err, repository.ErrSubmissionConflict):
+			s.logger.Warn("Решение отправляется параллельным запросом", zap.Int64("assignment_id", assignment.ID), zap.Int64("student_id", req.StudentId))
+			return nil, status.Errorf(codes.Aborted, "Решение уже отправляется, повторите запрос")
+		}
+		s.logger.Error("Ошибка при сохранении решения", zap.Error(err), zap.Int64("assignment_id", assignment.ID), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении решения: %v", err)
+	}
+
+	s.logger.Info("Решение отправлено", zap.Int64("submission_id", submission.ID), zap.Int32("version", submission.Version), zap.Bool("late", submission.Late))
+	return submissionToProto(submission), nil
+}
+
+func (s *AssignmentService) GetSubmission(ctx context.Context, req *proto.SubmissionIDRequest) (*proto.Submission, error) {
+	s.logger.Info("Получение решения", zap.Int64("submission_id", req.SubmissionId))
+
+	submission, err := s.getSubmission(ctx, req.SubmissionId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Решение успешно получено", zap.Int64("submission_id", req.SubmissionId))
+	return submissionToProto(submission), nil
+}
+
+func (s *AssignmentService) ListSubmissions(ctx context.Context, req *proto.ListSubmissionsRequest) (*proto.SubmissionList, error) {
+	s.logger.Info("Получение решений задания", zap.Int64("assignment_id", req.AssignmentId), zap.Bool("ungraded_only", req.UngradedOnly), zap.Bool("late_only", req.LateOnly))
+
+	assignment, err := s.getAssignment(ctx, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCourseInstructor(ctx, assignment.CourseID); err != nil {
+		return nil, err
+	}
+
+	submissions, err := s.assignmentRepo.GetSubmissions(ctx, assignment.ID, repository.SubmissionFilter{
+		StudentID:    req.StudentId,
+		UngradedOnly: req.UngradedOnly,
+		LateOnly:     req.LateOnly,
+		AllVersions:  req.AllVersions,
+	})
+	if err != nil {
+		s.logger.Error("Ошибка при получении решений", zap.Error(err), zap.Int64("assignment_id", req.AssignmentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении решений: %v", err)
+	}
+
+	resp := &proto.SubmissionList{}
+	for _, submission := range submissions {
+		resp.Submissions = append(resp.Submissions, submissionToProto(submission))
+	}
+
+	s.logger.Info("Решения успешно получены", zap.Int("count", len(submissions)), zap.Int64("assignment_id", req.AssignmentId))
+	return resp, nil
+}
+
+// GradeSubmission выставляет оценку. Если у задания есть рубрика, оценка складывается из баллов
+// по всем её критериям; штраф за просрочку применяется к итоговой оценке.
+func (s *AssignmentService) GradeSubmission(ctx context.Context, req *proto.GradeSubmissionRequest) (*proto.Submission, error) {
+	s.logger.Info("Оценка решения", zap.Int64("submission_id", req.SubmissionId), zap.Int32("score", req.Score))
+
+	if req.SubmissionId == 0 {
+		s.logger.Warn("Некорректный ID решения", zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID решения должен быть указан")
+	}
+
+	submission, err := s.assignmentRepo.GetSubmissionByID(ctx, req.SubmissionId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении решения", zap.Error(err), zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении решения: %v", err)
+	}
+	if submission == nil {
+		s.logger.Warn("Решение не найдено", zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.NotFound, "Решение с ID %d не найдено", req.SubmissionId)
+	}
+
+	assignment, err := s.getAssignment(ctx, submission.AssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCourseInstructor(ctx, assignment.CourseID); err != nil {
+		return nil, err
+	}
+
+	rawScore, rubricScores, err := gradeFromRequest(assignment, req)
+	if err != nil {
+		s.logger.Warn("Некорректная оценка", zap.Error(err), zap.Int64("submission_id", req.SubmissionId))
+		return nil, err
+	}
+	score := submission.ApplyPenalty(rawScore)
+	submission.RawScore = &rawScore
+	submission.Score = &score
+	submission.Feedback = req.Feedback
+	submission.RubricScores = rubricScores
+	submission.PeerGraded = false
+	submission.GradedBy = nil
+	if user, ok := middleware.UserFromContext(ctx); ok && user.Role == "instructor" {
+		submission.GradedBy = &user.ID
+	}
+
+	if err := s.assignmentRepo.GradeSubmission(ctx, submission); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "Решение с ID %d не найдено", req.SubmissionId)
+		}
+		s.logger.Error("Ошибка при сохранении оценки", zap.Error(err), zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении оценки: %v", err)
+	}
+
+	s.logger.Info("Решение оценено", zap.Int64("submission_id", submission.ID), zap.Int32("raw_score", rawScore), zap.Int32("score", score))
+	return submissionToProto(submission), nil
+}
+
+func (s *AssignmentService) GetSubmissionFile(ctx context.Context, req *proto.SubmissionFileRequest) (*proto.SubmissionFileContent, error) {
+	s.logger.Info("Получение файла решения", zap.Int64("file_id", req.FileId))
+
+	if req.FileId == 0 {
+		s.logger.Warn("Некорректный ID файла", zap.Int64("file_id", req.FileId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID файла должен быть указан")
+	}
+
+	file, err := s.assignmentRepo.GetSubmissionFile(ctx, req.FileId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении файла", zap.Error(err), zap.Int64("file_id", req.FileId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении файла: %v", err)
+	}
+	if file == nil {
+		s.logger.Warn("Файл не найден", zap.Int64("file_id", req.FileId))
+		return nil, status.Errorf(codes.NotFound, "Файл с ID %d не найден", req.FileId)
+	}
+	if _, err := s.getSubmission(ctx, file.SubmissionID, true); err != nil {
+		return nil, err
+	}
+
+	reader, err := s.blobs.Get(ctx, file.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			s.logger.Error("Файл отсутствует в хранилище", zap.Int64("file_id", req.FileId), zap.String("key", file.StorageKey))
+			return nil, status.Errorf(codes.NotFound, "Содержимое файла с ID %d не найдено", req.FileId)
+		}
+		s.logger.Error("Ошибка чтения файла из хранилища", zap.Error(err), zap.Int64("file_id", req.FileId))
+		return nil, status.Errorf(codes.Internal, "Ошибка чтения файла: %v", err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		s.logger.Error("Ошибка чтения файла из хранилища", zap.Error(err), zap.Int64("file_id", req.FileId))
+		return nil, status.Errorf(codes.Internal, "Ошибка чтения файла: %v", err)
+	}
+
+	s.logger.Info("Файл решения получен", zap.Int64("file_id", req.FileId), zap.Int64("size", file.Size))
+	return &proto.SubmissionFileContent{Filename: file.Filename, ContentType: file.ContentType, Content: content}, nil
+}
+
+func (s *AssignmentService) getAssignment(ctx context.Context, assignmentID int64) (*models.Assignment, error) {
+	if assignmentID == 0 {
+		s.logger.Warn("Некорректный ID задания", zap.Int64("assignment_id", assignmentID))
+		return nil, status.Errorf(codes.InvalidArgument, "ID задания должен быть указан")
+	}
+
+	assignment, err := s.assignmentRepo.GetAssignmentByID(ctx, assignmentID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении задания", zap.Error(err), zap.Int64("assignment_id", assignmentID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении задания: %v", err)
+	}
+	if assignment == nil {
+		s.logger.Warn("Задание не найдено", zap.Int64("assignment_id", assignmentID))
+		return nil, status.Errorf(codes.NotFound, "Задание с ID %d не найдено", assignmentID)
+	}
+	return assignment, nil
+}
+
+// getSubmission возвращает решение, если оно принадлежит студенту из токена или относится к курсу преподавателя из токена.
+// С allowReviewer = true доступ получает и студент, которому решение назначено на взаимную проверку.
+func (s *AssignmentService) getSubmission(ctx context.Context, submissionID int64, allowReviewer bool) (*models.Submission, error) {
+	if submissionID == 0 {
+		s.logger.Warn("Некорректный ID решения", zap.Int64("submission_id", submissionID))
+		return nil, status.Errorf(codes.InvalidArgument, "ID решения должен быть указан")
+	}
+
+	submission, err := s.assignmentRepo.GetSubmissionByID(ctx, submissionID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении решения", zap.Error(err), zap.Int64("submission_id", submissionID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении решения: %v", err)
+	}
+	if submission == nil {
+		s.logger.Warn("Решение не найдено", zap.Int64("submission_id", submissionID))
+		return nil, status.Errorf(codes.NotFound, "Решение с ID %d не найдено", submissionID)
+	}
+
+	if err := checkStudentAccess(ctx, submission.StudentID); err != nil {
+		if !allowReviewer || !s.isPeerReviewer(ctx, submissionID) {
+			s.logger.Warn("Попытка получить решение другого студента", zap.Int64("submission_id", submissionID))
+			return nil, err
+		}
+	}
+	if user, ok := middleware.UserFromContext(ctx); ok && user.Role == "instructor" {
+		assignment, err := s.getAssignment(ctx, submission.AssignmentID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkCourseInstructor(ctx, assignment.CourseID); err != nil {
+			return nil, err
+		}
+	}
+	return submission, nil
+}
+
+func (s *AssignmentService) isPeerReviewer(ctx context.Context, submissionID int64) bool {
+	user, _ := middleware.UserFromContext(ctx)
+	reviewer, err := s.peerReviewRepo.IsPeerReviewer(ctx, submissionID, user.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке рецензента", zap.Error(err), zap.Int64("submission_id", submissionID))
+		return false
+	}
+	return reviewer
+}
+
+func (s *AssignmentService) checkCourseInstructor(ctx context.Context, courseID int64) error {
+	course, err := s.courseRepo.GetCourseByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", courseID))
+		return status.Errorf(codes.NotFound, "Курс с ID %d не найден", courseID)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Нет доступа к заданиям курса", zap.Int64("course_id", courseID))
+		return err
+	}
+	return nil
+}
+
+// storeFiles сохраняет файлы решения в хранилище под случайными ключами и добавляет их описание к решению.
+func (s *AssignmentService) storeFiles(ctx context.Context, assignmentID, studentID int64, files []*proto.UploadedFile, submission *models.Submission) error {
+	for _, file := range files {
+		filename := filepath.Base(strings.ReplaceAll(strings.TrimSpace(file.Filename), "\\", "/"))
+		if filename == "" || filename == "." || filename == "/" {
+			s.deleteBlobs(ctx, submissionKeys(submission))
+			return status.Errorf(codes.InvalidArgument, "Имя файла должно быть указано")
+		}
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = http.DetectContentType(file.Content)
+		}
+
+		suffix := make([]byte, 16)
+		if _, err := rand.Read(suffix); err != nil {
+			s.deleteBlobs(ctx, submissionKeys(submission))
+			return status.Errorf(codes.Internal, "Ошибка при сохранении файла: %v", err)
+		}
+		key := fmt.Sprintf("assignments/%d/%d/%s", assignmentID, studentID, hex.EncodeToString(suffix))
+
+		size, err := s.blobs.Put(ctx, key, bytes.NewReader(file.Content))
+		if err != nil {
+			s.logger.Error("Ошибка при сохранении файла в хранилище", zap.Error(err), zap.String("filename", filename))
+			s.deleteBlobs(ctx, submissionKeys(submission))
+			return status.Errorf(codes.Internal, "Ошибка при сохранении файла %s: %v", filename, err)
+		}
+		submission.Files = append(submission.Files, &models.SubmissionFile{
+			Filename:    filename,
+			ContentType: contentType,
+			Size:        size,
+			StorageKey:  key,
+		})
+	}
+	return nil
+}
+
+// deleteBlobs удаляет файлы из хранилища; ошибки только логируются, чтобы не мешать основному действию.
+func (s *AssignmentService) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			s.logger.Warn("Не удалось удалить файл из хранилища", zap.Error(err), zap.String("key", key))
+		}
+	}
+}
+
+func submissionKeys(submission *models.Submission) []string {
+	keys := make([]string, 0, len(submission.Files))
+	for _, file := range submission.Files {
+		keys = append(keys, file.StorageKey)
+	}
+	return keys
+}
+
+func assignmentFromRequest(title, description, dueAt string, latePolicy proto.LatePolicy, latePenaltyPercent, maxScore, maxSubmissions, maxFiles int32, rubric []*proto.RubricCriterion, peerReviewCount int32, peerReviewDueAt string) (*models.Assignment, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Название задания должно быть заполнено")
+	}
+	policy, ok := latePolicies[latePolicy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Неизвестная политика приёма просроченных решений")
+	}
+	if latePenaltyPercent < 0 || latePenaltyPercent > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "Штраф за просрочку должен быть от 0 до 100%%")
+	}
+	if policy == models.LatePolicyPenalty && latePenaltyPercent == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Для политики со штрафом нужно указать размер штрафа")
+	}
+	if maxSubmissions < 0 || maxFiles < 0 || maxFiles > maxSubmissionFiles {
+		return nil, status.Errorf(codes.InvalidArgument, "Число отправок не может быть отрицательным, а число файлов должно быть от 0 до %d", maxSubmissionFiles)
+	}
+
+	assignment := &models.Assignment{
+		Title:              title,
+		Description:        description,
+		LatePolicy:         policy,
+		LatePenaltyPercent: latePenaltyPercent,
+		MaxScore:           maxScore,
+		MaxFiles:           maxFiles,
+	}
+	if policy != models.LatePolicyPenalty {
+		assignment.LatePenaltyPercent = 0
+	}
+	if maxSubmissions > 0 {
+		assignment.MaxSubmissions = &maxSubmissions
+	}
+	if assignment.MaxFiles == 0 {
+		assignment.MaxFiles = defaultMaxSubmissionFiles
+	}
+
+	var err error
+	if assignment.DueAt, err = parseDeadline(dueAt); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Некорректный срок сдачи: %s", dueAt)
+	}
+
+	if len(rubric) > 0 {
+		var total int32
+		for i, c := range rubric {
+			if strings.TrimSpace(c.Title) == "" || c.MaxPoints <= 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "Критерий %d: нужно указать название и положительный максимальный балл", i+1)
+			}
+			assignment.Rubric = append(assignment.Rubric, &models.RubricCriterion{
+				Title:       strings.TrimSpace(c.Title),
+				Description: c.Description,
+				MaxPoints:   c.MaxPoints,
+			})
+			total += c.MaxPoints
+		}
+		if maxScore != 0 && maxScore != total {
+			return nil, status.Errorf(codes.InvalidArgument, "Максимальная оценка (%d) не совпадает с суммой баллов рубрики (%d)", maxScore, total)
+		}
+		assignment.MaxScore = total
+	}
+	if assignment.MaxScore <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Максимальная оценка должна быть больше нуля")
+	}
+
+	if peerReviewCount < 0 || peerReviewCount > maxPeerReviewCount {
+		return nil, status.Errorf(codes.InvalidArgument, "Число рецензентов должно быть от 0 до %d", maxPeerReviewCount)
+	}
+	if peerReviewCount > 0 {
+		if len(assignment.Rubric) == 0 || assignment.DueAt == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Для взаимной проверки нужны рубрика и срок сдачи")
+		}
+		assignment.PeerReviewCount = peerReviewCount
+		if assignment.PeerReviewDueAt, err = parseDeadline(peerReviewDueAt); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Некорректный срок взаимной проверки: %s", peerReviewDueAt)
+		}
+		if assignment.PeerReviewDueAt != nil && !assignment.PeerReviewDueAt.After(*assignment.DueAt) {
+			return nil, status.Errorf(codes.InvalidArgument, "Срок взаимной проверки должен быть позже срока сдачи")
+		}
+	}
+	return assignment, nil
+}
+
+// parseDeadline разбирает срок в формате RFC3339 или YYYY-MM-DD; дата без времени означает конец этого дня.
+func parseDeadline(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		deadline, err = time.Parse(dateLayout, value)
+		deadline = deadline.Add(24*time.Hour - time.Second)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
+
+func sameRubric(a, b []*models.RubricCriterion) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Title != b[i].Title || a[i].Description != b[i].Description || a[i].MaxPoints != b[i].MaxPoints {
+			return false
+		}
+	}
+	return true
+}
+
+// gradeFromRequest проверяет оценку и возвращает её без учёта штрафа вместе с баллами по рубрике.
+func gradeFromRequest(assignment *models.Assignment, req *proto.GradeSubmissionRequest) (int32, []*models.RubricScore, error) {
+	if len(assignment.Rubric) == 0 {
+		if len(req.RubricScores) > 0 {
+			return 0, nil, status.Errorf(codes.InvalidArgument, "У задания нет рубрики")
+		}
+		if req.Score < 0 || req.Score > assignment.MaxScore {
+			return 0, nil, status.Errorf(codes.InvalidArgument, "Оценка должна быть от 0 до %d", assignment.MaxScore)
+		}
+		return req.Score, nil, nil
+	}
+
+	return rubricScoresFromRequest(assignment.Rubric, req.RubricScores)
+}
+
+// rubricScoresFromRequest проверяет, что каждый критерий рубрики оценён ровно один раз и в допустимых пределах,
+// и возвращает сумму баллов вместе с баллами в порядке критериев.
+func rubricScoresFromRequest(rubric []*models.RubricCriterion, requested []*proto.RubricScore) (int32, []*models.RubricScore, error) {
+	given := make(map[int64]*proto.RubricScore, len(requested))
+	for _, score := range requested {
+		if _, duplicate := given[score.CriterionId]; duplicate {
+			return 0, nil, status.Errorf(codes.InvalidArgument, "Повторная оценка по критерию с ID %d", score.CriterionId)
+		}
+		given[score.CriterionId] = score
+	}
+
+	var total int32
+	scores := make([]*models.RubricScore, 0, len(rubric))
+	for _, criterion := range rubric {
+		score, ok := given[criterion.ID]
+		if !ok {
+			return 0, nil, status.Errorf(codes.InvalidArgument, "Не указаны баллы по критерию «%s»", criterion.Title)
+		}
+		if score.Points < 0 || score.Points > criterion.MaxPoints {
+			return 0, nil, status.Errorf(codes.InvalidArgument, "Баллы по критерию «%s» должны быть от 0 до %d", criterion.Title, criterion.MaxPoints)
+		}
+		delete(given, criterion.ID)
+		scores = append(scores, &models.RubricScore{CriterionID: criterion.ID, Points: score.Points, Comment: score.Comment})
+		total += score.Points
+	}
+	for criterionID := range given {
+		return 0, nil, status.Errorf(codes.InvalidArgument, "Критерий с ID %d не относится к заданию", criterionID)
+	}
+	return total, scores, nil
+}
+
+func assignmentToProto(assignment *models.Assignment) *proto.Assignment {
+	grpcAssignment := &proto.Assignment{
+		Id:                 assignment.ID,
+		CourseId:           assignment.CourseID,
+		Title:              assignment.Title,
+		Description:        assignment.Description,
+		DueAt:              formatOptionalTime(assignment.DueAt),
+		LatePenaltyPercent: assignment.LatePenaltyPercent,
+		MaxScore:           assignment.MaxScore,
+		MaxFiles:           assignment.MaxFiles,
+	}
+	for protoPolicy, policy := range latePolicies {
+		if policy == assignment.LatePolicy && protoPolicy != proto.LatePolicy_LATE_POLICY_UNSPECIFIED {
+			grpcAssignment.LatePolicy = protoPolicy
+		}
+	}
+	if assignment.MaxSubmissions != nil {
+		grpcAssignment.MaxSubmissions = *assignment.MaxSubmissions
+	}
+	grpcAssignment.PeerReviewCount = assignment.PeerReviewCount
+	grpcAssignment.PeerReviewDueAt = formatOptionalTime(assignment.PeerReviewDueAt)
+	grpcAssignment.PeerReviewsAllocated = assignment.PeerReviewsAllocatedAt != nil
+	for _, criterion := range assignment.Rubric {
+		grpcAssignment.Rubric = append(grpcAssignment.Rubric, &proto.RubricCriterion{
+			Id:          criterion.ID,
+			Title:       criterion.Title,
+			Description: criterion.Description,
+			MaxPoints:   criterion.MaxPoints,
+		})
+	}
+	return grpcAssignment
+}
+
+func submissionToProto(submission *models.Submission) *proto.Submission {
+	grpcSubmission := &proto.Submission{
+		Id:             submission.ID,
+		AssignmentId:   submission.AssignmentID,
+		StudentId:      submission.StudentID,
+		Version:        submission.Version,
+		Text:           submission.Text,
+		SubmittedAt:    submission.SubmittedAt.Format(time.RFC3339),
+		Late:           submission.Late,
+		PenaltyPercent: submission.PenaltyPercent,
+		Graded:         submission.GradedAt != nil,
+		Feedback:       submission.Feedback,
+		GradedAt:       formatOptionalTime(submission.GradedAt),
+		PeerGraded:     submission.PeerGraded,
+	}
+	if submission.RawScore != nil {
+		grpcSubmission.RawScore = *submission.RawScore
+	}
+	if submission.Score != nil {
+		grpcSubmission.Score = *submission.Score
+	}
+	for _, file := range submission.Files {
+		grpcSubmission.Files = append(grpcSubmission.Files, &proto.SubmissionFile{
+			Id:          file.ID,
+			Filename:    file.Filename,
+			ContentType: file.ContentType,
+			Size:        file.Size,
+		})
+	}
+	for _, score := range submission.RubricScores {
+		grpcSubmission.RubricScores = append(grpcSubmission.RubricScores, &proto.RubricScore{
+			CriterionId: score.CriterionID,
+			Points:      score.Points,
+			Comment:     score.Comment,
+		})
+	}
+	return grpcSubmission
+}
@@ -0,0 +1,506 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func prepareAssignmentTables(t *testing.T, ctx context.Context) {
+	_, err := db.Exec(ctx, "TRUNCATE TABLE submission_rubric_scores, submission_files, assignment_submissions, assignment_rubric_criteria, assignments RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы заданий")
+	prepareQuizTables(t, ctx)
+}
+
+func createTestAssignment(t *testing.T, ctx context.Context, req *proto.CreateAssignmentRequest) *proto.Assignment {
+	if req.CourseId == 0 {
+		req.CourseId = 1
+	}
+	if req.Title == "" {
+		req.Title = "Домашнее задание"
+	}
+	assignment, err := clientAssignment.CreateAssignment(withToken(t, ctx, 1, "instructor"), req)
+	require.NoError(t, err, "Ошибка создания задания")
+	return assignment
+}
+
+func TestCreateAssignment(t *testing.T) {
+	ctx := context.Background()
+	prepareAssignmentTables(t, ctx)
+
+	testCases := []struct {
+		Name             string
+		Ctx              context.Context
+		Request          *proto.CreateAssignmentRequest
+		ShouldError      bool
+		ExpectedCode     codes.Code
+		ExpectedMaxScore int32
+		ExpectedMaxFiles int32
+	}{
+		{
+			Name:             "Задание без рубрики",
+			Ctx:              withToken(t, ctx, 1, "instructor"),
+			Request:          &proto.CreateAssignmentRequest{CourseId: 1, Title: "Эссе", DueAt: "2030-01-01", MaxScore: 10},
+			ExpectedMaxScore: 10,
+			ExpectedMaxFiles: 5,
+		},
+		{
+			Name: "Оценка по рубрике",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
+			Request: &proto.CreateAssignmentRequest{CourseId: 1, Title: "Проект", MaxFiles: 2, Rubric: []*proto.RubricCriterion{
+				{Title: "Код", MaxPoints: 6},
+				{Title: "Тесты", MaxPoints: 4},
+			}},
+			ExpectedMaxScore: 10,
+			ExpectedMaxFiles: 2,
+		},
+		{
+			Name:         "Пустое название",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateAssignmentRequest{CourseId: 1, MaxScore: 10},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Не указана максимальная оценка",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateAssignmentRequest{CourseId: 1, Title: "Эссе"},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Оценка не совпадает с рубрикой",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
+			Request: &proto.CreateAssignmentRequest{CourseId: 1, Title: "Проект", MaxScore: 5, Rubric: []*proto.RubricCriterion{
+				{Title: "Код", MaxPoints: 6},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Политика со штрафом без размера штрафа",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateAssignmentRequest{CourseId: 1, Title: "Эссе", MaxScore: 10, LatePolicy: proto.LatePolicy_LATE_POLICY_PENALTY},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Взаимная проверка без рубрики",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateAssignmentRequest{CourseId: 1, Title: "Эссе", MaxScore: 10, DueAt: "2030-01-01", PeerReviewCount: 3},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Некорректный срок сдачи",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateAssignmentRequest{CourseId: 1, Title: "Эссе", MaxScore: 10, DueAt: "завтра"},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Курс не найден",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateAssignmentRequest{CourseId: 99, Title: "Эссе", MaxScore: 10},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name:         "Преподаватель другого курса",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.CreateAssignmentRequest{CourseId: 1, Title: "Эссе", MaxScore: 10},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientAssignment.CreateAssignment(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова CreateAssignment")
+			assert.NotZero(t, resp.Id, "ID задания не должен быть нулевым")
+			assert.Equal(t, tc.ExpectedMaxScore, resp.MaxScore, "Некорректная максимальная оценка")
+			assert.Equal(t, tc.ExpectedMaxFiles, resp.MaxFiles, "Некорректное число файлов")
+			assert.Len(t, resp.Rubric, len(tc.Request.Rubric), "Некорректное число критериев")
+
+			got, err := clientAssignment.GetAssignment(ctx, &proto.AssignmentIDRequest{AssignmentId: resp.Id})
+			require.NoError(t, err, "Ошибка получения задания")
+			assert.Equal(t, resp.Title, got.Title, "Некорректное название задания")
+			assert.Equal(t, resp.DueAt, got.DueAt, "Некорректный срок сдачи")
+		})
+	}
+}
+
+func TestSubmitAssignment(t *testing.T) {
+	ctx := context.Background()
+	prepareAssignmentTables(t, ctx)
+
+	assignment := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{MaxScore: 10, MaxSubmissions: 2, MaxFiles: 1})
+	student := withToken(t, ctx, 1, "student")
+
+	testCases := []struct {
+		Name            string
+		Ctx             context.Context
+		Request         *proto.SubmitAssignmentRequest
+		ShouldError     bool
+		ExpectedCode    codes.Code
+		ExpectedVersion int32
+	}{
+		{
+			Name: "Первая отправка с файлом",
+			Ctx:  student,
+			Request: &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Решение", Files: []*proto.UploadedFile{
+				{Filename: "../solution.go", Content: []byte("package main")},
+			}},
+			ExpectedVersion: 1,
+		},
+		{
+			Name:            "Повторная отправка",
+			Ctx:             student,
+			Request:         &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Исправленное решение"},
+			ExpectedVersion: 2,
+		},
+		{
+			Name:         "Отправки исчерпаны",
+			Ctx:          student,
+			Request:      &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Ещё одно решение"},
+			ShouldError:  true,
+			ExpectedCode: codes.FailedPrecondition,
+		},
+		{
+			Name:         "Пустое решение",
+			Ctx:          withToken(t, ctx, 2, "student"),
+			Request:      &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 2},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Слишком много файлов",
+			Ctx:  withToken(t, ctx, 2, "student"),
+			Request: &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 2, Files: []*proto.UploadedFile{
+				{Filename: "a.txt", Content: []byte("a")},
+				{Filename: "b.txt", Content: []byte("b")},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Студент не записан на курс",
+			Ctx:          withToken(t, ctx, 2, "student"),
+			Request:      &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 2, Text: "Решение"},
+			ShouldError:  true,
+			ExpectedCode: codes.FailedPrecondition,
+		},
+		{
+			Name:         "Отправка от имени другого студента",
+			Ctx:          withToken(t, ctx, 2, "student"),
+			Request:      &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Решение"},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Задание не найдено",
+			Ctx:          student,
+			Request:      &proto.SubmitAssignmentRequest{AssignmentId: 99, StudentId: 1, Text: "Решение"},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientAssignment.SubmitAssignment(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова SubmitAssignment")
+			assert.Equal(t, tc.ExpectedVersion, resp.Version, "Некорректная версия решения")
+			assert.False(t, resp.Late, "Решение не должно быть просроченным")
+			require.Len(t, resp.Files, len(tc.Request.Files), "Некорректное число файлов")
+			for _, file := range resp.Files {
+				assert.Equal(t, "solution.go", file.Filename, "Путь должен быть отброшен из имени файла")
+				assert.NotEmpty(t, file.ContentType, "Тип содержимого должен определяться автоматически")
+			}
+		})
+	}
+}
+
+func TestSubmitLateAssignment(t *testing.T) {
+	ctx := context.Background()
+	prepareAssignmentTables(t, ctx)
+	student := withToken(t, ctx, 1, "student")
+	dueAt := time.Now().UTC().Add(-36 * time.Hour).Format(time.RFC3339)
+
+	penalty := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{MaxScore: 10, DueAt: dueAt, LatePolicy: proto.LatePolicy_LATE_POLICY_PENALTY, LatePenaltyPercent: 10})
+	submission, err := clientAssignment.SubmitAssignment(student, &proto.SubmitAssignmentRequest{AssignmentId: penalty.Id, StudentId: 1, Text: "Поздно"})
+	require.NoError(t, err, "Просроченное решение должно приниматься со штрафом")
+	assert.True(t, submission.Late, "Решение должно быть отмечено просроченным")
+	assert.Equal(t, int32(20), submission.PenaltyPercent, "Штраф начисляется за каждые начатые сутки")
+
+	graded, err := clientAssignment.GradeSubmission(withToken(t, ctx, 1, "instructor"), &proto.GradeSubmissionRequest{SubmissionId: submission.Id, Score: 10, Feedback: "Хорошо"})
+	require.NoError(t, err, "Ошибка оценки решения")
+	assert.Equal(t, int32(10), graded.RawScore, "Некорректная оценка без штрафа")
+	assert.Equal(t, int32(8), graded.Score, "Штраф должен применяться к оценке")
+
+	accept := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{MaxScore: 10, DueAt: dueAt})
+	submission, err = clientAssignment.SubmitAssignment(student, &proto.SubmitAssignmentRequest{AssignmentId: accept.Id, StudentId: 1, Text: "Поздно"})
+	require.NoError(t, err, "Просроченное решение должно приниматься без штрафа")
+	assert.True(t, submission.Late, "Решение должно быть отмечено просроченным")
+	assert.Zero(t, submission.PenaltyPercent, "Штраф не должен начисляться")
+
+	reject := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{MaxScore: 10, DueAt: dueAt, LatePolicy: proto.LatePolicy_LATE_POLICY_REJECT})
+	_, err = clientAssignment.SubmitAssignment(student, &proto.SubmitAssignmentRequest{AssignmentId: reject.Id, StudentId: 1, Text: "Поздно"})
+	require.Error(t, err, "Просроченное решение должно отклоняться")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+}
+
+func TestGradeSubmission(t *testing.T) {
+	ctx := context.Background()
+	prepareAssignmentTables(t, ctx)
+
+	assignment := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{Rubric: []*proto.RubricCriterion{
+		{Title: "Код", MaxPoints: 6},
+		{Title: "Тесты", MaxPoints: 4},
+	}})
+	code, tests := assignment.Rubric[0].Id, assignment.Rubric[1].Id
+
+	submission, err := clientAssignment.SubmitAssignment(withToken(t, ctx, 1, "student"), &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Решение"})
+	require.NoError(t, err, "Ошибка отправки решения")
+
+	testCases := []struct {
+		Name          string
+		Ctx           context.Context
+		Request       *proto.GradeSubmissionRequest
+		ShouldError   bool
+		ExpectedCode  codes.Code
+		ExpectedScore int32
+	}{
+		{
+			Name: "Оценка по всем критериям",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
+			Request: &proto.GradeSubmissionRequest{SubmissionId: submission.Id, Feedback: "Добавьте тесты", RubricScores: []*proto.RubricScore{
+				{CriterionId: code, Points: 5, Comment: "Чисто"},
+				{CriterionId: tests, Points: 1},
+			}},
+			ExpectedScore: 6,
+		},
+		{
+			Name: "Не оценён один из критериев",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
+			Request: &proto.GradeSubmissionRequest{SubmissionId: submission.Id, RubricScores: []*proto.RubricScore{
+				{CriterionId: code, Points: 5},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Баллы выше максимума критерия",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
+			Request: &proto.GradeSubmissionRequest{SubmissionId: submission.Id, RubricScores: []*proto.RubricScore{
+				{CriterionId: code, Points: 7},
+				{CriterionId: tests, Points: 4},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Преподаватель другого курса",
+			Ctx:  withToken(t, ctx, 2, "instructor"),
+			Request: &proto.GradeSubmissionRequest{SubmissionId: submission.Id, RubricScores: []*proto.RubricScore{
+				{CriterionId: code, Points: 6},
+				{CriterionId: tests, Points: 4},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Решение не найдено",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.GradeSubmissionRequest{SubmissionId: 99, Score: 1},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientAssignment.GradeSubmission(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова GradeSubmission")
+			assert.True(t, resp.Graded, "Решение должно быть оценено")
+			assert.Equal(t, tc.ExpectedScore, resp.Score, "Некорректная оценка")
+			assert.Len(t, resp.RubricScores, len(tc.Request.RubricScores), "Некорректное число оценок по критериям")
+
+			got, err := clientAssignment.GetSubmission(withToken(t, ctx, 1, "student"), &proto.SubmissionIDRequest{SubmissionId: resp.Id})
+			require.NoError(t, err, "Студент должен видеть оценку своего решения")
+			assert.Equal(t, tc.ExpectedScore, got.Score, "Некорректная оценка")
+			assert.Equal(t, tc.Request.Feedback, got.Feedback, "Некорректный отзыв")
+		})
+	}
+
+	_, err = clientAssignment.UpdateAssignment(withToken(t, ctx, 1, "instructor"), &proto.UpdateAssignmentRequest{Id: assignment.Id, Title: assignment.Title, Rubric: []*proto.RubricCriterion{
+		{Title: "Код", MaxPoints: 10},
+	}})
+	require.Error(t, err, "Рубрику нельзя менять после начала проверки")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+}
+
+func TestListSubmissions(t *testing.T) {
+	ctx := context.Background()
+	prepareAssignmentTables(t, ctx)
+
+	_, err := db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES (2, 1)")
+	require.NoError(t, err, "Не удалось добавить запись на курс")
+
+	assignment := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{MaxScore: 10})
+	first, err := clientAssignment.SubmitAssignment(withToken(t, ctx, 1, "student"), &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Версия 1"})
+	require.NoError(t, err, "Ошибка отправки решения")
+	_, err = clientAssignment.SubmitAssignment(withToken(t, ctx, 1, "student"), &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Версия 2"})
+	require.NoError(t, err, "Ошибка отправки решения")
+	second, err := clientAssignment.SubmitAssignment(withToken(t, ctx, 2, "student"), &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 2, Text: "Решение"})
+	require.NoError(t, err, "Ошибка отправки решения")
+	_, err = clientAssignment.GradeSubmission(withToken(t, ctx, 1, "instructor"), &proto.GradeSubmissionRequest{SubmissionId: second.Id, Score: 9})
+	require.NoError(t, err, "Ошибка оценки решения")
+	_, err = db.Exec(ctx, "UPDATE assignment_submissions SET late = TRUE WHERE id = $1", first.Id)
+	require.NoError(t, err, "Не удалось отметить решение просроченным")
+
+	testCases := []struct {
+		Name          string
+		Ctx           context.Context
+		Request       *proto.ListSubmissionsRequest
+		ShouldError   bool
+		ExpectedCode  codes.Code
+		ExpectedTexts []string
+	}{
+		{
+			Name:          "Последние версии решений",
+			Ctx:           withToken(t, ctx, 1, "instructor"),
+			Request:       &proto.ListSubmissionsRequest{AssignmentId: assignment.Id},
+			ExpectedTexts: []string{"Версия 2", "Решение"},
+		},
+		{
+			Name:          "Только непроверенные",
+			Ctx:           withToken(t, ctx, 1, "instructor"),
+			Request:       &proto.ListSubmissionsRequest{AssignmentId: assignment.Id, UngradedOnly: true},
+			ExpectedTexts: []string{"Версия 2"},
+		},
+		{
+			Name:          "Только просроченные среди всех версий",
+			Ctx:           withToken(t, ctx, 1, "instructor"),
+			Request:       &proto.ListSubmissionsRequest{AssignmentId: assignment.Id, LateOnly: true, AllVersions: true},
+			ExpectedTexts: []string{"Версия 1"},
+		},
+		{
+			Name:          "Все версии одного студента",
+			Ctx:           withToken(t, ctx, 1, "instructor"),
+			Request:       &proto.ListSubmissionsRequest{AssignmentId: assignment.Id, StudentId: 1, AllVersions: true},
+			ExpectedTexts: []string{"Версия 1", "Версия 2"},
+		},
+		{
+			Name:         "Преподаватель другого курса",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.ListSubmissionsRequest{AssignmentId: assignment.Id},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientAssignment.ListSubmissions(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова ListSubmissions")
+			var texts []string
+			for _, submission := range resp.Submissions {
+				texts = append(texts, submission.Text)
+			}
+			assert.ElementsMatch(t, tc.ExpectedTexts, texts, "Некорректный список решений")
+		})
+	}
+}
+
+func TestSubmissionFileAccess(t *testing.T) {
+	ctx := context.Background()
+	prepareAssignmentTables(t, ctx)
+
+	assignment := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{MaxScore: 10})
+	submission, err := clientAssignment.SubmitAssignment(withToken(t, ctx, 1, "student"), &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Files: []*proto.UploadedFile{
+		{Filename: "report.txt", ContentType: "text/plain", Content: []byte("Отчёт")},
+	}})
+	require.NoError(t, err, "Ошибка отправки решения")
+	require.Len(t, submission.Files, 1, "Файл должен быть сохранён")
+	fileID := submission.Files[0].Id
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{Name: "Автор решения", Ctx: withToken(t, ctx, 1, "student")},
+		{Name: "Преподаватель курса", Ctx: withToken(t, ctx, 1, "instructor")},
+		{Name: "Другой студент", Ctx: withToken(t, ctx, 2, "student"), ShouldError: true, ExpectedCode: codes.PermissionDenied},
+		{Name: "Преподаватель другого курса", Ctx: withToken(t, ctx, 2, "instructor"), ShouldError: true, ExpectedCode: codes.PermissionDenied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientAssignment.GetSubmissionFile(tc.Ctx, &proto.SubmissionFileRequest{FileId: fileID})
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова GetSubmissionFile")
+			assert.Equal(t, "report.txt", resp.Filename, "Некорректное имя файла")
+			assert.Equal(t, "text/plain", resp.ContentType, "Некорректный тип содержимого")
+			assert.Equal(t, []byte("Отчёт"), resp.Content, "Некорректное содержимое файла")
+		})
+	}
+
+	_, err = clientAssignment.DeleteAssignment(withToken(t, ctx, 1, "instructor"), &proto.AssignmentIDRequest{AssignmentId: assignment.Id})
+	require.NoError(t, err, "Ошибка удаления задания")
+	_, err = clientAssignment.GetSubmissionFile(withToken(t, ctx, 1, "student"), &proto.SubmissionFileRequest{FileId: fileID})
+	assert.Equal(t, codes.NotFound, status.Code(err), "Файл удалённого задания не должен быть доступен")
+}
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"GoEdu/internal/certificate"
+	"GoEdu/internal/config"
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CertificateIssuer выдаёт сертификат, когда студент завершил все лекции курса.
+type CertificateIssuer interface {
+	IssueIfCompleted(ctx context.Context, studentID, courseID int64) (*models.Certificate, error)
+}
+
+// PathCertificateReader находит выданные сертификаты учебных программ.
+type PathCertificateReader interface {
+	GetPathCertificate(ctx context.Context, studentID, pathID int64) (*proto.Certificate, error)
+}
+
+type CertificateService struct {
+	proto.UnimplementedCertificateServiceServer
+	certificateRepo repository.CertificateRepository
+	lectureRepo     repository.LectureRepository
+	courseRepo      repository.CourseRepository
+	pathRepo        repository.LearningPathRepository
+	signer          *certificate.Signer
+	appBaseURL      string
+	logger          *zap.Logger
+}
+
+func NewCertificateService(certificateRepo repository.CertificateRepository, lectureRepo repository.LectureRepository, courseRepo repository.CourseRepository, pathRepo repository.LearningPathRepository, cfg *config.Config, logger *zap.Logger) *CertificateService {
+	return &CertificateService{
+		certificateRepo: certificateRepo,
+		lectureRepo:     lectureRepo,
+		courseRepo:      courseRepo,
+		pathRepo:        pathRepo,
+		signer:          certificate.NewSigner(cfg.CertificateSecretKey),
+		appBaseURL:      strings.TrimRight(cfg.AppBaseURL, "/"),
+		logger:          logger,
+	}
+}
+
+// IssueIfCompleted выдаёт сертификат, если студент завершил курс, и возвращает nil, если курс ещё не пройден.
+// Повторный вызов возвращает ранее выданный сертификат. Вместе с сертификатом курса выдаются сертификаты
+// программ студента, которые этот курс завершил.
+func (s *CertificateService) IssueIfCompleted(ctx context.Context, studentID, courseID int64) (*models.Certificate, error) {
+	existing, err := s.certificateRepo.GetCertificateByStudentCourse(ctx, studentID, courseID)
+	if err != nil || existing != nil {
+		return existing, err
+	}
+
+	progress, err := s.lectureRepo.GetCourseProgress(ctx, studentID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if progress < 100 {
+		return nil, nil
+	}
+
+	studentName, courseName, instructorName, err := s.certificateRepo.GetIssueNames(ctx, studentID, courseID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить данные для сертификата: %w", err)
+	}
+
+	serial, err := certificate.NewSerial()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сгенерировать серийный номер: %w", err)
+	}
+
+	cert := &models.Certificate{
+		Serial:         serial,
+		StudentID:      studentID,
+		CourseID:       courseID,
+		StudentName:    studentName,
+		CourseName:     courseName,
+		InstructorName: instructorName,
+		IssuedAt:       time.Now().UTC().Truncate(time.Second),
+	}
+	cert.Signature = s.signer.Sign(cert)
+	cert.PDF = certificate.RenderPDF(cert, s.verifyURL(cert))
+
+	created, err := s.certificateRepo.CreateCertificate(ctx, cert)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Выдан сертификат", zap.Int64("student_id", studentID), zap.Int64("course_id", courseID), zap.String("serial", created.Serial))
+	s.issueCompletedPaths(ctx, studentID, courseID)
+	return created, nil
+}
+
+// issueCompletedPaths выдаёт сертификаты программ с курсом courseID, которые студент завершил.
+// Ошибки только записываются в лог: сертификат программы будет выдан при завершении следующей лекции.
+func (s *CertificateService) issueCompletedPaths(ctx context.Context, studentID, courseID int64) {
+	pathIDs, err := s.pathRepo.GetEnrolledPathIDsByCourse(ctx, studentID, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении программ курса", zap.Error(err), zap.Int64("student_id", studentID), zap.Int64("course_id", courseID))
+		return
+	}
+	for _, pathID := range pathIDs {
+		path, err := s.pathRepo.GetLearningPathByID(ctx, pathID)
+		if err != nil || path == nil {
+			s.logger.Error("Ошибка при получении программы", zap.Error(err), zap.Int64("path_id", pathID))
+			continue
+		}
+		progress, err := pathCourseProgress(ctx, s.lectureRepo, studentID, path)
+		if err != nil {
+			s.logger.Error("Ошибка при получении прогресса программы", zap.Error(err), zap.Int64("path_id", pathID), zap.Int64("student_id", studentID))
+			continue
+		}
+		if !pathCompleted(path, progress) {
+			continue
+		}
+		if _, err := s.issuePathCertificate(ctx, studentID, path); err != nil {
+			s.logger.Error("Ошибка при выдаче сертификата программы", zap.Error(err), zap.Int64("path_id", pathID), zap.Int64("student_id", studentID))
+		}
+	}
+}
+
+// GetPathCertificate возвращает сертификат студента по программе или nil, если он ещё не выдан.
+// Сертификат при этом не выдаётся: это делает IssueIfCompleted при завершении лекции.
+func (s *CertificateService) GetPathCertificate(ctx context.Context, studentID, pathID int64) (*proto.Certificate, error) {
+	cert, err := s.certificateRepo.GetCertificateByStudentPath(ctx, studentID, pathID)
+	if err != nil || cert == nil {
+		return nil, err
+	}
+	return s.certificateToProto(cert), nil
+}
+
+// issuePathCertificate выдаёт сертификат завершённой программы, если он ещё не выдан.
+func (s *CertificateService) issuePathCertificate(ctx context.Context, studentID int64, path *models.LearningPath) (*models.Certificate, error) {
+	existing, err := s.certificateRepo.GetCertificateByStudentPath(ctx, studentID, path.ID)
+	if err != nil || existing != nil {
+		return existing, err
+	}
+
+	studentName, pathName, instructorName, err := s.certificateRepo.GetPathIssueNames(ctx, studentID, path.ID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить данные для сертификата программы: %w", err)
+	}
+
+	serial, err := certificate.NewSerial()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сгенерировать серийный номер: %w", err)
+	}
+
+	cert := &models.Certificate{
+		Serial:         serial,
+		StudentID:      studentID,
+		PathID:         path.ID,
+		StudentName:    studentName,
+		CourseName:     pathName,
+		InstructorName: instructorName,
+		IssuedAt:       time.Now().UTC().Truncate(time.Second),
+	}
+	cert.Signature = s.signer.Sign(cert)
+	cert.PDF = certificate.RenderPDF(cert, s.verifyURL(cert))
+
+	created, err := s.certificateRepo.CreateCertificate(ctx, cert)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Выдан сертификат программы", zap.Int64("student_id", studentID), zap.Int64("path_id", path.ID), zap.String("serial", created.Serial))
+	return created, nil
+}
+
+func (s *CertificateService) GetCertificate(ctx context.Context, req *proto.CertificateIDRequest) (*proto.Certificate, error) {
+	s.logger.Info("Получение сертификата", zap.Int64("certificate_id", req.CertificateId))
+
+	if req.CertificateId == 0 {
+		s.logger.Warn("Некорректный ID сертификата", zap.Int64("certificate_id", req.CertificateId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID сертификата должен быть указан")
+	}
+
+	cert, err := s.certificateRepo.GetCertificateByID(ctx, req.CertificateId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении сертификата", zap.Error(err), zap.Int64("certificate_id", req.CertificateId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении сертификата: %v", err)
+	}
+	if cert == nil {
+		s.logger.Warn("Сертификат не найден", zap.Int64("certificate_id", req.CertificateId))
+		return nil, status.Errorf(codes.NotFound, "Сертификат с ID %d не найден", req.CertificateId)
+	}
+
+	if err := checkStudentAccess(ctx, cert.StudentID); err != nil {
+		s.logger.Warn("Попытка получить чужой сертификат", zap.Int64("certificate_id", req.CertificateId))
+		return nil, err
+	}
+	if user, ok := middleware.UserFromContext(ctx); ok && user.Role == "instructor" && cert.PathID != 0 {
+		path, err := s.pathRepo.GetLearningPathByID(ctx, cert.PathID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении программы", zap.Error(err), zap.Int64("path_id", cert.PathID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении программы: %v", err)
+		}
+		if path == nil || path.InstructorID != user.ID {
+			s.logger.Warn("Сертификат запрошен преподавателем другой программы", zap.Int64("certificate_id", req.CertificateId), zap.Int64("user_id", user.ID))
+			return nil, status.Errorf(codes.PermissionDenied, "Действие доступно только автору программы")
+		}
+	} else if ok && user.Role == "instructor" {
+		course, err := s.courseRepo.GetCourseByID(ctx, cert.CourseID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", cert.CourseID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+		}
+		if course == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "Действие доступно только преподавателю курса")
+		}
+		if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+			s.logger.Warn("Сертификат запрошен преподавателем другого курса", zap.Int64("certificate_id", req.CertificateId), zap.Int64("user_id", user.ID))
+			return nil, err
+		}
+	}
+
+	s.logger.Info("Сертификат успешно получен", zap.Int64("certificate_id", cert.ID))
+	grpcCert := s.certificateToProto(cert)
+	grpcCert.Pdf = cert.PDF
+	return grpcCert, nil
+}
+
+func (s *CertificateService) ListMyCertificates(ctx context.Context, req *proto.Empty) (*proto.CertificateList, error) {
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok || user.Role != "student" {
+		s.logger.Warn("Список сертификатов запрошен не студентом")
+		return nil, status.Errorf(codes.PermissionDenied, "Список сертификатов доступен только студентам")
+	}
+
+	s.logger.Info("Получение сертификатов студента", zap.Int64("student_id", user.ID))
+
+	certs, err := s.certificateRepo.GetCertificatesByStudent(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении сертификатов", zap.Error(err), zap.Int64("student_id", user.ID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении сертификатов: %v", err)
+	}
+
+	resp := &proto.CertificateList{}
+	for _, cert := range certs {
+		resp.Certificates = append(resp.Certificates, s.certificateToProto(cert))
+	}
+
+	s.logger.Info("Сертификаты студента получены", zap.Int64("student_id", user.ID), zap.Int("count", len(certs)))
+	return resp, nil
+}
+
+func (s *CertificateService) VerifyCertificate(ctx context.Context, req *proto.VerifyCertificateRequest) (*proto.CertificateVerification, error) {
+	s.logger.Info("Проверка сертификата", zap.String("serial", req.Serial))
+
+	serial := strings.ToUpper(strings.TrimSpace(req.Serial))
+	if serial == "" {
+		s.logger.Warn("Серийный номер не указан")
+		return nil, status.Errorf(codes.InvalidArgument, "Серийный номер сертификата должен быть указан")
+	}
+
+	cert, err := s.certificateRepo.GetCertificateBySerial(ctx, serial)
+	if err != nil {
+		s.logger.Error("Ошибка при получении сертификата", zap.Error(err), zap.String("serial", serial))
+		return nil, status.Errorf(codes.Internal, "Ошибка при проверке сертификата: %v", err)
+	}
+	if cert == nil {
+		s.logger.Warn("Сертификат для проверки не найден", zap.String("serial", serial))
+		return &proto.CertificateVerification{Valid: false, Reason: "Сертификат с таким серийным номером не выдавался"}, nil
+	}
+
+	resp := &proto.CertificateVerification{Valid: true, Certificate: s.certificateToProto(cert)}
+	switch {
+	case !s.signer.Verify(cert, cert.Signature):
+		s.logger.Error("Данные сертификата не соответствуют подписи", zap.String("serial", serial))
+		resp.Valid, resp.Reason = false, "Данные сертификата изменены после выдачи"
+	case req.Signature != "" && !s.signer.Verify(cert, req.Signature):
+		s.logger.Warn("Подпись сертификата не совпадает", zap.String("serial", serial))
+		resp.Valid, resp.Reason = false, "Подпись не соответствует сертификату"
+	}
+
+	s.logger.Info("Сертификат проверен", zap.String("serial", serial), zap.Bool("valid", resp.Valid))
+	return resp, nil
+}
+
+func (s *CertificateService) verifyURL(cert *models.Certificate) string {
+	return s.appBaseURL + "/v1/certificates/verify/" + url.PathEscape(cert.Serial) + "?signature=" + cert.Signature
+}
+
+func (s *CertificateService) certificateToProto(cert *models.Certificate) *proto.Certificate {
+	return &proto.Certificate{
+		Id:             cert.ID,
+		Serial:         cert.Serial,
+		StudentId:      cert.StudentID,
+		StudentName:    cert.StudentName,
+		CourseId:       cert.CourseID,
+		PathId:         cert.PathID,
+		CourseName:     cert.CourseName,
+		InstructorName: cert.InstructorName,
+		IssuedAt:       cert.IssuedAt.Format(time.RFC3339),
+		Signature:      cert.Signature,
+		VerifyUrl:      s.verifyURL(cert),
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"bytes"
+	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func prepareCertificateTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"certificates", "lecture_progress", "lecture_completions", "lectures"},
+		Instructors: 1,
+		Students:    2,
+		Courses:     []int64{1},
+		Enrollments: [][2]int64{{1, 1}, {2, 1}},
+	})
+
+	_, err := db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)", 1, 1, "Лекция 1", "Содержание 1", 2, 1, "Лекция 2", "Содержание 2")
+	require.NoError(t, err, "Не удалось добавить лекции")
+}
+
+func TestCertificateIssuedOnCompletion(t *testing.T) {
+	ctx := context.Background()
+	prepareCertificateTables(t, ctx)
+
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	_, err := clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 1})
+	require.NoError(t, err, "Ошибка завершения лекции 1")
+
+	list, err := clientCertificate.ListMyCertificates(studentCtx, &proto.Empty{})
+	require.NoError(t, err, "Ошибка получения сертификатов")
+	assert.Empty(t, list.Certificates, "Сертификат не должен выдаваться до завершения курса")
+
+	_, err = clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 2})
+	require.NoError(t, err, "Ошибка завершения лекции 2")
+
+	list, err = clientCertificate.ListMyCertificates(studentCtx, &proto.Empty{})
+	require.NoError(t, err, "Ошибка получения сертификатов")
+	require.Len(t, list.Certificates, 1, "После завершения курса должен быть выдан сертификат")
+
+	issued := list.Certificates[0]
+	assert.Equal(t, "Студент 1", issued.StudentName, "Имя студента не совпадает")
+	assert.Equal(t, "Курс 1", issued.CourseName, "Название курса не совпадает")
+	assert.Equal(t, "Преподаватель 1", issued.InstructorName, "Имя преподавателя не совпадает")
+	assert.NotEmpty(t, issued.Serial, "Серийный номер должен быть заполнен")
+	assert.Empty(t, issued.Pdf, "PDF не передаётся в списке")
+
+	cert, err := clientCertificate.GetCertificate(studentCtx, &proto.CertificateIDRequest{CertificateId: issued.Id})
+	require.NoError(t, err, "Ошибка получения сертификата")
+	assert.True(t, bytes.HasPrefix(cert.Pdf, []byte("%PDF-")), "Сертификат должен содержать PDF")
+	assert.True(t, bytes.Contains(cert.Pdf, []byte(issued.Serial)), "PDF должен содержать серийный номер")
+
+	_, err = clientCertificate.GetCertificate(withToken(t, ctx, 2, "student"), &proto.CertificateIDRequest{CertificateId: issued.Id})
+	require.Error(t, err, "Чужой сертификат не должен быть доступен")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+
+	_, err = clientLecture.GetCourseProgress(studentCtx, &proto.CourseProgressRequest{StudentId: 1, CourseId: 1})
+	require.NoError(t, err, "Ошибка получения прогресса")
+	_, err = clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 2})
+	require.NoError(t, err, "Ошибка повторного завершения лекции 2")
+
+	var count int
+	err = db.QueryRow(ctx, "SELECT COUNT(*) FROM certificates WHERE student_id = 1").Scan(&count)
+	require.NoError(t, err, "Ошибка проверки данных в базе")
+	assert.Equal(t, 1, count, "Сертификат не должен выдаваться повторно")
+}
+
+func TestVerifyCertificate(t *testing.T) {
+	ctx := context.Background()
+	prepareCertificateTables(t, ctx)
+
+	_, err := db.Exec(ctx, "INSERT INTO lecture_completions (student_id, lecture_id) VALUES (1, 1)")
+	require.NoError(t, err, "Не удалось добавить завершение лекции")
+
+	studentCtx := withToken(t, ctx, 1, "student")
+	_, err = clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 2})
+	require.NoError(t, err, "Ошибка завершения лекции 2")
+
+	list, err := clientCertificate.ListMyCertificates(studentCtx, &proto.Empty{})
+	require.NoError(t, err, "Ошибка получения сертификатов")
+	require.Len(t, list.Certificates, 1, "Сертификат должен быть выдан после завершения курса")
+	cert := list.Certificates[0]
+
+	testCases := []struct {
+		Name          string
+		Request       *proto.VerifyCertificateRequest
+		ExpectedValid bool
+	}{
+		{
+			Name:          "Подлинный сертификат",
+			Request:       &proto.VerifyCertificateRequest{Serial: cert.Serial, Signature: cert.Signature},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Проверка только по серийному номеру",
+			Request:       &proto.VerifyCertificateRequest{Serial: cert.Serial},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Неверная подпись",
+			Request:       &proto.VerifyCertificateRequest{Serial: cert.Serial, Signature: "deadbeef"},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Неизвестный серийный номер",
+			Request:       &proto.VerifyCertificateRequest{Serial: "GE-AAAA-BBBB-CCCC"},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientCertificate.VerifyCertificate(ctx, tc.Request)
+			require.NoError(t, err, "Ошибка проверки сертификата")
+			assert.Equal(t, tc.ExpectedValid, resp.Valid, "Некорректный результат проверки: %s", resp.Reason)
+		})
+	}
+
+	_, err = db.Exec(ctx, "UPDATE certificates SET student_name = 'Подделка' WHERE id = $1", cert.Id)
+	require.NoError(t, err, "Не удалось изменить сертификат")
+
+	resp, err := clientCertificate.VerifyCertificate(ctx, &proto.VerifyCertificateRequest{Serial: cert.Serial})
+	require.NoError(t, err, "Ошибка проверки сертификата")
+	assert.False(t, resp.Valid, "Изменённый сертификат не должен проходить проверку")
+
+	_, err = clientCertificate.VerifyCertificate(ctx, &proto.VerifyCertificateRequest{})
+	require.Error(t, err, "Ожидалась ошибка для пустого серийного номера")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Некорректный код ошибки")
+}
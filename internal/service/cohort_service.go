@@ -0,0 +1,471 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const dateLayout = "2006-01-02"
+
+type CohortService struct {
+	proto.UnimplementedCohortServiceServer
+	cohortRepo     repository.CohortRepository
+	courseRepo     repository.CourseRepository
+	lectureRepo    repository.LectureRepository
+	enrollmentRepo repository.EnrollmentRepository
+	logger         *zap.Logger
+}
+
+func NewCohortService(cohortRepo repository.CohortRepository, courseRepo repository.CourseRepository, lectureRepo repository.LectureRepository,
+	enrollmentRepo repository.EnrollmentRepository, logger *zap.Logger) *CohortService {
+	return &CohortService{
+		cohortRepo:     cohortRepo,
+		courseRepo:     courseRepo,
+		lectureRepo:    lectureRepo,
+		enrollmentRepo: enrollmentRepo,
+		logger:         logger,
+	}
+}
+
+func (s *CohortService) CreateCohort(ctx context.Context, req *proto.CreateCohortRequest) (*proto.Cohort, error) {
+	s.logger.Info("Создание потока", zap.Int64("course_id", req.CourseId), zap.String("name", req.Name))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	cohort, err := s.cohortFromRequest(req.Name, req.StartDate, req.EndDate, req.InstructorIds)
+	if err != nil {
+		return nil, err
+	}
+	cohort.CourseID = req.CourseId
+
+	course, err := s.courseRepo.GetCourseByID(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Нет доступа к созданию потока", zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+
+	id, err := s.cohortRepo.CreateCohort(ctx, cohort)
+	if err != nil {
+		return nil, s.cohortWriteError(err, req.CourseId)
+	}
+	cohort.ID = id
+
+	s.logger.Info("Поток успешно создан", zap.Int64("cohort_id", id), zap.Int64("course_id", req.CourseId))
+	return cohortToProto(cohort), nil
+}
+
+func (s *CohortService) UpdateCohort(ctx context.Context, req *proto.UpdateCohortRequest) (*proto.Cohort, error) {
+	s.logger.Info("Обновление потока", zap.Int64("cohort_id", req.Id))
+
+	if req.Id == 0 {
+		s.logger.Warn("Некорректный ID потока", zap.Int64("cohort_id", req.Id))
+		return nil, status.Errorf(codes.InvalidArgument, "ID потока должен быть указан")
+	}
+
+	existing, err := s.getCohortForInstructor(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	cohort, err := s.cohortFromRequest(req.Name, req.StartDate, req.EndDate, req.InstructorIds)
+	if err != nil {
+		return nil, err
+	}
+	cohort.ID = existing.ID
+	cohort.CourseID = existing.CourseID
+	cohort.StudentCount = existing.StudentCount
+
+	if err := s.cohortRepo.UpdateCohort(ctx, cohort); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "Поток с ID %d не найден", req.Id)
+		}
+		return nil, s.cohortWriteError(err, existing.CourseID)
+	}
+
+	s.logger.Info("Поток успешно обновлён", zap.Int64("cohort_id", req.Id))
+	return cohortToProto(cohort), nil
+}
+
+func (s *CohortService) DeleteCohort(ctx context.Context, req *proto.CohortIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление потока", zap.Int64("cohort_id", req.CohortId))
+
+	if _, err := s.getCohortForInstructor(ctx, req.CohortId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.cohortRepo.DeleteCohort(ctx, req.CohortId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении потока", zap.Error(err), zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении потока: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Поток не найден", zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.NotFound, "Поток с ID %d не найден", req.CohortId)
+	}
+
+	s.logger.Info("Поток успешно удалён", zap.Int64("cohort_id", req.CohortId))
+	return &proto.Empty{}, nil
+}
+
+func (s *CohortService) ListCohorts(ctx context.Context, req *proto.CourseIDRequest) (*proto.CohortList, error) {
+	s.logger.Info("Получение потоков курса", zap.Int64("course_id", req.CourseId))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	course, err := s.courseRepo.GetCourseByID(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
+	}
+
+	cohorts, err := s.cohortRepo.GetCohortsByCourse(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении потоков", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении потоков: %v", err)
+	}
+
+	// Список потоков видят владелец курса и наставники его потоков.
+	instructorIDs := []int64{course.InstructorID}
+	for _, cohort := range cohorts {
+		instructorIDs = append(instructorIDs, cohort.InstructorIDs...)
+	}
+	if err := checkInstructorAccess(ctx, instructorIDs...); err != nil {
+		s.logger.Warn("Нет доступа к потокам курса", zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+
+	var grpcCohorts []*proto.Cohort
+	for _, cohort := range cohorts {
+		grpcCohorts = append(grpcCohorts, cohortToProto(cohort))
+	}
+
+	s.logger.Info("Потоки успешно получены", zap.Int("count", len(grpcCohorts)), zap.Int64("course_id", req.CourseId))
+	return &proto.CohortList{Cohorts: grpcCohorts}, nil
+}
+
+func (s *CohortService) AssignStudentsToCohort(ctx context.Context, req *proto.CohortStudentsRequest) (*proto.Empty, error) {
+	s.logger.Info("Распределение студентов в поток", zap.Int64("cohort_id", req.CohortId), zap.Int("count", len(req.StudentIds)))
+
+	studentIDs, err := s.validateCohortStudents(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cohort, err := s.getCohortForInstructor(ctx, req.CohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cohortRepo.AssignStudents(ctx, cohort.ID, cohort.CourseID, studentIDs); err != nil {
+		if errors.Is(err, repository.ErrStudentsNotEnrolled) {
+			s.logger.Warn("Не все студенты записаны на курс", zap.Int64("cohort_id", req.CohortId), zap.Int64("course_id", cohort.CourseID))
+			return nil, status.Errorf(codes.FailedPrecondition, "Все студенты должны быть записаны на курс %d", cohort.CourseID)
+		}
+		s.logger.Error("Ошибка при распределении студентов", zap.Error(err), zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при распределении студентов: %v", err)
+	}
+
+	s.logger.Info("Студенты распределены в поток", zap.Int64("cohort_id", req.CohortId), zap.Int("count", len(studentIDs)))
+	return &proto.Empty{}, nil
+}
+
+func (s *CohortService) RemoveStudentsFromCohort(ctx context.Context, req *proto.CohortStudentsRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление студентов из потока", zap.Int64("cohort_id", req.CohortId), zap.Int("count", len(req.StudentIds)))
+
+	studentIDs, err := s.validateCohortStudents(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getCohortForInstructor(ctx, req.CohortId); err != nil {
+		return nil, err
+	}
+
+	if err := s.cohortRepo.RemoveStudents(ctx, req.CohortId, studentIDs); err != nil {
+		s.logger.Error("Ошибка при удалении студентов из потока", zap.Error(err), zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении студентов из потока: %v", err)
+	}
+
+	s.logger.Info("Студенты удалены из потока", zap.Int64("cohort_id", req.CohortId))
+	return &proto.Empty{}, nil
+}
+
+func (s *CohortService) GetCohortStudents(ctx context.Context, req *proto.CohortIDRequest) (*proto.StudentList, error) {
+	s.logger.Info("Получение студентов потока", zap.Int64("cohort_id", req.CohortId))
+
+	if _, err := s.getCohortForInstructor(ctx, req.CohortId); err != nil {
+		return nil, err
+	}
+
+	students, err := s.cohortRepo.GetCohortStudents(ctx, req.CohortId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении студентов потока", zap.Error(err), zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении студентов потока: %v", err)
+	}
+
+	var grpcStudents []*proto.Student
+	for _, student := range students {
+		grpcStudents = append(grpcStudents, &proto.Student{
+			Id:    student.ID,
+			Name:  student.Name,
+			Email: student.Email,
+		})
+	}
+
+	s.logger.Info("Студенты потока успешно получены", zap.Int("count", len(grpcStudents)), zap.Int64("cohort_id", req.CohortId))
+	return &proto.StudentList{Students: grpcStudents}, nil
+}
+
+func (s *CohortService) GetCohortProgress(ctx context.Context, req *proto.CohortIDRequest) (*proto.CohortProgress, error) {
+	s.logger.Info("Получение прогресса потока", zap.Int64("cohort_id", req.CohortId))
+
+	cohort, err := s.getCohortForInstructor(ctx, req.CohortId)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.cohortRepo.GetCohortProgress(ctx, req.CohortId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении прогресса потока", zap.Error(err), zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении прогресса потока: %v", err)
+	}
+
+	resp := &proto.CohortProgress{CohortId: cohort.ID, CourseId: cohort.CourseID}
+	var total int32
+	for _, p := range progress {
+		percent := p.Percent()
+		total += percent
+		resp.Students = append(resp.Students, &proto.StudentProgress{
+			StudentId:         p.StudentID,
+			Name:              p.Name,
+			CompletedLectures: p.CompletedLectures,
+			TotalLectures:     p.TotalLectures,
+			CompletedPercent:  percent,
+		})
+	}
+	if len(progress) > 0 {
+		resp.AveragePercent = total / int32(len(progress))
+	}
+
+	s.logger.Info("Прогресс потока успешно получен", zap.Int64("cohort_id", req.CohortId), zap.Int32("average_percent", resp.AveragePercent))
+	return resp, nil
+}
+
+func (s *CohortService) GetCohortSchedule(ctx context.Context, req *proto.CohortIDRequest) (*proto.CohortSchedule, error) {
+	s.logger.Info("Получение расписания потока", zap.Int64("cohort_id", req.CohortId))
+
+	cohort, err := s.getCohort(ctx, req.CohortId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkScheduleAccess(ctx, cohort); err != nil {
+		return nil, err
+	}
+
+	lectures, err := s.lectureRepo.GetLecturesByCourse(ctx, cohort.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении лекций", zap.Error(err), zap.Int64("course_id", cohort.CourseID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении лекций: %v", err)
+	}
+
+	now := time.Now()
+	resp := &proto.CohortSchedule{CohortId: cohort.ID}
+	for _, lecture := range lectures {
+		release := &proto.LectureRelease{LectureId: lecture.ID, Title: lecture.Title, Released: true}
+		if releaseAt, ok := cohortReleaseDate(cohort, lecture); ok {
+			release.ReleaseDate = releaseAt.Format(dateLayout)
+			release.Released = !now.Before(releaseAt)
+		}
+		resp.Lectures = append(resp.Lectures, release)
+	}
+
+	s.logger.Info("Расписание потока успешно получено", zap.Int64("cohort_id", req.CohortId), zap.Int("count", len(resp.Lectures)))
+	return resp, nil
+}
+
+// cohortReleaseDate возвращает дату открытия лекции для потока, если для лекции задано смещение.
+func cohortReleaseDate(cohort *models.Cohort, lecture *models.Lecture) (time.Time, bool) {
+	if lecture.CohortOffsetDays == nil {
+		return time.Time{}, false
+	}
+	return cohort.StartDate.AddDate(0, 0, int(*lecture.CohortOffsetDays)), true
+}
+
+func (s *CohortService) getCohort(ctx context.Context, cohortID int64) (*models.Cohort, error) {
+	if cohortID == 0 {
+		s.logger.Warn("Некорректный ID потока", zap.Int64("cohort_id", cohortID))
+		return nil, status.Errorf(codes.InvalidArgument, "ID потока должен быть указан")
+	}
+
+	cohort, err := s.cohortRepo.GetCohortByID(ctx, cohortID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении потока", zap.Error(err), zap.Int64("cohort_id", cohortID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении потока: %v", err)
+	}
+	if cohort == nil {
+		s.logger.Warn("Поток не найден", zap.Int64("cohort_id", cohortID))
+		return nil, status.Errorf(codes.NotFound, "Поток с ID %d не найден", cohortID)
+	}
+	return cohort, nil
+}
+
+// getCohortForInstructor возвращает поток, если им может управлять текущий преподаватель:
+// владелец курса или наставник потока.
+func (s *CohortService) getCohortForInstructor(ctx context.Context, cohortID int64) (*models.Cohort, error) {
+	cohort, err := s.getCohort(ctx, cohortID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCohortInstructor(ctx, cohort); err != nil {
+		return nil, err
+	}
+	return cohort, nil
+}
+
+func (s *CohortService) checkCohortInstructor(ctx context.Context, cohort *models.Cohort) error {
+	course, err := s.courseRepo.GetCourseByID(ctx, cohort.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", cohort.CourseID))
+		return status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		return status.Errorf(codes.NotFound, "Курс с ID %d не найден", cohort.CourseID)
+	}
+
+	if err := checkInstructorAccess(ctx, append([]int64{course.InstructorID}, cohort.InstructorIDs...)...); err != nil {
+		s.logger.Warn("Нет доступа к потоку", zap.Int64("cohort_id", cohort.ID))
+		return err
+	}
+	return nil
+}
+
+// checkScheduleAccess пропускает к расписанию потока его студентов, а также владельца курса и наставников потока.
+func (s *CohortService) checkScheduleAccess(ctx context.Context, cohort *models.Cohort) error {
+	user, _ := middleware.UserFromContext(ctx)
+	if user.Role != "student" {
+		return s.checkCohortInstructor(ctx, cohort)
+	}
+
+	enrollment, err := s.enrollmentRepo.GetEnrollment(ctx, user.ID, cohort.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении записи на курс", zap.Error(err), zap.Int64("student_id", user.ID), zap.Int64("course_id", cohort.CourseID))
+		return status.Errorf(codes.Internal, "Ошибка при получении записи на курс: %v", err)
+	}
+	if enrollment == nil || enrollment.CohortID == nil || *enrollment.CohortID != cohort.ID {
+		s.logger.Warn("Студент не учится в потоке", zap.Int64("student_id", user.ID), zap.Int64("cohort_id", cohort.ID))
+		return status.Errorf(codes.PermissionDenied, "Расписание доступно только студентам потока")
+	}
+	return nil
+}
+
+func (s *CohortService) cohortFromRequest(name, startDate, endDate string, instructorIDs []int64) (*models.Cohort, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 255 {
+		s.logger.Warn("Некорректное название потока", zap.String("name", name))
+		return nil, status.Errorf(codes.InvalidArgument, "Название потока должно содержать от 1 до 255 символов")
+	}
+
+	start, err := time.Parse(dateLayout, startDate)
+	if err != nil {
+		s.logger.Warn("Некорректная дата начала потока", zap.String("start_date", startDate))
+		return nil, status.Errorf(codes.InvalidArgument, "Дата начала должна быть в формате YYYY-MM-DD")
+	}
+	end, err := time.Parse(dateLayout, endDate)
+	if err != nil {
+		s.logger.Warn("Некорректная дата окончания потока", zap.String("end_date", endDate))
+		return nil, status.Errorf(codes.InvalidArgument, "Дата окончания должна быть в формате YYYY-MM-DD")
+	}
+	if end.Before(start) {
+		s.logger.Warn("Дата окончания раньше даты начала", zap.String("start_date", startDate), zap.String("end_date", endDate))
+		return nil, status.Errorf(codes.InvalidArgument, "Дата окончания не может быть раньше даты начала")
+	}
+
+	return &models.Cohort{
+		Name:          name,
+		StartDate:     start,
+		EndDate:       end,
+		InstructorIDs: uniqueIDs(instructorIDs),
+	}, nil
+}
+
+func (s *CohortService) validateCohortStudents(req *proto.CohortStudentsRequest) ([]int64, error) {
+	if req.CohortId == 0 {
+		s.logger.Warn("Некорректный ID потока", zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID потока должен быть указан")
+	}
+
+	studentIDs := uniqueIDs(req.StudentIds)
+	if len(studentIDs) == 0 {
+		s.logger.Warn("Не указаны студенты", zap.Int64("cohort_id", req.CohortId))
+		return nil, status.Errorf(codes.InvalidArgument, "Список студентов не может быть пустым")
+	}
+	return studentIDs, nil
+}
+
+func (s *CohortService) cohortWriteError(err error, courseID int64) error {
+	switch {
+	case errors.Is(err, repository.ErrCohortNameTaken):
+		s.logger.Warn("Поток с таким названием уже существует", zap.Int64("course_id", courseID))
+		return status.Errorf(codes.AlreadyExists, "Поток с таким названием уже существует в курсе")
+	case errors.Is(err, repository.ErrCohortInstructorAbsent):
+		s.logger.Warn("Указан несуществующий преподаватель потока", zap.Int64("course_id", courseID))
+		return status.Errorf(codes.InvalidArgument, "Указан несуществующий преподаватель потока")
+	}
+	s.logger.Error("Ошибка при сохранении потока", zap.Error(err), zap.Int64("course_id", courseID))
+	return status.Errorf(codes.Internal, "Ошибка при сохранении потока: %v", err)
+}
+
+// uniqueIDs отбрасывает нулевые и повторяющиеся идентификаторы, сохраняя порядок.
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
+
+func cohortToProto(cohort *models.Cohort) *proto.Cohort {
+	return &proto.Cohort{
+		Id:            cohort.ID,
+		CourseId:      cohort.CourseID,
+		Name:          cohort.Name,
+		StartDate:     cohort.StartDate.Format(dateLayout),
+		EndDate:       cohort.EndDate.Format(dateLayout),
+		InstructorIds: cohort.InstructorIDs,
+		StudentCount:  cohort.StudentCount,
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func prepareCohortTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"cohort_instructors", "cohorts", "lecture_completions", "lectures"},
+		Instructors: 1,
+		Students:    2,
+		Courses:     []int64{1},
+		Enrollments: [][2]int64{{1, 1}, {2, 1}},
+	})
+}
+
+func TestCreateCohort(t *testing.T) {
+	ctx := context.Background()
+	prepareCohortTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	testCases := []struct {
+		Name         string
+		Request      *proto.CreateCohortRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Успешное создание потока",
+			Request: &proto.CreateCohortRequest{CourseId: 1, Name: "Весна", StartDate: "2025-03-01", EndDate: "2025-05-31", InstructorIds: []int64{1}},
+		},
+		{
+			Name:         "Повторное название потока",
+			Request:      &proto.CreateCohortRequest{CourseId: 1, Name: "Весна", StartDate: "2025-03-01", EndDate: "2025-05-31"},
+			ShouldError:  true,
+			ExpectedCode: codes.AlreadyExists,
+		},
+		{
+			Name:         "Дата окончания раньше даты начала",
+			Request:      &proto.CreateCohortRequest{CourseId: 1, Name: "Осень", StartDate: "2025-10-01", EndDate: "2025-09-01"},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Несуществующий преподаватель",
+			Request:      &proto.CreateCohortRequest{CourseId: 1, Name: "Лето", StartDate: "2025-06-01", EndDate: "2025-08-31", InstructorIds: []int64{99}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Несуществующий курс",
+			Request:      &proto.CreateCohortRequest{CourseId: 99, Name: "Зима", StartDate: "2025-12-01", EndDate: "2026-02-28"},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientCohort.CreateCohort(instructorCtx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова CreateCohort")
+			assert.NotZero(t, resp.Id, "ID потока должен быть заполнен")
+			assert.Equal(t, tc.Request.Name, resp.Name, "Название потока не совпадает")
+			assert.Equal(t, tc.Request.StartDate, resp.StartDate, "Дата начала не совпадает")
+			assert.Equal(t, tc.Request.InstructorIds, resp.InstructorIds, "Преподаватели потока не совпадают")
+		})
+	}
+
+	list, err := clientCohort.ListCohorts(instructorCtx, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка получения потоков")
+	assert.Len(t, list.Cohorts, 1, "Некорректное количество потоков")
+
+	_, err = clientCohort.ListCohorts(withToken(t, ctx, 2, "instructor"), &proto.CourseIDRequest{CourseId: 1})
+	require.Error(t, err, "Потоки чужого курса не должны быть доступны")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+}
+
+func TestAssignStudentsToCohort(t *testing.T) {
+	ctx := context.Background()
+	prepareCohortTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	_, err := db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES ($1, $2, $3, $4)", 3, "Студент 3", "student3@domain.com", "securepassword")
+	require.NoError(t, err, "Не удалось добавить студента без записи")
+
+	_, err = db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)", 1, 1, "Лекция 1", "Содержание 1", 2, 1, "Лекция 2", "Содержание 2")
+	require.NoError(t, err, "Не удалось добавить лекции")
+
+	_, err = db.Exec(ctx, "INSERT INTO lecture_completions (student_id, lecture_id) VALUES ($1, $2)", 1, 1)
+	require.NoError(t, err, "Не удалось добавить завершение лекции")
+
+	cohort, err := clientCohort.CreateCohort(instructorCtx, &proto.CreateCohortRequest{CourseId: 1, Name: "Весна", StartDate: "2025-03-01", EndDate: "2025-05-31"})
+	require.NoError(t, err, "Не удалось создать поток")
+
+	_, err = clientCohort.AssignStudentsToCohort(instructorCtx, &proto.CohortStudentsRequest{CohortId: cohort.Id, StudentIds: []int64{1, 3}})
+	require.Error(t, err, "Студент без записи на курс не должен попасть в поток")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+
+	_, err = clientCohort.AssignStudentsToCohort(instructorCtx, &proto.CohortStudentsRequest{CohortId: cohort.Id, StudentIds: []int64{1, 1}})
+	require.NoError(t, err, "Ошибка распределения студентов")
+
+	students, err := clientCohort.GetCohortStudents(instructorCtx, &proto.CohortIDRequest{CohortId: cohort.Id})
+	require.NoError(t, err, "Ошибка получения студентов потока")
+	require.Len(t, students.Students, 1, "В потоке должен быть один студент")
+	assert.Equal(t, int64(1), students.Students[0].Id, "ID студента не совпадает")
+
+	progress, err := clientCohort.GetCohortProgress(instructorCtx, &proto.CohortIDRequest{CohortId: cohort.Id})
+	require.NoError(t, err, "Ошибка получения прогресса потока")
+	require.Len(t, progress.Students, 1, "Некорректное количество студентов в прогрессе")
+	assert.Equal(t, int32(50), progress.Students[0].CompletedPercent, "Некорректный процент завершения")
+	assert.Equal(t, int32(50), progress.AveragePercent, "Некорректный средний процент")
+
+	_, err = clientCohort.RemoveStudentsFromCohort(instructorCtx, &proto.CohortStudentsRequest{CohortId: cohort.Id, StudentIds: []int64{1}})
+	require.NoError(t, err, "Ошибка удаления студента из потока")
+
+	students, err = clientCohort.GetCohortStudents(instructorCtx, &proto.CohortIDRequest{CohortId: cohort.Id})
+	require.NoError(t, err, "Ошибка получения студентов потока")
+	assert.Empty(t, students.Students, "Поток должен быть пустым")
+
+	var count int
+	err = db.QueryRow(ctx, "SELECT COUNT(*) FROM enrollments WHERE course_id = 1").Scan(&count)
+	require.NoError(t, err, "Ошибка проверки данных в базе")
+	assert.Equal(t, 2, count, "Записи на курс должны сохраниться")
+}
+
+func TestGetCohortSchedule(t *testing.T) {
+	ctx := context.Background()
+	prepareCohortTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	start := time.Now().AddDate(0, 0, -3)
+	cohort, err := clientCohort.CreateCohort(instructorCtx, &proto.CreateCohortRequest{
+		CourseId:  1,
+		Name:      "Текущий поток",
+		StartDate: start.Format(dateLayout),
+		EndDate:   start.AddDate(0, 3, 0).Format(dateLayout),
+	})
+	require.NoError(t, err, "Не удалось создать поток")
+
+	_, err = db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content, cohort_offset_days) VALUES ($1, $2, $3, $4, NULL), ($5, $6, $7, $8, $9), ($10, $11, $12, $13, $14)",
+		1, 1, "Вводная", "Содержание",
+		2, 1, "Неделя 1", "Содержание", 0,
+		3, 1, "Неделя 2", "Содержание", 7)
+	require.NoError(t, err, "Не удалось добавить лекции")
+
+	_, err = clientCohort.AssignStudentsToCohort(instructorCtx, &proto.CohortStudentsRequest{CohortId: cohort.Id, StudentIds: []int64{1}})
+	require.NoError(t, err, "Ошибка распределения студентов")
+
+	resp, err := clientCohort.GetCohortSchedule(withToken(t, ctx, 1, "student"), &proto.CohortIDRequest{CohortId: cohort.Id})
+	require.NoError(t, err, "Ошибка получения расписания")
+	require.Len(t, resp.Lectures, 3, "Некорректное количество лекций")
+
+	expected := map[int64]struct {
+		ReleaseDate string
+		Released    bool
+	}{
+		1: {"", true},
+		2: {start.Format(dateLayout), true},
+		3: {start.AddDate(0, 0, 7).Format(dateLayout), false},
+	}
+	for _, lecture := range resp.Lectures {
+		assert.Equal(t, expected[lecture.LectureId].ReleaseDate, lecture.ReleaseDate, "Дата открытия лекции %d не совпадает", lecture.LectureId)
+		assert.Equal(t, expected[lecture.LectureId].Released, lecture.Released, "Признак открытия лекции %d не совпадает", lecture.LectureId)
+	}
+
+	_, err = clientCohort.GetCohortSchedule(withToken(t, ctx, 2, "student"), &proto.CohortIDRequest{CohortId: cohort.Id})
+	require.Error(t, err, "Расписание не должно быть доступно студенту другого потока")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+
+	_, err = clientCohort.GetCohortSchedule(instructorCtx, &proto.CohortIDRequest{CohortId: 99})
+	require.Error(t, err, "Ожидалась ошибка для несуществующего потока")
+	assert.Equal(t, codes.NotFound, status.Code(err), "Некорректный код ошибки")
+}
@@ -3,7 +3,9 @@ package service
 import (
 	"GoEdu/internal/models"
 	"GoEdu/internal/repository"
+	"GoEdu/internal/textsim"
 	"GoEdu/proto"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -12,21 +14,27 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"slices"
 	"strings"
+	"time"
 )
 
 type EducationService struct {
 	proto.UnimplementedEducationServiceServer
-	db         *pgxpool.Pool
-	courseRepo repository.CourseRepository
-	logger     *zap.Logger
+	db              *pgxpool.Pool
+	courseRepo      repository.CourseRepository
+	trendingRepo    repository.TrendingRepository
+	trendingWindows []int32 // Окна популярности в днях; первое — окно по умолчанию.
+	logger          *zap.Logger
 }
 
-func NewEducationService(db *pgxpool.Pool, courseRepo repository.CourseRepository, logger *zap.Logger) *EducationService {
+func NewEducationService(db *pgxpool.Pool, courseRepo repository.CourseRepository, trendingRepo repository.TrendingRepository, trendingWindows []int32, logger *zap.Logger) *EducationService {
 	return &EducationService{
-		db:         db,
-		courseRepo: courseRepo,
-		logger:     logger,
+		db:              db,
+		courseRepo:      courseRepo,
+		trendingRepo:    trendingRepo,
+		trendingWindows: trendingWindows,
+		logger:          logger,
 	}
 }
 
@@ -48,10 +56,19 @@ func (s *EducationService) CreateCourse(ctx context.Context, req *proto.NewCours
 		return nil, status.Errorf(codes.InvalidArgument, "Описание курса не может быть пустым")
 	}
 
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		s.logger.Warn("Некорректные теги курса", zap.Error(err))
+		return nil, err
+	}
+
 	course := &models.Course{
 		Name:         req.Name,
 		Description:  req.Description,
 		InstructorID: req.InstructorId,
+		Sequential:   req.Sequential,
+		Category:     strings.TrimSpace(req.Category),
+		Tags:         tags,
 	}
 
 	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
@@ -86,17 +103,22 @@ func (s *EducationService) CreateCourse(ctx context.Context, req *proto.NewCours
 		return nil, status.Errorf(codes.Internal, "Ошибка при фиксации транзакции: %v", err)
 	}
 
+	invalidateSimilarCourses(ctx, s.courseRepo, s.logger)
+
 	s.logger.Info("Курс успешно создан", zap.Int64("course_id", int64(id)))
 	return &proto.Course{
 		Id:           int64(id),
 		Name:         course.Name,
 		Description:  course.Description,
 		InstructorId: course.InstructorID,
+		Sequential:   course.Sequential,
+		Category:     course.Category,
+		Tags:         course.Tags,
 	}, nil
 }
 
-func (s *EducationService) GetCourses(ctx context.Context, req *proto.Empty) (*proto.CourseList, error) {
-	s.logger.Info("Получение всех курсов")
+func (s *EducationService) GetCourses(ctx context.Context, req *proto.CoursesRequest) (*proto.CourseList, error) {
+	s.logger.Info("Получение всех курсов", zap.String("sort", req.Sort.String()))
 
 	courses, err := s.courseRepo.GetAllCourses(ctx)
 	if err != nil {
@@ -105,17 +127,7 @@ func (s *EducationService) GetCourses(ctx context.Context, req *proto.Empty) (*p
 	}
 
 	s.logger.Info("Курсы успешно получены", zap.Int("count", len(courses)))
-
-	var grpcCourses []*proto.Course
-	for _, c := range courses {
-		grpcCourses = append(grpcCourses, &proto.Course{
-			Id:          c.ID,
-			Name:        c.Name,
-			Description: c.Description,
-		})
-	}
-
-	return &proto.CourseList{Courses: grpcCourses}, nil
+	return s.courseList(ctx, courses, req.Sort)
 }
 
 func (s *EducationService) GetCourseByID(ctx context.Context, req *proto.CourseIDRequest) (*proto.Course, error) {
@@ -132,12 +144,14 @@ func (s *EducationService) GetCourseByID(ctx context.Context, req *proto.CourseI
 		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
 	}
 
+	mean, err := s.courseRepo.GetRatingMean(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении средней оценки курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении средней оценки курсов: %v", err)
+	}
+
 	s.logger.Info("Курс успешно получен", zap.Int64("course_id", req.CourseId))
-	return &proto.Course{
-		Id:          course.ID,
-		Name:        course.Name,
-		Description: course.Description,
-	}, nil
+	return courseToProto(course, mean), nil
 }
 
 func (s *EducationService) UpdateCourse(ctx context.Context, req *proto.UpdateCourseRequest) (*proto.Course, error) {
@@ -157,6 +171,19 @@ func (s *EducationService) UpdateCourse(ctx context.Context, req *proto.UpdateCo
 		s.logger.Warn("Пустое описание курса", zap.Int64("course_id", req.Id))
 		return nil, status.Errorf(codes.InvalidArgument, "Описание курса не может быть пустым")
 	}
+	update := repository.CourseUpdate{Name: req.Name, Description: req.Description, Sequential: req.Sequential}
+	if req.Category != nil {
+		category := strings.TrimSpace(*req.Category)
+		update.Category = &category
+	}
+	if req.Tags != nil {
+		tags, err := normalizeTags(req.Tags.Tags)
+		if err != nil {
+			s.logger.Warn("Некорректные теги курса", zap.Error(err), zap.Int64("course_id", req.Id))
+			return nil, err
+		}
+		update.Tags = tags
+	}
 
 	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -183,7 +210,7 @@ func (s *EducationService) UpdateCourse(ctx context.Context, req *proto.UpdateCo
 		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.Id)
 	}
 
-	updatedCourse, err := s.courseRepo.UpdateCourse(ctx, tx, req.Id, req.Name, req.Description)
+	updatedCourse, err := s.courseRepo.UpdateCourse(ctx, tx, req.Id, update)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			s.logger.Warn("Курс с таким названием уже существует", zap.String("name", req.Name))
@@ -193,11 +220,19 @@ func (s *EducationService) UpdateCourse(ctx context.Context, req *proto.UpdateCo
 		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении курса: %v", err)
 	}
 
+	if updatedCourse.Name != course.Name || updatedCourse.Description != course.Description {
+		invalidateSimilarCourses(ctx, s.courseRepo, s.logger)
+	}
+
 	s.logger.Info("Курс успешно обновлен", zap.Int64("course_id", updatedCourse.ID))
 	return &proto.Course{
-		Id:          updatedCourse.ID,
-		Name:        updatedCourse.Name,
-		Description: updatedCourse.Description,
+		Id:           updatedCourse.ID,
+		Name:         updatedCourse.Name,
+		Description:  updatedCourse.Description,
+		InstructorId: updatedCourse.InstructorID,
+		Sequential:   updatedCourse.Sequential,
+		Category:     updatedCourse.Category,
+		Tags:         updatedCourse.Tags,
 	}, nil
 }
 
@@ -219,6 +254,8 @@ func (s *EducationService) DeleteCourse(ctx context.Context, req *proto.CourseID
 		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
 	}
 
+	invalidateSimilarCourses(ctx, s.courseRepo, s.logger)
+
 	s.logger.Info("Курс успешно удален", zap.Int64("course_id", req.CourseId))
 	return &proto.Empty{}, nil
 }
@@ -237,16 +274,246 @@ func (s *EducationService) SearchCourses(ctx context.Context, req *proto.SearchR
 		return nil, status.Errorf(codes.Internal, "Ошибка при поиске курсов: %v", err)
 	}
 
-	var grpcCourses []*proto.Course
+	s.logger.Info("Курсы успешно найдены", zap.Int("count", len(courses)))
+	return s.courseList(ctx, courses, req.Sort)
+}
+
+// similarCoursesCached — сколько похожих курсов сохраняется в кэше для каждого курса.
+const similarCoursesCached = 20
+
+func (s *EducationService) GetSimilarCourses(ctx context.Context, req *proto.SimilarCoursesRequest) (*proto.SimilarCourseList, error) {
+	s.logger.Info("Получение похожих курсов", zap.Int64("course_id", req.CourseId), zap.Int32("limit", req.Limit))
+
+	if req.CourseId <= 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "Некорректный ID курса")
+	}
+	if req.Limit < 0 || req.Limit > similarCoursesCached {
+		s.logger.Warn("Некорректное число похожих курсов", zap.Int32("limit", req.Limit))
+		return nil, status.Errorf(codes.InvalidArgument, "Число курсов должно быть от 1 до %d", similarCoursesCached)
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 5
+	}
+
+	similar, cached, err := s.courseRepo.GetCachedSimilarCourses(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении похожих курсов", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении похожих курсов: %v", err)
+	}
+	if !cached {
+		similar, err = s.computeSimilarCourses(ctx, req.CourseId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mean, err := s.courseRepo.GetRatingMean(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении средней оценки курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении средней оценки курсов: %v", err)
+	}
+
+	resp := &proto.SimilarCourseList{}
+	for _, item := range similar[:min(limit, len(similar))] {
+		resp.Courses = append(resp.Courses, &proto.SimilarCourse{Course: courseToProto(item.Course, mean), Score: item.Score})
+	}
+
+	s.logger.Info("Похожие курсы успешно получены", zap.Int64("course_id", req.CourseId), zap.Bool("cached", cached), zap.Int("count", len(resp.Courses)))
+	return resp, nil
+}
+
+// computeSimilarCourses рассчитывает похожие курсы по текстам всех курсов и сохраняет их в кэш.
+// Поколение кэша читается до текстов: если тексты изменятся во время расчёта, результат не сохранится.
+func (s *EducationService) computeSimilarCourses(ctx context.Context, courseID int64) ([]*models.SimilarCourse, error) {
+	generation, err := s.courseRepo.GetSimilarCoursesGeneration(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении поколения кэша похожих курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении похожих курсов: %v", err)
+	}
+
+	documents, err := s.courseRepo.GetCourseDocuments(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении текстов курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении текстов курсов: %v", err)
+	}
+	if !slices.ContainsFunc(documents, func(document *models.CourseDocument) bool { return document.CourseID == courseID }) {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", courseID))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", courseID)
+	}
+
+	similar := textsim.Similar(documents, courseID, similarCoursesCached)
+	if err := s.courseRepo.CacheSimilarCourses(ctx, courseID, generation, similar); err != nil {
+		s.logger.Error("Ошибка при сохранении похожих курсов", zap.Error(err), zap.Int64("course_id", courseID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении похожих курсов: %v", err)
+	}
+
+	courses, err := s.courseRepo.GetAllCourses(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курсов: %v", err)
+	}
+	byID := make(map[int64]*models.Course, len(courses))
 	for _, course := range courses {
-		grpcCourses = append(grpcCourses, &proto.Course{
-			Id:           course.ID,
-			Name:         course.Name,
-			Description:  course.Description,
-			InstructorId: course.InstructorID,
+		byID[course.ID] = course
+	}
+	for _, item := range similar {
+		item.Course = byID[item.CourseID]
+	}
+	similar = slices.DeleteFunc(similar, func(item *models.SimilarCourse) bool { return item.Course == nil })
+	s.logger.Info("Похожие курсы рассчитаны", zap.Int64("course_id", courseID), zap.Int("courses", len(documents)), zap.Int("similar", len(similar)))
+	return similar, nil
+}
+
+func (s *EducationService) GetTrendingCourses(ctx context.Context, req *proto.TrendingRequest) (*proto.TrendingCourseList, error) {
+	s.logger.Info("Получение популярных курсов", zap.Int32("window_days", req.WindowDays), zap.Int32("limit", req.Limit))
+
+	if len(s.trendingWindows) == 0 {
+		s.logger.Warn("Окна популярности курсов не настроены")
+		return nil, status.Errorf(codes.FailedPrecondition, "Популярные курсы не рассчитываются")
+	}
+	windowDays := req.WindowDays
+	if windowDays == 0 {
+		windowDays = s.trendingWindows[0]
+	}
+	if !slices.Contains(s.trendingWindows, windowDays) {
+		s.logger.Warn("Некорректное окно популярности", zap.Int32("window_days", req.WindowDays))
+		return nil, status.Errorf(codes.InvalidArgument, "Окно %d дн. не поддерживается, доступны: %v", req.WindowDays, s.trendingWindows)
+	}
+	if req.Limit < 0 || req.Limit > 100 {
+		s.logger.Warn("Некорректное число популярных курсов", zap.Int32("limit", req.Limit))
+		return nil, status.Errorf(codes.InvalidArgument, "Число курсов должно быть от 1 до 100")
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+
+	trending, err := s.trendingRepo.GetTrending(ctx, windowDays, limit)
+	if err != nil {
+		s.logger.Error("Ошибка при получении популярных курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении популярных курсов: %v", err)
+	}
+	mean, err := s.courseRepo.GetRatingMean(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении средней оценки курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении средней оценки курсов: %v", err)
+	}
+
+	resp := &proto.TrendingCourseList{WindowDays: windowDays}
+	for _, course := range trending {
+		resp.ComputedAt = course.ComputedAt.Format(time.RFC3339)
+		resp.Courses = append(resp.Courses, &proto.TrendingCourse{
+			Course:        courseToProto(course.Course, mean),
+			Rank:          course.Rank,
+			Score:         course.Score,
+			Enrollments:   course.Enrollments,
+			Completions:   course.Completions,
+			Reviews:       course.Reviews,
+			AverageRating: course.AverageRating,
 		})
 	}
 
-	s.logger.Info("Курсы успешно найдены", zap.Int("count", len(grpcCourses)))
+	s.logger.Info("Популярные курсы успешно получены", zap.Int32("window_days", windowDays), zap.Int("count", len(resp.Courses)))
+	return resp, nil
+}
+
+// courseList переводит курсы в ответ API с рейтингами и упорядочивает их: при COURSE_SORT_RATING —
+// по сглаженной оценке, а при равенстве — по числу отзывов; при COURSE_SORT_TRENDING — по популярности
+// за окно по умолчанию, курсы без событий в окне идут последними.
+func (s *EducationService) courseList(ctx context.Context, courses []*models.Course, sort proto.CourseSort) (*proto.CourseList, error) {
+	mean, err := s.courseRepo.GetRatingMean(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении средней оценки курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении средней оценки курсов: %v", err)
+	}
+
+	var grpcCourses []*proto.Course
+	for _, course := range courses {
+		grpcCourses = append(grpcCourses, courseToProto(course, mean))
+	}
+	switch sort {
+	case proto.CourseSort_COURSE_SORT_RATING:
+		slices.SortStableFunc(grpcCourses, func(a, b *proto.Course) int {
+			if c := cmp.Compare(b.Rating.Score, a.Rating.Score); c != 0 {
+				return c
+			}
+			return cmp.Compare(b.Rating.Count, a.Rating.Count)
+		})
+	case proto.CourseSort_COURSE_SORT_TRENDING:
+		if len(s.trendingWindows) == 0 {
+			break
+		}
+		scores, err := s.trendingRepo.GetTrendingScores(ctx, s.trendingWindows[0])
+		if err != nil {
+			s.logger.Error("Ошибка при получении популярности курсов", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении популярности курсов: %v", err)
+		}
+		slices.SortStableFunc(grpcCourses, func(a, b *proto.Course) int {
+			scoreA, okA := scores[a.Id]
+			scoreB, okB := scores[b.Id]
+			if okA != okB {
+				if okA {
+					return -1
+				}
+				return 1
+			}
+			return cmp.Compare(scoreB, scoreA)
+		})
+	}
 	return &proto.CourseList{Courses: grpcCourses}, nil
 }
+
+func courseToProto(course *models.Course, mean float64) *proto.Course {
+	distribution := course.RatingCounts
+	if len(distribution) == 0 {
+		distribution = make([]int32, 5)
+	}
+	return &proto.Course{
+		Id:           course.ID,
+		Name:         course.Name,
+		Description:  course.Description,
+		InstructorId: course.InstructorID,
+		Sequential:   course.Sequential,
+		Category:     course.Category,
+		Tags:         course.Tags,
+		Rating: &proto.CourseRating{
+			Average:      course.AverageRating(),
+			Count:        course.RatingCount,
+			Distribution: distribution,
+			Score:        course.BayesianRating(mean),
+		},
+	}
+}
+
+// invalidateSimilarCourses сбрасывает кэш похожих курсов после удаления курса или изменения текстов курса и его лекций.
+// Ошибка только записывается в лог: изменение уже сохранено, а устаревший кэш не ломает ответы.
+func invalidateSimilarCourses(ctx context.Context, courseRepo repository.CourseRepository, logger *zap.Logger) {
+	if err := courseRepo.InvalidateSimilarCourses(ctx); err != nil {
+		logger.Error("Не удалось сбросить кэш похожих курсов", zap.Error(err))
+	}
+}
+
+// normalizeTags убирает пустые и повторяющиеся (без учёта регистра) теги курса и проверяет их длину.
+// Возвращает пустой, а не nil, срез, чтобы очистка тегов отличалась от их отсутствия в запросе.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := []string{}
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		if len([]rune(tag)) > 50 {
+			return nil, status.Errorf(codes.InvalidArgument, "Тег «%s» длиннее 50 символов", tag)
+		}
+		seen[key] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > 20 {
+		return nil, status.Errorf(codes.InvalidArgument, "У курса может быть не больше 20 тегов")
+	}
+	return normalized, nil
+}
@@ -6,9 +6,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc/status"
 
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
 	"GoEdu/proto"
 )
 
@@ -115,7 +119,7 @@ func TestGetCourses(t *testing.T) {
 
 	testCases := []struct {
 		Name            string
-		Request         *proto.Empty
+		Request         *proto.CoursesRequest
 		ExpectedCount   int
 		ExpectedCourses []struct {
 			Name        string
@@ -126,7 +130,7 @@ func TestGetCourses(t *testing.T) {
 	}{
 		{
 			Name:          "Успешное получение курсов",
-			Request:       &proto.Empty{},
+			Request:       &proto.CoursesRequest{},
 			ExpectedCount: 2,
 			ExpectedCourses: []struct {
 				Name        string
@@ -503,3 +507,274 @@ func TestSearchCourses(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTrendingCourses(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := db.Exec(ctx, "TRUNCATE TABLE course_trending, certificates, reviews, enrollments, courses, students, instructors RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы")
+
+	_, err = db.Exec(ctx, "INSERT INTO instructors (id, name, email, password) VALUES (1, 'Преподаватель', 'instructor@domain.com', 'securepassword')")
+	require.NoError(t, err, "Не удалось добавить преподавателя")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO courses (id, name, description, instructor_id)
+		SELECT id, 'Курс ' || id, 'Описание курса ' || id, 1 FROM generate_series(1, 4) AS id
+	`)
+	require.NoError(t, err, "Не удалось добавить курсы")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO students (id, name, email, password)
+		SELECT id, 'Студент ' || id, 'student' || id || '@domain.com', 'securepassword' FROM generate_series(1, 5) AS id
+	`)
+	require.NoError(t, err, "Не удалось добавить студентов")
+
+	// Курс 1 набрал записи больше месяца назад, курс 2 — сейчас и уже пройден, у курса 3 свежие записи,
+	// но отзыв с оценкой 1 обнуляет его популярность.
+	_, err = db.Exec(ctx, `
+		INSERT INTO enrollments (student_id, course_id, enrolled_at)
+		VALUES
+		(1, 1, NOW() - INTERVAL '40 days'), (2, 1, NOW() - INTERVAL '40 days'), (3, 1, NOW() - INTERVAL '40 days'),
+		(1, 2, NOW()), (2, 2, NOW()),
+		(4, 3, NOW() - INTERVAL '1 day'), (5, 3, NOW() - INTERVAL '1 day')
+	`)
+	require.NoError(t, err, "Не удалось добавить зачисления")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO certificates (serial, student_id, course_id, student_name, course_name, instructor_name, issued_at, signature, pdf)
+		VALUES ('TREND-1', 1, 2, 'Студент 1', 'Курс 2', 'Преподаватель', NOW(), 'signature', '')
+	`)
+	require.NoError(t, err, "Не удалось добавить сертификат")
+
+	_, err = db.Exec(ctx, "INSERT INTO reviews (student_id, course_id, comment, rating) VALUES (4, 3, 'Не понравилось', 1)")
+	require.NoError(t, err, "Не удалось добавить отзыв")
+
+	builder := jobs.NewTrendingBuilder(repository.NewTrendingRepository(db), []int32{7, 30, 90}, time.Minute, zapLogger)
+	require.NoError(t, builder.Rebuild(ctx), "Ошибка пересчёта популярных курсов")
+
+	testCases := []struct {
+		Name               string
+		Request            *proto.TrendingRequest
+		ExpectedWindow     int32
+		ExpectedIDs        []int64
+		ExpectedEnrollment []int32
+		ShouldError        bool
+		ExpectedCode       codes.Code
+	}{
+		{
+			Name:               "Окно по умолчанию",
+			Request:            &proto.TrendingRequest{},
+			ExpectedWindow:     7,
+			ExpectedIDs:        []int64{2, 3},
+			ExpectedEnrollment: []int32{2, 2},
+		},
+		{
+			Name:               "Окно 90 дней учитывает давние записи",
+			Request:            &proto.TrendingRequest{WindowDays: 90},
+			ExpectedWindow:     90,
+			ExpectedIDs:        []int64{2, 1, 3},
+			ExpectedEnrollment: []int32{2, 3, 2},
+		},
+		{
+			Name:               "Ограничение числа курсов",
+			Request:            &proto.TrendingRequest{WindowDays: 90, Limit: 1},
+			ExpectedWindow:     90,
+			ExpectedIDs:        []int64{2},
+			ExpectedEnrollment: []int32{2},
+		},
+		{
+			Name:         "Ненастроенное окно",
+			Request:      &proto.TrendingRequest{WindowDays: 14},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Слишком много курсов",
+			Request:      &proto.TrendingRequest{Limit: 101},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientEducation.GetTrendingCourses(ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				t.Logf("Полученный код ошибки: %v", st.Code())
+				return
+			}
+
+			require.NoError(t, err, "Ошибка при вызове GetTrendingCourses")
+			assert.Equal(t, tc.ExpectedWindow, resp.WindowDays, "Некорректное окно")
+			assert.NotEmpty(t, resp.ComputedAt, "Время пересчёта должно быть указано")
+			require.Len(t, resp.Courses, len(tc.ExpectedIDs), "Некорректное количество курсов")
+			for i, course := range resp.Courses {
+				assert.Equal(t, tc.ExpectedIDs[i], course.Course.Id, "Некорректный порядок курсов")
+				assert.Equal(t, int32(i+1), course.Rank, "Некорректное место курса")
+				assert.Equal(t, tc.ExpectedEnrollment[i], course.Enrollments, "Некорректное число записей")
+			}
+			assert.Equal(t, int32(1), resp.Courses[0].Completions, "Завершение курса должно учитываться")
+		})
+	}
+
+	t.Run("Сортировка каталога по популярности", func(t *testing.T) {
+		resp, err := clientEducation.GetCourses(ctx, &proto.CoursesRequest{Sort: proto.CourseSort_COURSE_SORT_TRENDING})
+		require.NoError(t, err, "Ошибка при вызове GetCourses")
+
+		var ids []int64
+		for _, course := range resp.Courses {
+			ids = append(ids, course.Id)
+		}
+		assert.Equal(t, []int64{2, 3, 1, 4}, ids, "Курсы без событий в окне должны идти последними")
+	})
+}
+
+func TestGetSimilarCourses(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := db.Exec(ctx, "TRUNCATE TABLE similar_courses_cache, lectures, courses, instructors RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы")
+
+	_, err = db.Exec(ctx, "INSERT INTO instructors (id, name, email, password) VALUES (1, 'Преподаватель', 'instructor@domain.com', 'securepassword')")
+	require.NoError(t, err, "Не удалось добавить преподавателя")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO courses (id, name, description, instructor_id)
+		VALUES
+		(1, 'Программирование на Go', 'Горутины, каналы и конкурентность в Go', 1),
+		(2, 'Конкурентность в Go', 'Каналы и горутины на практике', 1),
+		(3, 'Акварельная живопись', 'Рисование акварелью для начинающих', 1),
+		(4, 'Introduction to Go programming', 'Goroutines and channels', 1)
+	`)
+	require.NoError(t, err, "Не удалось добавить курсы")
+
+	_, err = db.Exec(ctx, "INSERT INTO lectures (course_id, title, content) VALUES (1, 'Горутины', 'Содержание'), (1, 'Каналы', 'Содержание')")
+	require.NoError(t, err, "Не удалось добавить лекции")
+
+	testCases := []struct {
+		Name         string
+		Request      *proto.SimilarCoursesRequest
+		ExpectedIDs  []int64
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:        "Похожие курсы по словам в разных формах",
+			Request:     &proto.SimilarCoursesRequest{CourseId: 1},
+			ExpectedIDs: []int64{2, 4},
+		},
+		{
+			Name:        "Повторный запрос из кэша с ограничением",
+			Request:     &proto.SimilarCoursesRequest{CourseId: 1, Limit: 1},
+			ExpectedIDs: []int64{2},
+		},
+		{
+			Name:        "Курс без похожих",
+			Request:     &proto.SimilarCoursesRequest{CourseId: 3},
+			ExpectedIDs: nil,
+		},
+		{
+			Name:         "Несуществующий курс",
+			Request:      &proto.SimilarCoursesRequest{CourseId: 99},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name:         "Некорректный ID курса",
+			Request:      &proto.SimilarCoursesRequest{CourseId: 0},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Слишком много курсов",
+			Request:      &proto.SimilarCoursesRequest{CourseId: 1, Limit: 21},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientEducation.GetSimilarCourses(ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				t.Logf("Полученный код ошибки: %v", st.Code())
+				return
+			}
+
+			require.NoError(t, err, "Ошибка при вызове GetSimilarCourses")
+			var ids []int64
+			for _, course := range resp.Courses {
+				ids = append(ids, course.Course.Id)
+				assert.Positive(t, course.Score, "Близость должна быть положительной")
+			}
+			assert.Equal(t, tc.ExpectedIDs, ids, "Некорректные похожие курсы")
+		})
+	}
+
+	cachedCourses := func(t *testing.T) int {
+		var count int
+		err := db.QueryRow(ctx, "SELECT COUNT(*) FROM similar_courses_cache").Scan(&count)
+		require.NoError(t, err, "Ошибка проверки кэша")
+		return count
+	}
+
+	t.Run("Изменение описания курса сбрасывает кэш", func(t *testing.T) {
+		require.Positive(t, cachedCourses(t), "Похожие курсы должны быть в кэше")
+
+		_, err := clientEducation.UpdateCourse(ctx, &proto.UpdateCourseRequest{Id: 3, Name: "Акварельная живопись", Description: "Рисуем акварелью, а потом пишем на Go"})
+		require.NoError(t, err, "Ошибка при обновлении курса")
+		assert.Zero(t, cachedCourses(t), "Кэш должен быть сброшен")
+
+		resp, err := clientEducation.GetSimilarCourses(ctx, &proto.SimilarCoursesRequest{CourseId: 3})
+		require.NoError(t, err, "Ошибка при вызове GetSimilarCourses")
+		assert.NotEmpty(t, resp.Courses, "После изменения описания у курса должны появиться похожие")
+	})
+
+	t.Run("Добавление лекции сбрасывает кэш", func(t *testing.T) {
+		require.Positive(t, cachedCourses(t), "Похожие курсы должны быть в кэше")
+
+		_, err := clientLecture.AddLectureToCourse(ctx, &proto.LectureRequest{CourseId: 2, Title: "Мьютексы", Content: "Содержание"})
+		require.NoError(t, err, "Ошибка при добавлении лекции")
+		assert.Zero(t, cachedCourses(t), "Кэш должен быть сброшен")
+	})
+
+	t.Run("Расчёт, начатый до сброса, не попадает в кэш", func(t *testing.T) {
+		courseRepo := repository.NewCourseRepository(db)
+		generation, err := courseRepo.GetSimilarCoursesGeneration(ctx)
+		require.NoError(t, err, "Ошибка получения поколения кэша")
+
+		require.NoError(t, courseRepo.InvalidateSimilarCourses(ctx), "Ошибка сброса кэша")
+		err = courseRepo.CacheSimilarCourses(ctx, 1, generation, []*models.SimilarCourse{{CourseID: 3, Score: 0.5}})
+		require.NoError(t, err, "Ошибка сохранения похожих курсов")
+		assert.Zero(t, cachedCourses(t), "Устаревший расчёт не должен сохраняться")
+
+		resp, err := clientEducation.GetSimilarCourses(ctx, &proto.SimilarCoursesRequest{CourseId: 1})
+		require.NoError(t, err, "Ошибка при вызове GetSimilarCourses")
+		assert.NotEmpty(t, resp.Courses, "Похожие курсы должны быть рассчитаны заново")
+		assert.Equal(t, 1, cachedCourses(t), "Свежий расчёт должен сохраняться")
+	})
+
+	t.Run("Удаление курса сбрасывает кэш", func(t *testing.T) {
+		_, err := clientEducation.GetSimilarCourses(ctx, &proto.SimilarCoursesRequest{CourseId: 1})
+		require.NoError(t, err, "Ошибка при вызове GetSimilarCourses")
+		require.Positive(t, cachedCourses(t), "Похожие курсы должны быть в кэше")
+
+		_, err = clientEducation.DeleteCourse(ctx, &proto.CourseIDRequest{CourseId: 2})
+		require.NoError(t, err, "Ошибка при удалении курса")
+		assert.Zero(t, cachedCourses(t), "Кэш должен быть сброшен")
+
+		resp, err := clientEducation.GetSimilarCourses(ctx, &proto.SimilarCoursesRequest{CourseId: 1})
+		require.NoError(t, err, "Ошибка при вызове GetSimilarCourses")
+		for _, course := range resp.Courses {
+			assert.NotEqual(t, int64(2), course.Course.Id, "Удалённый курс не должен попадать в похожие")
+		}
+	})
+}
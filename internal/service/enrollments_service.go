@@ -1,24 +1,54 @@
 package service
 
 import (
+	"GoEdu/internal/config"
+	"GoEdu/internal/mailer"
+	"GoEdu/internal/models"
 	"GoEdu/internal/repository"
 	"GoEdu/proto"
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	maxBulkEnrollRows  = 5000
+	invitationLifetime = 7 * 24 * time.Hour
+)
+
 type EnrollmentService struct {
 	proto.UnimplementedEnrollmentServiceServer
+	db             *pgxpool.Pool
 	enrollmentRepo repository.EnrollmentRepository
+	studentRepo    repository.StudentRepository
+	courseRepo     repository.CourseRepository
+	mailer         mailer.Mailer
+	cfg            *config.Config
 	logger         *zap.Logger
 }
 
-func NewEnrollmentService(enrollmentRepo repository.EnrollmentRepository, logger *zap.Logger) *EnrollmentService {
+func NewEnrollmentService(db *pgxpool.Pool, enrollmentRepo repository.EnrollmentRepository, studentRepo repository.StudentRepository, courseRepo repository.CourseRepository, mailer mailer.Mailer, cfg *config.Config, logger *zap.Logger) *EnrollmentService {
 	return &EnrollmentService{
+		db:             db,
 		enrollmentRepo: enrollmentRepo,
+		studentRepo:    studentRepo,
+		courseRepo:     courseRepo,
+		mailer:         mailer,
+		cfg:            cfg,
 		logger:         logger,
 	}
 }
@@ -113,3 +143,329 @@ func (s *EnrollmentService) GetCoursesByStudent(ctx context.Context, req *proto.
 	s.logger.Info("Курсы успешно получены", zap.Int("count", len(grpcCourses)), zap.Int64("student_id", req.Id))
 	return &proto.CourseList{Courses: grpcCourses}, nil
 }
+
+func (s *EnrollmentService) BulkEnroll(ctx context.Context, req *proto.BulkEnrollRequest) (*proto.BulkEnrollResponse, error) {
+	s.logger.Info("Массовая запись студентов на курс", zap.Int64("course_id", req.CourseId), zap.Bool("dry_run", req.DryRun), zap.Int("size", len(req.Csv)))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+	if len(bytes.TrimSpace(req.Csv)) == 0 {
+		s.logger.Warn("Пустой CSV-файл", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "CSV-файл не может быть пустым")
+	}
+
+	course, err := s.courseRepo.GetCourseByID(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Преподаватель не является владельцем курса", zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+
+	rows, err := parseEnrollmentCSV(req.Csv)
+	if err != nil {
+		s.logger.Warn("Некорректный CSV-файл", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "Некорректный CSV-файл: %v", err)
+	}
+
+	emails := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Error == "" {
+			emails = append(emails, row.Email)
+		}
+	}
+	existing, err := s.studentRepo.GetStudentsByEmails(ctx, emails)
+	if err != nil {
+		s.logger.Error("Ошибка при поиске студентов", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при поиске студентов: %v", err)
+	}
+
+	for _, row := range rows {
+		if row.Error != "" {
+			continue
+		}
+		student, ok := existing[row.Email]
+		if !ok {
+			if row.Name == "" {
+				row.Error = "имя обязательно для нового студента"
+				continue
+			}
+			row.StudentCreated = true
+			row.Enrolled = true
+			continue
+		}
+		row.StudentId = student.ID
+		enrolled, err := s.enrollmentRepo.IsEnrolled(ctx, student.ID, req.CourseId)
+		if err != nil {
+			s.logger.Error("Ошибка при проверке записи на курс", zap.Error(err), zap.Int64("student_id", student.ID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при проверке записи на курс: %v", err)
+		}
+		row.AlreadyEnrolled = enrolled
+		row.Enrolled = !enrolled
+	}
+
+	resp := &proto.BulkEnrollResponse{
+		CourseId:  req.CourseId,
+		DryRun:    req.DryRun,
+		TotalRows: int32(len(rows)),
+		Rows:      rows,
+	}
+
+	if req.DryRun || (req.AllOrNothing && countFailedRows(rows) > 0) {
+		if !req.DryRun {
+			resetBulkEnrollRows(rows)
+		}
+		fillBulkEnrollCounts(resp)
+		s.logger.Info("Массовая запись не применялась", zap.Int64("course_id", req.CourseId), zap.Bool("dry_run", req.DryRun), zap.Int32("failed", resp.FailedCount))
+		return resp, nil
+	}
+
+	invitations, err := s.applyBulkEnroll(ctx, req, rows)
+	if err != nil {
+		s.logger.Error("Ошибка при массовой записи на курс", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при массовой записи на курс: %v", err)
+	}
+	resp.Committed = invitations != nil
+	fillBulkEnrollCounts(resp)
+
+	for email, token := range invitations {
+		s.sendInvitation(ctx, course, email, token)
+	}
+
+	s.logger.Info("Массовая запись завершена", zap.Int64("course_id", req.CourseId), zap.Int32("enrolled", resp.EnrolledCount), zap.Int32("created", resp.CreatedCount), zap.Int32("failed", resp.FailedCount))
+	return resp, nil
+}
+
+// applyBulkEnroll выполняет запись в одной транзакции, оборачивая каждую строку в точку сохранения.
+// Возвращает токены приглашений для созданных студентов или nil, если транзакция была откачена.
+func (s *EnrollmentService) applyBulkEnroll(ctx context.Context, req *proto.BulkEnrollRequest, rows []*proto.BulkEnrollRowResult) (map[string]string, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	invitations := make(map[string]string)
+	for _, row := range rows {
+		if row.Error != "" || row.AlreadyEnrolled {
+			continue
+		}
+
+		token, err := s.enrollRow(ctx, tx, req.CourseId, row)
+		if err != nil {
+			s.logger.Warn("Строка CSV не обработана", zap.Error(err), zap.Int32("line", row.Line), zap.String("email", row.Email))
+			row.Error = err.Error()
+			if row.StudentCreated {
+				row.StudentCreated = false
+				row.StudentId = 0
+			}
+			row.Enrolled = false
+			if req.AllOrNothing {
+				resetBulkEnrollRows(rows)
+				return nil, nil
+			}
+			continue
+		}
+		if token != "" {
+			invitations[row.Email] = token
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+func (s *EnrollmentService) enrollRow(ctx context.Context, tx pgx.Tx, courseID int64, row *proto.BulkEnrollRowResult) (string, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer savepoint.Rollback(ctx)
+
+	var token string
+	if row.StudentCreated {
+		id, err := s.studentRepo.CreateInvitedStudent(ctx, savepoint, &models.Student{Name: row.Name, Email: row.Email})
+		if err != nil {
+			return "", fmt.Errorf("не удалось создать студента: %w", err)
+		}
+		row.StudentId = id
+
+		var tokenHash string
+		token, tokenHash, err = newInvitationToken()
+		if err != nil {
+			return "", err
+		}
+		invitation := &models.StudentInvitation{
+			TokenHash: tokenHash,
+			StudentID: id,
+			CourseID:  courseID,
+			ExpiresAt: time.Now().Add(invitationLifetime),
+		}
+		if err := s.studentRepo.CreateInvitation(ctx, savepoint, invitation); err != nil {
+			return "", fmt.Errorf("не удалось создать приглашение: %w", err)
+		}
+	}
+
+	enrolled, err := s.enrollmentRepo.EnrollStudentTx(ctx, savepoint, row.StudentId, courseID)
+	if err != nil {
+		return "", fmt.Errorf("не удалось записать на курс: %w", err)
+	}
+	row.Enrolled = enrolled
+	row.AlreadyEnrolled = !enrolled
+
+	return token, savepoint.Commit(ctx)
+}
+
+func (s *EnrollmentService) sendInvitation(ctx context.Context, course *models.Course, email, token string) {
+	link := strings.TrimRight(s.cfg.AppBaseURL, "/") + "/v1/students/invitations/accept?token=" + token
+	body := fmt.Sprintf("Здравствуйте!\n\nВас записали на курс «%s» в GoEdu.\nЧтобы задать пароль и начать обучение, перейдите по ссылке:\n%s\n\nСсылка действительна 7 дней.", course.Name, link)
+
+	if err := s.mailer.Send(ctx, email, "Приглашение на курс «"+course.Name+"»", body); err != nil {
+		s.logger.Error("Не удалось отправить приглашение", zap.Error(err), zap.String("email", email))
+	}
+}
+
+// parseEnrollmentCSV разбирает CSV с колонками email и name. Строка заголовка необязательна;
+// ошибки отдельных строк сохраняются в результате, а не прерывают разбор.
+func parseEnrollmentCSV(data []byte) ([]*proto.BulkEnrollRowResult, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if bytes.Count(data, []byte(";")) > bytes.Count(data, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	emailCol, nameCol := 0, 1
+	seen := make(map[string]int32)
+	var rows []*proto.BulkEnrollRowResult
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first {
+			if e, n, ok := enrollmentCSVHeader(record); ok {
+				emailCol, nameCol = e, n
+				continue
+			}
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		if len(rows) >= maxBulkEnrollRows {
+			return nil, fmt.Errorf("превышено максимальное количество строк: %d", maxBulkEnrollRows)
+		}
+
+		row := &proto.BulkEnrollRowResult{Line: int32(line)}
+		if emailCol < len(record) {
+			row.Email = strings.ToLower(strings.TrimSpace(record[emailCol]))
+		}
+		if nameCol < len(record) {
+			row.Name = strings.TrimSpace(record[nameCol])
+		}
+
+		switch {
+		case row.Email == "":
+			row.Error = "email не указан"
+		case !emailRegex.MatchString(row.Email):
+			row.Error = "некорректный формат email"
+		case row.Name != "" && !nameRegex.MatchString(row.Name):
+			row.Error = "имя может содержать только буквы, цифры, пробелы, дефисы и апострофы"
+		case seen[row.Email] != 0:
+			row.Error = fmt.Sprintf("email повторяется в строке %d", seen[row.Email])
+		default:
+			seen[row.Email] = row.Line
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New("файл не содержит строк с данными")
+	}
+	return rows, nil
+}
+
+func enrollmentCSVHeader(record []string) (emailCol, nameCol int, ok bool) {
+	emailCol, nameCol = -1, -1
+	for i, field := range record {
+		switch strings.ToLower(strings.TrimSpace(field)) {
+		case "email", "e-mail", "почта":
+			emailCol = i
+		case "name", "имя", "фио":
+			nameCol = i
+		}
+	}
+	if emailCol < 0 {
+		return 0, 1, false
+	}
+	if nameCol < 0 {
+		nameCol = len(record)
+	}
+	return emailCol, nameCol, true
+}
+
+func newInvitationToken() (token, tokenHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("не удалось сгенерировать токен приглашения: %w", err)
+	}
+	token = hex.EncodeToString(buf)
+	return token, hashInvitationToken(token), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func countFailedRows(rows []*proto.BulkEnrollRowResult) int {
+	failed := 0
+	for _, row := range rows {
+		if row.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// resetBulkEnrollRows помечает строки как необработанные после отката загрузки.
+func resetBulkEnrollRows(rows []*proto.BulkEnrollRowResult) {
+	for _, row := range rows {
+		row.Enrolled = false
+		if row.StudentCreated {
+			row.StudentCreated = false
+			row.StudentId = 0
+		}
+	}
+}
+
+func fillBulkEnrollCounts(resp *proto.BulkEnrollResponse) {
+	resp.EnrolledCount, resp.CreatedCount, resp.FailedCount = 0, 0, 0
+	for _, row := range resp.Rows {
+		if row.Enrolled {
+			resp.EnrolledCount++
+		}
+		if row.StudentCreated {
+			resp.CreatedCount++
+		}
+		if row.Error != "" {
+			resp.FailedCount++
+		}
+	}
+}
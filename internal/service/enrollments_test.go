@@ -326,3 +326,166 @@ func TestGetCoursesByStudent(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEnrollmentCSV(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Data           string
+		ShouldError    bool
+		ExpectedEmails []string
+		ExpectedErrors []bool
+	}{
+		{
+			Name:           "Файл с заголовком в произвольном порядке",
+			Data:           "name,email\nСтудент 1,Student1@Domain.com\nСтудент 2,student2@domain.com\n",
+			ExpectedEmails: []string{"student1@domain.com", "student2@domain.com"},
+			ExpectedErrors: []bool{false, false},
+		},
+		{
+			Name:           "Файл без заголовка с разделителем точка с запятой",
+			Data:           "student1@domain.com;Студент 1\nstudent2@domain.com;Студент 2\n",
+			ExpectedEmails: []string{"student1@domain.com", "student2@domain.com"},
+			ExpectedErrors: []bool{false, false},
+		},
+		{
+			Name:           "Ошибки в отдельных строках",
+			Data:           "email,name\nnot-an-email,Студент\nstudent1@domain.com,Студент 1\nstudent1@domain.com,Дубликат\n,Без почты\n",
+			ExpectedEmails: []string{"not-an-email", "student1@domain.com", "student1@domain.com", ""},
+			ExpectedErrors: []bool{true, false, true, true},
+		},
+		{
+			Name:        "Только заголовок",
+			Data:        "email,name\n",
+			ShouldError: true,
+		},
+		{
+			Name:        "Некорректные кавычки",
+			Data:        "email,name\n\"student1@domain.com,Студент\n",
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			rows, err := parseEnrollmentCSV([]byte(tc.Data))
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка разбора CSV")
+			require.Len(t, rows, len(tc.ExpectedEmails), "Некорректное количество строк")
+			for i, row := range rows {
+				assert.Equal(t, tc.ExpectedEmails[i], row.Email, "Email не совпадает")
+				assert.Equal(t, tc.ExpectedErrors[i], row.Error != "", "Некорректный признак ошибки: %s", row.Error)
+			}
+		})
+	}
+}
+
+func TestBulkEnroll(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := db.Exec(ctx, "TRUNCATE TABLE student_invitations, enrollments, courses, students RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы")
+
+	_, err = db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES ($1, $2, $3, $4)", 1, "Студент 1", "student1@domain.com", "securepassword")
+	require.NoError(t, err, "Не удалось добавить студента")
+	_, err = db.Exec(ctx, "SELECT setval('students_id_seq', 1)")
+	require.NoError(t, err, "Не удалось обновить последовательность")
+
+	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id) VALUES ($1, $2, $3, $4)", 1, "Курс 1", "Описание курса 1", 1)
+	require.NoError(t, err, "Не удалось добавить курс")
+
+	csvData := []byte("email,name\nstudent1@domain.com,Студент 1\nnew1@domain.com,Новый Студент\nbroken-email,Студент\n")
+
+	testCases := []struct {
+		Name             string
+		Request          *proto.BulkEnrollRequest
+		ExpectedEnrolled int32
+		ExpectedCreated  int32
+		ExpectedFailed   int32
+		ExpectedCommit   bool
+		ExpectedInDB     int
+		ShouldError      bool
+		ExpectedCode     codes.Code
+	}{
+		{
+			Name:             "Проверка без записи",
+			Request:          &proto.BulkEnrollRequest{CourseId: 1, Csv: csvData, DryRun: true},
+			ExpectedEnrolled: 2,
+			ExpectedCreated:  1,
+			ExpectedFailed:   1,
+			ExpectedInDB:     0,
+		},
+		{
+			Name:           "Всё или ничего при ошибке в строке",
+			Request:        &proto.BulkEnrollRequest{CourseId: 1, Csv: csvData, AllOrNothing: true},
+			ExpectedFailed: 1,
+			ExpectedInDB:   0,
+		},
+		{
+			Name:             "Запись с построчными ошибками",
+			Request:          &proto.BulkEnrollRequest{CourseId: 1, Csv: csvData},
+			ExpectedEnrolled: 2,
+			ExpectedCreated:  1,
+			ExpectedFailed:   1,
+			ExpectedCommit:   true,
+			ExpectedInDB:     2,
+		},
+		{
+			Name:           "Повторная загрузка не создаёт дублей",
+			Request:        &proto.BulkEnrollRequest{CourseId: 1, Csv: csvData},
+			ExpectedFailed: 1,
+			ExpectedCommit: true,
+			ExpectedInDB:   2,
+		},
+		{
+			Name:         "Несуществующий курс",
+			Request:      &proto.BulkEnrollRequest{CourseId: 99, Csv: csvData},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name:         "Пустой файл",
+			Request:      &proto.BulkEnrollRequest{CourseId: 1},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientEnrollments.BulkEnroll(withToken(t, ctx, 1, "instructor"), tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова BulkEnroll")
+			assert.Equal(t, int32(3), resp.TotalRows, "Некорректное количество строк")
+			assert.Equal(t, tc.ExpectedEnrolled, resp.EnrolledCount, "Некорректное количество записей")
+			assert.Equal(t, tc.ExpectedCreated, resp.CreatedCount, "Некорректное количество созданных аккаунтов")
+			assert.Equal(t, tc.ExpectedFailed, resp.FailedCount, "Некорректное количество ошибок")
+			assert.Equal(t, tc.ExpectedCommit, resp.Committed, "Некорректный признак сохранения")
+
+			var count int
+			err = db.QueryRow(ctx, "SELECT COUNT(*) FROM enrollments WHERE course_id = $1", tc.Request.CourseId).Scan(&count)
+			require.NoError(t, err, "Ошибка проверки данных в базе")
+			assert.Equal(t, tc.ExpectedInDB, count, "Некорректное количество записей в базе")
+		})
+	}
+
+	invitations := 0
+	for _, m := range mail.Sent() {
+		if m.To == "new1@domain.com" {
+			invitations++
+		}
+	}
+	assert.Equal(t, 1, invitations, "Приглашение должно быть отправлено один раз")
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"GoEdu/internal/events"
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// eventReplayPage — сколько сохранённых событий загружается за раз при возобновлении потока.
+const eventReplayPage = 500
+
+type EventService struct {
+	proto.UnimplementedEventServiceServer
+	outboxRepo repository.OutboxRepository
+	courseRepo repository.CourseRepository
+	broker     *events.Broker
+	logger     *zap.Logger
+}
+
+func NewEventService(outboxRepo repository.OutboxRepository, courseRepo repository.CourseRepository, broker *events.Broker, logger *zap.Logger) *EventService {
+	return &EventService{
+		outboxRepo: outboxRepo,
+		courseRepo: courseRepo,
+		broker:     broker,
+		logger:     logger,
+	}
+}
+
+// SubscribeEvents передаёт события курса или студента, пока клиент не отключится. Права те же, что
+// у обычных методов: студент получает события о себе и публичные события курсов, преподаватель — все события
+// своих курсов, администратор — любые. Заголовки ответа отправляются, когда поток подписан на новые события.
+func (s *EventService) SubscribeEvents(req *proto.SubscribeEventsRequest, stream proto.EventService_SubscribeEventsServer) error {
+	ctx := stream.Context()
+	s.logger.Info("Подписка на события", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId),
+		zap.Strings("event_types", req.EventTypes), zap.String("last_event_id", req.LastEventId))
+
+	if err := s.checkSubscribeAccess(ctx, req); err != nil {
+		return err
+	}
+
+	live, unsubscribe := s.broker.Subscribe()
+	defer unsubscribe()
+
+	filter := &eventFilter{req: req, courseRepo: s.courseRepo, instructors: make(map[int64]int64)}
+	filter.user, filter.authenticated = middleware.UserFromContext(ctx)
+
+	// Подписка оформляется до чтения сохранённых событий, чтобы между ними не было разрыва;
+	// события, уже переданные из outbox, в живом потоке пропускаются.
+	var lastReplayed int64
+	if req.LastEventId != "" {
+		cursor := req.LastEventId
+		for {
+			batch, found, err := s.outboxRepo.GetEventsAfter(ctx, cursor, eventReplayPage)
+			if err != nil {
+				s.logger.Error("Ошибка при чтении сохранённых событий", zap.Error(err))
+				return status.Errorf(codes.Internal, "Ошибка при чтении сохранённых событий: %v", err)
+			}
+			if !found {
+				s.logger.Warn("Событие для возобновления потока не найдено", zap.String("last_event_id", cursor))
+				return status.Errorf(codes.OutOfRange, "Событие %s не найдено: оно устарело или не существует; загрузите данные заново и подпишитесь без last_event_id", cursor)
+			}
+			for _, event := range batch {
+				if err := s.send(stream, filter, event); err != nil {
+					return err
+				}
+				lastReplayed = event.ID
+			}
+			if len(batch) < eventReplayPage {
+				break
+			}
+			cursor = batch[len(batch)-1].EventID
+		}
+	}
+	if err := stream.SendHeader(metadata.Pairs("x-subscribed-at", time.Now().UTC().Format(time.RFC3339))); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Подписчик отключился от потока событий")
+			return nil
+		case event, ok := <-live:
+			if !ok {
+				s.logger.Warn("Подписчик не успевает получать события, поток закрыт")
+				return status.Errorf(codes.ResourceExhausted, "Клиент не успевает получать события; переподключитесь с last_event_id")
+			}
+			if event.ID <= lastReplayed {
+				continue
+			}
+			if err := s.send(stream, filter, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// checkSubscribeAccess проверяет фильтры подписки так же, как соответствующие обычные методы:
+// студент не может подписаться на события другого студента, а преподаватель — на чужой курс.
+func (s *EventService) checkSubscribeAccess(ctx context.Context, req *proto.SubscribeEventsRequest) error {
+	if req.CourseId == 0 && req.StudentId == 0 {
+		return status.Errorf(codes.InvalidArgument, "Укажите курс или студента")
+	}
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(models.EventTypes, eventType) {
+			return status.Errorf(codes.InvalidArgument, "Неизвестный тип события %q", eventType)
+		}
+	}
+	if req.StudentId != 0 {
+		if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+			s.logger.Warn("Попытка подписаться на события другого студента", zap.Int64("student_id", req.StudentId))
+			return err
+		}
+	}
+
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Требуется аутентификация")
+	}
+	if user.Role == "instructor" && req.CourseId == 0 {
+		return status.Errorf(codes.InvalidArgument, "Преподаватель может подписаться только на события своего курса")
+	}
+	// Студенту по курсу приходят только публичные события и его собственные — это проверяет eventFilter.
+	if req.CourseId == 0 || user.Role == "student" {
+		return nil
+	}
+
+	course, err := s.courseRepo.GetCourseByID(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		return status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Попытка подписаться на события чужого курса", zap.Int64("course_id", req.CourseId))
+		return err
+	}
+	return nil
+}
+
+func (s *EventService) send(stream proto.EventService_SubscribeEventsServer, filter *eventFilter, event *models.OutboxEvent) error {
+	scope, err := event.Scope()
+	if err != nil {
+		s.logger.Warn("Некорректные данные события", zap.Error(err), zap.String("event_id", event.EventID))
+		return nil
+	}
+	if !filter.matches(stream.Context(), event, scope) {
+		return nil
+	}
+	return stream.Send(&proto.DomainEvent{
+		Id:            event.EventID,
+		Type:          event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateID,
+		CourseId:      scope.CourseID,
+		StudentId:     scope.StudentID,
+		CreatedAt:     event.CreatedAt.Format(time.RFC3339),
+		Payload:       string(event.Payload),
+	})
+}
+
+// eventFilter отбирает события по фильтрам подписки и правам пользователя.
+type eventFilter struct {
+	req           *proto.SubscribeEventsRequest
+	user          middleware.User
+	authenticated bool
+	courseRepo    repository.CourseRepository
+	instructors   map[int64]int64 // Кэш преподавателей курсов.
+}
+
+func (f *eventFilter) matches(ctx context.Context, event *models.OutboxEvent, scope models.EventScope) bool {
+	if len(f.req.EventTypes) > 0 && !slices.Contains(f.req.EventTypes, event.EventType) {
+		return false
+	}
+	if f.req.CourseId != 0 && scope.CourseID != f.req.CourseId {
+		return false
+	}
+	if f.req.StudentId != 0 && scope.StudentID != f.req.StudentId {
+		return false
+	}
+	if models.IsPublicEvent(event.EventType) {
+		return true
+	}
+	if !f.authenticated {
+		return false
+	}
+
+	switch f.user.Role {
+	case "admin":
+		return true
+	case "student":
+		return scope.StudentID == f.user.ID
+	case "instructor":
+		return scope.CourseID != 0 && f.courseInstructor(ctx, scope.CourseID) == f.user.ID
+	}
+	return false
+}
+
+func (f *eventFilter) courseInstructor(ctx context.Context, courseID int64) int64 {
+	if id, ok := f.instructors[courseID]; ok {
+		return id
+	}
+	course, err := f.courseRepo.GetCourseByID(ctx, courseID)
+	if err != nil || course == nil {
+		return 0
+	}
+	f.instructors[courseID] = course.InstructorID
+	return course.InstructorID
+}
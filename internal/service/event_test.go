@@ -0,0 +1,171 @@
+package service
+
+import (
+	"GoEdu/internal/events"
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func prepareEventTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"outbox_events"},
+		Instructors: 2,
+		Students:    2,
+		Courses:     []int64{1, 2},
+	})
+}
+
+// subscribeEvents открывает поток и дожидается заголовков, после которых сервер уже подписан на новые события.
+func subscribeEvents(t *testing.T, ctx context.Context, req *proto.SubscribeEventsRequest) (proto.EventService_SubscribeEventsClient, error) {
+	t.Helper()
+	stream, err := clientEvents.SubscribeEvents(ctx, req)
+	require.NoError(t, err, "Ошибка открытия потока событий")
+	if header, _ := stream.Header(); header == nil {
+		_, err = stream.Recv()
+		return nil, err
+	}
+	return stream, nil
+}
+
+func receiveEvents(t *testing.T, stream proto.EventService_SubscribeEventsClient, count int) []*proto.DomainEvent {
+	t.Helper()
+	received := make([]*proto.DomainEvent, 0, count)
+	for len(received) < count {
+		event, err := stream.Recv()
+		require.NoError(t, err, "Ошибка получения события")
+		received = append(received, event)
+	}
+	return received
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	ctx := context.Background()
+	prepareEventTables(t, ctx)
+
+	bus := events.NewBus()
+	bus.Subscribe(eventBroker)
+	relay := jobs.NewOutboxRelay(repository.NewOutboxRepository(db), bus, 100, time.Hour, time.Minute, zapLogger)
+
+	var firstEventID string
+
+	t.Run("Студент получает свои и публичные события курса", func(t *testing.T) {
+		streamCtx, cancel := context.WithTimeout(withToken(t, ctx, 1, "student"), 10*time.Second)
+		defer cancel()
+		stream, err := subscribeEvents(t, streamCtx, &proto.SubscribeEventsRequest{CourseId: 1})
+		require.NoError(t, err, "Ошибка подписки на события")
+
+		_, err = clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: 2, CourseId: 1})
+		require.NoError(t, err, "Ошибка записи студента 2")
+		_, err = clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: 1, CourseId: 1})
+		require.NoError(t, err, "Ошибка записи студента 1")
+		_, err = clientEducation.UpdateCourse(ctx, &proto.UpdateCourseRequest{Id: 1, Name: "Курс 1", Description: "Новое описание"})
+		require.NoError(t, err, "Ошибка обновления курса")
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+
+		received := receiveEvents(t, stream, 2)
+		assert.Equal(t, models.EventStudentEnrolled, received[0].Type, "Первым должно прийти событие записи")
+		assert.Equal(t, int64(1), received[0].StudentId, "Студент не должен видеть запись другого студента")
+		assert.Equal(t, int64(1), received[0].CourseId, "Некорректный курс события")
+		assert.NotEmpty(t, received[0].Payload, "Данные события не заполнены")
+		assert.Equal(t, models.EventCourseUpdated, received[1].Type, "Изменение курса должно быть видно студенту")
+
+		err = db.QueryRow(ctx, "SELECT event_id FROM outbox_events ORDER BY id LIMIT 1").Scan(&firstEventID)
+		require.NoError(t, err, "Ошибка проверки данных в базе")
+	})
+
+	t.Run("Возобновление потока с last_event_id", func(t *testing.T) {
+		streamCtx, cancel := context.WithTimeout(withToken(t, ctx, 1, "instructor"), 10*time.Second)
+		defer cancel()
+		stream, err := subscribeEvents(t, streamCtx, &proto.SubscribeEventsRequest{CourseId: 1, LastEventId: firstEventID})
+		require.NoError(t, err, "Ошибка подписки на события")
+
+		received := receiveEvents(t, stream, 2)
+		assert.Equal(t, models.EventStudentEnrolled, received[0].Type, "Некорректный порядок событий")
+		assert.Equal(t, int64(1), received[0].StudentId, "Преподаватель должен видеть записи на свой курс")
+		assert.Equal(t, models.EventCourseUpdated, received[1].Type, "Некорректный порядок событий")
+
+		_, err = clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: 2, CourseId: 2})
+		require.NoError(t, err, "Ошибка записи на чужой курс")
+		_, err = clientEnrollments.UnEnrollStudent(ctx, &proto.UnEnrollRequest{StudentId: 2, CourseId: 1})
+		require.NoError(t, err, "Ошибка отписки студента")
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+
+		received = receiveEvents(t, stream, 1)
+		assert.Equal(t, models.EventStudentUnenrolled, received[0].Type, "События чужого курса не должны приходить")
+	})
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.SubscribeEventsRequest
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:         "События другого студента",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.SubscribeEventsRequest{StudentId: 2},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Чужой курс преподавателя",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.SubscribeEventsRequest{CourseId: 2},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Без фильтров",
+			Ctx:          withToken(t, ctx, 1, "admin"),
+			Request:      &proto.SubscribeEventsRequest{},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Без авторизации",
+			Ctx:          ctx,
+			Request:      &proto.SubscribeEventsRequest{CourseId: 1},
+			ExpectedCode: codes.Unauthenticated,
+		},
+		{
+			Name:         "Неизвестный тип события",
+			Ctx:          withToken(t, ctx, 1, "admin"),
+			Request:      &proto.SubscribeEventsRequest{CourseId: 1, EventTypes: []string{"course.exploded"}},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Несуществующий курс",
+			Ctx:          withToken(t, ctx, 1, "admin"),
+			Request:      &proto.SubscribeEventsRequest{CourseId: 99},
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name:         "Неизвестное событие для возобновления",
+			Ctx:          withToken(t, ctx, 1, "admin"),
+			Request:      &proto.SubscribeEventsRequest{CourseId: 1, LastEventId: "00000000-0000-0000-0000-000000000000"},
+			ExpectedCode: codes.OutOfRange,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			streamCtx, cancel := context.WithTimeout(tc.Ctx, 10*time.Second)
+			defer cancel()
+			_, err := subscribeEvents(t, streamCtx, tc.Request)
+			require.Error(t, err, "Ожидалась ошибка, но её не было")
+			st, ok := status.FromError(err)
+			require.True(t, ok, "Ошибка не является статусной")
+			assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			t.Logf("Полученный код ошибки: %v", st.Code())
+		})
+	}
+}
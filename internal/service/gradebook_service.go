@@ -0,0 +1,663 @@
+package service
+
+import (
+	"GoEdu/internal/gradebook"
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var gradeItemTypes = map[proto.GradeItemType]string{
+	proto.GradeItemType_GRADE_ITEM_TYPE_QUIZ:       models.GradeItemQuiz,
+	proto.GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT: models.GradeItemAssignment,
+	proto.GradeItemType_GRADE_ITEM_TYPE_FINAL:      models.GradeItemFinal,
+}
+
+type GradebookService struct {
+	proto.UnimplementedGradebookServiceServer
+	gradebookRepo  repository.GradebookRepository
+	courseRepo     repository.CourseRepository
+	enrollmentRepo repository.EnrollmentRepository
+	logger         *zap.Logger
+}
+
+func NewGradebookService(gradebookRepo repository.GradebookRepository, courseRepo repository.CourseRepository, enrollmentRepo repository.EnrollmentRepository, logger *zap.Logger) *GradebookService {
+	return &GradebookService{
+		gradebookRepo:  gradebookRepo,
+		courseRepo:     courseRepo,
+		enrollmentRepo: enrollmentRepo,
+		logger:         logger,
+	}
+}
+
+func (s *GradebookService) CreateGradeCategory(ctx context.Context, req *proto.CreateGradeCategoryRequest) (*proto.GradeCategory, error) {
+	s.logger.Info("Создание категории оценок", zap.Int64("course_id", req.CourseId), zap.String("name", req.Name))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+	category, err := gradeCategoryFromRequest(req.Name, req.Weight)
+	if err != nil {
+		s.logger.Warn("Некорректные данные категории", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+	category.CourseID = req.CourseId
+
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	if err := s.gradebookRepo.CreateCategory(ctx, category); err != nil {
+		s.logger.Error("Ошибка при создании категории", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при создании категории: %v", err)
+	}
+
+	s.logger.Info("Категория оценок создана", zap.Int64("category_id", category.ID), zap.Int64("course_id", req.CourseId))
+	return gradeCategoryToProto(category), nil
+}
+
+func (s *GradebookService) UpdateGradeCategory(ctx context.Context, req *proto.UpdateGradeCategoryRequest) (*proto.GradeCategory, error) {
+	s.logger.Info("Обновление категории оценок", zap.Int64("category_id", req.Id))
+
+	if req.Id == 0 {
+		s.logger.Warn("Некорректный ID категории", zap.Int64("category_id", req.Id))
+		return nil, status.Errorf(codes.InvalidArgument, "ID категории должен быть указан")
+	}
+	category, err := gradeCategoryFromRequest(req.Name, req.Weight)
+	if err != nil {
+		s.logger.Warn("Некорректные данные категории", zap.Error(err), zap.Int64("category_id", req.Id))
+		return nil, err
+	}
+	category.ID = req.Id
+
+	if _, err := s.getCategory(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.gradebookRepo.UpdateCategory(ctx, category); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Warn("Категория не найдена", zap.Int64("category_id", req.Id))
+			return nil, status.Errorf(codes.NotFound, "Категория с ID %d не найдена", req.Id)
+		}
+		s.logger.Error("Ошибка при обновлении категории", zap.Error(err), zap.Int64("category_id", req.Id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении категории: %v", err)
+	}
+
+	s.logger.Info("Категория оценок обновлена", zap.Int64("category_id", req.Id))
+	return gradeCategoryToProto(category), nil
+}
+
+func (s *GradebookService) DeleteGradeCategory(ctx context.Context, req *proto.GradeCategoryIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление категории оценок", zap.Int64("category_id", req.CategoryId))
+
+	if req.CategoryId == 0 {
+		s.logger.Warn("Некорректный ID категории", zap.Int64("category_id", req.CategoryId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID категории должен быть указан")
+	}
+	if _, err := s.getCategory(ctx, req.CategoryId); err != nil {
+		return nil, err
+	}
+
+	if err := s.gradebookRepo.DeleteCategory(ctx, req.CategoryId); err != nil {
+		s.logger.Error("Ошибка при удалении категории", zap.Error(err), zap.Int64("category_id", req.CategoryId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении категории: %v", err)
+	}
+
+	s.logger.Info("Категория оценок удалена", zap.Int64("category_id", req.CategoryId))
+	return &proto.Empty{}, nil
+}
+
+func (s *GradebookService) ListGradeCategories(ctx context.Context, req *proto.CourseIDRequest) (*proto.GradeCategoryList, error) {
+	s.logger.Info("Получение категорий оценок", zap.Int64("course_id", req.CourseId))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.gradebookRepo.GetCategoriesByCourse(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении категорий", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении категорий: %v", err)
+	}
+
+	resp := &proto.GradeCategoryList{}
+	for _, category := range categories {
+		resp.Categories = append(resp.Categories, gradeCategoryToProto(category))
+	}
+	return resp, nil
+}
+
+func (s *GradebookService) SetGradeItemCategory(ctx context.Context, req *proto.SetGradeItemCategoryRequest) (*proto.GradeItem, error) {
+	s.logger.Info("Изменение категории элемента журнала", zap.Int64("course_id", req.CourseId),
+		zap.String("item_type", req.ItemType.String()), zap.Int64("item_id", req.ItemId), zap.Int64("category_id", req.CategoryId))
+
+	if req.CourseId == 0 || req.ItemId == 0 {
+		s.logger.Warn("Некорректный ID курса или элемента", zap.Int64("course_id", req.CourseId), zap.Int64("item_id", req.ItemId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса и ID элемента должны быть указаны")
+	}
+	itemType := gradeItemTypes[req.ItemType]
+	if itemType != models.GradeItemQuiz && itemType != models.GradeItemAssignment {
+		s.logger.Warn("Некорректный тип элемента", zap.String("item_type", req.ItemType.String()))
+		return nil, status.Errorf(codes.InvalidArgument, "Категорию можно задать только тесту или заданию")
+	}
+
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	var categoryID *int64
+	if req.CategoryId != 0 {
+		category, err := s.getCategory(ctx, req.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		if category.CourseID != req.CourseId {
+			s.logger.Warn("Категория другого курса", zap.Int64("category_id", req.CategoryId), zap.Int64("course_id", req.CourseId))
+			return nil, status.Errorf(codes.InvalidArgument, "Категория с ID %d относится к другому курсу", req.CategoryId)
+		}
+		categoryID = &category.ID
+	}
+
+	updated, err := s.gradebookRepo.SetItemCategory(ctx, req.CourseId, itemType, req.ItemId, categoryID)
+	if err != nil {
+		s.logger.Error("Ошибка при изменении категории элемента", zap.Error(err), zap.Int64("item_id", req.ItemId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при изменении категории элемента: %v", err)
+	}
+	if !updated {
+		s.logger.Warn("Элемент журнала не найден", zap.String("item_type", itemType), zap.Int64("item_id", req.ItemId))
+		return nil, status.Errorf(codes.NotFound, "Элемент с ID %d не найден в курсе", req.ItemId)
+	}
+
+	items, err := s.gradebookRepo.GetItems(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении элементов журнала", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении элементов журнала: %v", err)
+	}
+	index := slices.IndexFunc(items, func(item *models.GradeItem) bool { return item.Type == itemType && item.ID == req.ItemId })
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "Элемент с ID %d не найден в курсе", req.ItemId)
+	}
+
+	s.logger.Info("Категория элемента журнала изменена", zap.String("item_type", itemType), zap.Int64("item_id", req.ItemId))
+	return gradeItemToProto(items[index]), nil
+}
+
+func (s *GradebookService) GetLetterScheme(ctx context.Context, req *proto.CourseIDRequest) (*proto.LetterScheme, error) {
+	s.logger.Info("Получение шкалы оценок", zap.Int64("course_id", req.CourseId))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	letters, err := s.gradebookRepo.GetLetterScheme(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении шкалы оценок", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении шкалы оценок: %v", err)
+	}
+	return letterSchemeToProto(req.CourseId, letters), nil
+}
+
+// SetLetterScheme заменяет шкалу буквенных оценок курса. Буквы не должны повторяться, пороги лежат
+// в диапазоне 0–100 и тоже не повторяются, а одна из оценок должна иметь порог 0, чтобы оценка была у каждого.
+func (s *GradebookService) SetLetterScheme(ctx context.Context, req *proto.SetLetterSchemeRequest) (*proto.LetterScheme, error) {
+	s.logger.Info("Изменение шкалы оценок", zap.Int64("course_id", req.CourseId), zap.Int("letters", len(req.Letters)))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	letters := make([]*models.LetterGrade, 0, len(req.Letters))
+	seenLetters := make(map[string]bool, len(req.Letters))
+	seenThresholds := make(map[float64]bool, len(req.Letters))
+	for _, letter := range req.Letters {
+		name := strings.TrimSpace(letter.Letter)
+		if name == "" || len([]rune(name)) > 8 {
+			return nil, status.Errorf(codes.InvalidArgument, "Буквенная оценка должна содержать от 1 до 8 символов")
+		}
+		if letter.MinPercent < 0 || letter.MinPercent > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "Порог оценки %s должен быть от 0 до 100", name)
+		}
+		if seenLetters[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "Оценка %s указана несколько раз", name)
+		}
+		if seenThresholds[letter.MinPercent] {
+			return nil, status.Errorf(codes.InvalidArgument, "Порог %g указан для нескольких оценок", letter.MinPercent)
+		}
+		seenLetters[name], seenThresholds[letter.MinPercent] = true, true
+		letters = append(letters, &models.LetterGrade{Letter: name, MinPercent: letter.MinPercent})
+	}
+	if len(letters) > 0 && !seenThresholds[0] {
+		s.logger.Warn("В шкале нет оценки с порогом 0", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "Одна из оценок должна иметь порог 0")
+	}
+	gradebook.SortLetters(letters)
+
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	if err := s.gradebookRepo.SetLetterScheme(ctx, req.CourseId, letters); err != nil {
+		s.logger.Error("Ошибка при сохранении шкалы оценок", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении шкалы оценок: %v", err)
+	}
+
+	s.logger.Info("Шкала оценок изменена", zap.Int64("course_id", req.CourseId))
+	return letterSchemeToProto(req.CourseId, letters), nil
+}
+
+// GetGradebook возвращает журнал курса: категории, элементы, шкалу и оценки всех записанных студентов.
+func (s *GradebookService) GetGradebook(ctx context.Context, req *proto.CourseIDRequest) (*proto.Gradebook, error) {
+	s.logger.Info("Получение журнала оценок", zap.Int64("course_id", req.CourseId))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	course, grades, err := s.computeGrades(ctx, req.CourseId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.Gradebook{CourseId: req.CourseId}
+	for _, category := range course.Categories {
+		resp.Categories = append(resp.Categories, gradeCategoryToProto(category))
+	}
+	for _, item := range course.Items {
+		resp.Items = append(resp.Items, gradeItemToProto(item))
+	}
+	for _, letter := range course.Letters {
+		resp.Letters = append(resp.Letters, &proto.LetterGrade{Letter: letter.Letter, MinPercent: letter.MinPercent})
+	}
+	for _, student := range grades {
+		resp.Students = append(resp.Students, studentGradesToProto(student))
+	}
+
+	s.logger.Info("Журнал оценок получен", zap.Int64("course_id", req.CourseId), zap.Int("students", len(resp.Students)))
+	return resp, nil
+}
+
+// GetStudentGrades возвращает оценки студента курса. Студент видит только свои оценки, преподаватель — оценки студентов своего курса.
+func (s *GradebookService) GetStudentGrades(ctx context.Context, req *proto.StudentGradesRequest) (*proto.StudentGrades, error) {
+	s.logger.Info("Получение оценок студента", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+
+	if req.CourseId == 0 || req.StudentId == 0 {
+		s.logger.Warn("Некорректный ID курса или студента", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса и ID студента должны быть указаны")
+	}
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка получить оценки другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+	if user, _ := middleware.UserFromContext(ctx); user.Role != "student" {
+		if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+			return nil, err
+		}
+	}
+
+	_, grades, err := s.computeGrades(ctx, req.CourseId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	return studentGradesToProto(grades[0]), nil
+}
+
+// AdjustGrade вручную изменяет оценку студента за тест (в процентах), задание (в баллах) или итоговую оценку
+// (в процентах). Каждое изменение сохраняется в истории вместе с прежней оценкой, причиной и автором.
+func (s *GradebookService) AdjustGrade(ctx context.Context, req *proto.AdjustGradeRequest) (*proto.GradeAdjustment, error) {
+	s.logger.Info("Ручное изменение оценки", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId),
+		zap.String("item_type", req.ItemType.String()), zap.Int64("item_id", req.ItemId))
+
+	if req.CourseId == 0 || req.StudentId == 0 {
+		s.logger.Warn("Некорректный ID курса или студента", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса и ID студента должны быть указаны")
+	}
+	itemType, ok := gradeItemTypes[req.ItemType]
+	if !ok {
+		s.logger.Warn("Некорректный тип элемента", zap.String("item_type", req.ItemType.String()))
+		return nil, status.Errorf(codes.InvalidArgument, "Тип изменяемой оценки должен быть указан")
+	}
+	itemID := req.ItemId
+	if itemType == models.GradeItemFinal {
+		itemID = 0
+	} else if itemID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID теста или задания должен быть указан")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Причина изменения оценки должна быть указана")
+	}
+	if req.Score != nil && *req.Score < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Оценка не может быть отрицательной")
+	}
+
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	_, grades, err := s.computeGrades(ctx, req.CourseId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	student := grades[0]
+
+	adjustment := &models.GradeAdjustment{
+		CourseID:  req.CourseId,
+		StudentID: req.StudentId,
+		ItemType:  itemType,
+		ItemID:    itemID,
+		Score:     req.Score,
+		Reason:    reason,
+	}
+	maxScore := 100.0
+	if itemType == models.GradeItemFinal {
+		if student.Graded {
+			adjustment.PreviousScore = &student.FinalPercent
+		}
+	} else {
+		index := slices.IndexFunc(student.Items, func(item *gradebook.ItemGrade) bool {
+			return item.Item.Type == itemType && item.Item.ID == itemID
+		})
+		if index < 0 {
+			s.logger.Warn("Элемент журнала не найден", zap.String("item_type", itemType), zap.Int64("item_id", itemID))
+			return nil, status.Errorf(codes.NotFound, "Элемент с ID %d не найден в курсе", itemID)
+		}
+		item := student.Items[index]
+		maxScore = item.Item.MaxScore
+		if item.Graded {
+			adjustment.PreviousScore = &item.Score
+		}
+	}
+	if req.Score != nil && *req.Score > maxScore {
+		s.logger.Warn("Оценка больше максимальной", zap.Float64("score", *req.Score), zap.Float64("max_score", maxScore))
+		return nil, status.Errorf(codes.InvalidArgument, "Оценка не может быть больше %g", maxScore)
+	}
+	if user, ok := middleware.UserFromContext(ctx); ok && user.Role == "instructor" {
+		adjustment.InstructorID = &user.ID
+	}
+
+	if err := s.gradebookRepo.CreateAdjustment(ctx, adjustment); err != nil {
+		s.logger.Error("Ошибка при сохранении изменения оценки", zap.Error(err), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении изменения оценки: %v", err)
+	}
+
+	s.logger.Info("Оценка изменена вручную", zap.Int64("adjustment_id", adjustment.ID), zap.Int64("student_id", req.StudentId))
+	return gradeAdjustmentToProto(adjustment), nil
+}
+
+func (s *GradebookService) ListGradeAdjustments(ctx context.Context, req *proto.ListGradeAdjustmentsRequest) (*proto.GradeAdjustmentList, error) {
+	s.logger.Info("Получение истории изменений оценок", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	adjustments, err := s.gradebookRepo.GetAdjustments(ctx, req.CourseId, req.StudentId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении истории изменений", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении истории изменений: %v", err)
+	}
+
+	resp := &proto.GradeAdjustmentList{}
+	for _, adjustment := range adjustments {
+		resp.Adjustments = append(resp.Adjustments, gradeAdjustmentToProto(adjustment))
+	}
+	return resp, nil
+}
+
+// ExportGradebook выгружает журнал курса в CSV или XLSX: по строке на студента, столбцы — элементы,
+// категории, итоговый процент и буквенная оценка.
+func (s *GradebookService) ExportGradebook(ctx context.Context, req *proto.ExportGradebookRequest) (*proto.GradebookFile, error) {
+	s.logger.Info("Выгрузка журнала оценок", zap.Int64("course_id", req.CourseId), zap.String("format", req.Format.String()))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+	if err := s.checkCourseInstructor(ctx, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	course, grades, err := s.computeGrades(ctx, req.CourseId, 0)
+	if err != nil {
+		return nil, err
+	}
+	rows := gradebook.Table(course, grades)
+
+	var buf bytes.Buffer
+	file := &proto.GradebookFile{}
+	switch req.Format {
+	case proto.GradebookFormat_GRADEBOOK_FORMAT_CSV:
+		file.Filename = fmt.Sprintf("gradebook-course-%d.csv", req.CourseId)
+		file.ContentType = "text/csv; charset=utf-8"
+		err = gradebook.WriteCSV(&buf, rows)
+	case proto.GradebookFormat_GRADEBOOK_FORMAT_XLSX:
+		file.Filename = fmt.Sprintf("gradebook-course-%d.xlsx", req.CourseId)
+		file.ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		err = gradebook.WriteXLSX(&buf, rows)
+	default:
+		s.logger.Warn("Неизвестный формат выгрузки", zap.String("format", req.Format.String()))
+		return nil, status.Errorf(codes.InvalidArgument, "Неизвестный формат выгрузки")
+	}
+	if err != nil {
+		s.logger.Error("Ошибка при формировании файла журнала", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при формировании файла журнала: %v", err)
+	}
+	file.Content = buf.Bytes()
+
+	s.logger.Info("Журнал оценок выгружен", zap.Int64("course_id", req.CourseId), zap.Int("students", len(grades)))
+	return file, nil
+}
+
+func (s *GradebookService) checkCourseInstructor(ctx context.Context, courseID int64) error {
+	course, err := s.courseRepo.GetCourseByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", courseID))
+		return status.Errorf(codes.NotFound, "Курс с ID %d не найден", courseID)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Нет доступа к журналу курса", zap.Int64("course_id", courseID))
+		return err
+	}
+	return nil
+}
+
+// getCategory возвращает категорию и проверяет, что пользователь — преподаватель её курса.
+func (s *GradebookService) getCategory(ctx context.Context, id int64) (*models.GradeCategory, error) {
+	category, err := s.gradebookRepo.GetCategoryByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении категории", zap.Error(err), zap.Int64("category_id", id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении категории: %v", err)
+	}
+	if category == nil {
+		s.logger.Warn("Категория не найдена", zap.Int64("category_id", id))
+		return nil, status.Errorf(codes.NotFound, "Категория с ID %d не найдена", id)
+	}
+	if err := s.checkCourseInstructor(ctx, category.CourseID); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// computeGrades загружает настройки журнала и считает оценки студентов курса в порядке ID.
+// Если studentID не 0, считает оценки только этого студента; студент должен быть записан на курс.
+func (s *GradebookService) computeGrades(ctx context.Context, courseID, studentID int64) (*gradebook.Course, []*gradebook.StudentGrades, error) {
+	internalErr := func(err error) error {
+		s.logger.Error("Ошибка при расчёте оценок", zap.Error(err), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.Internal, "Ошибка при расчёте оценок: %v", err)
+	}
+
+	students, err := s.enrollmentRepo.GetStudentsByCourse(ctx, courseID)
+	if err != nil {
+		return nil, nil, internalErr(err)
+	}
+	slices.SortFunc(students, func(a, b *models.Student) int { return cmp.Compare(a.ID, b.ID) })
+	if studentID != 0 {
+		index := slices.IndexFunc(students, func(student *models.Student) bool { return student.ID == studentID })
+		if index < 0 {
+			s.logger.Warn("Студент не записан на курс", zap.Int64("student_id", studentID), zap.Int64("course_id", courseID))
+			return nil, nil, status.Errorf(codes.NotFound, "Студент с ID %d не записан на курс", studentID)
+		}
+		students = students[index : index+1]
+	}
+
+	course := &gradebook.Course{}
+	if course.Categories, err = s.gradebookRepo.GetCategoriesByCourse(ctx, courseID); err != nil {
+		return nil, nil, internalErr(err)
+	}
+	if course.Items, err = s.gradebookRepo.GetItems(ctx, courseID); err != nil {
+		return nil, nil, internalErr(err)
+	}
+	if course.Letters, err = s.gradebookRepo.GetLetterScheme(ctx, courseID); err != nil {
+		return nil, nil, internalErr(err)
+	}
+	if len(course.Letters) == 0 {
+		course.Letters = gradebook.DefaultLetters
+	}
+
+	scores, err := s.gradebookRepo.GetScores(ctx, courseID)
+	if err != nil {
+		return nil, nil, internalErr(err)
+	}
+	adjustments, err := s.gradebookRepo.GetAdjustments(ctx, courseID, studentID)
+	if err != nil {
+		return nil, nil, internalErr(err)
+	}
+
+	return course, gradebook.Compute(course, students, scores, adjustments), nil
+}
+
+func gradeCategoryFromRequest(name string, weight float64) (*models.GradeCategory, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Название категории должно быть указано")
+	}
+	if weight <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Вес категории должен быть больше нуля")
+	}
+	return &models.GradeCategory{Name: name, Weight: weight}, nil
+}
+
+func gradeCategoryToProto(category *models.GradeCategory) *proto.GradeCategory {
+	return &proto.GradeCategory{
+		Id:       category.ID,
+		CourseId: category.CourseID,
+		Name:     category.Name,
+		Weight:   category.Weight,
+	}
+}
+
+func gradeItemTypeToProto(itemType string) proto.GradeItemType {
+	for protoType, value := range gradeItemTypes {
+		if value == itemType {
+			return protoType
+		}
+	}
+	return proto.GradeItemType_GRADE_ITEM_TYPE_UNSPECIFIED
+}
+
+func gradeItemToProto(item *models.GradeItem) *proto.GradeItem {
+	grpcItem := &proto.GradeItem{
+		Type:     gradeItemTypeToProto(item.Type),
+		Id:       item.ID,
+		Title:    item.Title,
+		MaxScore: item.MaxScore,
+	}
+	if item.CategoryID != nil {
+		grpcItem.CategoryId = *item.CategoryID
+	}
+	return grpcItem
+}
+
+func letterSchemeToProto(courseID int64, letters []*models.LetterGrade) *proto.LetterScheme {
+	scheme := &proto.LetterScheme{CourseId: courseID}
+	if len(letters) == 0 {
+		letters, scheme.IsDefault = gradebook.DefaultLetters, true
+	}
+	for _, letter := range letters {
+		scheme.Letters = append(scheme.Letters, &proto.LetterGrade{Letter: letter.Letter, MinPercent: letter.MinPercent})
+	}
+	return scheme
+}
+
+func studentGradesToProto(grades *gradebook.StudentGrades) *proto.StudentGrades {
+	grpcGrades := &proto.StudentGrades{
+		StudentId:     grades.Student.ID,
+		StudentName:   grades.Student.Name,
+		StudentEmail:  grades.Student.Email,
+		Graded:        grades.Graded,
+		FinalPercent:  grades.FinalPercent,
+		FinalAdjusted: grades.FinalAdjusted,
+		Letter:        grades.Letter,
+	}
+	for _, item := range grades.Items {
+		grpcGrades.Items = append(grpcGrades.Items, &proto.ItemGrade{
+			ItemType: gradeItemTypeToProto(item.Item.Type),
+			ItemId:   item.Item.ID,
+			Graded:   item.Graded,
+			Score:    gradebook.Round(item.Score),
+			Adjusted: item.Adjusted,
+		})
+	}
+	for _, category := range grades.Categories {
+		grpcGrades.Categories = append(grpcGrades.Categories, &proto.CategoryGrade{
+			CategoryId: category.Category.ID,
+			Graded:     category.Graded,
+			Percent:    gradebook.Round(category.Percent),
+		})
+	}
+	return grpcGrades
+}
+
+func gradeAdjustmentToProto(adjustment *models.GradeAdjustment) *proto.GradeAdjustment {
+	grpcAdjustment := &proto.GradeAdjustment{
+		Id:            adjustment.ID,
+		CourseId:      adjustment.CourseID,
+		StudentId:     adjustment.StudentID,
+		ItemType:      gradeItemTypeToProto(adjustment.ItemType),
+		ItemId:        adjustment.ItemID,
+		Score:         adjustment.Score,
+		PreviousScore: adjustment.PreviousScore,
+		Reason:        adjustment.Reason,
+		CreatedAt:     adjustment.CreatedAt.Format(time.RFC3339),
+	}
+	if adjustment.InstructorID != nil {
+		grpcAdjustment.InstructorId = *adjustment.InstructorID
+	}
+	return grpcAdjustment
+}
@@ -0,0 +1,474 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// prepareGradebookTables создаёт в курсе 1 тест (ID 1) и задание на 20 баллов. Студент 1 лучше всего прошёл тест
+// на 80% и получил за задание 15 баллов, студент 2 прошёл тест на 90% и задание не сдавал.
+func prepareGradebookTables(t *testing.T, ctx context.Context) *proto.Assignment {
+	_, err := db.Exec(ctx, "TRUNCATE TABLE grade_adjustments, letter_grades, grade_categories RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы журнала")
+	prepareAssignmentTables(t, ctx)
+
+	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES (2, 1)")
+	require.NoError(t, err, "Не удалось добавить запись на курс")
+	_, err = db.Exec(ctx, "INSERT INTO quizzes (id, course_id, title) VALUES (1, 1, 'Входной тест')")
+	require.NoError(t, err, "Не удалось добавить тест")
+	_, err = db.Exec(ctx, `INSERT INTO quiz_attempts (quiz_id, student_id, attempt_number, submitted_at, percent) VALUES
+		(1, 1, 1, NOW(), 60), (1, 1, 2, NOW(), 80), (1, 1, 3, NULL, 100), (1, 2, 1, NOW(), 90)`)
+	require.NoError(t, err, "Не удалось добавить попытки теста")
+
+	assignment := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{Title: "Эссе", MaxScore: 20})
+	submission, err := clientAssignment.SubmitAssignment(withToken(t, ctx, 1, "student"), &proto.SubmitAssignmentRequest{AssignmentId: assignment.Id, StudentId: 1, Text: "Решение"})
+	require.NoError(t, err, "Ошибка отправки решения")
+	_, err = clientAssignment.GradeSubmission(withToken(t, ctx, 1, "instructor"), &proto.GradeSubmissionRequest{SubmissionId: submission.Id, Score: 15})
+	require.NoError(t, err, "Ошибка оценки решения")
+
+	return assignment
+}
+
+func createTestGradeCategory(t *testing.T, ctx context.Context, name string, weight float64) *proto.GradeCategory {
+	category, err := clientGradebook.CreateGradeCategory(withToken(t, ctx, 1, "instructor"), &proto.CreateGradeCategoryRequest{CourseId: 1, Name: name, Weight: weight})
+	require.NoError(t, err, "Ошибка создания категории")
+	return category
+}
+
+func TestCreateGradeCategory(t *testing.T) {
+	ctx := context.Background()
+	prepareGradebookTables(t, ctx)
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.CreateGradeCategoryRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Создание категории",
+			Ctx:     withToken(t, ctx, 1, "instructor"),
+			Request: &proto.CreateGradeCategoryRequest{CourseId: 1, Name: " Тесты ", Weight: 40},
+		},
+		{
+			Name:         "Пустое название",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateGradeCategoryRequest{CourseId: 1, Name: " ", Weight: 40},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Нулевой вес",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateGradeCategoryRequest{CourseId: 1, Name: "Задания"},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Несуществующий курс",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.CreateGradeCategoryRequest{CourseId: 99, Name: "Задания", Weight: 60},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name:         "Преподаватель другого курса",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.CreateGradeCategoryRequest{CourseId: 1, Name: "Задания", Weight: 60},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Студент не может создавать категории",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.CreateGradeCategoryRequest{CourseId: 1, Name: "Задания", Weight: 60},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientGradebook.CreateGradeCategory(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			} else {
+				require.NoError(t, err, "Ошибка создания категории")
+				assert.NotZero(t, resp.Id, "ID категории должен быть присвоен")
+				assert.Equal(t, "Тесты", resp.Name, "Название должно сохраняться без пробелов по краям")
+				assert.Equal(t, float64(40), resp.Weight, "Некорректный вес")
+			}
+		})
+	}
+}
+
+func TestGradebookWeightedCategories(t *testing.T) {
+	ctx := context.Background()
+	assignment := prepareGradebookTables(t, ctx)
+	instructor := withToken(t, ctx, 1, "instructor")
+
+	book, err := clientGradebook.GetGradebook(instructor, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка получения журнала")
+	require.Len(t, book.Items, 2, "В журнале должны быть тест и задание")
+	assert.Equal(t, proto.GradeItemType_GRADE_ITEM_TYPE_QUIZ, book.Items[0].Type, "Тесты идут первыми")
+	assert.Equal(t, float64(20), book.Items[1].MaxScore, "Максимум задания — его max_score")
+	require.Len(t, book.Students, 2, "В журнале должны быть все студенты курса")
+	assert.Equal(t, float64(80), book.Students[0].Items[0].Score, "За тест берётся лучшая завершённая попытка")
+	assert.Equal(t, float64(15), book.Students[0].Items[1].Score, "Некорректная оценка за задание")
+	assert.Equal(t, 77.5, book.Students[0].FinalPercent, "Без категорий элементы учитываются с равным весом")
+	assert.Equal(t, "C", book.Students[0].Letter, "Некорректная буквенная оценка")
+	assert.False(t, book.Students[1].Items[1].Graded, "Студент 2 не сдавал задание")
+	assert.Equal(t, float64(90), book.Students[1].FinalPercent, "Элементы без оценки не учитываются")
+
+	quizzes := createTestGradeCategory(t, ctx, "Тесты", 1)
+	assignments := createTestGradeCategory(t, ctx, "Задания", 3)
+	_, err = clientGradebook.SetGradeItemCategory(instructor, &proto.SetGradeItemCategoryRequest{
+		CourseId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_QUIZ, ItemId: 1, CategoryId: quizzes.Id,
+	})
+	require.NoError(t, err, "Ошибка назначения категории тесту")
+
+	book, err = clientGradebook.GetGradebook(instructor, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка получения журнала")
+	assert.Equal(t, float64(80), book.Students[0].FinalPercent, "Элементы без категории не должны входить в итог")
+
+	item, err := clientGradebook.SetGradeItemCategory(instructor, &proto.SetGradeItemCategoryRequest{
+		CourseId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT, ItemId: assignment.Id, CategoryId: assignments.Id,
+	})
+	require.NoError(t, err, "Ошибка назначения категории заданию")
+	assert.Equal(t, assignments.Id, item.CategoryId, "Категория должна быть назначена")
+
+	grades, err := clientGradebook.GetStudentGrades(instructor, &proto.StudentGradesRequest{CourseId: 1, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения оценок студента")
+	require.Len(t, grades.Categories, 2, "Должны быть оценки по обеим категориям")
+	assert.Equal(t, 75.0, grades.Categories[1].Percent, "Некорректный процент по заданиям")
+	assert.Equal(t, 76.25, grades.FinalPercent, "Итог должен учитывать веса категорий")
+	assert.Equal(t, "C", grades.Letter, "Некорректная буквенная оценка")
+
+	grades, err = clientGradebook.GetStudentGrades(instructor, &proto.StudentGradesRequest{CourseId: 1, StudentId: 2})
+	require.NoError(t, err, "Ошибка получения оценок студента")
+	assert.False(t, grades.Categories[1].Graded, "У студента 2 нет оценок по заданиям")
+	assert.Equal(t, float64(90), grades.FinalPercent, "Категории без оценок не должны учитываться")
+	assert.Equal(t, "A", grades.Letter, "Некорректная буквенная оценка")
+
+	_, err = clientGradebook.DeleteGradeCategory(instructor, &proto.GradeCategoryIDRequest{CategoryId: assignments.Id})
+	require.NoError(t, err, "Ошибка удаления категории")
+	grades, err = clientGradebook.GetStudentGrades(instructor, &proto.StudentGradesRequest{CourseId: 1, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения оценок студента")
+	assert.Equal(t, float64(80), grades.FinalPercent, "Задание должно остаться без категории")
+
+	_, err = clientGradebook.SetGradeItemCategory(withToken(t, ctx, 2, "instructor"), &proto.SetGradeItemCategoryRequest{
+		CourseId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_QUIZ, ItemId: 1,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Преподаватель другого курса не может менять категории")
+
+	_, err = clientGradebook.SetGradeItemCategory(instructor, &proto.SetGradeItemCategoryRequest{
+		CourseId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_QUIZ, ItemId: 99, CategoryId: quizzes.Id,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err), "Несуществующий тест должен давать NotFound")
+}
+
+func TestSetLetterScheme(t *testing.T) {
+	ctx := context.Background()
+	prepareGradebookTables(t, ctx)
+	instructor := withToken(t, ctx, 1, "instructor")
+
+	testCases := []struct {
+		Name         string
+		Request      *proto.SetLetterSchemeRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:         "Нет оценки с порогом 0",
+			Request:      &proto.SetLetterSchemeRequest{CourseId: 1, Letters: []*proto.LetterGrade{{Letter: "Зачёт", MinPercent: 50}}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Повторяющаяся оценка",
+			Request: &proto.SetLetterSchemeRequest{CourseId: 1, Letters: []*proto.LetterGrade{
+				{Letter: "A", MinPercent: 50}, {Letter: "A", MinPercent: 0},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Порог больше 100",
+			Request: &proto.SetLetterSchemeRequest{CourseId: 1, Letters: []*proto.LetterGrade{
+				{Letter: "A", MinPercent: 101}, {Letter: "F", MinPercent: 0},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Шкала «зачёт/незачёт»",
+			Request: &proto.SetLetterSchemeRequest{CourseId: 1, Letters: []*proto.LetterGrade{
+				{Letter: "Незачёт", MinPercent: 0}, {Letter: "Зачёт", MinPercent: 78},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientGradebook.SetLetterScheme(instructor, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			} else {
+				require.NoError(t, err, "Ошибка сохранения шкалы")
+				require.Len(t, resp.Letters, 2, "Некорректное число оценок")
+				assert.Equal(t, "Зачёт", resp.Letters[0].Letter, "Шкала должна быть упорядочена по убыванию порога")
+				assert.False(t, resp.IsDefault, "Шкала курса не должна считаться шкалой по умолчанию")
+			}
+		})
+	}
+
+	book, err := clientGradebook.GetGradebook(instructor, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка получения журнала")
+	assert.Equal(t, "Незачёт", book.Students[0].Letter, "77.5% ниже порога зачёта")
+	assert.Equal(t, "Зачёт", book.Students[1].Letter, "90% выше порога зачёта")
+
+	scheme, err := clientGradebook.SetLetterScheme(instructor, &proto.SetLetterSchemeRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка сброса шкалы")
+	assert.True(t, scheme.IsDefault, "Пустой список должен возвращать шкалу по умолчанию")
+	assert.Len(t, scheme.Letters, 5, "Шкала по умолчанию — A–F")
+}
+
+func TestAdjustGrade(t *testing.T) {
+	ctx := context.Background()
+	assignment := prepareGradebookTables(t, ctx)
+	instructor := withToken(t, ctx, 1, "instructor")
+	score := func(value float64) *float64 { return &value }
+
+	testCases := []struct {
+		Name             string
+		Ctx              context.Context
+		Request          *proto.AdjustGradeRequest
+		ShouldError      bool
+		ExpectedCode     codes.Code
+		ExpectedPrevious *float64
+	}{
+		{
+			Name: "Изменение оценки за задание",
+			Ctx:  instructor,
+			Request: &proto.AdjustGradeRequest{CourseId: 1, StudentId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT,
+				ItemId: assignment.Id, Score: score(19), Reason: "Апелляция"},
+			ExpectedPrevious: score(15),
+		},
+		{
+			Name: "Оценка за несданное задание",
+			Ctx:  instructor,
+			Request: &proto.AdjustGradeRequest{CourseId: 1, StudentId: 2, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT,
+				ItemId: assignment.Id, Score: score(10), Reason: "Сдано устно"},
+		},
+		{
+			Name: "Оценка больше максимальной",
+			Ctx:  instructor,
+			Request: &proto.AdjustGradeRequest{CourseId: 1, StudentId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT,
+				ItemId: assignment.Id, Score: score(21), Reason: "Бонус"},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Без причины",
+			Ctx:  instructor,
+			Request: &proto.AdjustGradeRequest{CourseId: 1, StudentId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_QUIZ,
+				ItemId: 1, Score: score(100), Reason: " "},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Несуществующее задание",
+			Ctx:  instructor,
+			Request: &proto.AdjustGradeRequest{CourseId: 1, StudentId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT,
+				ItemId: 99, Score: score(10), Reason: "Апелляция"},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name: "Студент не записан на курс",
+			Ctx:  withToken(t, ctx, 2, "instructor"),
+			Request: &proto.AdjustGradeRequest{CourseId: 2, StudentId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_FINAL,
+				Score: score(100), Reason: "Апелляция"},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name: "Студент не может менять оценки",
+			Ctx:  withToken(t, ctx, 1, "student"),
+			Request: &proto.AdjustGradeRequest{CourseId: 1, StudentId: 1, ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_FINAL,
+				Score: score(100), Reason: "Хочу"},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientGradebook.AdjustGrade(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			} else {
+				require.NoError(t, err, "Ошибка изменения оценки")
+				assert.Equal(t, tc.ExpectedPrevious, resp.PreviousScore, "Некорректная прежняя оценка")
+				assert.Equal(t, int64(1), resp.InstructorId, "Автор изменения должен сохраняться")
+			}
+		})
+	}
+
+	grades, err := clientGradebook.GetStudentGrades(instructor, &proto.StudentGradesRequest{CourseId: 1, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения оценок студента")
+	assert.True(t, grades.Items[1].Adjusted, "Оценка должна быть отмечена изменённой")
+	assert.Equal(t, float64(19), grades.Items[1].Score, "Должна действовать изменённая оценка")
+	assert.Equal(t, 87.5, grades.FinalPercent, "Итог должен пересчитываться с изменённой оценкой")
+
+	final, err := clientGradebook.AdjustGrade(instructor, &proto.AdjustGradeRequest{CourseId: 1, StudentId: 1,
+		ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_FINAL, ItemId: 5, Score: score(95), Reason: "Активность на семинарах"})
+	require.NoError(t, err, "Ошибка изменения итоговой оценки")
+	assert.Zero(t, final.ItemId, "У итоговой оценки нет элемента")
+	assert.Equal(t, score(87.5), final.PreviousScore, "Некорректная прежняя итоговая оценка")
+
+	grades, err = clientGradebook.GetStudentGrades(instructor, &proto.StudentGradesRequest{CourseId: 1, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения оценок студента")
+	assert.True(t, grades.FinalAdjusted, "Итог должен быть отмечен изменённым")
+	assert.Equal(t, float64(95), grades.FinalPercent, "Ручной итог заменяет рассчитанный")
+	assert.Equal(t, "A", grades.Letter, "Буквенная оценка считается по ручному итогу")
+
+	_, err = clientGradebook.AdjustGrade(instructor, &proto.AdjustGradeRequest{CourseId: 1, StudentId: 1,
+		ItemType: proto.GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT, ItemId: assignment.Id, Reason: "Ошибочное изменение"})
+	require.NoError(t, err, "Ошибка отмены изменения")
+	grades, err = clientGradebook.GetStudentGrades(instructor, &proto.StudentGradesRequest{CourseId: 1, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения оценок студента")
+	assert.False(t, grades.Items[1].Adjusted, "Ручное изменение должно быть отменено")
+	assert.Equal(t, float64(15), grades.Items[1].Score, "Должна вернуться рассчитанная оценка")
+
+	history, err := clientGradebook.ListGradeAdjustments(instructor, &proto.ListGradeAdjustmentsRequest{CourseId: 1, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения истории изменений")
+	require.Len(t, history.Adjustments, 3, "Все изменения студента должны сохраняться в истории")
+	assert.Equal(t, "Апелляция", history.Adjustments[0].Reason, "История должна идти в порядке внесения")
+	assert.Nil(t, history.Adjustments[2].Score, "Отмена изменения сохраняется без оценки")
+	assert.Equal(t, score(19), history.Adjustments[2].PreviousScore, "Отмена должна хранить отменённую оценку")
+
+	history, err = clientGradebook.ListGradeAdjustments(instructor, &proto.ListGradeAdjustmentsRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка получения истории изменений")
+	assert.Len(t, history.Adjustments, 4, "Без студента возвращается история всего курса")
+}
+
+func TestGetStudentGrades(t *testing.T) {
+	ctx := context.Background()
+	prepareGradebookTables(t, ctx)
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.StudentGradesRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Студент получает свои оценки",
+			Ctx:     withToken(t, ctx, 1, "student"),
+			Request: &proto.StudentGradesRequest{CourseId: 1, StudentId: 1},
+		},
+		{
+			Name:         "Оценки другого студента",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.StudentGradesRequest{CourseId: 1, StudentId: 2},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Студент не записан на курс",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.StudentGradesRequest{CourseId: 2, StudentId: 1},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name:         "Преподаватель другого курса",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.StudentGradesRequest{CourseId: 1, StudentId: 1},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientGradebook.GetStudentGrades(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			} else {
+				require.NoError(t, err, "Ошибка получения оценок")
+				assert.Equal(t, "Студент 1", resp.StudentName, "Некорректный студент")
+				assert.Equal(t, 77.5, resp.FinalPercent, "Некорректный итог")
+			}
+		})
+	}
+}
+
+func TestExportGradebook(t *testing.T) {
+	ctx := context.Background()
+	prepareGradebookTables(t, ctx)
+	instructor := withToken(t, ctx, 1, "instructor")
+
+	_, err := db.Exec(ctx, `UPDATE students SET name = '=HYPERLINK("http://evil.example")' WHERE id = 2`)
+	require.NoError(t, err, "Не удалось изменить имя студента")
+
+	file, err := clientGradebook.ExportGradebook(instructor, &proto.ExportGradebookRequest{CourseId: 1, Format: proto.GradebookFormat_GRADEBOOK_FORMAT_CSV})
+	require.NoError(t, err, "Ошибка выгрузки CSV")
+	assert.Equal(t, "gradebook-course-1.csv", file.Filename, "Некорректное имя файла")
+	require.True(t, bytes.HasPrefix(file.Content, []byte("\xef\xbb\xbf")), "CSV должен начинаться с метки порядка байтов UTF-8")
+
+	records, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(file.Content, []byte("\xef\xbb\xbf")))).ReadAll()
+	require.NoError(t, err, "Выгрузка должна быть корректным CSV")
+	require.Len(t, records, 3, "Заголовок и строка на каждого студента")
+	assert.Equal(t, []string{"ID студента", "Имя", "Email", "Входной тест (100)", "Эссе (20)", "Итог, %", "Оценка"}, records[0], "Некорректный заголовок")
+	assert.Equal(t, []string{"1", "Студент 1", "student1@domain.com", "80", "15", "77.5", "C"}, records[1], "Некорректная строка студента 1")
+	assert.Equal(t, "", records[2][4], "Пустая ячейка для элемента без оценки")
+	assert.Equal(t, `'=HYPERLINK("http://evil.example")`, records[2][1], "Текст, похожий на формулу, должен экранироваться")
+
+	file, err = clientGradebook.ExportGradebook(instructor, &proto.ExportGradebookRequest{CourseId: 1, Format: proto.GradebookFormat_GRADEBOOK_FORMAT_XLSX})
+	require.NoError(t, err, "Ошибка выгрузки XLSX")
+	archive, err := zip.NewReader(bytes.NewReader(file.Content), int64(len(file.Content)))
+	require.NoError(t, err, "XLSX должен быть zip-архивом")
+
+	var sheet []byte
+	for _, part := range archive.File {
+		if part.Name == "xl/worksheets/sheet1.xml" {
+			reader, err := part.Open()
+			require.NoError(t, err, "Не удалось открыть лист")
+			sheet, err = io.ReadAll(reader)
+			reader.Close()
+			require.NoError(t, err, "Не удалось прочитать лист")
+		}
+	}
+	require.NotNil(t, sheet, "В книге должен быть лист с журналом")
+	assert.Contains(t, string(sheet), `<c r="F2"><v>77.5</v></c>`, "Итог должен быть числовой ячейкой")
+	assert.Contains(t, string(sheet), "student2@domain.com", "В листе должны быть все студенты")
+
+	_, err = clientGradebook.ExportGradebook(withToken(t, ctx, 2, "instructor"), &proto.ExportGradebookRequest{CourseId: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Преподаватель другого курса не может выгружать журнал")
+}
@@ -0,0 +1,387 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LearningPathService struct {
+	proto.UnimplementedLearningPathServiceServer
+	pathRepo     repository.LearningPathRepository
+	courseRepo   repository.CourseRepository
+	lectureRepo  repository.LectureRepository
+	certificates PathCertificateReader
+	logger       *zap.Logger
+}
+
+func NewLearningPathService(pathRepo repository.LearningPathRepository, courseRepo repository.CourseRepository, lectureRepo repository.LectureRepository, certificates PathCertificateReader, logger *zap.Logger) *LearningPathService {
+	return &LearningPathService{
+		pathRepo:     pathRepo,
+		courseRepo:   courseRepo,
+		lectureRepo:  lectureRepo,
+		certificates: certificates,
+		logger:       logger,
+	}
+}
+
+func (s *LearningPathService) CreateLearningPath(ctx context.Context, req *proto.NewLearningPathRequest) (*proto.LearningPath, error) {
+	s.logger.Info("Создание учебной программы", zap.String("name", req.Name), zap.Int64("instructor_id", req.InstructorId))
+
+	if req.InstructorId == 0 {
+		s.logger.Warn("Некорректный ID преподавателя")
+		return nil, status.Errorf(codes.InvalidArgument, "ID преподавателя должен быть указан")
+	}
+	if err := checkInstructorAccess(ctx, req.InstructorId); err != nil {
+		s.logger.Warn("Попытка создать программу от имени другого преподавателя", zap.Int64("instructor_id", req.InstructorId))
+		return nil, err
+	}
+
+	path, err := s.pathFromRequest(req.Name, req.Description, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	path.InstructorID = req.InstructorId
+
+	if err := s.pathRepo.CreateLearningPath(ctx, path); err != nil {
+		return nil, s.pathWriteError(err)
+	}
+
+	s.logger.Info("Учебная программа успешно создана", zap.Int64("path_id", path.ID))
+	return s.getPath(ctx, path.ID)
+}
+
+func (s *LearningPathService) UpdateLearningPath(ctx context.Context, req *proto.UpdateLearningPathRequest) (*proto.LearningPath, error) {
+	s.logger.Info("Обновление учебной программы", zap.Int64("path_id", req.Id))
+
+	if _, err := s.getPathForInstructor(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	path, err := s.pathFromRequest(req.Name, req.Description, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	path.ID = req.Id
+
+	updated, err := s.pathRepo.UpdateLearningPath(ctx, path)
+	if err != nil {
+		return nil, s.pathWriteError(err)
+	}
+	if !updated {
+		s.logger.Warn("Учебная программа не найдена", zap.Int64("path_id", req.Id))
+		return nil, status.Errorf(codes.NotFound, "Учебная программа с ID %d не найдена", req.Id)
+	}
+
+	s.logger.Info("Учебная программа успешно обновлена", zap.Int64("path_id", req.Id))
+	return s.getPath(ctx, req.Id)
+}
+
+func (s *LearningPathService) DeleteLearningPath(ctx context.Context, req *proto.LearningPathIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление учебной программы", zap.Int64("path_id", req.PathId))
+
+	if _, err := s.getPathForInstructor(ctx, req.PathId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.pathRepo.DeleteLearningPath(ctx, req.PathId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении учебной программы", zap.Error(err), zap.Int64("path_id", req.PathId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении учебной программы: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Учебная программа не найдена", zap.Int64("path_id", req.PathId))
+		return nil, status.Errorf(codes.NotFound, "Учебная программа с ID %d не найдена", req.PathId)
+	}
+
+	s.logger.Info("Учебная программа успешно удалена", zap.Int64("path_id", req.PathId))
+	return &proto.Empty{}, nil
+}
+
+func (s *LearningPathService) GetLearningPath(ctx context.Context, req *proto.LearningPathIDRequest) (*proto.LearningPath, error) {
+	s.logger.Info("Получение учебной программы", zap.Int64("path_id", req.PathId))
+
+	if req.PathId == 0 {
+		s.logger.Warn("Некорректный ID учебной программы")
+		return nil, status.Errorf(codes.InvalidArgument, "ID учебной программы должен быть указан")
+	}
+	return s.getPath(ctx, req.PathId)
+}
+
+func (s *LearningPathService) ListLearningPaths(ctx context.Context, req *proto.Empty) (*proto.LearningPathList, error) {
+	s.logger.Info("Получение списка учебных программ")
+
+	paths, err := s.pathRepo.GetLearningPaths(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении учебных программ", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении учебных программ: %v", err)
+	}
+	mean, err := s.courseRepo.GetRatingMean(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении средней оценки курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении средней оценки курсов: %v", err)
+	}
+
+	resp := &proto.LearningPathList{}
+	for _, path := range paths {
+		resp.Paths = append(resp.Paths, learningPathToProto(path, mean))
+	}
+
+	s.logger.Info("Учебные программы успешно получены", zap.Int("count", len(paths)))
+	return resp, nil
+}
+
+func (s *LearningPathService) EnrollInLearningPath(ctx context.Context, req *proto.LearningPathStudentRequest) (*proto.LearningPathEnrollment, error) {
+	s.logger.Info("Запись на учебную программу", zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+
+	if req.PathId == 0 || req.StudentId == 0 {
+		s.logger.Warn("Некорректные данные для записи на программу", zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID программы и ID студента должны быть указаны")
+	}
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка записать на программу другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+
+	path, err := s.pathRepo.GetLearningPathByID(ctx, req.PathId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении учебной программы", zap.Error(err), zap.Int64("path_id", req.PathId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении учебной программы: %v", err)
+	}
+	if path == nil {
+		s.logger.Warn("Учебная программа не найдена", zap.Int64("path_id", req.PathId))
+		return nil, status.Errorf(codes.NotFound, "Учебная программа с ID %d не найдена", req.PathId)
+	}
+
+	enrolled, err := s.pathRepo.EnrollInLearningPath(ctx, req.PathId, req.StudentId)
+	if err != nil {
+		s.logger.Error("Ошибка при записи на учебную программу", zap.Error(err), zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при записи на учебную программу: %v", err)
+	}
+
+	s.logger.Info("Студент записан на учебную программу", zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId), zap.Int("enrolled_courses", enrolled))
+	return &proto.LearningPathEnrollment{PathId: req.PathId, StudentId: req.StudentId, EnrolledCourses: int32(enrolled)}, nil
+}
+
+func (s *LearningPathService) GetLearningPathProgress(ctx context.Context, req *proto.LearningPathStudentRequest) (*proto.LearningPathProgress, error) {
+	s.logger.Info("Получение прогресса по учебной программе", zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+
+	if req.PathId == 0 || req.StudentId == 0 {
+		s.logger.Warn("Некорректные данные для получения прогресса программы", zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID программы и ID студента должны быть указаны")
+	}
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка получить прогресс другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+
+	path, err := s.pathRepo.GetLearningPathByID(ctx, req.PathId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении учебной программы", zap.Error(err), zap.Int64("path_id", req.PathId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении учебной программы: %v", err)
+	}
+	if path == nil {
+		s.logger.Warn("Учебная программа не найдена", zap.Int64("path_id", req.PathId))
+		return nil, status.Errorf(codes.NotFound, "Учебная программа с ID %d не найдена", req.PathId)
+	}
+	if user, _ := middleware.UserFromContext(ctx); user.Role != "student" {
+		if err := checkInstructorAccess(ctx, path.InstructorID); err != nil {
+			s.logger.Warn("Прогресс запрошен преподавателем другой программы", zap.Int64("path_id", req.PathId))
+			return nil, err
+		}
+	}
+
+	enrolled, err := s.pathRepo.IsEnrolledInLearningPath(ctx, req.PathId, req.StudentId)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке записи на программу", zap.Error(err), zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при проверке записи на программу: %v", err)
+	}
+	if !enrolled {
+		s.logger.Warn("Студент не записан на программу", zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.FailedPrecondition, "Студент не записан на учебную программу")
+	}
+
+	progress, err := pathCourseProgress(ctx, s.lectureRepo, req.StudentId, path)
+	if err != nil {
+		s.logger.Error("Ошибка при получении прогресса", zap.Error(err), zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении прогресса: %v", err)
+	}
+
+	resp := &proto.LearningPathProgress{PathId: path.ID, StudentId: req.StudentId, Completed: pathCompleted(path, progress)}
+	var requiredSum int32
+	for i, item := range path.Items {
+		resp.Courses = append(resp.Courses, &proto.LearningPathCourseProgress{
+			CourseId:         item.CourseID,
+			CourseName:       item.Course.Name,
+			Required:         item.Required,
+			CompletedPercent: progress[i],
+		})
+		if !item.Required {
+			continue
+		}
+		resp.RequiredTotal++
+		requiredSum += progress[i]
+		if progress[i] == 100 {
+			resp.RequiredCompleted++
+		}
+	}
+	if resp.RequiredTotal > 0 {
+		resp.CompletedPercent = requiredSum / resp.RequiredTotal
+	}
+
+	resp.Certificate, err = s.certificates.GetPathCertificate(ctx, req.StudentId, path.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении сертификата программы", zap.Error(err), zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении сертификата программы: %v", err)
+	}
+
+	s.logger.Info("Прогресс по учебной программе получен", zap.Int64("path_id", req.PathId), zap.Int64("student_id", req.StudentId),
+		zap.Int32("completed_percent", resp.CompletedPercent), zap.Bool("completed", resp.Completed))
+	return resp, nil
+}
+
+// pathFromRequest проверяет название и список курсов программы. Курс не может повторяться,
+// и хотя бы один курс должен быть обязательным, иначе программу нельзя завершить.
+func (s *LearningPathService) pathFromRequest(name, description string, items []*proto.LearningPathItemRequest) (*models.LearningPath, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		s.logger.Warn("Пустое название учебной программы")
+		return nil, status.Errorf(codes.InvalidArgument, "Название учебной программы не может быть пустым")
+	}
+	if len(items) == 0 {
+		s.logger.Warn("Учебная программа без курсов")
+		return nil, status.Errorf(codes.InvalidArgument, "В учебной программе должен быть хотя бы один курс")
+	}
+
+	path := &models.LearningPath{Name: name, Description: strings.TrimSpace(description)}
+	seen := make(map[int64]bool, len(items))
+	hasRequired := false
+	for _, item := range items {
+		if item.CourseId <= 0 {
+			s.logger.Warn("Некорректный ID курса программы", zap.Int64("course_id", item.CourseId))
+			return nil, status.Errorf(codes.InvalidArgument, "Некорректный ID курса")
+		}
+		if seen[item.CourseId] {
+			s.logger.Warn("Курс повторяется в программе", zap.Int64("course_id", item.CourseId))
+			return nil, status.Errorf(codes.InvalidArgument, "Курс с ID %d указан в программе несколько раз", item.CourseId)
+		}
+		seen[item.CourseId] = true
+
+		required := item.Required == nil || *item.Required
+		hasRequired = hasRequired || required
+		path.Items = append(path.Items, &models.LearningPathItem{CourseID: item.CourseId, Required: required})
+	}
+	if !hasRequired {
+		s.logger.Warn("В учебной программе нет обязательных курсов")
+		return nil, status.Errorf(codes.InvalidArgument, "Хотя бы один курс программы должен быть обязательным")
+	}
+	return path, nil
+}
+
+func (s *LearningPathService) pathWriteError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrLearningPathNameTaken):
+		s.logger.Warn("Учебная программа с таким названием уже существует")
+		return status.Errorf(codes.AlreadyExists, "Учебная программа с таким названием уже существует")
+	case errors.Is(err, repository.ErrLearningPathCourse):
+		s.logger.Warn("Курс программы не существует")
+		return status.Errorf(codes.InvalidArgument, "Курс программы не существует")
+	}
+	s.logger.Error("Ошибка при сохранении учебной программы", zap.Error(err))
+	return status.Errorf(codes.Internal, "Ошибка при сохранении учебной программы: %v", err)
+}
+
+// getPathForInstructor возвращает программу, если её может изменять преподаватель из токена.
+func (s *LearningPathService) getPathForInstructor(ctx context.Context, id int64) (*models.LearningPath, error) {
+	if id == 0 {
+		s.logger.Warn("Некорректный ID учебной программы")
+		return nil, status.Errorf(codes.InvalidArgument, "ID учебной программы должен быть указан")
+	}
+
+	path, err := s.pathRepo.GetLearningPathByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении учебной программы", zap.Error(err), zap.Int64("path_id", id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении учебной программы: %v", err)
+	}
+	if path == nil {
+		s.logger.Warn("Учебная программа не найдена", zap.Int64("path_id", id))
+		return nil, status.Errorf(codes.NotFound, "Учебная программа с ID %d не найдена", id)
+	}
+	if err := checkInstructorAccess(ctx, path.InstructorID); err != nil {
+		s.logger.Warn("Нет доступа к учебной программе", zap.Int64("path_id", id))
+		return nil, err
+	}
+	return path, nil
+}
+
+func (s *LearningPathService) getPath(ctx context.Context, id int64) (*proto.LearningPath, error) {
+	path, err := s.pathRepo.GetLearningPathByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении учебной программы", zap.Error(err), zap.Int64("path_id", id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении учебной программы: %v", err)
+	}
+	if path == nil {
+		s.logger.Warn("Учебная программа не найдена", zap.Int64("path_id", id))
+		return nil, status.Errorf(codes.NotFound, "Учебная программа с ID %d не найдена", id)
+	}
+	mean, err := s.courseRepo.GetRatingMean(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении средней оценки курсов", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении средней оценки курсов: %v", err)
+	}
+	return learningPathToProto(path, mean), nil
+}
+
+func learningPathToProto(path *models.LearningPath, mean float64) *proto.LearningPath {
+	grpcPath := &proto.LearningPath{
+		Id:           path.ID,
+		Name:         path.Name,
+		Description:  path.Description,
+		InstructorId: path.InstructorID,
+	}
+	for _, item := range path.Items {
+		grpcPath.Items = append(grpcPath.Items, &proto.LearningPathItem{
+			CourseId: item.CourseID,
+			Position: item.Position,
+			Required: item.Required,
+			Course:   courseToProto(item.Course, mean),
+		})
+	}
+	return grpcPath
+}
+
+// pathCourseProgress возвращает прогресс студента по каждому курсу программы в порядке курсов.
+func pathCourseProgress(ctx context.Context, lectureRepo repository.LectureRepository, studentID int64, path *models.LearningPath) ([]int32, error) {
+	progress := make([]int32, len(path.Items))
+	for i, item := range path.Items {
+		percent, err := lectureRepo.GetCourseProgress(ctx, studentID, item.CourseID)
+		if err != nil {
+			return nil, err
+		}
+		progress[i] = percent
+	}
+	return progress, nil
+}
+
+// pathCompleted сообщает, пройдены ли все обязательные курсы программы.
+func pathCompleted(path *models.LearningPath, progress []int32) bool {
+	completed := false
+	for i, item := range path.Items {
+		if !item.Required {
+			continue
+		}
+		if progress[i] < 100 {
+			return false
+		}
+		completed = true
+	}
+	return completed
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func prepareLearningPathTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"learning_paths", "certificates", "lecture_progress", "lecture_completions", "lectures"},
+		Instructors: 2,
+		Students:    2,
+		Courses:     []int64{1, 1, 1},
+	})
+
+	_, err := db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content) SELECT id, id, 'Лекция ' || id, 'Содержание' FROM generate_series(1, 3) AS id")
+	require.NoError(t, err, "Не удалось добавить лекции")
+}
+
+func TestCreateLearningPath(t *testing.T) {
+	ctx := context.Background()
+	prepareLearningPathTables(t, ctx)
+
+	optional := false
+	testCases := []struct {
+		Name          string
+		Ctx           context.Context
+		Request       *proto.NewLearningPathRequest
+		ExpectedItems []int64
+		ShouldError   bool
+		ExpectedCode  codes.Code
+	}{
+		{
+			Name: "Успешное создание программы",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
+			Request: &proto.NewLearningPathRequest{
+				Name:         "Backend-разработчик",
+				InstructorId: 1,
+				Items: []*proto.LearningPathItemRequest{
+					{CourseId: 2}, {CourseId: 1}, {CourseId: 3, Required: &optional},
+				},
+			},
+			ExpectedItems: []int64{2, 1, 3},
+		},
+		{
+			Name:         "Повторное название",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewLearningPathRequest{Name: "Backend-разработчик", InstructorId: 1, Items: []*proto.LearningPathItemRequest{{CourseId: 1}}},
+			ShouldError:  true,
+			ExpectedCode: codes.AlreadyExists,
+		},
+		{
+			Name:         "Программа без курсов",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewLearningPathRequest{Name: "Пустая программа", InstructorId: 1},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Нет обязательных курсов",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewLearningPathRequest{Name: "Только факультативы", InstructorId: 1, Items: []*proto.LearningPathItemRequest{{CourseId: 1, Required: &optional}}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Курс повторяется",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewLearningPathRequest{Name: "Повтор", InstructorId: 1, Items: []*proto.LearningPathItemRequest{{CourseId: 1}, {CourseId: 1}}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Несуществующий курс",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewLearningPathRequest{Name: "Несуществующий курс", InstructorId: 1, Items: []*proto.LearningPathItemRequest{{CourseId: 99}}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Программа от имени другого преподавателя",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.NewLearningPathRequest{Name: "Чужая программа", InstructorId: 1, Items: []*proto.LearningPathItemRequest{{CourseId: 1}}},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientPath.CreateLearningPath(tc.Ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				t.Logf("Полученный код ошибки: %v", st.Code())
+				return
+			}
+
+			require.NoError(t, err, "Ошибка при создании программы")
+			require.Len(t, resp.Items, len(tc.ExpectedItems), "Некорректное количество курсов")
+			for i, item := range resp.Items {
+				assert.Equal(t, tc.ExpectedItems[i], item.CourseId, "Некорректный порядок курсов")
+				assert.Equal(t, int32(i+1), item.Position, "Некорректная позиция курса")
+				assert.Equal(t, item.CourseId, item.Course.Id, "Курс не заполнен")
+			}
+			assert.False(t, resp.Items[2].Required, "Третий курс должен быть необязательным")
+		})
+	}
+}
+
+func TestLearningPathProgressAndCertificate(t *testing.T) {
+	ctx := context.Background()
+	prepareLearningPathTables(t, ctx)
+
+	optional := false
+	path, err := clientPath.CreateLearningPath(withToken(t, ctx, 1, "instructor"), &proto.NewLearningPathRequest{
+		Name:         "Backend-разработчик",
+		InstructorId: 1,
+		Items:        []*proto.LearningPathItemRequest{{CourseId: 1}, {CourseId: 2}, {CourseId: 3, Required: &optional}},
+	})
+	require.NoError(t, err, "Ошибка при создании программы")
+
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	t.Run("Запись на программу записывает на все курсы", func(t *testing.T) {
+		enrollment, err := clientPath.EnrollInLearningPath(studentCtx, &proto.LearningPathStudentRequest{PathId: path.Id, StudentId: 1})
+		require.NoError(t, err, "Ошибка записи на программу")
+		assert.Equal(t, int32(3), enrollment.EnrolledCourses, "Студент должен быть записан на все курсы программы")
+
+		enrollment, err = clientPath.EnrollInLearningPath(studentCtx, &proto.LearningPathStudentRequest{PathId: path.Id, StudentId: 1})
+		require.NoError(t, err, "Ошибка повторной записи на программу")
+		assert.Zero(t, enrollment.EnrolledCourses, "Повторная запись не должна создавать записей на курсы")
+
+		_, err = clientPath.EnrollInLearningPath(studentCtx, &proto.LearningPathStudentRequest{PathId: path.Id, StudentId: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "Нельзя записать на программу другого студента")
+	})
+
+	t.Run("Прогресс по обязательным курсам", func(t *testing.T) {
+		_, err := clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 1})
+		require.NoError(t, err, "Ошибка завершения лекции 1")
+
+		progress, err := clientPath.GetLearningPathProgress(studentCtx, &proto.LearningPathStudentRequest{PathId: path.Id, StudentId: 1})
+		require.NoError(t, err, "Ошибка получения прогресса программы")
+		assert.Equal(t, int32(50), progress.CompletedPercent, "Некорректный прогресс программы")
+		assert.Equal(t, int32(1), progress.RequiredCompleted, "Некорректное число пройденных курсов")
+		assert.Equal(t, int32(2), progress.RequiredTotal, "Некорректное число обязательных курсов")
+		assert.False(t, progress.Completed, "Программа ещё не пройдена")
+		assert.Nil(t, progress.Certificate, "Сертификат программы не должен выдаваться досрочно")
+		require.Len(t, progress.Courses, 3, "Прогресс должен включать все курсы")
+		assert.Equal(t, int32(100), progress.Courses[0].CompletedPercent, "Некорректный прогресс курса")
+	})
+
+	t.Run("Сертификат после прохождения обязательных курсов", func(t *testing.T) {
+		_, err := clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 2})
+		require.NoError(t, err, "Ошибка завершения лекции 2")
+
+		var count int
+		err = db.QueryRow(ctx, "SELECT COUNT(*) FROM certificates WHERE student_id = 1 AND path_id = $1", path.Id).Scan(&count)
+		require.NoError(t, err, "Ошибка проверки данных в базе")
+		assert.Equal(t, 1, count, "Сертификат программы должен быть выдан вместе с сертификатом последнего курса")
+
+		progress, err := clientPath.GetLearningPathProgress(studentCtx, &proto.LearningPathStudentRequest{PathId: path.Id, StudentId: 1})
+		require.NoError(t, err, "Ошибка получения прогресса программы")
+		assert.True(t, progress.Completed, "Программа должна быть пройдена без необязательного курса")
+		require.NotNil(t, progress.Certificate, "Сертификат программы должен быть выдан")
+		assert.Equal(t, path.Id, progress.Certificate.PathId, "Сертификат должен относиться к программе")
+		assert.Zero(t, progress.Certificate.CourseId, "У сертификата программы нет курса")
+		assert.Equal(t, "Backend-разработчик", progress.Certificate.CourseName, "Название программы не совпадает")
+
+		verification, err := clientCertificate.VerifyCertificate(ctx, &proto.VerifyCertificateRequest{Serial: progress.Certificate.Serial, Signature: progress.Certificate.Signature})
+		require.NoError(t, err, "Ошибка проверки сертификата")
+		assert.True(t, verification.Valid, "Сертификат программы должен проходить проверку")
+	})
+
+	t.Run("Просмотр прогресса не выдаёт сертификат", func(t *testing.T) {
+		_, err := db.Exec(ctx, "DELETE FROM certificates WHERE path_id = $1", path.Id)
+		require.NoError(t, err, "Не удалось удалить сертификат программы")
+
+		progress, err := clientPath.GetLearningPathProgress(withToken(t, ctx, 1, "instructor"), &proto.LearningPathStudentRequest{PathId: path.Id, StudentId: 1})
+		require.NoError(t, err, "Ошибка получения прогресса программы")
+		assert.True(t, progress.Completed, "Программа должна оставаться пройденной")
+		assert.Nil(t, progress.Certificate, "Просмотр прогресса не должен выдавать сертификат")
+
+		var count int
+		err = db.QueryRow(ctx, "SELECT COUNT(*) FROM certificates WHERE student_id = 1 AND path_id = $1", path.Id).Scan(&count)
+		require.NoError(t, err, "Ошибка проверки данных в базе")
+		assert.Zero(t, count, "Сертификат программы не должен создаваться при просмотре прогресса")
+	})
+
+	t.Run("Прогресс студента, не записанного на программу", func(t *testing.T) {
+		_, err := clientPath.GetLearningPathProgress(withToken(t, ctx, 2, "student"), &proto.LearningPathStudentRequest{PathId: path.Id, StudentId: 2})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+	})
+}
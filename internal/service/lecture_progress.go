@@ -0,0 +1,208 @@
+package service
+
+import (
+	"GoEdu/internal/models"
+	"GoEdu/proto"
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxHeartbeatGap — наибольший промежуток между событиями просмотра, который засчитывается как
+// время в лекции. Клиент присылает heartbeat примерно раз в минуту; более долгая пауза означает,
+// что студент отвлёкся.
+const maxHeartbeatGap = 2 * time.Minute
+
+func (s *LectureService) RecordLectureProgress(ctx context.Context, req *proto.LectureProgressEvent) (*proto.LectureProgress, error) {
+	s.logger.Info("Запись прогресса лекции", zap.Int64("lecture_id", req.LectureId), zap.Int64("student_id", req.StudentId), zap.String("event", req.Event.String()))
+
+	if req.StudentId == 0 || req.LectureId == 0 {
+		s.logger.Warn("Некорректные данные события прогресса", zap.Int64("lecture_id", req.LectureId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID студента и ID лекции должны быть указаны")
+	}
+	if req.PositionSeconds < 0 {
+		s.logger.Warn("Отрицательная позиция просмотра", zap.Int32("position_seconds", req.PositionSeconds))
+		return nil, status.Errorf(codes.InvalidArgument, "Позиция просмотра не может быть отрицательной")
+	}
+
+	var maxElapsed time.Duration
+	switch req.Event {
+	case proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_STARTED:
+		// Время с прошлого просмотра не засчитывается: студент только вернулся к лекции.
+	case proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_HEARTBEAT, proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_COMPLETED:
+		maxElapsed = maxHeartbeatGap
+	default:
+		s.logger.Warn("Неизвестный тип события прогресса", zap.Int32("event", int32(req.Event)))
+		return nil, status.Errorf(codes.InvalidArgument, "Тип события должен быть указан")
+	}
+
+	lecture, err := s.lectureForStudent(ctx, req.StudentId, req.LectureId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Event == proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_COMPLETED {
+		if err := s.checkRequiredQuizzes(ctx, req.StudentId, req.LectureId); err != nil {
+			return nil, err
+		}
+	}
+
+	progress, err := s.progressRepo.RecordLectureActivity(ctx, req.StudentId, req.LectureId, req.PositionSeconds, maxElapsed)
+	if err != nil {
+		s.logger.Error("Ошибка при записи прогресса лекции", zap.Error(err), zap.Int64("lecture_id", req.LectureId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при записи прогресса лекции: %v", err)
+	}
+	progress.Title = lecture.Title
+	progress.Position = lecture.Position
+
+	if req.Event == proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_COMPLETED {
+		if err := s.lectureRepo.MarkLectureAsCompleted(ctx, req.StudentId, req.LectureId); err != nil {
+			s.logger.Error("Ошибка при отметке лекции как завершенной", zap.Error(err), zap.Int64("lecture_id", req.LectureId), zap.Int64("student_id", req.StudentId))
+			return nil, status.Errorf(codes.Internal, "Ошибка при отметке лекции как завершенной: %v", err)
+		}
+		now := time.Now()
+		progress.CompletedAt = &now
+		s.issueCertificateIfCompleted(ctx, req.StudentId, lecture.CourseID)
+	}
+
+	s.logger.Info("Прогресс лекции записан", zap.Int64("lecture_id", req.LectureId), zap.Int64("student_id", req.StudentId), zap.Int64("time_spent_seconds", progress.TimeSpentSeconds))
+	return lectureProgressToProto(progress), nil
+}
+
+func (s *LectureService) GetDetailedCourseProgress(ctx context.Context, req *proto.CourseProgressRequest) (*proto.DetailedCourseProgress, error) {
+	s.logger.Info("Получение подробного прогресса курса", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+
+	if req.StudentId == 0 || req.CourseId == 0 {
+		s.logger.Warn("Некорректные данные для получения прогресса курса", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID студента и ID курса должны быть указаны")
+	}
+
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка получить прогресс другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+
+	course, err := s.courseRepo.GetCourseByID(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
+	}
+
+	lectures, err := s.progressRepo.GetCourseLectureProgress(ctx, req.StudentId, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении прогресса по лекциям", zap.Error(err), zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении прогресса: %v", err)
+	}
+
+	summary := models.SummarizeProgress(lectures)
+	resp := &proto.DetailedCourseProgress{
+		CourseId:              req.CourseId,
+		StudentId:             req.StudentId,
+		CompletedPercent:      summary.Percent(),
+		CompletedLectures:     summary.CompletedLectures,
+		TotalLectures:         summary.TotalLectures,
+		TotalTimeSpentSeconds: summary.TimeSpentSeconds,
+		LastActivityAt:        formatOptionalTime(summary.LastActivityAt),
+		ResumeLectureId:       summary.ResumeLectureID,
+	}
+	for _, lecture := range lectures {
+		resp.Lectures = append(resp.Lectures, lectureProgressToProto(lecture))
+	}
+
+	s.logger.Info("Подробный прогресс курса получен", zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId), zap.Int32("completed_percent", resp.CompletedPercent))
+	return resp, nil
+}
+
+func (s *LectureService) GetStudentDashboard(ctx context.Context, req *proto.StudentIDRequest) (*proto.StudentDashboard, error) {
+	s.logger.Info("Получение сводки обучения студента", zap.Int64("student_id", req.Id))
+
+	if req.Id == 0 {
+		s.logger.Warn("Некорректный ID студента", zap.Int64("student_id", req.Id))
+		return nil, status.Errorf(codes.InvalidArgument, "ID студента должен быть указан")
+	}
+
+	if err := checkStudentAccess(ctx, req.Id); err != nil {
+		s.logger.Warn("Попытка получить сводку другого студента", zap.Int64("student_id", req.Id))
+		return nil, err
+	}
+
+	courses, err := s.progressRepo.GetStudentProgress(ctx, req.Id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении прогресса студента", zap.Error(err), zap.Int64("student_id", req.Id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении прогресса студента: %v", err)
+	}
+
+	type courseSummary struct {
+		course  *models.CourseLectureProgress
+		summary models.ProgressSummary
+	}
+	summaries := make([]courseSummary, 0, len(courses))
+	for _, course := range courses {
+		summaries = append(summaries, courseSummary{course: course, summary: models.SummarizeProgress(course.Lectures)})
+	}
+	// Сначала курсы с недавней активностью, затем ещё не начатые.
+	sort.SliceStable(summaries, func(i, j int) bool {
+		a, b := summaries[i].summary.LastActivityAt, summaries[j].summary.LastActivityAt
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.After(*b)
+	})
+
+	resp := &proto.StudentDashboard{
+		StudentId:       req.Id,
+		EnrolledCourses: int32(len(courses)),
+	}
+	for _, cs := range summaries {
+		if cs.summary.TotalLectures > 0 && cs.summary.CompletedLectures == cs.summary.TotalLectures {
+			resp.CompletedCourses++
+		}
+		resp.TotalTimeSpentSeconds += cs.summary.TimeSpentSeconds
+		resp.Courses = append(resp.Courses, &proto.CourseProgressSummary{
+			CourseId:          cs.course.CourseID,
+			CourseName:        cs.course.CourseName,
+			CompletedPercent:  cs.summary.Percent(),
+			CompletedLectures: cs.summary.CompletedLectures,
+			TotalLectures:     cs.summary.TotalLectures,
+			TimeSpentSeconds:  cs.summary.TimeSpentSeconds,
+			LastActivityAt:    formatOptionalTime(cs.summary.LastActivityAt),
+			ResumeLectureId:   cs.summary.ResumeLectureID,
+		})
+	}
+
+	s.logger.Info("Сводка обучения студента получена", zap.Int64("student_id", req.Id), zap.Int32("enrolled_courses", resp.EnrolledCourses))
+	return resp, nil
+}
+
+func lectureProgressToProto(progress *models.LectureProgress) *proto.LectureProgress {
+	grpcProgress := &proto.LectureProgress{
+		LectureId:           progress.LectureID,
+		Title:               progress.Title,
+		Position:            progress.Position,
+		TimeSpentSeconds:    progress.TimeSpentSeconds,
+		LastPositionSeconds: progress.LastPositionSeconds,
+		LastActivityAt:      formatOptionalTime(progress.LastActivityAt),
+		CompletedAt:         formatOptionalTime(progress.CompletedAt),
+	}
+	switch {
+	case progress.CompletedAt != nil:
+		grpcProgress.Status = proto.LectureProgressStatus_LECTURE_PROGRESS_STATUS_COMPLETED
+	case progress.LastActivityAt != nil:
+		grpcProgress.Status = proto.LectureProgressStatus_LECTURE_PROGRESS_STATUS_IN_PROGRESS
+	}
+	return grpcProgress
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
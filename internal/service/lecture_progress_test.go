@@ -0,0 +1,148 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func prepareProgressTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"lecture_progress", "lecture_completions", "lectures"},
+		Instructors: 1,
+		Students:    1,
+		Courses:     []int64{1, 1, 1},
+		Enrollments: [][2]int64{{1, 1}, {1, 2}},
+	})
+
+	_, err := db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content, position) VALUES (1, 1, 'Лекция 1', 'Содержание 1', 1), (2, 1, 'Лекция 2', 'Содержание 2', 2), (3, 1, 'Лекция 3', 'Содержание 3', 3), (4, 3, 'Чужая лекция', 'Содержание 4', 1)")
+	require.NoError(t, err, "Не удалось добавить лекции")
+}
+
+func TestRecordLectureProgress(t *testing.T) {
+	ctx := context.Background()
+	prepareProgressTables(t, ctx)
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	testCases := []struct {
+		Name         string
+		Request      *proto.LectureProgressEvent
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Начало просмотра",
+			Request: &proto.LectureProgressEvent{StudentId: 1, LectureId: 2, Event: proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_STARTED},
+		},
+		{
+			Name:    "Heartbeat с позицией",
+			Request: &proto.LectureProgressEvent{StudentId: 1, LectureId: 2, Event: proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_HEARTBEAT, PositionSeconds: 120},
+		},
+		{
+			Name:         "Тип события не указан",
+			Request:      &proto.LectureProgressEvent{StudentId: 1, LectureId: 2},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Лекция курса, на который студент не записан",
+			Request:      &proto.LectureProgressEvent{StudentId: 1, LectureId: 4, Event: proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_STARTED},
+			ShouldError:  true,
+			ExpectedCode: codes.FailedPrecondition,
+		},
+		{
+			Name:         "Лекция не найдена",
+			Request:      &proto.LectureProgressEvent{StudentId: 1, LectureId: 99, Event: proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_STARTED},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientLecture.RecordLectureProgress(studentCtx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова RecordLectureProgress")
+			assert.Equal(t, tc.Request.LectureId, resp.LectureId, "ID лекции не совпадает")
+			assert.Equal(t, tc.Request.PositionSeconds, resp.LastPositionSeconds, "Позиция просмотра не совпадает")
+			assert.Equal(t, proto.LectureProgressStatus_LECTURE_PROGRESS_STATUS_IN_PROGRESS, resp.Status, "Некорректный статус лекции")
+		})
+	}
+
+	resp, err := clientLecture.RecordLectureProgress(studentCtx, &proto.LectureProgressEvent{StudentId: 1, LectureId: 1, Event: proto.LectureProgressEventType_LECTURE_PROGRESS_EVENT_COMPLETED})
+	require.NoError(t, err, "Ошибка завершения лекции")
+	assert.Equal(t, proto.LectureProgressStatus_LECTURE_PROGRESS_STATUS_COMPLETED, resp.Status, "Лекция должна быть завершена")
+
+	var completed bool
+	err = db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM lecture_completions WHERE student_id = 1 AND lecture_id = 1)").Scan(&completed)
+	require.NoError(t, err, "Ошибка проверки данных в базе")
+	assert.True(t, completed, "Завершение лекции должно сохраниться")
+}
+
+func TestGetDetailedCourseProgress(t *testing.T) {
+	ctx := context.Background()
+	prepareProgressTables(t, ctx)
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	_, err := db.Exec(ctx, `
+        INSERT INTO lecture_progress (student_id, lecture_id, started_at, last_activity_at, time_spent_seconds, last_position_seconds) VALUES
+            (1, 1, NOW() - INTERVAL '2 days', NOW() - INTERVAL '2 days', 600, 900),
+            (1, 3, NOW() - INTERVAL '1 hour', NOW() - INTERVAL '1 hour', 300, 240)`)
+	require.NoError(t, err, "Не удалось добавить прогресс")
+
+	_, err = db.Exec(ctx, "INSERT INTO lecture_completions (student_id, lecture_id) VALUES ($1, $2)", 1, 1)
+	require.NoError(t, err, "Не удалось добавить завершение лекции")
+
+	resp, err := clientLecture.GetDetailedCourseProgress(studentCtx, &proto.CourseProgressRequest{StudentId: 1, CourseId: 1})
+	require.NoError(t, err, "Ошибка получения подробного прогресса")
+
+	assert.Equal(t, int32(33), resp.CompletedPercent, "Некорректный процент завершения")
+	assert.Equal(t, int32(1), resp.CompletedLectures, "Некорректное количество завершённых лекций")
+	assert.Equal(t, int32(3), resp.TotalLectures, "Некорректное количество лекций")
+	assert.Equal(t, int64(900), resp.TotalTimeSpentSeconds, "Некорректное общее время")
+	assert.Equal(t, int64(3), resp.ResumeLectureId, "Продолжить нужно с последней открытой лекции")
+	require.Len(t, resp.Lectures, 3, "Некорректное количество лекций")
+	assert.Equal(t, proto.LectureProgressStatus_LECTURE_PROGRESS_STATUS_COMPLETED, resp.Lectures[0].Status, "Лекция 1 должна быть завершена")
+	assert.Equal(t, proto.LectureProgressStatus_LECTURE_PROGRESS_STATUS_NOT_STARTED, resp.Lectures[1].Status, "Лекция 2 не должна быть начата")
+	assert.Equal(t, int32(240), resp.Lectures[2].LastPositionSeconds, "Некорректная позиция просмотра")
+
+	_, err = clientLecture.GetDetailedCourseProgress(withToken(t, ctx, 2, "student"), &proto.CourseProgressRequest{StudentId: 1, CourseId: 1})
+	require.Error(t, err, "Чужой прогресс не должен быть доступен")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+
+	_, err = clientLecture.GetDetailedCourseProgress(studentCtx, &proto.CourseProgressRequest{StudentId: 1, CourseId: 99})
+	require.Error(t, err, "Ожидалась ошибка для несуществующего курса")
+	assert.Equal(t, codes.NotFound, status.Code(err), "Некорректный код ошибки")
+}
+
+func TestGetStudentDashboard(t *testing.T) {
+	ctx := context.Background()
+	prepareProgressTables(t, ctx)
+
+	_, err := db.Exec(ctx, "INSERT INTO lecture_progress (student_id, lecture_id, time_spent_seconds) VALUES ($1, $2, $3)", 1, 1, 120)
+	require.NoError(t, err, "Не удалось добавить прогресс")
+
+	resp, err := clientLecture.GetStudentDashboard(withToken(t, ctx, 1, "student"), &proto.StudentIDRequest{Id: 1})
+	require.NoError(t, err, "Ошибка получения сводки")
+
+	assert.Equal(t, int32(2), resp.EnrolledCourses, "Некорректное количество курсов")
+	assert.Equal(t, int32(0), resp.CompletedCourses, "Завершённых курсов быть не должно")
+	assert.Equal(t, int64(120), resp.TotalTimeSpentSeconds, "Некорректное общее время")
+	require.Len(t, resp.Courses, 2, "Некорректное количество курсов в сводке")
+	assert.Equal(t, int64(1), resp.Courses[0].CourseId, "Первым должен идти курс с активностью")
+	assert.Equal(t, int64(1), resp.Courses[0].ResumeLectureId, "Некорректная лекция для продолжения")
+	assert.Equal(t, int32(0), resp.Courses[1].TotalLectures, "Курс без лекций должен попасть в сводку")
+}
@@ -1,28 +1,45 @@
 package service
 
 import (
+	"GoEdu/internal/middleware"
 	"GoEdu/internal/models"
+	"GoEdu/internal/recommend"
 	"GoEdu/internal/repository"
 	"GoEdu/proto"
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type LectureService struct {
 	proto.UnimplementedLectureServiceServer
-	lectureRepo repository.LectureRepository
-	logger      *zap.Logger
+	lectureRepo    repository.LectureRepository
+	enrollmentRepo repository.EnrollmentRepository
+	courseRepo     repository.CourseRepository
+	progressRepo   repository.ProgressRepository
+	quizRepo       repository.QuizRepository
+	recommendRepo  repository.RecommendationRepository
+	certificates   CertificateIssuer
+	logger         *zap.Logger
 }
 
-func NewLectureService(lectureRepo repository.LectureRepository, logger *zap.Logger) *LectureService {
+func NewLectureService(lectureRepo repository.LectureRepository, enrollmentRepo repository.EnrollmentRepository, courseRepo repository.CourseRepository, progressRepo repository.ProgressRepository, quizRepo repository.QuizRepository, recommendRepo repository.RecommendationRepository, certificates CertificateIssuer, logger *zap.Logger) *LectureService {
 	return &LectureService{
-		lectureRepo: lectureRepo,
-		logger:      logger,
+		lectureRepo:    lectureRepo,
+		enrollmentRepo: enrollmentRepo,
+		courseRepo:     courseRepo,
+		progressRepo:   progressRepo,
+		quizRepo:       quizRepo,
+		recommendRepo:  recommendRepo,
+		certificates:   certificates,
+		logger:         logger,
 	}
 }
 
@@ -34,10 +51,30 @@ func (s *LectureService) AddLectureToCourse(ctx context.Context, req *proto.Lect
 		return nil, status.Errorf(codes.InvalidArgument, "Все поля должны быть заполнены")
 	}
 
+	if req.CohortOffsetDays != nil && *req.CohortOffsetDays < 0 {
+		s.logger.Warn("Отрицательное смещение открытия лекции", zap.Int64("course_id", req.CourseId), zap.Int32("cohort_offset_days", *req.CohortOffsetDays))
+		return nil, status.Errorf(codes.InvalidArgument, "Смещение открытия лекции не может быть отрицательным")
+	}
+
+	if req.Position != nil && *req.Position < 1 {
+		s.logger.Warn("Некорректная позиция лекции", zap.Int64("course_id", req.CourseId), zap.Int32("position", *req.Position))
+		return nil, status.Errorf(codes.InvalidArgument, "Позиция лекции должна быть положительной")
+	}
+
+	releaseAt, err := parseReleaseRules(req.ReleaseAt, req.ReleaseAfterDays)
+	if err != nil {
+		s.logger.Warn("Некорректные правила открытия лекции", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+
 	lecture := &models.Lecture{
-		CourseID: req.CourseId,
-		Title:    req.Title,
-		Content:  req.Content,
+		CourseID:         req.CourseId,
+		Title:            req.Title,
+		Content:          req.Content,
+		CohortOffsetDays: req.CohortOffsetDays,
+		ReleaseAt:        releaseAt,
+		ReleaseAfterDays: req.ReleaseAfterDays,
+		Position:         req.GetPosition(),
 	}
 
 	newLecture, err := s.lectureRepo.AddLectureToCourse(ctx, lecture)
@@ -46,13 +83,10 @@ func (s *LectureService) AddLectureToCourse(ctx context.Context, req *proto.Lect
 		return nil, status.Errorf(codes.Internal, "Ошибка при добавлении лекции: %v", err)
 	}
 
+	invalidateSimilarCourses(ctx, s.courseRepo, s.logger)
+
 	s.logger.Info("Лекция успешно добавлена", zap.Int64("lecture_id", newLecture.ID), zap.Int64("course_id", newLecture.CourseID))
-	return &proto.Lecture{
-		Id:       newLecture.ID,
-		CourseId: newLecture.CourseID,
-		Title:    newLecture.Title,
-		Content:  newLecture.Content,
-	}, nil
+	return lectureToProto(newLecture), nil
 }
 
 func (s *LectureService) GetLecturesByCourse(ctx context.Context, req *proto.CourseIDRequest) (*proto.LectureList, error) {
@@ -69,14 +103,47 @@ func (s *LectureService) GetLecturesByCourse(ctx context.Context, req *proto.Cou
 		return nil, status.Errorf(codes.Internal, "Ошибка при получении лекций: %v", err)
 	}
 
+	viewer, err := s.lectureViewer(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при определении доступа к лекциям", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении лекций: %v", err)
+	}
+
+	var completed map[int64]bool
+	if viewer.checksSequence() {
+		completed, err = s.lectureRepo.GetCompletedLectureIDs(ctx, viewer.studentID, req.CourseId)
+		if err != nil {
+			s.logger.Error("Ошибка при получении завершённых лекций", zap.Error(err), zap.Int64("course_id", req.CourseId))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении лекций: %v", err)
+		}
+	}
+
+	now := time.Now()
+	var blockingID int64
 	var grpcLectures []*proto.Lecture
 	for _, lecture := range lectures {
-		grpcLectures = append(grpcLectures, &proto.Lecture{
-			Id:       lecture.ID,
-			CourseId: lecture.CourseID,
-			Title:    lecture.Title,
-			Content:  lecture.Content,
-		})
+		grpcLecture := lectureToProto(lecture)
+		if !viewer.unrestricted {
+			availableAt, known := lecture.AvailableAt(viewer.enrollment)
+			if known && !availableAt.IsZero() {
+				grpcLecture.AvailableAt = availableAt.Format(time.RFC3339)
+			}
+			if !known || availableAt.After(now) {
+				grpcLecture.Locked = true
+			}
+		}
+		if viewer.checksSequence() {
+			if blockingID != 0 {
+				grpcLecture.Locked = true
+				grpcLecture.BlockedByLectureId = blockingID
+			} else if !completed[lecture.ID] {
+				blockingID = lecture.ID
+			}
+		}
+		if grpcLecture.Locked {
+			grpcLecture.Content = ""
+		}
+		grpcLectures = append(grpcLectures, grpcLecture)
 	}
 
 	s.logger.Info("Лекции успешно получены", zap.Int("count", len(grpcLectures)), zap.Int64("course_id", req.CourseId))
@@ -102,6 +169,16 @@ func (s *LectureService) GetLectureContent(ctx context.Context, req *proto.Lectu
 		return nil, status.Errorf(codes.NotFound, "Лекция с ID %d не найдена", req.LectureId)
 	}
 
+	viewer, err := s.lectureViewer(ctx, lecture.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при определении доступа к лекции", zap.Error(err), zap.Int64("lecture_id", req.LectureId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении содержания лекции: %v", err)
+	}
+
+	if err := s.checkLectureOpen(ctx, lecture, viewer); err != nil {
+		return nil, err
+	}
+
 	s.logger.Info("Содержание лекции успешно получено", zap.Int64("lecture_id", lecture.ID))
 	return &proto.LectureContent{
 		Id:       lecture.ID,
@@ -119,25 +196,48 @@ func (s *LectureService) UpdateLecture(ctx context.Context, req *proto.UpdateLec
 		return nil, status.Errorf(codes.InvalidArgument, "ID лекции должен быть указан")
 	}
 
+	if req.CohortOffsetDays != nil && *req.CohortOffsetDays < 0 {
+		s.logger.Warn("Отрицательное смещение открытия лекции", zap.Int64("lecture_id", req.Id), zap.Int32("cohort_offset_days", *req.CohortOffsetDays))
+		return nil, status.Errorf(codes.InvalidArgument, "Смещение открытия лекции не может быть отрицательным")
+	}
+
+	if req.Position != nil && *req.Position < 1 {
+		s.logger.Warn("Некорректная позиция лекции", zap.Int64("lecture_id", req.Id), zap.Int32("position", *req.Position))
+		return nil, status.Errorf(codes.InvalidArgument, "Позиция лекции должна быть положительной")
+	}
+
+	releaseAt, err := parseReleaseRules(req.ReleaseAt, req.ReleaseAfterDays)
+	if err != nil {
+		s.logger.Warn("Некорректные правила открытия лекции", zap.Error(err), zap.Int64("lecture_id", req.Id))
+		return nil, err
+	}
+
 	lectureToUpdate := &models.Lecture{
-		ID:      req.Id,
-		Title:   req.Title,
-		Content: req.Content,
+		ID:               req.Id,
+		Title:            req.Title,
+		Content:          req.Content,
+		CohortOffsetDays: req.CohortOffsetDays,
+		ReleaseAt:        releaseAt,
+		ReleaseAfterDays: req.ReleaseAfterDays,
+		Position:         req.GetPosition(),
 	}
 
-	updatedLecture, err := s.lectureRepo.UpdateLecture(ctx, lectureToUpdate)
+	updatedLecture, err := s.lectureRepo.UpdateLecture(ctx, lectureToUpdate, req.ClearReleaseRules)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Warn("Лекция не найдена", zap.Int64("lecture_id", req.Id))
+			return nil, status.Errorf(codes.NotFound, "Лекция с ID %d не найдена", req.Id)
+		}
 		s.logger.Error("Ошибка при обновлении лекции", zap.Error(err), zap.Int64("lecture_id", req.Id))
 		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении лекции: %v", err)
 	}
 
+	if req.Title != "" {
+		invalidateSimilarCourses(ctx, s.courseRepo, s.logger)
+	}
+
 	s.logger.Info("Лекция успешно обновлена", zap.Int64("lecture_id", updatedLecture.ID))
-	return &proto.Lecture{
-		Id:       updatedLecture.ID,
-		CourseId: updatedLecture.CourseID,
-		Title:    updatedLecture.Title,
-		Content:  updatedLecture.Content,
-	}, nil
+	return lectureToProto(updatedLecture), nil
 }
 
 func (s *LectureService) DeleteLecture(ctx context.Context, req *proto.LectureIDRequest) (*proto.Empty, error) {
@@ -158,6 +258,8 @@ func (s *LectureService) DeleteLecture(ctx context.Context, req *proto.LectureID
 		return nil, status.Errorf(codes.Internal, "Ошибка при удалении лекции: %v", err)
 	}
 
+	invalidateSimilarCourses(ctx, s.courseRepo, s.logger)
+
 	s.logger.Info("Лекция успешно удалена", zap.Int64("lecture_id", req.LectureId))
 	return &proto.Empty{}, nil
 }
@@ -170,12 +272,22 @@ func (s *LectureService) MarkLectureAsCompleted(ctx context.Context, req *proto.
 		return nil, status.Errorf(codes.InvalidArgument, "ID студента и ID лекции должны быть указаны")
 	}
 
-	err := s.lectureRepo.MarkLectureAsCompleted(ctx, req.StudentId, req.LectureId)
+	lecture, err := s.lectureForStudent(ctx, req.StudentId, req.LectureId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRequiredQuizzes(ctx, req.StudentId, req.LectureId); err != nil {
+		return nil, err
+	}
+
+	err = s.lectureRepo.MarkLectureAsCompleted(ctx, req.StudentId, req.LectureId)
 	if err != nil {
 		s.logger.Error("Ошибка при отметке лекции как завершенной", zap.Error(err), zap.Int64("lecture_id", req.LectureId), zap.Int64("student_id", req.StudentId))
 		return nil, status.Errorf(codes.NotFound, "Ошибка при отметке лекции как завершенной: %v", err)
 	}
 
+	s.issueCertificateIfCompleted(ctx, req.StudentId, lecture.CourseID)
+
 	s.logger.Info("Лекция успешно отмечена как завершенная", zap.Int64("lecture_id", req.LectureId), zap.Int64("student_id", req.StudentId))
 	return &proto.Empty{}, nil
 }
@@ -188,6 +300,16 @@ func (s *LectureService) GetCourseProgress(ctx context.Context, req *proto.Cours
 		return nil, status.Errorf(codes.InvalidArgument, "ID студента и ID курса должны быть указаны")
 	}
 
+	course, err := s.courseRepo.GetCourseByID(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
+	}
+
 	progress, err := s.lectureRepo.GetCourseProgress(ctx, req.StudentId, req.CourseId)
 	if err != nil {
 		s.logger.Error("Ошибка при получении прогресса", zap.Error(err), zap.Int64("course_id", req.CourseId), zap.Int64("student_id", req.StudentId))
@@ -202,30 +324,323 @@ func (s *LectureService) GetCourseProgress(ctx context.Context, req *proto.Cours
 	}, nil
 }
 
+// recommendationLimit — сколько курсов возвращают GetRecommendedCourses и GetCourseRecommendations.
+const recommendationLimit = 5
+
+// GetRecommendedCourses возвращает те же курсы, что и GetCourseRecommendations, но без пояснений —
+// в прежнем формате CourseList.
 func (s *LectureService) GetRecommendedCourses(ctx context.Context, req *proto.StudentIDRequest) (*proto.CourseList, error) {
+	recommendations, err := s.GetCourseRecommendations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &proto.CourseList{}
+	for _, recommendation := range recommendations.Recommendations {
+		result.Courses = append(result.Courses, recommendation.Course)
+	}
+	return result, nil
+}
+
+// GetCourseRecommendations рекомендует студенту курсы по заранее рассчитанной близости к курсам, на которые
+// он записан (см. recommend.Recommend). Если персональных рекомендаций не хватает, список дополняется
+// популярными курсами.
+func (s *LectureService) GetCourseRecommendations(ctx context.Context, req *proto.StudentIDRequest) (*proto.RecommendationList, error) {
 	s.logger.Info("Получение рекомендованных курсов", zap.Int64("student_id", req.Id))
 
 	if req.Id == 0 {
 		s.logger.Warn("Некорректный ID студента", zap.Int64("student_id", req.Id))
 		return nil, status.Errorf(codes.InvalidArgument, "ID студента должен быть указан")
 	}
+	if err := checkStudentAccess(ctx, req.Id); err != nil {
+		s.logger.Warn("Запрос рекомендаций другого студента", zap.Int64("student_id", req.Id))
+		return nil, err
+	}
 
-	courses, err := s.lectureRepo.GetRecommendedCourses(ctx, req.Id)
+	history, err := s.recommendRepo.GetStudentInteractions(ctx, req.Id)
 	if err != nil {
-		s.logger.Error("Ошибка при получении рекомендованных курсов", zap.Error(err), zap.Int64("student_id", req.Id))
+		s.logger.Error("Ошибка при получении курсов студента", zap.Error(err), zap.Int64("student_id", req.Id))
 		return nil, status.Errorf(codes.Internal, "Ошибка при получении рекомендованных курсов: %v", err)
 	}
+	exclude := make(map[int64]bool, len(history))
+	courseIDs := make([]int64, 0, len(history))
+	for _, interaction := range history {
+		exclude[interaction.CourseID] = true
+		courseIDs = append(courseIDs, interaction.CourseID)
+	}
 
-	var grpcCourses []*proto.Course
-	for _, course := range courses {
-		grpcCourses = append(grpcCourses, &proto.Course{
-			Id:           course.ID,
-			Name:         course.Name,
-			Description:  course.Description,
-			InstructorId: course.InstructorID,
+	var ranked []*models.CourseRecommendation
+	if len(history) > 0 {
+		similarities, err := s.recommendRepo.GetSimilarities(ctx, courseIDs)
+		if err != nil {
+			s.logger.Error("Ошибка при получении похожих курсов", zap.Error(err), zap.Int64("student_id", req.Id))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении рекомендованных курсов: %v", err)
+		}
+		ranked = recommend.Recommend(history, similarities, exclude, recommendationLimit)
+	}
+
+	result := &proto.RecommendationList{}
+	names := make(map[int64]string)
+	for _, recommendation := range ranked {
+		course, err := s.courseRepo.GetCourseByID(ctx, recommendation.CourseID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", recommendation.CourseID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении рекомендованных курсов: %v", err)
+		}
+		if course == nil {
+			continue
+		}
+		because, ok := names[recommendation.BecauseCourseID]
+		if !ok {
+			source, err := s.courseRepo.GetCourseByID(ctx, recommendation.BecauseCourseID)
+			if err != nil {
+				s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", recommendation.BecauseCourseID))
+				return nil, status.Errorf(codes.Internal, "Ошибка при получении рекомендованных курсов: %v", err)
+			}
+			if source != nil {
+				because = source.Name
+			}
+			names[recommendation.BecauseCourseID] = because
+		}
+
+		reason := fmt.Sprintf("Похож на курс «%s», на который вы записаны", because)
+		if recommendation.Collaborative {
+			reason = fmt.Sprintf("Потому что вы проходите курс «%s»: его студенты часто выбирают и этот", because)
+		}
+		exclude[course.ID] = true
+		result.Recommendations = append(result.Recommendations, &proto.CourseRecommendation{
+			Course:          recommendedCourseToProto(course),
+			Score:           recommendation.Score,
+			Reason:          reason,
+			BecauseCourseId: recommendation.BecauseCourseID,
 		})
 	}
 
-	s.logger.Info("Рекомендованные курсы успешно получены", zap.Int("count", len(grpcCourses)), zap.Int64("student_id", req.Id))
-	return &proto.CourseList{Courses: grpcCourses}, nil
+	if missing := recommendationLimit - len(result.Recommendations); missing > 0 {
+		popular, err := s.recommendRepo.GetPopularCourses(ctx, req.Id, missing+len(result.Recommendations))
+		if err != nil {
+			s.logger.Error("Ошибка при получении популярных курсов", zap.Error(err), zap.Int64("student_id", req.Id))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении рекомендованных курсов: %v", err)
+		}
+		for _, course := range popular {
+			if len(result.Recommendations) == recommendationLimit {
+				break
+			}
+			if exclude[course.ID] {
+				continue
+			}
+			result.Recommendations = append(result.Recommendations, &proto.CourseRecommendation{
+				Course: recommendedCourseToProto(course),
+				Reason: "Популярный курс",
+			})
+		}
+	}
+
+	s.logger.Info("Рекомендованные курсы успешно получены", zap.Int("count", len(result.Recommendations)), zap.Int("personalized", len(ranked)), zap.Int64("student_id", req.Id))
+	return result, nil
+}
+
+func recommendedCourseToProto(course *models.Course) *proto.Course {
+	return &proto.Course{
+		Id:           course.ID,
+		Name:         course.Name,
+		Description:  course.Description,
+		InstructorId: course.InstructorID,
+		Category:     course.Category,
+		Tags:         course.Tags,
+	}
+}
+
+// issueCertificateIfCompleted выдаёт сертификат после завершения курса. Вызывается только при отметке
+// завершения лекции; ошибка выдачи не отменяет отметку, и сертификат будет выдан при повторной отметке.
+func (s *LectureService) issueCertificateIfCompleted(ctx context.Context, studentID, courseID int64) {
+	if s.certificates == nil {
+		return
+	}
+	if _, err := s.certificates.IssueIfCompleted(ctx, studentID, courseID); err != nil {
+		s.logger.Error("Ошибка при выдаче сертификата", zap.Error(err), zap.Int64("student_id", studentID), zap.Int64("course_id", courseID))
+	}
+}
+
+// checkRequiredQuizzes не даёт завершить лекцию, пока студент не сдал её обязательные тесты.
+func (s *LectureService) checkRequiredQuizzes(ctx context.Context, studentID, lectureID int64) error {
+	quiz, err := s.quizRepo.GetUnpassedRequiredQuiz(ctx, studentID, lectureID)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке обязательных тестов", zap.Error(err), zap.Int64("lecture_id", lectureID), zap.Int64("student_id", studentID))
+		return status.Errorf(codes.Internal, "Ошибка при проверке обязательных тестов: %v", err)
+	}
+	if quiz != nil {
+		s.logger.Warn("Обязательный тест лекции не сдан", zap.Int64("lecture_id", lectureID), zap.Int64("quiz_id", quiz.ID), zap.Int64("student_id", studentID))
+		return quizRequiredError(quiz)
+	}
+	return nil
+}
+
+// lectureForStudent возвращает лекцию, если студент записан на её курс и лекция для него уже открыта.
+func (s *LectureService) lectureForStudent(ctx context.Context, studentID, lectureID int64) (*models.Lecture, error) {
+	if err := checkStudentAccess(ctx, studentID); err != nil {
+		s.logger.Warn("Попытка изменить прогресс другого студента", zap.Int64("student_id", studentID))
+		return nil, err
+	}
+
+	lecture, err := s.lectureRepo.GetLectureContent(ctx, lectureID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении лекции", zap.Error(err), zap.Int64("lecture_id", lectureID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении лекции: %v", err)
+	}
+	if lecture == nil {
+		s.logger.Warn("Лекция не найдена", zap.Int64("lecture_id", lectureID))
+		return nil, status.Errorf(codes.NotFound, "Лекция с ID %d не найдена", lectureID)
+	}
+
+	course, err := s.courseRepo.GetCourseByID(ctx, lecture.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", lecture.CourseID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+
+	enrollment, err := s.enrollmentRepo.GetEnrollment(ctx, studentID, lecture.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке записи на курс", zap.Error(err), zap.Int64("student_id", studentID), zap.Int64("course_id", lecture.CourseID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при проверке записи на курс: %v", err)
+	}
+	if enrollment == nil {
+		s.logger.Warn("Студент не записан на курс", zap.Int64("student_id", studentID), zap.Int64("course_id", lecture.CourseID))
+		return nil, status.Errorf(codes.FailedPrecondition, "Студент с ID %d не записан на курс с ID %d", studentID, lecture.CourseID)
+	}
+
+	viewer := lectureViewer{
+		enrollment: enrollment,
+		studentID:  studentID,
+		sequential: course != nil && course.Sequential,
+	}
+	if err := s.checkLectureOpen(ctx, lecture, viewer); err != nil {
+		return nil, err
+	}
+	return lecture, nil
+
+}
+
+// lectureViewer описывает, как пользователь из контекста видит лекции курса.
+type lectureViewer struct {
+	// unrestricted — преподаватель курса видит все лекции независимо от правил открытия.
+	unrestricted bool
+	// enrollment — запись студента на курс; nil для анонимных и незаписанных пользователей.
+	enrollment *models.Enrollment
+	// studentID и sequential задают проверку порядка прохождения для студентов последовательных курсов.
+	studentID  int64
+	sequential bool
+}
+
+func (v lectureViewer) checksSequence() bool {
+	return !v.unrestricted && v.sequential && v.studentID != 0
+}
+
+func (s *LectureService) lectureViewer(ctx context.Context, courseID int64) (lectureViewer, error) {
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return lectureViewer{}, nil
+	}
+
+	course, err := s.courseRepo.GetCourseByID(ctx, courseID)
+	if err != nil || course == nil {
+		return lectureViewer{}, err
+	}
+
+	switch user.Role {
+	case "instructor":
+		return lectureViewer{unrestricted: course.InstructorID == user.ID}, nil
+	case "student":
+		enrollment, err := s.enrollmentRepo.GetEnrollment(ctx, user.ID, courseID)
+		if err != nil {
+			return lectureViewer{}, err
+		}
+		return lectureViewer{enrollment: enrollment, studentID: user.ID, sequential: course.Sequential}, nil
+	}
+	return lectureViewer{}, nil
+}
+
+// checkLectureOpen возвращает FailedPrecondition, если лекция ещё закрыта для пользователя
+// правилами открытия или порядком прохождения курса.
+func (s *LectureService) checkLectureOpen(ctx context.Context, lecture *models.Lecture, viewer lectureViewer) error {
+	if viewer.unrestricted {
+		return nil
+	}
+
+	availableAt, known := lecture.AvailableAt(viewer.enrollment)
+	if !known {
+		s.logger.Warn("Лекция доступна только записанным на курс студентам", zap.Int64("lecture_id", lecture.ID))
+		return status.Errorf(codes.FailedPrecondition, "Лекция откроется после записи на курс")
+	}
+	if availableAt.After(time.Now()) {
+		s.logger.Warn("Лекция ещё не открыта", zap.Int64("lecture_id", lecture.ID), zap.Time("available_at", availableAt))
+		return status.Errorf(codes.FailedPrecondition, "Лекция откроется %s", availableAt.Format(time.RFC3339))
+	}
+
+	if !viewer.checksSequence() {
+		return nil
+	}
+
+	blockingID, err := s.lectureRepo.GetBlockingLecture(ctx, viewer.studentID, lecture)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке порядка прохождения", zap.Error(err), zap.Int64("lecture_id", lecture.ID), zap.Int64("student_id", viewer.studentID))
+		return status.Errorf(codes.Internal, "Ошибка при проверке порядка прохождения: %v", err)
+	}
+	if blockingID != 0 {
+		s.logger.Warn("Предыдущая лекция не завершена", zap.Int64("lecture_id", lecture.ID), zap.Int64("blocking_lecture_id", blockingID), zap.Int64("student_id", viewer.studentID))
+		return lectureSequenceError(blockingID)
+	}
+	return nil
+}
+
+// lectureSequenceError сообщает, какую лекцию нужно завершить первой. ID блокирующей лекции
+// передаётся и в тексте, и в PreconditionFailure, чтобы клиент мог перейти к ней.
+func lectureSequenceError(blockingID int64) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("Сначала необходимо завершить лекцию с ID %d", blockingID))
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{
+			Type:        "LECTURE_NOT_COMPLETED",
+			Subject:     fmt.Sprintf("lectures/%d", blockingID),
+			Description: "Предыдущая лекция курса не завершена",
+		}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// parseReleaseRules проверяет правила открытия лекции. Дата принимается в формате RFC3339 или YYYY-MM-DD.
+func parseReleaseRules(releaseAt string, releaseAfterDays *int32) (*time.Time, error) {
+	if releaseAfterDays != nil && *releaseAfterDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Срок открытия лекции после записи не может быть отрицательным")
+	}
+	if releaseAt == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, releaseAt)
+	if err != nil {
+		t, err = time.Parse(dateLayout, releaseAt)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Некорректная дата открытия лекции: %s", releaseAt)
+	}
+	return &t, nil
+}
+
+func lectureToProto(lecture *models.Lecture) *proto.Lecture {
+	grpcLecture := &proto.Lecture{
+		Id:               lecture.ID,
+		CourseId:         lecture.CourseID,
+		Title:            lecture.Title,
+		Content:          lecture.Content,
+		CohortOffsetDays: lecture.CohortOffsetDays,
+		ReleaseAfterDays: lecture.ReleaseAfterDays,
+		Position:         lecture.Position,
+	}
+	if lecture.ReleaseAt != nil {
+		grpcLecture.ReleaseAt = lecture.ReleaseAt.Format(time.RFC3339)
+	}
+	return grpcLecture
 }
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/repository"
 	"GoEdu/proto"
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -310,12 +313,20 @@ func TestGetRecommendedCourses(t *testing.T) {
 	`)
 	require.NoError(t, err, "Не удалось добавить курсы")
 
+	_, err = db.Exec(ctx, `
+		INSERT INTO students (id, name, email, password)
+		VALUES (1, 'Студент 1', 'student1@domain.com', 'securepassword')
+		ON CONFLICT (id) DO NOTHING
+	`)
+	require.NoError(t, err, "Не удалось добавить студента")
+
 	// Добавление зачисления
 	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES ($1, $2)", 1, 1)
 	require.NoError(t, err, "Не удалось добавить зачисления")
 
 	testCases := []struct {
 		Name         string
+		Ctx          context.Context
 		Request      *proto.StudentIDRequest
 		Expected     *proto.CourseList
 		ShouldError  bool
@@ -323,6 +334,7 @@ func TestGetRecommendedCourses(t *testing.T) {
 	}{
 		{
 			Name: "Успешное получение рекомендованных курсов",
+			Ctx:  withToken(t, ctx, 1, "student"),
 			Request: &proto.StudentIDRequest{
 				Id: 1,
 			},
@@ -346,6 +358,7 @@ func TestGetRecommendedCourses(t *testing.T) {
 		},
 		{
 			Name: "Некорректный ID студента",
+			Ctx:  withToken(t, ctx, 1, "student"),
 			Request: &proto.StudentIDRequest{
 				Id: 0,
 			},
@@ -356,7 +369,7 @@ func TestGetRecommendedCourses(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			resp, err := clientLecture.GetRecommendedCourses(ctx, tc.Request)
+			resp, err := clientLecture.GetRecommendedCourses(tc.Ctx, tc.Request)
 
 			if tc.ShouldError {
 				require.Error(t, err, "Ожидалась ошибка, но её не было")
@@ -381,6 +394,119 @@ func TestGetRecommendedCourses(t *testing.T) {
 	}
 }
 
+func TestGetCourseRecommendations(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := db.Exec(ctx, "TRUNCATE TABLE course_similarities, enrollments, courses, students, instructors RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO instructors (id, name, email, password)
+		VALUES
+		(1, 'Инструктор 1', 'instructor1@domain.com', 'password1'),
+		(2, 'Инструктор 2', 'instructor2@domain.com', 'password2')
+	`)
+	require.NoError(t, err, "Не удалось добавить преподавателей")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO courses (id, name, description, instructor_id, category, tags)
+		VALUES
+		(1, 'Go для начинающих', 'Основы Go', 1, 'programming', '{go,backend}'),
+		(2, 'Продвинутый Go', 'Конкурентность в Go', 1, 'programming', '{go,concurrency}'),
+		(3, 'Рисование', 'Основы рисунка', 2, 'art', '{drawing}'),
+		(4, 'Базы данных', 'SQL и PostgreSQL', 1, 'programming', '{sql,backend}'),
+		(5, 'Акварель', 'Живопись акварелью', 2, 'art', '{painting}')
+	`)
+	require.NoError(t, err, "Не удалось добавить курсы")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO students (id, name, email, password)
+		SELECT id, 'Студент ' || id, 'student' || id || '@domain.com', 'securepassword' FROM generate_series(1, 6) AS id
+	`)
+	require.NoError(t, err, "Не удалось добавить студентов")
+
+	// Студенты 2 и 3 вместе с курсом 1 выбирают курс 4, студент 4 — курс 3.
+	_, err = db.Exec(ctx, `
+		INSERT INTO enrollments (student_id, course_id)
+		VALUES (1, 1), (2, 1), (2, 4), (3, 1), (3, 4), (4, 1), (4, 3), (5, 3), (5, 5)
+	`)
+	require.NoError(t, err, "Не удалось добавить зачисления")
+
+	builder := jobs.NewRecommendationBuilder(repository.NewRecommendationRepository(db), repository.NewCourseRepository(db), time.Minute, zapLogger)
+	pairs, err := builder.Rebuild(ctx)
+	require.NoError(t, err, "Ошибка пересчёта рекомендаций")
+	assert.Positive(t, pairs, "Близость курсов должна быть рассчитана")
+
+	testCases := []struct {
+		Name            string
+		Ctx             context.Context
+		Request         *proto.StudentIDRequest
+		ExpectedIDs     []int64
+		ExpectedBecause []int64
+		ShouldError     bool
+		ExpectedCode    codes.Code
+	}{
+		{
+			Name:            "Персональные рекомендации",
+			Ctx:             withToken(t, ctx, 1, "student"),
+			Request:         &proto.StudentIDRequest{Id: 1},
+			ExpectedIDs:     []int64{4, 2, 3, 5},
+			ExpectedBecause: []int64{1, 1, 1, 0},
+		},
+		{
+			Name:            "Студент без записей получает популярные курсы",
+			Ctx:             withToken(t, ctx, 6, "student"),
+			Request:         &proto.StudentIDRequest{Id: 6},
+			ExpectedIDs:     []int64{1, 3, 4, 5, 2},
+			ExpectedBecause: []int64{0, 0, 0, 0, 0},
+		},
+		{
+			Name:         "Рекомендации другого студента",
+			Ctx:          withToken(t, ctx, 2, "student"),
+			Request:      &proto.StudentIDRequest{Id: 1},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Некорректный ID студента",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.StudentIDRequest{Id: 0},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientLecture.GetCourseRecommendations(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				t.Logf("Полученный код ошибки: %v", st.Code())
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова метода")
+			var ids, because []int64
+			for _, recommendation := range resp.Recommendations {
+				ids = append(ids, recommendation.Course.Id)
+				because = append(because, recommendation.BecauseCourseId)
+				assert.NotEmpty(t, recommendation.Reason, "У рекомендации должно быть пояснение")
+			}
+			assert.Equal(t, tc.ExpectedIDs, ids, "Некорректный порядок рекомендаций")
+			assert.Equal(t, tc.ExpectedBecause, because, "Некорректные курсы-основания")
+		})
+	}
+
+	resp, err := clientLecture.GetCourseRecommendations(withToken(t, ctx, 1, "student"), &proto.StudentIDRequest{Id: 1})
+	require.NoError(t, err, "Ошибка вызова метода")
+	assert.Contains(t, resp.Recommendations[0].Reason, "Go для начинающих", "Пояснение должно называть курс студента")
+	assert.Equal(t, "Популярный курс", resp.Recommendations[3].Reason, "Некорректное пояснение для популярного курса")
+}
+
 func TestGetCourseProgress(t *testing.T) {
 	ctx := context.Background()
 
@@ -388,8 +514,8 @@ func TestGetCourseProgress(t *testing.T) {
 	_, err := db.Exec(ctx, "TRUNCATE TABLE lecture_completions, lectures, courses RESTART IDENTITY CASCADE")
 	require.NoError(t, err, "Не удалось очистить таблицы")
 
-	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id) VALUES ($1, $2, $3, $4)", 1, "Курс 1", "Описание курса 1", 1)
-	require.NoError(t, err, "Не удалось добавить курс")
+	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)", 1, "Курс 1", "Описание курса 1", 1, 2, "Курс 2", "Описание курса 2", 1)
+	require.NoError(t, err, "Не удалось добавить курсы")
 
 	_, err = db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)", 1, 1, "Лекция 1", "Содержание лекции 1", 2, 1, "Лекция 2", "Содержание лекции 2")
 	require.NoError(t, err, "Не удалось добавить лекции")
@@ -417,6 +543,19 @@ func TestGetCourseProgress(t *testing.T) {
 			},
 			ShouldError: false,
 		},
+		{
+			Name: "Курс без лекций",
+			Request: &proto.CourseProgressRequest{
+				CourseId:  2,
+				StudentId: 1,
+			},
+			Expected: &proto.CourseProgress{
+				CourseId:         2,
+				StudentId:        1,
+				CompletedPercent: 0,
+			},
+			ShouldError: false,
+		},
 		{
 			Name: "Курс не найден",
 			Request: &proto.CourseProgressRequest{
@@ -424,7 +563,7 @@ func TestGetCourseProgress(t *testing.T) {
 				StudentId: 1,
 			},
 			ShouldError:  true,
-			ExpectedCode: codes.Internal,
+			ExpectedCode: codes.NotFound,
 		},
 		{
 			Name: "Некорректный ID студента",
@@ -472,11 +611,19 @@ func TestGetCourseProgress(t *testing.T) {
 func TestMarkLectureAsCompleted(t *testing.T) {
 	ctx := context.Background()
 
-	_, err := db.Exec(ctx, "TRUNCATE TABLE lecture_completions, lectures, students RESTART IDENTITY CASCADE")
+	_, err := db.Exec(ctx, "TRUNCATE TABLE lecture_completions, lectures, enrollments, courses, students RESTART IDENTITY CASCADE")
 	require.NoError(t, err, "Не удалось очистить таблицы")
 
-	_, err = db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES ($1, $2, $3, $4)", 1, "Студент 1", "student1@domain.com", "securepassword")
-	require.NoError(t, err, "Не удалось добавить студента")
+	_, err = db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)",
+		1, "Студент 1", "student1@domain.com", "securepassword",
+		2, "Студент 2", "student2@domain.com", "securepassword")
+	require.NoError(t, err, "Не удалось добавить студентов")
+
+	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id) VALUES ($1, $2, $3, $4)", 1, "Курс 1", "Описание курса 1", 1)
+	require.NoError(t, err, "Не удалось добавить курс")
+
+	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES ($1, $2)", 1, 1)
+	require.NoError(t, err, "Не удалось добавить запись на курс")
 
 	_, err = db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content) VALUES ($1, $2, $3, $4)", 1, 1, "Лекция 1", "Содержание лекции 1")
 	require.NoError(t, err, "Не удалось добавить лекцию")
@@ -504,6 +651,15 @@ func TestMarkLectureAsCompleted(t *testing.T) {
 			ShouldError:  true,
 			ExpectedCode: codes.NotFound,
 		},
+		{
+			Name: "Студент не записан на курс",
+			Request: &proto.LectureCompletionRequest{
+				StudentId: 2,
+				LectureId: 1,
+			},
+			ShouldError:  true,
+			ExpectedCode: codes.FailedPrecondition,
+		},
 		{
 			Name: "Студент не найден",
 			Request: &proto.LectureCompletionRequest{
@@ -511,7 +667,7 @@ func TestMarkLectureAsCompleted(t *testing.T) {
 				LectureId: 1,
 			},
 			ShouldError:  true,
-			ExpectedCode: codes.NotFound,
+			ExpectedCode: codes.FailedPrecondition,
 		},
 		{
 			Name: "Некорректный запрос",
@@ -526,7 +682,7 @@ func TestMarkLectureAsCompleted(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			resp, err := clientLecture.MarkLectureAsCompleted(ctx, tc.Request)
+			resp, err := clientLecture.MarkLectureAsCompleted(withToken(t, ctx, tc.Request.StudentId, "student"), tc.Request)
 
 			if tc.ShouldError {
 				require.Error(t, err, "Ожидалась ошибка, но её не было")
@@ -542,3 +698,161 @@ func TestMarkLectureAsCompleted(t *testing.T) {
 		})
 	}
 }
+
+func TestSequentialLectureCompletion(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := db.Exec(ctx, "TRUNCATE TABLE lecture_completions, lectures, enrollments, courses, students RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы")
+
+	_, err = db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES ($1, $2, $3, $4)", 1, "Студент 1", "student1@domain.com", "securepassword")
+	require.NoError(t, err, "Не удалось добавить студента")
+
+	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id, sequential) VALUES ($1, $2, $3, $4, TRUE)", 1, "Курс 1", "Описание курса 1", 1)
+	require.NoError(t, err, "Не удалось добавить курс")
+
+	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES ($1, $2)", 1, 1)
+	require.NoError(t, err, "Не удалось добавить запись на курс")
+
+	// Порядок задаётся позицией, а не ID: лекция 3 идёт второй.
+	_, err = db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content, position) VALUES (1, 1, 'Первая', 'Содержание 1', 1), (2, 1, 'Третья', 'Содержание 2', 3), (3, 1, 'Вторая', 'Содержание 3', 2)")
+	require.NoError(t, err, "Не удалось добавить лекции")
+
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	_, err = clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 2})
+	require.Error(t, err, "Лекцию нельзя завершить раньше предыдущих")
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code(), "Некорректный код ошибки")
+	assert.Contains(t, st.Message(), "ID 3", "В ошибке должна быть указана блокирующая лекция")
+
+	_, err = clientLecture.GetLectureContent(studentCtx, &proto.LectureIDRequest{LectureId: 3})
+	require.Error(t, err, "Лекцию нельзя открыть раньше предыдущих")
+	assert.Contains(t, status.Convert(err).Message(), "ID 1", "В ошибке должна быть указана блокирующая лекция")
+
+	lectures, err := clientLecture.GetLecturesByCourse(studentCtx, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка получения лекций")
+	require.Len(t, lectures.Lectures, 3, "Некорректное количество лекций")
+	assert.Equal(t, []int64{1, 3, 2}, []int64{lectures.Lectures[0].Id, lectures.Lectures[1].Id, lectures.Lectures[2].Id}, "Лекции должны идти по позиции")
+	assert.False(t, lectures.Lectures[0].Locked, "Первая лекция должна быть открыта")
+	assert.True(t, lectures.Lectures[1].Locked, "Вторая лекция должна быть закрыта")
+	assert.Equal(t, int64(1), lectures.Lectures[1].BlockedByLectureId, "Некорректная блокирующая лекция")
+
+	for _, lectureID := range []int64{1, 3, 2} {
+		_, err = clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: lectureID})
+		require.NoError(t, err, "Лекция %d должна завершаться по порядку", lectureID)
+	}
+
+	_, err = clientLecture.MarkLectureAsCompleted(withToken(t, ctx, 2, "student"), &proto.LectureCompletionRequest{StudentId: 1, LectureId: 1})
+	require.Error(t, err, "Нельзя отмечать лекции за другого студента")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+}
+
+func prepareLectureReleaseTables(t *testing.T, ctx context.Context) {
+	_, err := db.Exec(ctx, "TRUNCATE TABLE lecture_release_notifications, lecture_completions, lectures, enrollments, courses, students, instructors RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы")
+
+	_, err = db.Exec(ctx, "INSERT INTO instructors (id, name, email, password) VALUES ($1, $2, $3, $4)", 1, "Преподаватель 1", "instructor1@domain.com", "securepassword")
+	require.NoError(t, err, "Не удалось добавить преподавателя")
+
+	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id) VALUES ($1, $2, $3, $4)", 1, "Курс 1", "Описание курса 1", 1)
+	require.NoError(t, err, "Не удалось добавить курс")
+
+	_, err = db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)",
+		1, "Студент 1", "student1@domain.com", "securepassword",
+		2, "Студент 2", "student2@domain.com", "securepassword")
+	require.NoError(t, err, "Не удалось добавить студентов")
+
+	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id, enrolled_at) VALUES ($1, $2, NOW() - INTERVAL '3 days')", 1, 1)
+	require.NoError(t, err, "Не удалось добавить запись на курс")
+
+	_, err = db.Exec(ctx, `
+        INSERT INTO lectures (id, course_id, title, content, release_at, release_after_days) VALUES
+            (1, 1, 'Вводная', 'Содержание 1', NULL, NULL),
+            (2, 1, 'Открыта по дате', 'Содержание 2', NOW() - INTERVAL '1 day', NULL),
+            (3, 1, 'Закрыта по дате', 'Содержание 3', NOW() + INTERVAL '5 days', NULL),
+            (4, 1, 'Открыта после записи', 'Содержание 4', NULL, 1),
+            (5, 1, 'Закрыта после записи', 'Содержание 5', NULL, 10)`)
+	require.NoError(t, err, "Не удалось добавить лекции")
+}
+
+func TestLectureReleaseRules(t *testing.T) {
+	ctx := context.Background()
+	prepareLectureReleaseTables(t, ctx)
+
+	testCases := []struct {
+		Name           string
+		Ctx            context.Context
+		ExpectedLocked map[int64]bool
+	}{
+		{
+			Name:           "Записанный студент",
+			Ctx:            withToken(t, ctx, 1, "student"),
+			ExpectedLocked: map[int64]bool{1: false, 2: false, 3: true, 4: false, 5: true},
+		},
+		{
+			Name:           "Студент без записи на курс",
+			Ctx:            withToken(t, ctx, 2, "student"),
+			ExpectedLocked: map[int64]bool{1: false, 2: false, 3: true, 4: true, 5: true},
+		},
+		{
+			Name:           "Преподаватель курса",
+			Ctx:            withToken(t, ctx, 1, "instructor"),
+			ExpectedLocked: map[int64]bool{1: false, 2: false, 3: false, 4: false, 5: false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientLecture.GetLecturesByCourse(tc.Ctx, &proto.CourseIDRequest{CourseId: 1})
+			require.NoError(t, err, "Ошибка получения лекций")
+			require.Len(t, resp.Lectures, len(tc.ExpectedLocked), "Некорректное количество лекций")
+
+			for _, lecture := range resp.Lectures {
+				assert.Equal(t, tc.ExpectedLocked[lecture.Id], lecture.Locked, "Признак блокировки лекции %d не совпадает", lecture.Id)
+				if lecture.Locked {
+					assert.Empty(t, lecture.Content, "Содержание закрытой лекции %d не должно передаваться", lecture.Id)
+				}
+			}
+		})
+	}
+
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	_, err := clientLecture.GetLectureContent(studentCtx, &proto.LectureIDRequest{LectureId: 3})
+	require.Error(t, err, "Закрытая лекция не должна быть доступна")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+
+	content, err := clientLecture.GetLectureContent(studentCtx, &proto.LectureIDRequest{LectureId: 4})
+	require.NoError(t, err, "Открытая лекция должна быть доступна")
+	assert.Equal(t, "Содержание 4", content.Content, "Содержание лекции не совпадает")
+
+	_, err = clientLecture.GetLectureContent(withToken(t, ctx, 2, "student"), &proto.LectureIDRequest{LectureId: 4})
+	require.Error(t, err, "Лекция с открытием после записи недоступна без записи на курс")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+
+	_, err = clientLecture.GetLectureContent(ctx, &proto.LectureIDRequest{LectureId: 1})
+	require.NoError(t, err, "Лекция без правил открытия должна быть доступна")
+}
+
+func TestLectureReleaseNotifications(t *testing.T) {
+	ctx := context.Background()
+	prepareLectureReleaseTables(t, ctx)
+
+	recorder := &recordingMailer{}
+	notifier := jobs.NewLectureReleaseNotifier(repository.NewLectureRepository(db), recorder, "http://localhost", time.Minute, zapLogger)
+
+	sent, err := notifier.NotifyReleased(ctx)
+	require.NoError(t, err, "Ошибка рассылки уведомлений")
+	assert.Equal(t, 2, sent, "Уведомления должны прийти об открытых по расписанию лекциях 2 и 4")
+
+	mails := recorder.Sent()
+	require.Len(t, mails, 2, "Некорректное количество писем")
+	for _, m := range mails {
+		assert.Equal(t, "student1@domain.com", m.To, "Письмо отправлено не тому студенту")
+	}
+
+	sent, err = notifier.NotifyReleased(ctx)
+	require.NoError(t, err, "Ошибка повторной рассылки уведомлений")
+	assert.Zero(t, sent, "Повторные уведомления не должны отправляться")
+}
@@ -2,35 +2,157 @@ package service
 
 import (
 	"GoEdu/internal/config"
+	"GoEdu/internal/events"
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/moderation"
 	"context"
 	"log"
 	"net"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 
 	"GoEdu/internal/logger"
 	"GoEdu/internal/repository"
+	"GoEdu/internal/storage"
 	"GoEdu/proto"
 )
 
 var (
-	clientEducation   proto.EducationServiceClient
-	clientEnrollments proto.EnrollmentServiceClient
-	clientInstructor  proto.InstructorServiceClient
-	clientLecture     proto.LectureServiceClient
-	clientReview      proto.ReviewServiceClient
-	clientStudent     proto.StudentServiceClient
-	server            *grpc.Server
-	db                *pgxpool.Pool
-	zapLogger         *zap.Logger
+	clientEducation    proto.EducationServiceClient
+	clientEnrollments  proto.EnrollmentServiceClient
+	clientInstructor   proto.InstructorServiceClient
+	clientLecture      proto.LectureServiceClient
+	clientReview       proto.ReviewServiceClient
+	clientStudent      proto.StudentServiceClient
+	clientCohort       proto.CohortServiceClient
+	clientCertificate  proto.CertificateServiceClient
+	clientQuiz         proto.QuizServiceClient
+	clientBank         proto.QuestionBankServiceClient
+	clientAssignment   proto.AssignmentServiceClient
+	clientPeerReview   proto.PeerReviewServiceClient
+	clientGradebook    proto.GradebookServiceClient
+	clientModeration   proto.ModerationServiceClient
+	clientPath         proto.LearningPathServiceClient
+	clientWebhook      proto.WebhookServiceClient
+	clientEvents       proto.EventServiceClient
+	clientNotification proto.NotificationServiceClient
+	clientAnnouncement proto.AnnouncementServiceClient
+	eventBroker        *events.Broker
+	server             *grpc.Server
+	db                 *pgxpool.Pool
+	zapLogger          *zap.Logger
+	mail               *recordingMailer
+	cfg                *config.Config
 )
 
+// recordingMailer запоминает отправленные письма вместо реальной отправки.
+type recordingMailer struct {
+	mu   sync.Mutex
+	sent []sentMail
+}
+
+type sentMail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+func (m *recordingMailer) Send(ctx context.Context, to, subject, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, sentMail{To: to, Subject: subject, Body: body})
+	return nil
+}
+
+func (m *recordingMailer) Sent() []sentMail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]sentMail(nil), m.sent...)
+}
+
+// optionalAuthInterceptor применяет AuthInterceptor только к запросам с токеном, чтобы тесты
+// без авторизации вызывали сервисы напрямую, а тесты прав доступа проходили полную проверку.
+func optionalAuthInterceptor(auth grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["authorization"]) > 0 {
+			return auth(ctx, req, info, handler)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// optionalAuthStreamInterceptor — то же, что optionalAuthInterceptor, для потоковых методов.
+func optionalAuthStreamInterceptor(auth grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if md, ok := metadata.FromIncomingContext(ss.Context()); ok && len(md["authorization"]) > 0 {
+			return auth(srv, ss, info, handler)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// withToken добавляет в исходящий контекст JWT-токен пользователя с указанной ролью.
+func withToken(t *testing.T, ctx context.Context, userID int64, role string) context.Context {
+	t.Helper()
+	token, err := middleware.GenerateJWTToken(userID, "user@domain.com", role, []byte(cfg.JWTSecretKey), 1, zapLogger)
+	if err != nil {
+		t.Fatalf("Не удалось сгенерировать токен: %v", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// testData — общие исходные данные теста. Преподаватели и студенты получают ID начиная с 1
+// («Преподаватель 1», student1@domain.com и т. д.), курс с ID i+1 ведёт преподаватель Courses[i].
+type testData struct {
+	Tables      []string   // Таблицы теста, очищаемые вместе с основными.
+	Instructors int        // Количество преподавателей.
+	Students    int        // Количество студентов.
+	Courses     []int64    // ID преподавателей курсов.
+	Enrollments [][2]int64 // Записи на курсы: ID студента и ID курса.
+}
+
+// prepareTables очищает таблицы и заполняет их общими данными теста. Строки, нужные только
+// отдельному тесту (лекции, потоки и т. п.), тест добавляет сам.
+func prepareTables(t *testing.T, ctx context.Context, data testData) {
+	t.Helper()
+	tables := append(data.Tables, "enrollments", "courses", "students", "instructors")
+	_, err := db.Exec(ctx, "TRUNCATE TABLE "+strings.Join(tables, ", ")+" RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицы")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO instructors (id, name, email, password)
+		SELECT id, 'Преподаватель ' || id, 'instructor' || id || '@domain.com', 'securepassword' FROM generate_series(1, $1::int) AS id
+	`, data.Instructors)
+	require.NoError(t, err, "Не удалось добавить преподавателей")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO students (id, name, email, password)
+		SELECT id, 'Студент ' || id, 'student' || id || '@domain.com', 'securepassword' FROM generate_series(1, $1::int) AS id
+	`, data.Students)
+	require.NoError(t, err, "Не удалось добавить студентов")
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO courses (id, name, description, instructor_id)
+		SELECT id, 'Курс ' || id, 'Описание курса ' || id, instructor_id FROM unnest($1::bigint[]) WITH ORDINALITY AS c(instructor_id, id)
+	`, data.Courses)
+	require.NoError(t, err, "Не удалось добавить курсы")
+
+	for _, enrollment := range data.Enrollments {
+		_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES ($1, $2)", enrollment[0], enrollment[1])
+		require.NoError(t, err, "Не удалось добавить запись на курс")
+	}
+}
+
 func TestMain(m *testing.M) {
 	var err error
 
@@ -61,34 +183,89 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		zapLogger.Fatal("Не удалось запустить сервер", zap.Error(err))
 	}
+	setTestEnvDefaults(map[string]string{
+		"CERTIFICATE_SECRET_KEY": "test_certificate_secret_key",
+		"UNSUBSCRIBE_SECRET_KEY": "test_unsubscribe_secret_key",
+	})
 	loader := &config.EnvConfigLoader{}
-	cfg := config.NewConfig(loader)
+	cfg = config.NewConfig(loader)
 
 	courseRepo := repository.NewCourseRepository(db)
-	educationService := NewEducationService(db, courseRepo, zapLogger)
+	educationService := NewEducationService(db, courseRepo, repository.NewTrendingRepository(db), []int32{7, 30, 90}, zapLogger)
+
+	studentRepo := repository.NewStudentRepository(db)
+	mail = &recordingMailer{}
 
 	enrollmentRepo := repository.NewEnrollmentRepository(db)
-	enrollmentService := NewEnrollmentService(enrollmentRepo, zapLogger)
+	enrollmentService := NewEnrollmentService(db, enrollmentRepo, studentRepo, courseRepo, mail, cfg, zapLogger)
 
 	instructorRepo := repository.NewInstructorRepository(db)
 	instructorService := NewInstructorService(instructorRepo, cfg, zapLogger)
 
 	lectureRepo := repository.NewLectureRepository(db)
-	lectureService := NewLectureService(lectureRepo, zapLogger)
+	progressRepo := repository.NewProgressRepository(db)
+	certificateRepo := repository.NewCertificateRepository(db)
+	quizRepo := repository.NewQuizRepository(db)
+	learningPathRepo := repository.NewLearningPathRepository(db)
+	certificateService := NewCertificateService(certificateRepo, lectureRepo, courseRepo, learningPathRepo, cfg, zapLogger)
+	lectureService := NewLectureService(lectureRepo, enrollmentRepo, courseRepo, progressRepo, quizRepo, repository.NewRecommendationRepository(db), certificateService, zapLogger)
 
 	reviewRepo := repository.NewReviewRepository(db)
-	reviewService := NewReviewService(reviewRepo, zapLogger)
+	reviewService := NewReviewService(reviewRepo, enrollmentRepo, lectureRepo, courseRepo, 50, moderation.NewFilter([]string{"спам", "купи сейчас"}, true), 2, zapLogger)
+	moderationService := NewModerationService(repository.NewAdminRepository(db), reviewRepo, cfg, zapLogger)
+	learningPathService := NewLearningPathService(learningPathRepo, courseRepo, lectureRepo, certificateService, zapLogger)
+	webhookService := NewWebhookService(repository.NewWebhookRepository(db), true, zapLogger)
+	eventBroker = events.NewBroker()
+	notificationService := NewNotificationService(repository.NewNotificationRepository(db), cfg, zapLogger)
+	eventService := NewEventService(repository.NewOutboxRepository(db), courseRepo, eventBroker, zapLogger)
 
-	studentRepo := repository.NewStudentRepository(db)
 	studentService := NewStudentService(studentRepo, cfg, zapLogger)
 
-	server = grpc.NewServer()
+	cohortRepo := repository.NewCohortRepository(db)
+	cohortService := NewCohortService(cohortRepo, courseRepo, lectureRepo, enrollmentRepo, zapLogger)
+	announcementService := NewAnnouncementService(repository.NewAnnouncementRepository(db), courseRepo, cohortRepo, enrollmentRepo, zapLogger)
+
+	questionBankRepo := repository.NewQuestionBankRepository(db)
+	quizService := NewQuizService(quizRepo, questionBankRepo, courseRepo, lectureRepo, enrollmentRepo, zapLogger)
+	questionBankService := NewQuestionBankService(questionBankRepo, zapLogger)
+
+	storageDir, err := os.MkdirTemp("", "goedu-uploads-")
+	if err != nil {
+		zapLogger.Fatal("Не удалось создать каталог для файлов", zap.Error(err))
+	}
+	blobs, err := storage.NewLocalStore(storageDir)
+	if err != nil {
+		zapLogger.Fatal("Не удалось инициализировать файловое хранилище", zap.Error(err))
+	}
+	assignmentRepo := repository.NewAssignmentRepository(db)
+	peerReviewRepo := repository.NewPeerReviewRepository(db)
+	assignmentService := NewAssignmentService(assignmentRepo, courseRepo, enrollmentRepo, peerReviewRepo, blobs, zapLogger)
+	peerReviewService := NewPeerReviewService(peerReviewRepo, assignmentRepo, courseRepo, zapLogger)
+	gradebookService := NewGradebookService(repository.NewGradebookRepository(db), courseRepo, enrollmentRepo, zapLogger)
+
+	server = grpc.NewServer(
+		grpc.UnaryInterceptor(optionalAuthInterceptor(middleware.AuthInterceptor([]byte(cfg.JWTSecretKey), zapLogger))),
+		grpc.StreamInterceptor(optionalAuthStreamInterceptor(middleware.AuthStreamInterceptor([]byte(cfg.JWTSecretKey), zapLogger))),
+	)
 	proto.RegisterEducationServiceServer(server, educationService)
 	proto.RegisterEnrollmentServiceServer(server, enrollmentService)
 	proto.RegisterInstructorServiceServer(server, instructorService)
 	proto.RegisterLectureServiceServer(server, lectureService)
 	proto.RegisterReviewServiceServer(server, reviewService)
 	proto.RegisterStudentServiceServer(server, studentService)
+	proto.RegisterCohortServiceServer(server, cohortService)
+	proto.RegisterCertificateServiceServer(server, certificateService)
+	proto.RegisterQuizServiceServer(server, quizService)
+	proto.RegisterQuestionBankServiceServer(server, questionBankService)
+	proto.RegisterAssignmentServiceServer(server, assignmentService)
+	proto.RegisterPeerReviewServiceServer(server, peerReviewService)
+	proto.RegisterGradebookServiceServer(server, gradebookService)
+	proto.RegisterModerationServiceServer(server, moderationService)
+	proto.RegisterLearningPathServiceServer(server, learningPathService)
+	proto.RegisterWebhookServiceServer(server, webhookService)
+	proto.RegisterEventServiceServer(server, eventService)
+	proto.RegisterNotificationServiceServer(server, notificationService)
+	proto.RegisterAnnouncementServiceServer(server, announcementService)
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -113,9 +290,33 @@ func TestMain(m *testing.M) {
 	clientLecture = proto.NewLectureServiceClient(conn)
 	clientReview = proto.NewReviewServiceClient(conn)
 	clientStudent = proto.NewStudentServiceClient(conn)
+	clientCohort = proto.NewCohortServiceClient(conn)
+	clientCertificate = proto.NewCertificateServiceClient(conn)
+	clientQuiz = proto.NewQuizServiceClient(conn)
+	clientBank = proto.NewQuestionBankServiceClient(conn)
+	clientAssignment = proto.NewAssignmentServiceClient(conn)
+	clientPeerReview = proto.NewPeerReviewServiceClient(conn)
+	clientGradebook = proto.NewGradebookServiceClient(conn)
+	clientModeration = proto.NewModerationServiceClient(conn)
+	clientPath = proto.NewLearningPathServiceClient(conn)
+	clientWebhook = proto.NewWebhookServiceClient(conn)
+	clientEvents = proto.NewEventServiceClient(conn)
+	clientNotification = proto.NewNotificationServiceClient(conn)
+	clientAnnouncement = proto.NewAnnouncementServiceClient(conn)
 
 	code := m.Run()
 
 	server.Stop()
+	os.RemoveAll(storageDir)
 	os.Exit(code)
 }
+
+// setTestEnvDefaults задаёт обязательные переменные окружения, которых нет в .env, чтобы тесты
+// запускались без настройки секретов.
+func setTestEnvDefaults(values map[string]string) {
+	for key, value := range values {
+		if _, exists := os.LookupEnv(key); !exists {
+			os.Setenv(key, value)
+		}
+	}
+}
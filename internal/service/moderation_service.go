@@ -0,0 +1,130 @@
+package service
+
+import (
+	"GoEdu/internal/config"
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ModerationService struct {
+	proto.UnimplementedModerationServiceServer
+	adminRepo  repository.AdminRepository
+	reviewRepo repository.ReviewRepository
+	cfg        *config.Config
+	logger     *zap.Logger
+}
+
+func NewModerationService(adminRepo repository.AdminRepository, reviewRepo repository.ReviewRepository, cfg *config.Config, logger *zap.Logger) *ModerationService {
+	return &ModerationService{
+		adminRepo:  adminRepo,
+		reviewRepo: reviewRepo,
+		cfg:        cfg,
+		logger:     logger,
+	}
+}
+
+func (s *ModerationService) LoginAdmin(ctx context.Context, req *proto.LoginRequest) (*proto.AuthResponse, error) {
+	admin, err := s.adminRepo.GetAdminByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrAdminNotFound) {
+			s.logger.Warn("Администратор не найден", zap.String("email", req.Email))
+			return nil, status.Errorf(codes.NotFound, "Администратор не найден")
+		}
+		s.logger.Error("Ошибка при получении администратора", zap.Error(err), zap.String("email", req.Email))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении данных администратора: %v", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(req.Password)); err != nil {
+		s.logger.Warn("Неверный пароль администратора", zap.String("email", req.Email))
+		return nil, status.Errorf(codes.Unauthenticated, "Неверный пароль")
+	}
+
+	token, err := middleware.GenerateJWTToken(admin.ID, admin.Email, "admin", []byte(s.cfg.JWTSecretKey), s.cfg.TokenExpiryHours, s.logger)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Ошибка генерации токена")
+	}
+
+	s.logger.Info("Администратор вошёл в систему", zap.Int64("admin_id", admin.ID))
+	return &proto.AuthResponse{
+		Id:    admin.ID,
+		Name:  admin.Name,
+		Email: admin.Email,
+		Token: token,
+	}, nil
+}
+
+func (s *ModerationService) GetModerationQueue(ctx context.Context, req *proto.Empty) (*proto.ModerationQueue, error) {
+	s.logger.Info("Получение очереди модерации")
+
+	items, err := s.reviewRepo.GetModerationQueue(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка при получении очереди модерации", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении очереди модерации: %v", err)
+	}
+
+	queue := &proto.ModerationQueue{}
+	for _, item := range items {
+		reason := item.Review.ModerationNote
+		if item.Review.Status == models.ReviewApproved || reason == "" {
+			reason = "жалобы пользователей"
+		}
+		queue.Items = append(queue.Items, &proto.ModerationItem{
+			Review:        reviewToProto(item.Review),
+			Reason:        reason,
+			ReportCount:   int32(len(item.ReportReasons)),
+			ReportReasons: item.ReportReasons,
+		})
+	}
+
+	s.logger.Info("Очередь модерации получена", zap.Int("count", len(queue.Items)))
+	return queue, nil
+}
+
+func (s *ModerationService) ApproveReview(ctx context.Context, req *proto.ModerateReviewRequest) (*proto.Review, error) {
+	return s.moderate(ctx, req, models.ReviewApproved)
+}
+
+func (s *ModerationService) RejectReview(ctx context.Context, req *proto.ModerateReviewRequest) (*proto.Review, error) {
+	if strings.TrimSpace(req.Note) == "" {
+		s.logger.Warn("Отклонение отзыва без причины", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "Укажите причину отклонения отзыва")
+	}
+	return s.moderate(ctx, req, models.ReviewRejected)
+}
+
+// moderate сохраняет решение модератора по отзыву; модератор берётся из токена.
+func (s *ModerationService) moderate(ctx context.Context, req *proto.ModerateReviewRequest, reviewStatus string) (*proto.Review, error) {
+	s.logger.Info("Модерация отзыва", zap.Int64("review_id", req.ReviewId), zap.String("status", reviewStatus))
+
+	if req.ReviewId == 0 {
+		s.logger.Warn("Некорректный ID отзыва", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID отзыва должен быть указан")
+	}
+	var moderatorID int64
+	if user, ok := middleware.UserFromContext(ctx); ok {
+		moderatorID = user.ID
+	}
+
+	review, err := s.reviewRepo.SetReviewStatus(ctx, req.ReviewId, reviewStatus, strings.TrimSpace(req.Note), moderatorID)
+	if err != nil {
+		s.logger.Error("Ошибка при модерации отзыва", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при модерации отзыва: %v", err)
+	}
+	if review == nil {
+		s.logger.Warn("Отзыв не найден", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.NotFound, "Отзыв с ID %d не найден", req.ReviewId)
+	}
+
+	s.logger.Info("Решение по отзыву сохранено", zap.Int64("review_id", req.ReviewId), zap.String("status", reviewStatus), zap.Int64("moderator_id", moderatorID))
+	return reviewToProto(review), nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func queuedReview(t *testing.T, ctx context.Context, reviewID int64) *proto.ModerationItem {
+	queue, err := clientModeration.GetModerationQueue(ctx, &proto.Empty{})
+	require.NoError(t, err, "Ошибка при получении очереди модерации")
+	for _, item := range queue.Items {
+		if item.Review.Id == reviewID {
+			return item
+		}
+	}
+	return nil
+}
+
+func TestLoginAdmin(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := db.Exec(ctx, "TRUNCATE TABLE admins RESTART IDENTITY CASCADE")
+	require.NoError(t, err, "Не удалось очистить таблицу администраторов")
+	hash, err := bcrypt.GenerateFromPassword([]byte("adminpassword"), bcrypt.DefaultCost)
+	require.NoError(t, err, "Не удалось захешировать пароль")
+	_, err = db.Exec(ctx, "INSERT INTO admins (name, email, password) VALUES ('Модератор', 'admin@domain.com', $1)", string(hash))
+	require.NoError(t, err, "Не удалось добавить администратора")
+
+	testCases := []struct {
+		Name         string
+		Request      *proto.LoginRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{Name: "Успешный вход", Request: &proto.LoginRequest{Email: "admin@domain.com", Password: "adminpassword"}},
+		{Name: "Неверный пароль", Request: &proto.LoginRequest{Email: "admin@domain.com", Password: "wrong"}, ShouldError: true, ExpectedCode: codes.Unauthenticated},
+		{Name: "Администратор не найден", Request: &proto.LoginRequest{Email: "nobody@domain.com", Password: "adminpassword"}, ShouldError: true, ExpectedCode: codes.NotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientModeration.LoginAdmin(ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+			require.NoError(t, err, "Ошибка при входе администратора")
+			assert.NotEmpty(t, resp.Token, "Токен не получен")
+		})
+	}
+}
+
+func TestReviewPreModeration(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+	student := withToken(t, ctx, 1, "student")
+	admin := withToken(t, ctx, 1, "admin")
+
+	review, err := clientReview.CreateReview(student, &proto.ReviewRequest{CourseId: 2, Comment: "Подробности на example.com", Rating: 5})
+	require.NoError(t, err, "Ошибка при добавлении отзыва")
+	assert.Equal(t, "pending", review.Status, "Отзыв со ссылкой должен ждать модерации")
+
+	reviews, err := clientReview.GetReviewsByCourse(ctx, &proto.ReviewsRequest{CourseId: 2})
+	require.NoError(t, err, "Ошибка при получении отзывов")
+	assert.Empty(t, reviews.Reviews, "Задержанный отзыв не должен публиковаться")
+	course, err := clientEducation.GetCourseByID(ctx, &proto.CourseIDRequest{CourseId: 2})
+	require.NoError(t, err, "Ошибка при получении курса")
+	assert.Equal(t, int32(0), course.Rating.Count, "Задержанный отзыв не должен учитываться в рейтинге")
+
+	item := queuedReview(t, admin, review.Id)
+	require.NotNil(t, item, "Отзыв должен попасть в очередь модерации")
+	assert.Equal(t, "ссылка в тексте", item.Reason, "Некорректная причина задержки")
+
+	approved, err := clientModeration.ApproveReview(admin, &proto.ModerateReviewRequest{ReviewId: review.Id})
+	require.NoError(t, err, "Ошибка при одобрении отзыва")
+	assert.Equal(t, "approved", approved.Status, "Отзыв должен быть одобрен")
+	assert.Nil(t, queuedReview(t, admin, review.Id), "Одобренный отзыв не должен оставаться в очереди")
+
+	reviews, err = clientReview.GetReviewsByCourse(ctx, &proto.ReviewsRequest{CourseId: 2})
+	require.NoError(t, err, "Ошибка при получении отзывов")
+	assert.Len(t, reviews.Reviews, 1, "Одобренный отзыв должен публиковаться")
+	course, err = clientEducation.GetCourseByID(ctx, &proto.CourseIDRequest{CourseId: 2})
+	require.NoError(t, err, "Ошибка при получении курса")
+	assert.Equal(t, int32(1), course.Rating.Count, "Одобренный отзыв должен учитываться в рейтинге")
+
+	updated, err := clientReview.UpdateReview(student, &proto.UpdateReviewRequest{ReviewId: review.Id, Comment: "Это спам", Rating: 5})
+	require.NoError(t, err, "Ошибка при обновлении отзыва")
+	assert.Equal(t, "pending", updated.Status, "Правка со стоп-словом должна снова задерживаться")
+}
+
+func TestReportReview(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+	author := withToken(t, ctx, 1, "student")
+	admin := withToken(t, ctx, 1, "admin")
+
+	review, err := clientReview.CreateReview(author, &proto.ReviewRequest{CourseId: 1, Comment: "Хороший курс", Rating: 4})
+	require.NoError(t, err, "Ошибка при добавлении отзыва")
+	require.Equal(t, "approved", review.Status, "Чистый отзыв должен публиковаться сразу")
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.ReportReviewRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Успешная жалоба студента",
+			Ctx:     withToken(t, ctx, 2, "student"),
+			Request: &proto.ReportReviewRequest{ReviewId: review.Id, Reason: "Оскорбления"},
+		},
+		{
+			Name:         "Повторная жалоба",
+			Ctx:          withToken(t, ctx, 2, "student"),
+			Request:      &proto.ReportReviewRequest{ReviewId: review.Id, Reason: "Оскорбления"},
+			ShouldError:  true,
+			ExpectedCode: codes.AlreadyExists,
+		},
+		{
+			Name:         "Жалоба на собственный отзыв",
+			Ctx:          author,
+			Request:      &proto.ReportReviewRequest{ReviewId: review.Id, Reason: "Передумал"},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Пустая причина",
+			Ctx:          withToken(t, ctx, 3, "student"),
+			Request:      &proto.ReportReviewRequest{ReviewId: review.Id, Reason: "  "},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Жалоба без авторизации",
+			Ctx:          ctx,
+			Request:      &proto.ReportReviewRequest{ReviewId: review.Id, Reason: "Реклама"},
+			ShouldError:  true,
+			ExpectedCode: codes.Unauthenticated,
+		},
+		{
+			Name:         "Отзыв не найден",
+			Ctx:          withToken(t, ctx, 3, "student"),
+			Request:      &proto.ReportReviewRequest{ReviewId: 999, Reason: "Реклама"},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := clientReview.ReportReview(tc.Ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+			require.NoError(t, err, "Ошибка при отправке жалобы")
+		})
+	}
+
+	item := queuedReview(t, admin, review.Id)
+	require.NotNil(t, item, "Отзыв с жалобой должен попасть в очередь модерации")
+	assert.Equal(t, int32(1), item.ReportCount, "Некорректное число жалоб")
+	assert.Equal(t, "approved", item.Review.Status, "Одна жалоба не должна скрывать отзыв")
+
+	_, err = clientReview.ReportReview(withToken(t, ctx, 1, "instructor"), &proto.ReportReviewRequest{ReviewId: review.Id, Reason: "Спам"})
+	require.NoError(t, err, "Ошибка при отправке жалобы преподавателем")
+
+	reviews, err := clientReview.GetReviewsByCourse(ctx, &proto.ReviewsRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка при получении отзывов")
+	assert.Empty(t, reviews.Reviews, "Отзыв, набравший жалобы, должен скрываться")
+	course, err := clientEducation.GetCourseByID(ctx, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка при получении курса")
+	assert.Equal(t, int32(0), course.Rating.Count, "Скрытый отзыв не должен учитываться в рейтинге")
+
+	_, err = clientModeration.RejectReview(admin, &proto.ModerateReviewRequest{ReviewId: review.Id})
+	require.Error(t, err, "Отклонение без причины должно завершаться ошибкой")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Некорректный код ошибки")
+
+	rejected, err := clientModeration.RejectReview(admin, &proto.ModerateReviewRequest{ReviewId: review.Id, Note: "Нарушает правила"})
+	require.NoError(t, err, "Ошибка при отклонении отзыва")
+	assert.Equal(t, "rejected", rejected.Status, "Отзыв должен быть отклонён")
+	assert.Nil(t, queuedReview(t, admin, review.Id), "Отклонённый отзыв не должен оставаться в очереди")
+
+	edited, err := clientReview.UpdateReview(author, &proto.UpdateReviewRequest{ReviewId: review.Id, Comment: "Исправленный отзыв", Rating: 4})
+	require.NoError(t, err, "Ошибка при обновлении отзыва")
+	assert.Equal(t, "pending", edited.Status, "Исправленный после отклонения отзыв должен ждать модерации")
+}
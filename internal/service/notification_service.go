@@ -0,0 +1,260 @@
+package service
+
+import (
+	"GoEdu/internal/config"
+	"GoEdu/internal/models"
+	"GoEdu/internal/notification"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultNotifications = 50
+	maxNotifications     = 200
+)
+
+type NotificationService struct {
+	proto.UnimplementedNotificationServiceServer
+	notificationRepo repository.NotificationRepository
+	cfg              *config.Config
+	logger           *zap.Logger
+}
+
+func NewNotificationService(notificationRepo repository.NotificationRepository, cfg *config.Config, logger *zap.Logger) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		cfg:              cfg,
+		logger:           logger,
+	}
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, req *proto.ListNotificationsRequest) (*proto.NotificationList, error) {
+	s.logger.Info("Получение уведомлений", zap.Int64("student_id", req.StudentId), zap.Bool("unread_only", req.UnreadOnly), zap.Int64("before_id", req.BeforeId))
+
+	if err := s.checkStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultNotifications
+	}
+	limit = min(limit, maxNotifications)
+
+	notifications, err := s.notificationRepo.GetNotifications(ctx, req.StudentId, req.UnreadOnly, req.BeforeId, limit)
+	if err != nil {
+		s.logger.Error("Ошибка при получении уведомлений", zap.Error(err), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении уведомлений: %v", err)
+	}
+	unread, err := s.notificationRepo.CountUnread(ctx, req.StudentId)
+	if err != nil {
+		s.logger.Error("Ошибка при подсчёте непрочитанных уведомлений", zap.Error(err), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при подсчёте непрочитанных уведомлений: %v", err)
+	}
+
+	resp := &proto.NotificationList{UnreadCount: unread}
+	for _, notification := range notifications {
+		resp.Notifications = append(resp.Notifications, notificationToProto(notification))
+	}
+	if len(notifications) == limit {
+		resp.NextBeforeId = notifications[len(notifications)-1].ID
+	}
+	return resp, nil
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, req *proto.MarkNotificationsReadRequest) (*proto.MarkNotificationsReadResponse, error) {
+	s.logger.Info("Отметка уведомлений прочитанными", zap.Int64("student_id", req.StudentId), zap.Int64s("notification_ids", req.NotificationIds))
+
+	if err := s.checkStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+	if len(req.NotificationIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Укажите уведомления")
+	}
+
+	updated, err := s.notificationRepo.MarkRead(ctx, req.StudentId, req.NotificationIds)
+	if err != nil {
+		s.logger.Error("Ошибка при отметке уведомлений", zap.Error(err), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при отметке уведомлений: %v", err)
+	}
+	return s.markReadResponse(ctx, req.StudentId, updated)
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, req *proto.NotificationStudentRequest) (*proto.MarkNotificationsReadResponse, error) {
+	s.logger.Info("Отметка всех уведомлений прочитанными", zap.Int64("student_id", req.StudentId))
+
+	if err := s.checkStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.notificationRepo.MarkAllRead(ctx, req.StudentId)
+	if err != nil {
+		s.logger.Error("Ошибка при отметке уведомлений", zap.Error(err), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при отметке уведомлений: %v", err)
+	}
+	return s.markReadResponse(ctx, req.StudentId, updated)
+}
+
+func (s *NotificationService) markReadResponse(ctx context.Context, studentID, updated int64) (*proto.MarkNotificationsReadResponse, error) {
+	unread, err := s.notificationRepo.CountUnread(ctx, studentID)
+	if err != nil {
+		s.logger.Error("Ошибка при подсчёте непрочитанных уведомлений", zap.Error(err), zap.Int64("student_id", studentID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при подсчёте непрочитанных уведомлений: %v", err)
+	}
+	s.logger.Info("Уведомления отмечены прочитанными", zap.Int64("student_id", studentID), zap.Int64("updated", updated))
+	return &proto.MarkNotificationsReadResponse{Updated: int32(updated), UnreadCount: unread}, nil
+}
+
+func (s *NotificationService) GetNotificationPreferences(ctx context.Context, req *proto.NotificationStudentRequest) (*proto.NotificationPreferences, error) {
+	s.logger.Info("Получение настроек уведомлений", zap.Int64("student_id", req.StudentId))
+
+	if err := s.checkStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+	return s.preferences(ctx, req.StudentId)
+}
+
+func (s *NotificationService) UpdateNotificationPreferences(ctx context.Context, req *proto.UpdateNotificationPreferencesRequest) (*proto.NotificationPreferences, error) {
+	s.logger.Info("Изменение настроек уведомлений", zap.Int64("student_id", req.StudentId))
+
+	if err := s.checkStudent(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+	if len(req.Preferences) == 0 && req.EmailFrequency == nil && req.Locale == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Укажите настройки уведомлений")
+	}
+	if req.EmailFrequency != nil && !slices.Contains(models.EmailFrequencies, req.GetEmailFrequency()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Неизвестная частота писем %q: допустимы off, immediate, daily и weekly", req.GetEmailFrequency())
+	}
+	if req.Locale != nil && !slices.Contains(models.Locales, req.GetLocale()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Неподдерживаемый язык писем %q: допустимы ru и en", req.GetLocale())
+	}
+
+	preferences := make(map[string]bool, len(req.Preferences))
+	for _, preference := range req.Preferences {
+		if !slices.Contains(models.NotificationTypes, preference.Type) {
+			s.logger.Warn("Неизвестный тип уведомления", zap.String("type", preference.Type))
+			return nil, status.Errorf(codes.InvalidArgument, "Неизвестный тип уведомления %q", preference.Type)
+		}
+		preferences[preference.Type] = preference.Enabled
+	}
+
+	if len(preferences) > 0 {
+		if err := s.notificationRepo.SetPreferences(ctx, req.StudentId, preferences); err != nil {
+			return nil, s.settingsError(err, req.StudentId)
+		}
+	}
+	if req.EmailFrequency != nil || req.Locale != nil {
+		settings, err := s.notificationRepo.GetSettings(ctx, req.StudentId)
+		if err != nil {
+			return nil, s.settingsError(err, req.StudentId)
+		}
+		if req.EmailFrequency != nil {
+			// Повторная подписка отзывает ссылки отписки из писем, отправленных до неё.
+			if settings.EmailFrequency == models.EmailOff && req.GetEmailFrequency() != models.EmailOff {
+				now := time.Now()
+				settings.UnsubscribeRevokedAt = &now
+			}
+			settings.EmailFrequency = req.GetEmailFrequency()
+		}
+		if req.Locale != nil {
+			settings.Locale = req.GetLocale()
+		}
+		if err := s.notificationRepo.SaveSettings(ctx, settings); err != nil {
+			return nil, s.settingsError(err, req.StudentId)
+		}
+	}
+	s.logger.Info("Настройки уведомлений сохранены", zap.Int64("student_id", req.StudentId))
+	return s.preferences(ctx, req.StudentId)
+}
+
+func (s *NotificationService) Unsubscribe(ctx context.Context, req *proto.UnsubscribeRequest) (*proto.UnsubscribeResponse, error) {
+	studentID, issuedAt, ok := notification.ParseUnsubscribeToken(s.cfg.UnsubscribeSecretKey, req.Token)
+	if !ok {
+		s.logger.Warn("Некорректный токен отписки")
+		return nil, status.Errorf(codes.InvalidArgument, "Ссылка для отписки недействительна")
+	}
+	if time.Since(issuedAt) > notification.UnsubscribeTokenLifetime {
+		s.logger.Warn("Токен отписки истёк", zap.Int64("student_id", studentID), zap.Time("issued_at", issuedAt))
+		return nil, status.Errorf(codes.InvalidArgument, "Срок действия ссылки для отписки истёк: измените настройки писем в приложении")
+	}
+	s.logger.Info("Отписка от писем", zap.Int64("student_id", studentID))
+
+	settings, err := s.notificationRepo.GetSettings(ctx, studentID)
+	if err != nil {
+		return nil, s.settingsError(err, studentID)
+	}
+	if settings.UnsubscribeRevokedAt != nil && issuedAt.Before(settings.UnsubscribeRevokedAt.Truncate(time.Second)) {
+		s.logger.Warn("Токен отписки отозван", zap.Int64("student_id", studentID), zap.Time("issued_at", issuedAt))
+		return nil, status.Errorf(codes.InvalidArgument, "Ссылка для отписки больше не действует: измените настройки писем в приложении")
+	}
+	settings.EmailFrequency = models.EmailOff
+	if err := s.notificationRepo.SaveSettings(ctx, settings); err != nil {
+		return nil, s.settingsError(err, studentID)
+	}
+
+	s.logger.Info("Студент отписался от писем", zap.Int64("student_id", studentID))
+	return &proto.UnsubscribeResponse{StudentId: studentID, EmailFrequency: settings.EmailFrequency}, nil
+}
+
+func (s *NotificationService) settingsError(err error, studentID int64) error {
+	if errors.Is(err, repository.ErrNotificationStudent) {
+		return status.Errorf(codes.NotFound, "Студент с ID %d не найден", studentID)
+	}
+	s.logger.Error("Ошибка при сохранении настроек уведомлений", zap.Error(err), zap.Int64("student_id", studentID))
+	return status.Errorf(codes.Internal, "Ошибка при сохранении настроек уведомлений: %v", err)
+}
+
+// preferences возвращает настройки студента по всем типам уведомлений и письмам; не заданные явно типы включены.
+func (s *NotificationService) preferences(ctx context.Context, studentID int64) (*proto.NotificationPreferences, error) {
+	stored, err := s.notificationRepo.GetPreferences(ctx, studentID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении настроек уведомлений", zap.Error(err), zap.Int64("student_id", studentID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении настроек уведомлений: %v", err)
+	}
+	settings, err := s.notificationRepo.GetSettings(ctx, studentID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении настроек писем", zap.Error(err), zap.Int64("student_id", studentID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении настроек писем: %v", err)
+	}
+
+	resp := &proto.NotificationPreferences{StudentId: studentID, EmailFrequency: settings.EmailFrequency, Locale: settings.Locale}
+	for _, notificationType := range models.NotificationTypes {
+		enabled, ok := stored[notificationType]
+		resp.Preferences = append(resp.Preferences, &proto.NotificationPreference{Type: notificationType, Enabled: enabled || !ok})
+	}
+	return resp, nil
+}
+
+func (s *NotificationService) checkStudent(ctx context.Context, studentID int64) error {
+	if studentID == 0 {
+		return status.Errorf(codes.InvalidArgument, "ID студента должен быть указан")
+	}
+	if err := checkStudentAccess(ctx, studentID); err != nil {
+		s.logger.Warn("Попытка доступа к уведомлениям другого студента", zap.Int64("student_id", studentID))
+		return err
+	}
+	return nil
+}
+
+func notificationToProto(notification *models.Notification) *proto.Notification {
+	return &proto.Notification{
+		Id:        notification.ID,
+		Type:      notification.Type,
+		CourseId:  notification.CourseID,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Link:      notification.Link,
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+		Read:      notification.ReadAt != nil,
+		ReadAt:    formatOptionalTime(notification.ReadAt),
+	}
+}
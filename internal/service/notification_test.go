@@ -0,0 +1,332 @@
+package service
+
+import (
+	"GoEdu/internal/events"
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/models"
+	"GoEdu/internal/notification"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func prepareNotificationTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"outbox_events", "notifications", "notification_preferences", "notification_settings", "reviews", "lectures"},
+		Instructors: 1,
+		Students:    2,
+		Courses:     []int64{1},
+	})
+}
+
+func TestNotifications(t *testing.T) {
+	ctx := context.Background()
+	prepareNotificationTables(t, ctx)
+
+	bus := events.NewBus()
+	bus.Subscribe(notification.NewSink(repository.NewNotificationRepository(db), repository.NewCourseRepository(db), repository.NewAssignmentRepository(db)))
+	relay := jobs.NewOutboxRelay(repository.NewOutboxRepository(db), bus, 100, time.Hour, time.Minute, zapLogger)
+
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	t.Run("Уведомления создаются из событий", func(t *testing.T) {
+		_, err := clientNotification.UpdateNotificationPreferences(withToken(t, ctx, 2, "student"), &proto.UpdateNotificationPreferencesRequest{
+			StudentId:   2,
+			Preferences: []*proto.NotificationPreference{{Type: models.NotificationLectureAdded, Enabled: false}},
+		})
+		require.NoError(t, err, "Ошибка изменения настроек уведомлений")
+
+		for _, studentID := range []int64{1, 2} {
+			_, err := clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: studentID, CourseId: 1})
+			require.NoError(t, err, "Ошибка записи на курс")
+		}
+		_, err = clientLecture.AddLectureToCourse(ctx, &proto.LectureRequest{CourseId: 1, Title: "Введение", Content: "Содержание"})
+		require.NoError(t, err, "Ошибка добавления лекции")
+		_, err = clientLecture.AddLectureToCourse(ctx, &proto.LectureRequest{CourseId: 1, Title: "Будущая лекция", Content: "Содержание", ReleaseAt: time.Now().AddDate(0, 0, 7).Format(time.RFC3339)})
+		require.NoError(t, err, "Ошибка добавления лекции по расписанию")
+
+		_, err = db.Exec(ctx, "INSERT INTO reviews (id, student_id, course_id, comment, rating, status) VALUES (1, 1, 1, 'Отличный курс', 5, 'approved')")
+		require.NoError(t, err, "Не удалось добавить отзыв")
+		_, err = clientReview.ReplyToReview(withToken(t, ctx, 1, "instructor"), &proto.ReviewReplyRequest{ReviewId: 1, Comment: "Спасибо!"})
+		require.NoError(t, err, "Ошибка ответа на отзыв")
+		_, err = clientReview.ReplyToReview(withToken(t, ctx, 1, "instructor"), &proto.ReviewReplyRequest{ReviewId: 1, Comment: "Спасибо за отзыв!"})
+		require.NoError(t, err, "Ошибка изменения ответа на отзыв")
+
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+
+		list, err := clientNotification.ListNotifications(studentCtx, &proto.ListNotificationsRequest{StudentId: 1})
+		require.NoError(t, err, "Ошибка получения уведомлений")
+		require.Len(t, list.Notifications, 3, "Правка ответа и лекция по расписанию не должны создавать уведомлений")
+		assert.Equal(t, int32(3), list.UnreadCount, "Некорректное число непрочитанных")
+		assert.Equal(t, models.NotificationReviewReply, list.Notifications[0].Type, "Уведомления должны идти от новых к старым")
+		assert.Equal(t, "Спасибо!", list.Notifications[0].Body, "Некорректный текст ответа")
+		assert.Equal(t, models.NotificationLectureAdded, list.Notifications[1].Type, "Нет уведомления о новой лекции")
+		assert.Equal(t, "Введение", list.Notifications[1].Body, "Некорректное название лекции")
+		assert.Equal(t, models.NotificationEnrollment, list.Notifications[2].Type, "Нет уведомления о записи на курс")
+		assert.Equal(t, int64(1), list.Notifications[2].CourseId, "Некорректный курс уведомления")
+		assert.False(t, list.Notifications[2].Read, "Уведомление не должно быть прочитано")
+
+		list, err = clientNotification.ListNotifications(withToken(t, ctx, 2, "student"), &proto.ListNotificationsRequest{StudentId: 2})
+		require.NoError(t, err, "Ошибка получения уведомлений")
+		require.Len(t, list.Notifications, 1, "Студент отключил уведомления о лекциях")
+		assert.Equal(t, models.NotificationEnrollment, list.Notifications[0].Type, "Некорректный тип уведомления")
+	})
+
+	t.Run("Постраничная загрузка", func(t *testing.T) {
+		page, err := clientNotification.ListNotifications(studentCtx, &proto.ListNotificationsRequest{StudentId: 1, Limit: 2})
+		require.NoError(t, err, "Ошибка получения уведомлений")
+		require.Len(t, page.Notifications, 2, "Некорректный размер страницы")
+		require.NotZero(t, page.NextBeforeId, "Должна быть следующая страница")
+
+		page, err = clientNotification.ListNotifications(studentCtx, &proto.ListNotificationsRequest{StudentId: 1, Limit: 2, BeforeId: page.NextBeforeId})
+		require.NoError(t, err, "Ошибка получения уведомлений")
+		require.Len(t, page.Notifications, 1, "Некорректный размер последней страницы")
+		assert.Zero(t, page.NextBeforeId, "Страниц больше нет")
+	})
+
+	t.Run("Отметка прочитанными", func(t *testing.T) {
+		list, err := clientNotification.ListNotifications(studentCtx, &proto.ListNotificationsRequest{StudentId: 1})
+		require.NoError(t, err, "Ошибка получения уведомлений")
+
+		resp, err := clientNotification.MarkRead(studentCtx, &proto.MarkNotificationsReadRequest{StudentId: 1, NotificationIds: []int64{list.Notifications[0].Id, list.Notifications[0].Id}})
+		require.NoError(t, err, "Ошибка отметки уведомления")
+		assert.Equal(t, int32(1), resp.Updated, "Должно быть отмечено одно уведомление")
+		assert.Equal(t, int32(2), resp.UnreadCount, "Некорректное число непрочитанных")
+
+		resp, err = clientNotification.MarkRead(withToken(t, ctx, 2, "student"), &proto.MarkNotificationsReadRequest{StudentId: 2, NotificationIds: []int64{list.Notifications[1].Id}})
+		require.NoError(t, err, "Ошибка отметки уведомления")
+		assert.Zero(t, resp.Updated, "Чужие уведомления не должны отмечаться")
+
+		unread, err := clientNotification.ListNotifications(studentCtx, &proto.ListNotificationsRequest{StudentId: 1, UnreadOnly: true})
+		require.NoError(t, err, "Ошибка получения уведомлений")
+		assert.Len(t, unread.Notifications, 2, "Прочитанное уведомление не должно попадать в непрочитанные")
+
+		resp, err = clientNotification.MarkAllRead(studentCtx, &proto.NotificationStudentRequest{StudentId: 1})
+		require.NoError(t, err, "Ошибка отметки всех уведомлений")
+		assert.Equal(t, int32(2), resp.Updated, "Некорректное число отмеченных уведомлений")
+		assert.Zero(t, resp.UnreadCount, "Непрочитанных уведомлений не должно остаться")
+	})
+
+	t.Run("Настройки уведомлений", func(t *testing.T) {
+		preferences, err := clientNotification.GetNotificationPreferences(withToken(t, ctx, 2, "student"), &proto.NotificationStudentRequest{StudentId: 2})
+		require.NoError(t, err, "Ошибка получения настроек")
+		require.Len(t, preferences.Preferences, len(models.NotificationTypes), "Настройки должны включать все типы")
+		for _, preference := range preferences.Preferences {
+			assert.Equal(t, preference.Type != models.NotificationLectureAdded, preference.Enabled, "Некорректная настройка %s", preference.Type)
+		}
+	})
+
+	testCases := []struct {
+		Name         string
+		Call         func() error
+		ExpectedCode codes.Code
+	}{
+		{
+			Name: "Уведомления другого студента",
+			Call: func() error {
+				_, err := clientNotification.ListNotifications(withToken(t, ctx, 2, "student"), &proto.ListNotificationsRequest{StudentId: 1})
+				return err
+			},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Уведомления от имени преподавателя",
+			Call: func() error {
+				_, err := clientNotification.ListNotifications(withToken(t, ctx, 1, "instructor"), &proto.ListNotificationsRequest{StudentId: 1})
+				return err
+			},
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Неизвестный тип уведомления",
+			Call: func() error {
+				_, err := clientNotification.UpdateNotificationPreferences(studentCtx, &proto.UpdateNotificationPreferencesRequest{
+					StudentId:   1,
+					Preferences: []*proto.NotificationPreference{{Type: "unknown", Enabled: false}},
+				})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Отметка без уведомлений",
+			Call: func() error {
+				_, err := clientNotification.MarkRead(studentCtx, &proto.MarkNotificationsReadRequest{StudentId: 1})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Call()
+			require.Error(t, err, "Ожидалась ошибка, но её не было")
+			st, ok := status.FromError(err)
+			require.True(t, ok, "Ошибка не является статусной")
+			assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			t.Logf("Полученный код ошибки: %v", st.Code())
+		})
+	}
+}
+
+// readEmails возвращает письма, сохранённые файловым каналом, по адресатам.
+func readEmails(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err, "Не удалось прочитать каталог писем")
+
+	emails := make(map[string]string, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		require.NoError(t, err, "Не удалось прочитать письмо")
+		to, _, _ := strings.Cut(strings.TrimPrefix(string(content), "To: "), "\r\n")
+		emails[to] = string(content)
+	}
+	return emails
+}
+
+func TestNotificationEmails(t *testing.T) {
+	ctx := context.Background()
+	prepareNotificationTables(t, ctx)
+
+	notificationRepo := repository.NewNotificationRepository(db)
+	bus := events.NewBus()
+	bus.Subscribe(notification.NewSink(notificationRepo, repository.NewCourseRepository(db), repository.NewAssignmentRepository(db)))
+	relay := jobs.NewOutboxRelay(repository.NewOutboxRepository(db), bus, 100, time.Hour, time.Minute, zapLogger)
+
+	dir := t.TempDir()
+	channel, err := notification.NewFileChannel(dir)
+	require.NoError(t, err, "Ошибка создания файлового канала")
+	mailer := jobs.NewNotificationMailer(notificationRepo, channel, "https://goedu.example", cfg.UnsubscribeSecretKey, 8, time.Minute, zapLogger)
+
+	immediate, english := models.EmailImmediate, models.LocaleEnglish
+	_, err = clientNotification.UpdateNotificationPreferences(withToken(t, ctx, 1, "student"), &proto.UpdateNotificationPreferencesRequest{StudentId: 1, EmailFrequency: &immediate})
+	require.NoError(t, err, "Ошибка изменения частоты писем")
+	preferences, err := clientNotification.UpdateNotificationPreferences(withToken(t, ctx, 2, "student"), &proto.UpdateNotificationPreferencesRequest{StudentId: 2, Locale: &english})
+	require.NoError(t, err, "Ошибка изменения языка писем")
+	assert.Equal(t, models.EmailDaily, preferences.EmailFrequency, "По умолчанию письма приходят ежедневной сводкой")
+	assert.Equal(t, models.LocaleEnglish, preferences.Locale, "Язык писем не сохранён")
+
+	for _, studentID := range []int64{1, 2} {
+		_, err := clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: studentID, CourseId: 1})
+		require.NoError(t, err, "Ошибка записи на курс")
+	}
+	_, err = relay.RelayPending(ctx)
+	require.NoError(t, err, "Ошибка публикации событий")
+	now := time.Now()
+
+	t.Run("Сразу отправляется только уведомление с частотой immediate", func(t *testing.T) {
+		sent, err := mailer.SendPending(ctx, now)
+		require.NoError(t, err, "Ошибка отправки писем")
+		assert.Equal(t, 1, sent, "Сводка не должна отправляться до наступления часа сводок")
+
+		email := readEmails(t, dir)["student1@domain.com"]
+		assert.Contains(t, email, "Subject: Вы записаны на курс «Курс 1»", "Некорректная тема письма")
+		assert.Contains(t, email, "Здравствуйте, Студент 1!", "Письмо должно быть на русском")
+	})
+
+	t.Run("Ежедневная сводка на английском", func(t *testing.T) {
+		sent, err := mailer.SendPending(ctx, now.Add(24*time.Hour))
+		require.NoError(t, err, "Ошибка отправки писем")
+		assert.Equal(t, 1, sent, "Должна быть отправлена одна сводка")
+
+		email := readEmails(t, dir)["student2@domain.com"]
+		assert.Contains(t, email, "Subject: GoEdu: 1 new notification(s) today", "Некорректная тема сводки")
+		assert.Contains(t, email, `You are enrolled in "Курс 1"`, "Уведомление должно быть на языке студента")
+		assert.Contains(t, email, url.QueryEscape(notification.UnsubscribeToken(cfg.UnsubscribeSecretKey, 2, now.Add(24*time.Hour))), "В письме нет ссылки для отписки")
+
+		sent, err = mailer.SendPending(ctx, now.Add(24*time.Hour))
+		require.NoError(t, err, "Ошибка повторной отправки писем")
+		assert.Zero(t, sent, "Уведомления не должны отправляться повторно")
+	})
+
+	t.Run("Отписка по ссылке из письма", func(t *testing.T) {
+		resp, err := clientNotification.Unsubscribe(ctx, &proto.UnsubscribeRequest{Token: notification.UnsubscribeToken(cfg.UnsubscribeSecretKey, 2, now)})
+		require.NoError(t, err, "Ошибка отписки")
+		assert.Equal(t, int64(2), resp.StudentId, "Некорректный студент")
+		assert.Equal(t, models.EmailOff, resp.EmailFrequency, "Письма должны быть отключены")
+
+		preferences, err := clientNotification.GetNotificationPreferences(withToken(t, ctx, 2, "student"), &proto.NotificationStudentRequest{StudentId: 2})
+		require.NoError(t, err, "Ошибка получения настроек")
+		assert.Equal(t, models.EmailOff, preferences.EmailFrequency, "Отписка не сохранена")
+		assert.Equal(t, models.LocaleEnglish, preferences.Locale, "Отписка не должна менять язык писем")
+	})
+
+	t.Run("Повторная подписка отзывает старые ссылки отписки", func(t *testing.T) {
+		daily := models.EmailDaily
+		_, err := clientNotification.UpdateNotificationPreferences(withToken(t, ctx, 2, "student"), &proto.UpdateNotificationPreferencesRequest{StudentId: 2, EmailFrequency: &daily})
+		require.NoError(t, err, "Ошибка повторной подписки")
+
+		_, err = clientNotification.Unsubscribe(ctx, &proto.UnsubscribeRequest{Token: notification.UnsubscribeToken(cfg.UnsubscribeSecretKey, 2, now.Add(-time.Minute))})
+		require.Error(t, err, "Ссылка из письма до повторной подписки не должна действовать")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "Некорректный код ошибки")
+
+		_, err = clientNotification.Unsubscribe(ctx, &proto.UnsubscribeRequest{Token: notification.UnsubscribeToken(cfg.UnsubscribeSecretKey, 2, time.Now().Add(time.Second))})
+		require.NoError(t, err, "Новая ссылка отписки должна действовать")
+	})
+
+	unknownFrequency := "hourly"
+	testCases := []struct {
+		Name         string
+		Call         func() error
+		ExpectedCode codes.Code
+	}{
+		{
+			Name: "Поддельный токен отписки",
+			Call: func() error {
+				_, err := clientNotification.Unsubscribe(ctx, &proto.UnsubscribeRequest{Token: "1.deadbeef"})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Истёкший токен отписки",
+			Call: func() error {
+				issuedAt := time.Now().Add(-notification.UnsubscribeTokenLifetime - time.Hour)
+				_, err := clientNotification.Unsubscribe(ctx, &proto.UnsubscribeRequest{Token: notification.UnsubscribeToken(cfg.UnsubscribeSecretKey, 1, issuedAt)})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Неизвестная частота писем",
+			Call: func() error {
+				_, err := clientNotification.UpdateNotificationPreferences(withToken(t, ctx, 1, "student"), &proto.UpdateNotificationPreferencesRequest{StudentId: 1, EmailFrequency: &unknownFrequency})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Пустое изменение настроек",
+			Call: func() error {
+				_, err := clientNotification.UpdateNotificationPreferences(withToken(t, ctx, 1, "student"), &proto.UpdateNotificationPreferencesRequest{StudentId: 1})
+				return err
+			},
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Call()
+			require.Error(t, err, "Ожидалась ошибка, но её не было")
+			st, ok := status.FromError(err)
+			require.True(t, ok, "Ошибка не является статусной")
+			assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+			t.Logf("Полученный код ошибки: %v", st.Code())
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"GoEdu/internal/events"
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink запоминает полученные события; пока fail = true, возвращает ошибку.
+type recordingSink struct {
+	mu       sync.Mutex
+	fail     bool
+	received []*models.OutboxEvent
+}
+
+func (s *recordingSink) Name() string {
+	return "recording"
+}
+
+func (s *recordingSink) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return errors.New("получатель недоступен")
+	}
+	s.received = append(s.received, event)
+	return nil
+}
+
+func prepareOutboxTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"outbox_events", "certificates", "lecture_progress", "lecture_completions", "lectures"},
+		Instructors: 1,
+		Students:    1,
+		Courses:     []int64{1},
+	})
+
+	_, err := db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content) VALUES (1, 1, 'Лекция 1', 'Содержание')")
+	require.NoError(t, err, "Не удалось добавить лекцию")
+}
+
+func outboxEventTypes(t *testing.T, ctx context.Context) []string {
+	rows, err := db.Query(ctx, "SELECT event_type FROM outbox_events ORDER BY id")
+	require.NoError(t, err, "Ошибка чтения outbox")
+	var types []string
+	for rows.Next() {
+		var eventType string
+		require.NoError(t, rows.Scan(&eventType), "Ошибка чтения события")
+		types = append(types, eventType)
+	}
+	require.NoError(t, rows.Err(), "Ошибка чтения outbox")
+	return types
+}
+
+func TestOutboxEvents(t *testing.T) {
+	ctx := context.Background()
+	prepareOutboxTables(t, ctx)
+
+	t.Run("События пишутся вместе с изменениями", func(t *testing.T) {
+		_, err := clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: 1, CourseId: 1})
+		require.NoError(t, err, "Ошибка записи на курс")
+		_, err = clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: 1, CourseId: 1})
+		require.NoError(t, err, "Ошибка повторной записи на курс")
+
+		_, err = clientLecture.MarkLectureAsCompleted(withToken(t, ctx, 1, "student"), &proto.LectureCompletionRequest{StudentId: 1, LectureId: 1})
+		require.NoError(t, err, "Ошибка завершения лекции")
+		_, err = clientLecture.MarkLectureAsCompleted(withToken(t, ctx, 1, "student"), &proto.LectureCompletionRequest{StudentId: 1, LectureId: 1})
+		require.NoError(t, err, "Ошибка повторного завершения лекции")
+
+		assert.Equal(t, []string{models.EventStudentEnrolled, models.EventLectureCompleted, models.EventCourseCompleted},
+			outboxEventTypes(t, ctx), "Повторные действия не должны порождать событий")
+
+		var payload models.CertificateIssuedPayload
+		var data []byte
+		err = db.QueryRow(ctx, "SELECT payload FROM outbox_events WHERE event_type = $1", models.EventCourseCompleted).Scan(&data)
+		require.NoError(t, err, "Ошибка чтения события")
+		require.NoError(t, json.Unmarshal(data, &payload), "Некорректные данные события")
+		assert.Equal(t, int64(1), payload.StudentID, "Некорректный студент")
+		assert.Equal(t, int64(1), payload.CourseID, "Некорректный курс")
+		assert.NotEmpty(t, payload.Serial, "Не указан номер сертификата")
+	})
+
+	outboxRepo := repository.NewOutboxRepository(db)
+	sink := &recordingSink{fail: true}
+	bus := events.NewBus()
+	bus.Subscribe(sink)
+	relay := jobs.NewOutboxRelay(outboxRepo, bus, 2, time.Hour, time.Minute, zapLogger)
+
+	var firstEventID string
+	t.Run("Недоставленные события откладываются", func(t *testing.T) {
+		published, err := relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+		assert.Zero(t, published, "События не должны считаться опубликованными")
+
+		var attempts, pending int
+		err = db.QueryRow(ctx, "SELECT MIN(attempts), COUNT(*) FROM outbox_events WHERE published_at IS NULL AND next_attempt_at > NOW() AND last_error <> ''").Scan(&attempts, &pending)
+		require.NoError(t, err, "Ошибка проверки данных в базе")
+		assert.Equal(t, 3, pending, "Все события должны быть отложены")
+		assert.Equal(t, 1, attempts, "Должна быть учтена неудачная попытка")
+
+		published, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+		assert.Zero(t, published, "Отложенные события не должны повторяться раньше времени")
+
+		err = db.QueryRow(ctx, "SELECT event_id::TEXT FROM outbox_events ORDER BY id LIMIT 1").Scan(&firstEventID)
+		require.NoError(t, err, "Ошибка проверки данных в базе")
+	})
+
+	t.Run("Повторная доставка с тем же ключом идемпотентности", func(t *testing.T) {
+		_, err := db.Exec(ctx, "UPDATE outbox_events SET next_attempt_at = NOW()")
+		require.NoError(t, err, "Ошибка подготовки повтора")
+		sink.fail = false
+
+		published, err := relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+		assert.Equal(t, 3, published, "Все события должны быть опубликованы")
+		require.Len(t, sink.received, 3, "Получатель должен получить все события")
+		assert.Equal(t, firstEventID, sink.received[0].EventID, "Ключ идемпотентности не должен меняться между попытками")
+		assert.Equal(t, models.EventStudentEnrolled, sink.received[0].EventType, "События должны доставляться по порядку")
+
+		published, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+		assert.Zero(t, published, "Опубликованные события не должны публиковаться повторно")
+	})
+}
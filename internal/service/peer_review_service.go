@@ -0,0 +1,302 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/peerreview"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PeerReviewService struct {
+	proto.UnimplementedPeerReviewServiceServer
+	peerReviewRepo repository.PeerReviewRepository
+	assignmentRepo repository.AssignmentRepository
+	courseRepo     repository.CourseRepository
+	logger         *zap.Logger
+}
+
+func NewPeerReviewService(peerReviewRepo repository.PeerReviewRepository, assignmentRepo repository.AssignmentRepository, courseRepo repository.CourseRepository, logger *zap.Logger) *PeerReviewService {
+	return &PeerReviewService{
+		peerReviewRepo: peerReviewRepo,
+		assignmentRepo: assignmentRepo,
+		courseRepo:     courseRepo,
+		logger:         logger,
+	}
+}
+
+// ListPeerReviewTasks возвращает назначенные студенту рецензии вместе с текстом и файлами решений.
+// Автор решения рецензенту не показывается.
+func (s *PeerReviewService) ListPeerReviewTasks(ctx context.Context, req *proto.PeerReviewTasksRequest) (*proto.PeerReviewTaskList, error) {
+	s.logger.Info("Получение рецензий студента", zap.Int64("student_id", req.StudentId), zap.Bool("pending_only", req.PendingOnly))
+
+	if req.StudentId == 0 {
+		s.logger.Warn("Некорректный ID студента", zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID студента должен быть указан")
+	}
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка получить рецензии другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+
+	reviews, err := s.peerReviewRepo.GetPeerReviewsByReviewer(ctx, req.StudentId, req.PendingOnly)
+	if err != nil {
+		s.logger.Error("Ошибка при получении рецензий", zap.Error(err), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении рецензий: %v", err)
+	}
+
+	resp := &proto.PeerReviewTaskList{}
+	assignments := make(map[int64]*models.Assignment)
+	for _, review := range reviews {
+		submission, assignment, err := s.getReviewTarget(ctx, review, assignments)
+		if err != nil {
+			return nil, err
+		}
+
+		task := &proto.PeerReviewTask{
+			Review:          peerReviewToProto(review, false),
+			AssignmentId:    assignment.ID,
+			AssignmentTitle: assignment.Title,
+			DueAt:           formatOptionalTime(assignment.PeerReviewDueAt),
+			Text:            submission.Text,
+		}
+		for _, criterion := range assignment.Rubric {
+			task.Rubric = append(task.Rubric, &proto.RubricCriterion{
+				Id:          criterion.ID,
+				Title:       criterion.Title,
+				Description: criterion.Description,
+				MaxPoints:   criterion.MaxPoints,
+			})
+		}
+		for _, file := range submission.Files {
+			task.Files = append(task.Files, &proto.SubmissionFile{
+				Id:          file.ID,
+				Filename:    file.Filename,
+				ContentType: file.ContentType,
+				Size:        file.Size,
+			})
+		}
+		resp.Tasks = append(resp.Tasks, task)
+	}
+
+	s.logger.Info("Рецензии студента получены", zap.Int("count", len(resp.Tasks)), zap.Int64("student_id", req.StudentId))
+	return resp, nil
+}
+
+// SubmitPeerReview сохраняет рецензию и пересчитывает оценку решения по всем отправленным рецензиям,
+// если преподаватель ещё не выставил оценку сам.
+func (s *PeerReviewService) SubmitPeerReview(ctx context.Context, req *proto.SubmitPeerReviewRequest) (*proto.PeerReview, error) {
+	s.logger.Info("Отправка рецензии", zap.Int64("review_id", req.ReviewId))
+
+	if req.ReviewId == 0 {
+		s.logger.Warn("Некорректный ID рецензии", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID рецензии должен быть указан")
+	}
+
+	review, err := s.peerReviewRepo.GetPeerReviewByID(ctx, req.ReviewId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении рецензии", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении рецензии: %v", err)
+	}
+	if review == nil {
+		s.logger.Warn("Рецензия не найдена", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.NotFound, "Рецензия с ID %d не найдена", req.ReviewId)
+	}
+	if err := checkStudentAccess(ctx, review.ReviewerID); err != nil {
+		s.logger.Warn("Попытка отправить чужую рецензию", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.PermissionDenied, "Рецензия назначена другому студенту")
+	}
+
+	submission, assignment, err := s.getReviewTarget(ctx, review, nil)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.PeerReviewDueAt != nil && time.Now().After(*assignment.PeerReviewDueAt) {
+		s.logger.Warn("Срок взаимной проверки истёк", zap.Int64("review_id", req.ReviewId), zap.Int64("assignment_id", assignment.ID))
+		return nil, status.Errorf(codes.FailedPrecondition, "Срок взаимной проверки истёк %s", assignment.PeerReviewDueAt.Format(time.RFC3339))
+	}
+
+	_, scores, err := rubricScoresFromRequest(assignment.Rubric, req.Scores)
+	if err != nil {
+		s.logger.Warn("Некорректные баллы рецензии", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, err
+	}
+	review.Comment = req.Comment
+	review.Scores = scores
+
+	if err := s.peerReviewRepo.SubmitPeerReview(ctx, review); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "Рецензия с ID %d не найдена", req.ReviewId)
+		}
+		s.logger.Error("Ошибка при сохранении рецензии", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении рецензии: %v", err)
+	}
+
+	if err := s.updatePeerGrade(ctx, submission, assignment); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Рецензия отправлена", zap.Int64("review_id", review.ID), zap.Int64("submission_id", submission.ID))
+	return peerReviewToProto(review, false), nil
+}
+
+// ListSubmissionPeerReviews возвращает рецензии на решение. Автор решения видит только отправленные
+// рецензии без ID рецензентов, преподаватель курса — все рецензии.
+func (s *PeerReviewService) ListSubmissionPeerReviews(ctx context.Context, req *proto.SubmissionIDRequest) (*proto.PeerReviewList, error) {
+	s.logger.Info("Получение рецензий на решение", zap.Int64("submission_id", req.SubmissionId))
+
+	if req.SubmissionId == 0 {
+		s.logger.Warn("Некорректный ID решения", zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID решения должен быть указан")
+	}
+
+	submission, err := s.assignmentRepo.GetSubmissionByID(ctx, req.SubmissionId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении решения", zap.Error(err), zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении решения: %v", err)
+	}
+	if submission == nil {
+		s.logger.Warn("Решение не найдено", zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.NotFound, "Решение с ID %d не найдено", req.SubmissionId)
+	}
+
+	user, _ := middleware.UserFromContext(ctx)
+	author := user.Role == "student"
+	if author {
+		if err := checkStudentAccess(ctx, submission.StudentID); err != nil {
+			s.logger.Warn("Попытка получить рецензии на чужое решение", zap.Int64("submission_id", req.SubmissionId))
+			return nil, err
+		}
+	} else {
+		assignment, err := s.assignmentRepo.GetAssignmentByID(ctx, submission.AssignmentID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении задания", zap.Error(err), zap.Int64("assignment_id", submission.AssignmentID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении задания: %v", err)
+		}
+		if assignment == nil {
+			return nil, status.Errorf(codes.NotFound, "Задание с ID %d не найдено", submission.AssignmentID)
+		}
+		course, err := s.courseRepo.GetCourseByID(ctx, assignment.CourseID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", assignment.CourseID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+		}
+		if course == nil {
+			return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", assignment.CourseID)
+		}
+		if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+			s.logger.Warn("Нет доступа к рецензиям решения", zap.Int64("submission_id", req.SubmissionId))
+			return nil, err
+		}
+	}
+
+	reviews, err := s.peerReviewRepo.GetPeerReviewsBySubmission(ctx, submission.ID, author)
+	if err != nil {
+		s.logger.Error("Ошибка при получении рецензий", zap.Error(err), zap.Int64("submission_id", req.SubmissionId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении рецензий: %v", err)
+	}
+
+	resp := &proto.PeerReviewList{}
+	for _, review := range reviews {
+		resp.Reviews = append(resp.Reviews, peerReviewToProto(review, author))
+	}
+
+	s.logger.Info("Рецензии на решение получены", zap.Int("count", len(reviews)), zap.Int64("submission_id", req.SubmissionId))
+	return resp, nil
+}
+
+// getReviewTarget возвращает решение и задание рецензии; задания кэшируются в assignments, если он передан.
+func (s *PeerReviewService) getReviewTarget(ctx context.Context, review *models.PeerReview, assignments map[int64]*models.Assignment) (*models.Submission, *models.Assignment, error) {
+	submission, err := s.assignmentRepo.GetSubmissionByID(ctx, review.SubmissionID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении решения", zap.Error(err), zap.Int64("submission_id", review.SubmissionID))
+		return nil, nil, status.Errorf(codes.Internal, "Ошибка при получении решения: %v", err)
+	}
+	if submission == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "Решение с ID %d не найдено", review.SubmissionID)
+	}
+
+	assignment, ok := assignments[submission.AssignmentID]
+	if !ok {
+		assignment, err = s.assignmentRepo.GetAssignmentByID(ctx, submission.AssignmentID)
+		if err != nil {
+			s.logger.Error("Ошибка при получении задания", zap.Error(err), zap.Int64("assignment_id", submission.AssignmentID))
+			return nil, nil, status.Errorf(codes.Internal, "Ошибка при получении задания: %v", err)
+		}
+		if assignment == nil {
+			return nil, nil, status.Errorf(codes.NotFound, "Задание с ID %d не найдено", submission.AssignmentID)
+		}
+		if assignments != nil {
+			assignments[assignment.ID] = assignment
+		}
+	}
+	return submission, assignment, nil
+}
+
+// updatePeerGrade выставляет решению оценку по отправленным рецензиям. Оценку, выставленную
+// преподавателем, рецензии не меняют.
+func (s *PeerReviewService) updatePeerGrade(ctx context.Context, submission *models.Submission, assignment *models.Assignment) error {
+	if submission.GradedAt != nil && !submission.PeerGraded {
+		return nil
+	}
+
+	reviews, err := s.peerReviewRepo.GetPeerReviewsBySubmission(ctx, submission.ID, true)
+	if err != nil {
+		s.logger.Error("Ошибка при получении рецензий", zap.Error(err), zap.Int64("submission_id", submission.ID))
+		return status.Errorf(codes.Internal, "Ошибка при пересчёте оценки: %v", err)
+	}
+	rawScore, scores, ok := peerreview.Grade(assignment.Rubric, reviews)
+	if !ok {
+		return nil
+	}
+
+	score := submission.ApplyPenalty(rawScore)
+	submission.RawScore = &rawScore
+	submission.Score = &score
+	submission.RubricScores = scores
+	submission.GradedBy = nil
+	submission.PeerGraded = true
+
+	if err := s.assignmentRepo.GradeSubmission(ctx, submission); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Info("Решение уже оценено преподавателем, оценка по рецензиям не сохранена", zap.Int64("submission_id", submission.ID))
+			return nil
+		}
+		s.logger.Error("Ошибка при сохранении оценки по рецензиям", zap.Error(err), zap.Int64("submission_id", submission.ID))
+		return status.Errorf(codes.Internal, "Ошибка при пересчёте оценки: %v", err)
+	}
+
+	s.logger.Info("Оценка по рецензиям пересчитана", zap.Int64("submission_id", submission.ID), zap.Int("reviews", len(reviews)), zap.Int32("score", score))
+	return nil
+}
+
+func peerReviewToProto(review *models.PeerReview, hideReviewer bool) *proto.PeerReview {
+	grpcReview := &proto.PeerReview{
+		Id:           review.ID,
+		SubmissionId: review.SubmissionID,
+		ReviewerId:   review.ReviewerID,
+		AssignedAt:   review.AssignedAt.Format(time.RFC3339),
+		Submitted:    review.SubmittedAt != nil,
+		SubmittedAt:  formatOptionalTime(review.SubmittedAt),
+		Comment:      review.Comment,
+	}
+	if hideReviewer {
+		grpcReview.ReviewerId = 0
+	}
+	for _, score := range review.Scores {
+		grpcReview.Scores = append(grpcReview.Scores, &proto.RubricScore{
+			CriterionId: score.CriterionID,
+			Points:      score.Points,
+			Comment:     score.Comment,
+		})
+	}
+	return grpcReview
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// preparePeerReviewAssignment создаёт задание со взаимной проверкой тремя рецензентами, решения студентов 1–3
+// и распределяет их после истечения срока сдачи. Студент 4 записан на курс, но решения не отправлял.
+func preparePeerReviewAssignment(t *testing.T, ctx context.Context) (*proto.Assignment, map[int64]*proto.Submission) {
+	prepareAssignmentTables(t, ctx)
+
+	_, err := db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES (3, 'Студент 3', 'student3@domain.com', 'securepassword'), (4, 'Студент 4', 'student4@domain.com', 'securepassword')")
+	require.NoError(t, err, "Не удалось добавить студентов")
+	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES (2, 1), (3, 1), (4, 1)")
+	require.NoError(t, err, "Не удалось добавить записи на курс")
+
+	assignment := createTestAssignment(t, ctx, &proto.CreateAssignmentRequest{
+		DueAt:           time.Now().UTC().Add(time.Hour).Format(time.RFC3339),
+		PeerReviewCount: 3,
+		Rubric: []*proto.RubricCriterion{
+			{Title: "Содержание", MaxPoints: 10},
+			{Title: "Оформление", MaxPoints: 5},
+		},
+	})
+
+	submissions := make(map[int64]*proto.Submission)
+	for studentID := int64(1); studentID <= 3; studentID++ {
+		submission, err := clientAssignment.SubmitAssignment(withToken(t, ctx, studentID, "student"), &proto.SubmitAssignmentRequest{
+			AssignmentId: assignment.Id,
+			StudentId:    studentID,
+			Text:         "Решение",
+			Files:        []*proto.UploadedFile{{Filename: "essay.txt", Content: []byte("Эссе")}},
+		})
+		require.NoError(t, err, "Ошибка отправки решения")
+		submissions[studentID] = submission
+	}
+
+	_, err = db.Exec(ctx, "UPDATE assignments SET due_at = NOW() - INTERVAL '1 hour' WHERE id = $1", assignment.Id)
+	require.NoError(t, err, "Не удалось перенести срок сдачи")
+
+	allocator := jobs.NewPeerReviewAllocator(repository.NewAssignmentRepository(db), repository.NewPeerReviewRepository(db), repository.NewEnrollmentRepository(db), time.Minute, zapLogger)
+	allocated, err := allocator.AllocateDue(ctx)
+	require.NoError(t, err, "Ошибка распределения решений")
+	require.Equal(t, 1, allocated, "Должно быть распределено одно задание")
+
+	allocated, err = allocator.AllocateDue(ctx)
+	require.NoError(t, err, "Ошибка повторного распределения")
+	require.Zero(t, allocated, "Задание не должно распределяться повторно")
+
+	return assignment, submissions
+}
+
+func TestPeerReviewAllocation(t *testing.T) {
+	ctx := context.Background()
+	assignment, submissions := preparePeerReviewAssignment(t, ctx)
+
+	got, err := clientAssignment.GetAssignment(ctx, &proto.AssignmentIDRequest{AssignmentId: assignment.Id})
+	require.NoError(t, err, "Ошибка получения задания")
+	assert.True(t, got.PeerReviewsAllocated, "Задание должно быть отмечено распределённым")
+
+	reviewers := make(map[int64][]int64)
+	for studentID := int64(1); studentID <= 4; studentID++ {
+		tasks, err := clientPeerReview.ListPeerReviewTasks(withToken(t, ctx, studentID, "student"), &proto.PeerReviewTasksRequest{StudentId: studentID, PendingOnly: true})
+		require.NoError(t, err, "Ошибка получения рецензий студента")
+		for _, task := range tasks.Tasks {
+			assert.Equal(t, assignment.Id, task.AssignmentId, "Некорректное задание")
+			assert.Len(t, task.Rubric, 2, "Рецензенту должна быть видна рубрика")
+			assert.Len(t, task.Files, 1, "Рецензенту должны быть видны файлы решения")
+			reviewers[task.Review.SubmissionId] = append(reviewers[task.Review.SubmissionId], studentID)
+		}
+	}
+
+	for authorID, submission := range submissions {
+		assert.Len(t, reviewers[submission.Id], 3, "Каждое решение должно получить трёх рецензентов")
+		assert.NotContains(t, reviewers[submission.Id], authorID, "Автор не должен рецензировать своё решение")
+	}
+
+	_, err = clientPeerReview.ListPeerReviewTasks(withToken(t, ctx, 2, "student"), &proto.PeerReviewTasksRequest{StudentId: 1})
+	require.Error(t, err, "Студент не должен видеть чужие рецензии")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+
+	_, err = clientAssignment.UpdateAssignment(withToken(t, ctx, 1, "instructor"), &proto.UpdateAssignmentRequest{
+		Id: assignment.Id, Title: assignment.Title, DueAt: assignment.DueAt, PeerReviewCount: 2, Rubric: []*proto.RubricCriterion{
+			{Title: "Содержание", MaxPoints: 10},
+			{Title: "Оформление", MaxPoints: 5},
+		},
+	})
+	require.Error(t, err, "Число рецензентов нельзя менять после распределения")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+}
+
+func TestSubmitPeerReview(t *testing.T) {
+	ctx := context.Background()
+	assignment, submissions := preparePeerReviewAssignment(t, ctx)
+	content, design := assignment.Rubric[0].Id, assignment.Rubric[1].Id
+	target := submissions[1]
+
+	reviews, err := clientPeerReview.ListSubmissionPeerReviews(withToken(t, ctx, 1, "instructor"), &proto.SubmissionIDRequest{SubmissionId: target.Id})
+	require.NoError(t, err, "Ошибка получения рецензий преподавателем")
+	require.Len(t, reviews.Reviews, 3, "У решения должно быть три рецензии")
+
+	reviewFile, err := clientAssignment.GetSubmissionFile(withToken(t, ctx, reviews.Reviews[0].ReviewerId, "student"), &proto.SubmissionFileRequest{FileId: target.Files[0].Id})
+	require.NoError(t, err, "Рецензент должен иметь доступ к файлам решения")
+	assert.Equal(t, []byte("Эссе"), reviewFile.Content, "Некорректное содержимое файла")
+
+	testCases := []struct {
+		Name         string
+		ReviewerID   int64
+		Request      *proto.SubmitPeerReviewRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:       "Не оценён один из критериев",
+			ReviewerID: reviews.Reviews[0].ReviewerId,
+			Request: &proto.SubmitPeerReviewRequest{ReviewId: reviews.Reviews[0].Id, Scores: []*proto.RubricScore{
+				{CriterionId: content, Points: 2},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:       "Чужая рецензия",
+			ReviewerID: 1,
+			Request: &proto.SubmitPeerReviewRequest{ReviewId: reviews.Reviews[0].Id, Scores: []*proto.RubricScore{
+				{CriterionId: content, Points: 10},
+				{CriterionId: design, Points: 5},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:       "Заниженная оценка",
+			ReviewerID: reviews.Reviews[0].ReviewerId,
+			Request: &proto.SubmitPeerReviewRequest{ReviewId: reviews.Reviews[0].Id, Comment: "Слабо", Scores: []*proto.RubricScore{
+				{CriterionId: content, Points: 2},
+				{CriterionId: design, Points: 5},
+			}},
+		},
+		{
+			Name:       "Вторая рецензия",
+			ReviewerID: reviews.Reviews[1].ReviewerId,
+			Request: &proto.SubmitPeerReviewRequest{ReviewId: reviews.Reviews[1].Id, Scores: []*proto.RubricScore{
+				{CriterionId: content, Points: 8},
+				{CriterionId: design, Points: 4},
+			}},
+		},
+		{
+			Name:       "Третья рецензия",
+			ReviewerID: reviews.Reviews[2].ReviewerId,
+			Request: &proto.SubmitPeerReviewRequest{ReviewId: reviews.Reviews[2].Id, Comment: "Отлично", Scores: []*proto.RubricScore{
+				{CriterionId: content, Points: 9},
+				{CriterionId: design, Points: 0},
+			}},
+		},
+		{
+			Name:       "Рецензия не найдена",
+			ReviewerID: 2,
+			Request: &proto.SubmitPeerReviewRequest{ReviewId: 999, Scores: []*proto.RubricScore{
+				{CriterionId: content, Points: 1},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientPeerReview.SubmitPeerReview(withToken(t, ctx, tc.ReviewerID, "student"), tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова SubmitPeerReview")
+			assert.True(t, resp.Submitted, "Рецензия должна быть отмечена отправленной")
+			assert.Len(t, resp.Scores, 2, "Некорректное число баллов")
+		})
+	}
+
+	// Крайние оценки по каждому критерию отбрасываются: содержание — 8 из (2, 8, 9), оформление — 4 из (5, 4, 0).
+	graded, err := clientAssignment.GetSubmission(withToken(t, ctx, 1, "student"), &proto.SubmissionIDRequest{SubmissionId: target.Id})
+	require.NoError(t, err, "Ошибка получения решения")
+	assert.True(t, graded.Graded, "Решение должно быть оценено по рецензиям")
+	assert.True(t, graded.PeerGraded, "Оценка должна быть отмечена выставленной по рецензиям")
+	assert.Equal(t, int32(12), graded.Score, "Некорректная оценка по рецензиям")
+
+	authorView, err := clientPeerReview.ListSubmissionPeerReviews(withToken(t, ctx, 1, "student"), &proto.SubmissionIDRequest{SubmissionId: target.Id})
+	require.NoError(t, err, "Автор должен видеть рецензии на своё решение")
+	require.Len(t, authorView.Reviews, 3, "Автор должен видеть отправленные рецензии")
+	for _, review := range authorView.Reviews {
+		assert.Zero(t, review.ReviewerId, "Автор не должен видеть рецензентов")
+	}
+
+	_, err = clientPeerReview.ListSubmissionPeerReviews(withToken(t, ctx, 2, "student"), &proto.SubmissionIDRequest{SubmissionId: target.Id})
+	require.Error(t, err, "Студент не должен видеть рецензии на чужое решение")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+
+	overridden, err := clientAssignment.GradeSubmission(withToken(t, ctx, 1, "instructor"), &proto.GradeSubmissionRequest{SubmissionId: target.Id, RubricScores: []*proto.RubricScore{
+		{CriterionId: content, Points: 10},
+		{CriterionId: design, Points: 5},
+	}})
+	require.NoError(t, err, "Преподаватель должен иметь возможность изменить оценку")
+	assert.False(t, overridden.PeerGraded, "Оценка преподавателя не должна отмечаться как оценка по рецензиям")
+	assert.Equal(t, int32(15), overridden.Score, "Некорректная оценка преподавателя")
+
+	_, err = clientPeerReview.SubmitPeerReview(withToken(t, ctx, reviews.Reviews[0].ReviewerId, "student"), &proto.SubmitPeerReviewRequest{ReviewId: reviews.Reviews[0].Id, Scores: []*proto.RubricScore{
+		{CriterionId: content, Points: 0},
+		{CriterionId: design, Points: 0},
+	}})
+	require.NoError(t, err, "Рецензию можно исправить до окончания срока")
+
+	graded, err = clientAssignment.GetSubmission(withToken(t, ctx, 1, "student"), &proto.SubmissionIDRequest{SubmissionId: target.Id})
+	require.NoError(t, err, "Ошибка получения решения")
+	assert.Equal(t, int32(15), graded.Score, "Рецензии не должны менять оценку преподавателя")
+}
+
+func TestPeerReviewDeadline(t *testing.T) {
+	ctx := context.Background()
+	assignment, submissions := preparePeerReviewAssignment(t, ctx)
+
+	_, err := db.Exec(ctx, "UPDATE assignments SET peer_review_due_at = NOW() - INTERVAL '1 minute' WHERE id = $1", assignment.Id)
+	require.NoError(t, err, "Не удалось перенести срок взаимной проверки")
+
+	reviews, err := clientPeerReview.ListSubmissionPeerReviews(withToken(t, ctx, 1, "instructor"), &proto.SubmissionIDRequest{SubmissionId: submissions[2].Id})
+	require.NoError(t, err, "Ошибка получения рецензий")
+	require.NotEmpty(t, reviews.Reviews, "У решения должны быть рецензии")
+
+	review := reviews.Reviews[0]
+	_, err = clientPeerReview.SubmitPeerReview(withToken(t, ctx, review.ReviewerId, "student"), &proto.SubmitPeerReviewRequest{ReviewId: review.Id, Scores: []*proto.RubricScore{
+		{CriterionId: assignment.Rubric[0].Id, Points: 5},
+		{CriterionId: assignment.Rubric[1].Id, Points: 5},
+	}})
+	require.Error(t, err, "Рецензию нельзя отправить после срока")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+}
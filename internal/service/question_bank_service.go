@@ -0,0 +1,247 @@
+package service
+
+import (
+	"GoEdu/internal/models"
+	"GoEdu/internal/quiz"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxImportQuestions ограничивает размер одного импорта банка вопросов.
+const maxImportQuestions = 1000
+
+type QuestionBankService struct {
+	proto.UnimplementedQuestionBankServiceServer
+	bankRepo repository.QuestionBankRepository
+	logger   *zap.Logger
+}
+
+func NewQuestionBankService(bankRepo repository.QuestionBankRepository, logger *zap.Logger) *QuestionBankService {
+	return &QuestionBankService{
+		bankRepo: bankRepo,
+		logger:   logger,
+	}
+}
+
+func (s *QuestionBankService) CreateBankQuestion(ctx context.Context, req *proto.BankQuestionRequest) (*proto.QuizQuestion, error) {
+	s.logger.Info("Добавление вопроса в банк", zap.Int64("instructor_id", req.InstructorId))
+
+	if err := s.checkBankAccess(ctx, req.InstructorId); err != nil {
+		return nil, err
+	}
+	if req.Question == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Вопрос должен быть указан")
+	}
+
+	question, err := questionFromProto(req.Question)
+	if err != nil {
+		s.logger.Warn("Некорректные данные вопроса", zap.Error(err), zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.InvalidArgument, "Некорректные данные вопроса: %v", err)
+	}
+
+	if err := s.bankRepo.CreateQuestions(ctx, req.InstructorId, []*models.QuizQuestion{question}); err != nil {
+		s.logger.Error("Ошибка при добавлении вопроса в банк", zap.Error(err), zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при добавлении вопроса: %v", err)
+	}
+
+	s.logger.Info("Вопрос добавлен в банк", zap.Int64("question_id", question.ID), zap.Int64("instructor_id", req.InstructorId))
+	return questionToProto(question, true), nil
+}
+
+func (s *QuestionBankService) UpdateBankQuestion(ctx context.Context, req *proto.BankQuestionRequest) (*proto.QuizQuestion, error) {
+	if req.Question == nil || req.Question.Id == 0 {
+		s.logger.Warn("Некорректный ID вопроса", zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID вопроса должен быть указан")
+	}
+	s.logger.Info("Обновление вопроса банка", zap.Int64("instructor_id", req.InstructorId), zap.Int64("question_id", req.Question.Id))
+
+	if err := s.checkBankAccess(ctx, req.InstructorId); err != nil {
+		return nil, err
+	}
+
+	question, err := questionFromProto(req.Question)
+	if err != nil {
+		s.logger.Warn("Некорректные данные вопроса", zap.Error(err), zap.Int64("question_id", req.Question.Id))
+		return nil, status.Errorf(codes.InvalidArgument, "Некорректные данные вопроса: %v", err)
+	}
+	question.InstructorID = req.InstructorId
+
+	if err := s.bankRepo.UpdateQuestion(ctx, question); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Warn("Вопрос не найден в банке", zap.Int64("question_id", question.ID), zap.Int64("instructor_id", req.InstructorId))
+			return nil, status.Errorf(codes.NotFound, "Вопрос с ID %d не найден в банке", question.ID)
+		}
+		s.logger.Error("Ошибка при обновлении вопроса банка", zap.Error(err), zap.Int64("question_id", question.ID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении вопроса: %v", err)
+	}
+
+	s.logger.Info("Вопрос банка обновлён", zap.Int64("question_id", question.ID))
+	return questionToProto(question, true), nil
+}
+
+func (s *QuestionBankService) DeleteBankQuestion(ctx context.Context, req *proto.BankQuestionIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление вопроса из банка", zap.Int64("instructor_id", req.InstructorId), zap.Int64("question_id", req.QuestionId))
+
+	if req.QuestionId == 0 {
+		s.logger.Warn("Некорректный ID вопроса", zap.Int64("question_id", req.QuestionId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID вопроса должен быть указан")
+	}
+	if err := s.checkBankAccess(ctx, req.InstructorId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.bankRepo.DeleteQuestion(ctx, req.InstructorId, req.QuestionId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении вопроса из банка", zap.Error(err), zap.Int64("question_id", req.QuestionId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении вопроса: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Вопрос не найден в банке", zap.Int64("question_id", req.QuestionId), zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.NotFound, "Вопрос с ID %d не найден в банке", req.QuestionId)
+	}
+
+	s.logger.Info("Вопрос удалён из банка", zap.Int64("question_id", req.QuestionId))
+	return &proto.Empty{}, nil
+}
+
+func (s *QuestionBankService) ListBankQuestions(ctx context.Context, req *proto.ListBankQuestionsRequest) (*proto.BankQuestionList, error) {
+	s.logger.Info("Получение вопросов банка", zap.Int64("instructor_id", req.InstructorId), zap.Strings("tags", req.Tags))
+
+	if err := s.checkBankAccess(ctx, req.InstructorId); err != nil {
+		return nil, err
+	}
+
+	questions, err := s.bankRepo.GetQuestions(ctx, req.InstructorId, req.Tags, questionDifficulties[req.Difficulty])
+	if err != nil {
+		s.logger.Error("Ошибка при получении вопросов банка", zap.Error(err), zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении вопросов банка: %v", err)
+	}
+
+	resp := &proto.BankQuestionList{}
+	for _, question := range questions {
+		resp.Questions = append(resp.Questions, questionToProto(question, true))
+	}
+
+	s.logger.Info("Вопросы банка получены", zap.Int("count", len(questions)), zap.Int64("instructor_id", req.InstructorId))
+	return resp, nil
+}
+
+// ImportQuestionBank добавляет в банк все вопросы файла или ни одного, если хотя бы один вопрос некорректен.
+func (s *QuestionBankService) ImportQuestionBank(ctx context.Context, req *proto.ImportQuestionBankRequest) (*proto.ImportQuestionBankResponse, error) {
+	s.logger.Info("Импорт банка вопросов", zap.Int64("instructor_id", req.InstructorId), zap.String("format", req.Format.String()), zap.Int("size", len(req.Content)))
+
+	if err := s.checkBankAccess(ctx, req.InstructorId); err != nil {
+		return nil, err
+	}
+
+	var questions []*models.QuizQuestion
+	var err error
+	switch req.Format {
+	case proto.QuestionBankFormat_QUESTION_BANK_FORMAT_GIFT:
+		questions, err = quiz.ParseGIFT(req.Content)
+	case proto.QuestionBankFormat_QUESTION_BANK_FORMAT_QTI:
+		questions, err = quiz.ParseQTI(req.Content)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Формат файла должен быть указан")
+	}
+	if err != nil {
+		s.logger.Warn("Ошибка разбора файла банка вопросов", zap.Error(err), zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.InvalidArgument, "Ошибка разбора файла: %v", err)
+	}
+	if len(questions) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "В файле нет вопросов")
+	}
+	if len(questions) > maxImportQuestions {
+		return nil, status.Errorf(codes.InvalidArgument, "За один раз можно импортировать не более %d вопросов", maxImportQuestions)
+	}
+
+	for i, question := range questions {
+		question.Tags = append(question.Tags, req.Tags...)
+		if err := validateQuestion(question); err != nil {
+			s.logger.Warn("Некорректный вопрос в файле", zap.Error(err), zap.Int("question", i+1))
+			return nil, status.Errorf(codes.InvalidArgument, "Вопрос %d: %v", i+1, err)
+		}
+		question.Tags = uniqueTags(question.Tags)
+	}
+
+	if err := s.bankRepo.CreateQuestions(ctx, req.InstructorId, questions); err != nil {
+		s.logger.Error("Ошибка при импорте вопросов", zap.Error(err), zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при импорте вопросов: %v", err)
+	}
+
+	resp := &proto.ImportQuestionBankResponse{Imported: int32(len(questions))}
+	for _, question := range questions {
+		resp.Questions = append(resp.Questions, questionToProto(question, true))
+	}
+
+	s.logger.Info("Банк вопросов импортирован", zap.Int("count", len(questions)), zap.Int64("instructor_id", req.InstructorId))
+	return resp, nil
+}
+
+func (s *QuestionBankService) ExportQuestionBank(ctx context.Context, req *proto.ExportQuestionBankRequest) (*proto.QuestionBankFile, error) {
+	s.logger.Info("Экспорт банка вопросов", zap.Int64("instructor_id", req.InstructorId), zap.String("format", req.Format.String()))
+
+	if err := s.checkBankAccess(ctx, req.InstructorId); err != nil {
+		return nil, err
+	}
+	if req.Format != proto.QuestionBankFormat_QUESTION_BANK_FORMAT_GIFT && req.Format != proto.QuestionBankFormat_QUESTION_BANK_FORMAT_QTI {
+		return nil, status.Errorf(codes.InvalidArgument, "Формат файла должен быть указан")
+	}
+
+	questions, err := s.bankRepo.GetQuestions(ctx, req.InstructorId, req.Tags, questionDifficulties[req.Difficulty])
+	if err != nil {
+		s.logger.Error("Ошибка при получении вопросов банка", zap.Error(err), zap.Int64("instructor_id", req.InstructorId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении вопросов банка: %v", err)
+	}
+
+	file := &proto.QuestionBankFile{Format: req.Format}
+	if req.Format == proto.QuestionBankFormat_QUESTION_BANK_FORMAT_GIFT {
+		file.Filename = fmt.Sprintf("question-bank-%d.gift.txt", req.InstructorId)
+		file.Content = quiz.FormatGIFT(questions)
+	} else {
+		file.Filename = fmt.Sprintf("question-bank-%d.xml", req.InstructorId)
+		file.Content, err = quiz.FormatQTI(questions)
+		if err != nil {
+			s.logger.Error("Ошибка при формировании файла", zap.Error(err), zap.Int64("instructor_id", req.InstructorId))
+			return nil, status.Errorf(codes.Internal, "Ошибка при формировании файла: %v", err)
+		}
+	}
+
+	s.logger.Info("Банк вопросов экспортирован", zap.Int("count", len(questions)), zap.Int64("instructor_id", req.InstructorId))
+	return file, nil
+}
+
+func (s *QuestionBankService) checkBankAccess(ctx context.Context, instructorID int64) error {
+	if instructorID == 0 {
+		s.logger.Warn("Некорректный ID преподавателя", zap.Int64("instructor_id", instructorID))
+		return status.Errorf(codes.InvalidArgument, "ID преподавателя должен быть указан")
+	}
+	if err := checkInstructorAccess(ctx, instructorID); err != nil {
+		s.logger.Warn("Нет доступа к банку вопросов", zap.Int64("instructor_id", instructorID))
+		return err
+	}
+	return nil
+}
+
+// uniqueTags убирает повторяющиеся теги без учёта регистра, сохраняя первое написание.
+func uniqueTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	var unique []string
+	for _, tag := range tags {
+		key := strings.ToLower(tag)
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, tag)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"context"
+	"fmt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// addBankQuestions добавляет в банк преподавателя count вопросов с выбором, в которых правильный вариант — «верно».
+func addBankQuestions(t *testing.T, ctx context.Context, instructorID int64, count int, tag string, difficulty proto.QuestionDifficulty) {
+	for i := 0; i < count; i++ {
+		_, err := clientBank.CreateBankQuestion(withToken(t, ctx, instructorID, "instructor"), &proto.BankQuestionRequest{
+			InstructorId: instructorID,
+			Question: &proto.QuizQuestion{
+				Type:           proto.QuizQuestionType_QUIZ_QUESTION_TYPE_SINGLE_CHOICE,
+				Text:           fmt.Sprintf("Вопрос %s %d", tag, i+1),
+				Options:        []string{"верно", "неверно 1", "неверно 2", "неверно 3"},
+				CorrectOptions: []int32{0},
+				Tags:           []string{tag},
+				Difficulty:     difficulty,
+			},
+		})
+		require.NoError(t, err, "Не удалось добавить вопрос в банк")
+	}
+}
+
+func TestCreateBankQuestion(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.BankQuestionRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name: "Вопрос с тегами и сложностью",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
+			Request: &proto.BankQuestionRequest{InstructorId: 1, Question: &proto.QuizQuestion{
+				Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE, Text: "Срез — ссылочный тип", CorrectBool: boolPtr(true),
+				Tags: []string{"go", " "}, Difficulty: proto.QuestionDifficulty_QUESTION_DIFFICULTY_EASY,
+			}},
+		},
+		{
+			Name:         "Вопрос без типа",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.BankQuestionRequest{InstructorId: 1, Question: &proto.QuizQuestion{Text: "Вопрос"}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Без преподавателя",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.BankQuestionRequest{Question: sampleQuizQuestions()[0]},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Банк другого преподавателя",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.BankQuestionRequest{InstructorId: 1, Question: sampleQuizQuestions()[0]},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientBank.CreateBankQuestion(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова CreateBankQuestion")
+			assert.NotZero(t, resp.Id, "ID вопроса должен быть заполнен")
+			assert.Equal(t, []string{"go"}, resp.Tags, "Пустые теги должны быть отброшены")
+			assert.Equal(t, int32(1), resp.Points, "Баллы по умолчанию должны быть равны 1")
+		})
+	}
+}
+
+func TestManageBankQuestions(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	addBankQuestions(t, ctx, 1, 2, "go", proto.QuestionDifficulty_QUESTION_DIFFICULTY_EASY)
+	addBankQuestions(t, ctx, 1, 1, "sql", proto.QuestionDifficulty_QUESTION_DIFFICULTY_HARD)
+	addBankQuestions(t, ctx, 2, 1, "go", proto.QuestionDifficulty_QUESTION_DIFFICULTY_EASY)
+
+	list, err := clientBank.ListBankQuestions(instructorCtx, &proto.ListBankQuestionsRequest{InstructorId: 1})
+	require.NoError(t, err, "Ошибка получения банка")
+	assert.Len(t, list.Questions, 3, "В банке должны быть только вопросы преподавателя")
+
+	list, err = clientBank.ListBankQuestions(instructorCtx, &proto.ListBankQuestionsRequest{InstructorId: 1, Tags: []string{"GO"}})
+	require.NoError(t, err, "Ошибка получения банка по тегу")
+	assert.Len(t, list.Questions, 2, "Теги должны сравниваться без учёта регистра")
+
+	list, err = clientBank.ListBankQuestions(instructorCtx, &proto.ListBankQuestionsRequest{InstructorId: 1, Difficulty: proto.QuestionDifficulty_QUESTION_DIFFICULTY_HARD})
+	require.NoError(t, err, "Ошибка получения банка по сложности")
+	require.Len(t, list.Questions, 1, "Некорректный фильтр по сложности")
+
+	question := list.Questions[0]
+	question.Text = "Обновлённый вопрос"
+	question.Points = 3
+	updated, err := clientBank.UpdateBankQuestion(instructorCtx, &proto.BankQuestionRequest{InstructorId: 1, Question: question})
+	require.NoError(t, err, "Ошибка обновления вопроса")
+	assert.Equal(t, "Обновлённый вопрос", updated.Text)
+	assert.Equal(t, int32(3), updated.Points)
+
+	_, err = clientBank.UpdateBankQuestion(withToken(t, ctx, 2, "instructor"), &proto.BankQuestionRequest{InstructorId: 2, Question: question})
+	require.Error(t, err, "Чужой вопрос не должен обновляться")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = clientBank.DeleteBankQuestion(withToken(t, ctx, 2, "instructor"), &proto.BankQuestionIDRequest{InstructorId: 1, QuestionId: question.Id})
+	require.Error(t, err, "Преподаватель не должен удалять вопросы чужого банка")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = clientBank.DeleteBankQuestion(instructorCtx, &proto.BankQuestionIDRequest{InstructorId: 1, QuestionId: question.Id})
+	require.NoError(t, err, "Ошибка удаления вопроса")
+
+	_, err = clientBank.DeleteBankQuestion(instructorCtx, &proto.BankQuestionIDRequest{InstructorId: 1, QuestionId: question.Id})
+	require.Error(t, err, "Повторное удаление должно вернуть ошибку")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQuestionBankImportExport(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	gift := `// [tag:go] [difficulty:easy]
+::Q1:: Какое ключевое слово запускает горутину? {=go =Go}
+
+// [points:2]
+Какие типы ссылочные? {~%50%slice ~%50%map ~%-100%int}
+
+Столица России? {~Казань =Москва #Верно! ~Самара}
+
+Go — компилируемый язык {T}
+
+Число пи {#3.14:0.01}
+`
+
+	testCases := []struct {
+		Name         string
+		Request      *proto.ImportQuestionBankRequest
+		Imported     int32
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:     "Импорт GIFT",
+			Request:  &proto.ImportQuestionBankRequest{InstructorId: 1, Format: proto.QuestionBankFormat_QUESTION_BANK_FORMAT_GIFT, Content: []byte(gift), Tags: []string{"импорт"}},
+			Imported: 5,
+		},
+		{
+			Name:         "Вопрос без ответов",
+			Request:      &proto.ImportQuestionBankRequest{InstructorId: 1, Format: proto.QuestionBankFormat_QUESTION_BANK_FORMAT_GIFT, Content: []byte("Вопрос без блока ответов")},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Некорректный XML",
+			Request:      &proto.ImportQuestionBankRequest{InstructorId: 1, Format: proto.QuestionBankFormat_QUESTION_BANK_FORMAT_QTI, Content: []byte("<questestinterop><item>")},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Формат не указан",
+			Request:      &proto.ImportQuestionBankRequest{InstructorId: 1, Content: []byte(gift)},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientBank.ImportQuestionBank(instructorCtx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова ImportQuestionBank")
+			assert.Equal(t, tc.Imported, resp.Imported, "Некорректное количество импортированных вопросов")
+		})
+	}
+
+	list, err := clientBank.ListBankQuestions(instructorCtx, &proto.ListBankQuestionsRequest{InstructorId: 1})
+	require.NoError(t, err, "Ошибка получения банка")
+	require.Len(t, list.Questions, 5, "Ошибочный импорт не должен добавлять вопросы")
+	assert.Equal(t, proto.QuizQuestionType_QUIZ_QUESTION_TYPE_SHORT_TEXT, list.Questions[0].Type)
+	assert.Equal(t, []string{"go", "импорт"}, list.Questions[0].Tags)
+	assert.Equal(t, proto.QuestionDifficulty_QUESTION_DIFFICULTY_EASY, list.Questions[0].Difficulty)
+	assert.Equal(t, proto.QuizQuestionType_QUIZ_QUESTION_TYPE_MULTIPLE_CHOICE, list.Questions[1].Type)
+	assert.Equal(t, []int32{0, 1}, list.Questions[1].CorrectOptions)
+	assert.Equal(t, int32(2), list.Questions[1].Points)
+	assert.Equal(t, []int32{1}, list.Questions[2].CorrectOptions, "Отзыв после # не должен попадать в вариант")
+	assert.Equal(t, proto.QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE, list.Questions[3].Type)
+	assert.Equal(t, proto.QuizQuestionType_QUIZ_QUESTION_TYPE_NUMERIC, list.Questions[4].Type)
+
+	for _, format := range []proto.QuestionBankFormat{proto.QuestionBankFormat_QUESTION_BANK_FORMAT_GIFT, proto.QuestionBankFormat_QUESTION_BANK_FORMAT_QTI} {
+		t.Run("Экспорт и повторный импорт "+format.String(), func(t *testing.T) {
+			file, err := clientBank.ExportQuestionBank(instructorCtx, &proto.ExportQuestionBankRequest{InstructorId: 1, Format: format})
+			require.NoError(t, err, "Ошибка экспорта банка")
+			assert.NotEmpty(t, file.Filename, "Имя файла должно быть заполнено")
+
+			resp, err := clientBank.ImportQuestionBank(withToken(t, ctx, 2, "instructor"), &proto.ImportQuestionBankRequest{InstructorId: 2, Format: format, Content: file.Content})
+			require.NoError(t, err, "Экспортированный файл должен импортироваться")
+			require.Len(t, resp.Questions, len(list.Questions))
+			for i, question := range resp.Questions {
+				original := list.Questions[i]
+				assert.Equal(t, original.Type, question.Type)
+				assert.Equal(t, original.Text, question.Text)
+				assert.Equal(t, original.Points, question.Points)
+				assert.Equal(t, original.Options, question.Options)
+				assert.Equal(t, original.CorrectOptions, question.CorrectOptions)
+				assert.Equal(t, original.Tags, question.Tags)
+				assert.Equal(t, original.Difficulty, question.Difficulty)
+			}
+
+			_, err = db.Exec(ctx, "DELETE FROM quiz_questions WHERE instructor_id = 2")
+			require.NoError(t, err, "Не удалось очистить банк")
+		})
+	}
+}
+
+func TestQuizDrawnFromBank(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	addBankQuestions(t, ctx, 1, 6, "go", proto.QuestionDifficulty_QUESTION_DIFFICULTY_EASY)
+	addBankQuestions(t, ctx, 1, 2, "go", proto.QuestionDifficulty_QUESTION_DIFFICULTY_HARD)
+
+	_, err := clientQuiz.CreateQuiz(instructorCtx, &proto.CreateQuizRequest{
+		CourseId: 1, Title: "Слишком много вопросов",
+		DrawRules: []*proto.QuizDrawRule{{Count: 3, Tags: []string{"go"}, Difficulty: proto.QuestionDifficulty_QUESTION_DIFFICULTY_HARD}},
+	})
+	require.Error(t, err, "Правило не может требовать больше вопросов, чем есть в банке")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	quiz, err := clientQuiz.CreateQuiz(instructorCtx, &proto.CreateQuizRequest{
+		CourseId: 1, Title: "Случайный тест", MaxAttempts: 2, PassingScore: 100, ShuffleQuestions: true, ShuffleOptions: true,
+		DrawRules: []*proto.QuizDrawRule{
+			{Count: 3, Tags: []string{"go"}, Difficulty: proto.QuestionDifficulty_QUESTION_DIFFICULTY_EASY},
+			{Count: 1, Difficulty: proto.QuestionDifficulty_QUESTION_DIFFICULTY_HARD},
+		},
+	})
+	require.NoError(t, err, "Ошибка создания теста")
+	assert.Equal(t, int32(4), quiz.QuestionCount, "Количество вопросов должно учитывать правила")
+
+	student := withToken(t, ctx, 1, "student")
+	attempt, err := clientQuiz.StartQuizAttempt(student, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка начала попытки")
+	require.Len(t, attempt.Questions, 4, "Попытка должна содержать вопросы по правилам")
+	assert.Equal(t, int32(4), attempt.MaxScore)
+
+	// Первые шесть вопросов банка лёгкие, следующие два — сложные.
+	hard := 0
+	for _, question := range attempt.Questions {
+		assert.Empty(t, question.CorrectOptions, "Студент не должен видеть правильные ответы")
+		if question.Id > 6 {
+			hard++
+		}
+	}
+	assert.Equal(t, 1, hard, "Из сложных вопросов должен быть выбран один")
+
+	resumed, err := clientQuiz.StartQuizAttempt(student, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка продолжения попытки")
+	assert.Equal(t, attempt.Id, resumed.Id)
+	assert.Equal(t, attempt.Seed, resumed.Seed)
+	for i := range attempt.Questions {
+		assert.Equal(t, attempt.Questions[i].Id, resumed.Questions[i].Id, "Порядок вопросов должен сохраняться")
+		assert.Equal(t, attempt.Questions[i].Options, resumed.Questions[i].Options, "Порядок вариантов должен сохраняться")
+	}
+
+	var answers []*proto.QuizAnswer
+	for _, question := range attempt.Questions {
+		for i, option := range question.Options {
+			if option == "верно" {
+				answers = append(answers, &proto.QuizAnswer{QuestionId: question.Id, SelectedOptions: []int32{int32(i)}})
+			}
+		}
+	}
+	result, err := clientQuiz.SubmitQuizAttempt(student, &proto.SubmitQuizAttemptRequest{AttemptId: attempt.Id, StudentId: 1, Answers: answers})
+	require.NoError(t, err, "Ошибка отправки ответов")
+	assert.Equal(t, int32(4), result.Score, "Ответы должны проверяться по показанному порядку вариантов")
+	assert.True(t, result.Passed)
+
+	_, err = clientQuiz.SubmitQuizAttempt(student, &proto.SubmitQuizAttemptRequest{AttemptId: attempt.Id, StudentId: 1, Answers: []*proto.QuizAnswer{{QuestionId: 999}}})
+	require.Error(t, err, "Завершённую попытку нельзя отправить повторно")
+
+	// Вопросы из попытки меняются копированием и удаляются пометкой, не затрагивая завершённую попытку.
+	used := attempt.Questions[0]
+	used.Text = "Изменённый вопрос"
+	used.Options = []string{"верно", "неверно"}
+	used.CorrectOptions = []int32{0}
+	updated, err := clientBank.UpdateBankQuestion(instructorCtx, &proto.BankQuestionRequest{InstructorId: 1, Question: used})
+	require.NoError(t, err, "Ошибка изменения вопроса банка")
+	assert.NotEqual(t, used.Id, updated.Id, "Вопрос из попытки должен сохраниться под новым ID")
+
+	_, err = clientBank.DeleteBankQuestion(instructorCtx, &proto.BankQuestionIDRequest{InstructorId: 1, QuestionId: attempt.Questions[1].Id})
+	require.NoError(t, err, "Ошибка удаления вопроса банка")
+
+	bank, err := clientBank.ListBankQuestions(instructorCtx, &proto.ListBankQuestionsRequest{InstructorId: 1})
+	require.NoError(t, err, "Ошибка получения банка")
+	assert.Len(t, bank.Questions, 7, "Удалённый и заменённый вопросы не должны попадать в банк")
+
+	attempts, err := clientQuiz.ListQuizAttempts(student, &proto.QuizAttemptsRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения попыток")
+	require.Len(t, attempts.Attempts, 1)
+	assert.Equal(t, int32(4), attempts.Attempts[0].Score, "Результат завершённой попытки не должен меняться")
+}
@@ -0,0 +1,909 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	quizgen "GoEdu/internal/quiz"
+	"GoEdu/internal/repository"
+	"GoEdu/proto"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quizSubmitGrace — допуск на задержку сети при отправке ответов после окончания времени попытки.
+const quizSubmitGrace = 30 * time.Second
+
+var questionTypes = map[proto.QuizQuestionType]string{
+	proto.QuizQuestionType_QUIZ_QUESTION_TYPE_SINGLE_CHOICE:   models.QuestionSingleChoice,
+	proto.QuizQuestionType_QUIZ_QUESTION_TYPE_MULTIPLE_CHOICE: models.QuestionMultipleChoice,
+	proto.QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE:      models.QuestionTrueFalse,
+	proto.QuizQuestionType_QUIZ_QUESTION_TYPE_NUMERIC:         models.QuestionNumeric,
+	proto.QuizQuestionType_QUIZ_QUESTION_TYPE_SHORT_TEXT:      models.QuestionShortText,
+}
+
+var questionDifficulties = map[proto.QuestionDifficulty]string{
+	proto.QuestionDifficulty_QUESTION_DIFFICULTY_EASY:   models.DifficultyEasy,
+	proto.QuestionDifficulty_QUESTION_DIFFICULTY_MEDIUM: models.DifficultyMedium,
+	proto.QuestionDifficulty_QUESTION_DIFFICULTY_HARD:   models.DifficultyHard,
+}
+
+type QuizService struct {
+	proto.UnimplementedQuizServiceServer
+	quizRepo       repository.QuizRepository
+	bankRepo       repository.QuestionBankRepository
+	courseRepo     repository.CourseRepository
+	lectureRepo    repository.LectureRepository
+	enrollmentRepo repository.EnrollmentRepository
+	logger         *zap.Logger
+}
+
+func NewQuizService(quizRepo repository.QuizRepository, bankRepo repository.QuestionBankRepository, courseRepo repository.CourseRepository, lectureRepo repository.LectureRepository, enrollmentRepo repository.EnrollmentRepository, logger *zap.Logger) *QuizService {
+	return &QuizService{
+		quizRepo:       quizRepo,
+		bankRepo:       bankRepo,
+		courseRepo:     courseRepo,
+		lectureRepo:    lectureRepo,
+		enrollmentRepo: enrollmentRepo,
+		logger:         logger,
+	}
+}
+
+func (s *QuizService) CreateQuiz(ctx context.Context, req *proto.CreateQuizRequest) (*proto.Quiz, error) {
+	s.logger.Info("Создание теста", zap.Int64("course_id", req.CourseId), zap.Int64("lecture_id", req.LectureId), zap.String("title", req.Title))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	quiz, err := quizFromRequest(req.LectureId, req.Title, req.Description, req.TimeLimitSeconds, req.MaxAttempts, req.PassingScore, req.RequiredForCompletion, req.Questions, req.DrawRules)
+	if err != nil {
+		s.logger.Warn("Некорректные данные теста", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+	quiz.CourseID = req.CourseId
+	quiz.ShuffleQuestions = req.ShuffleQuestions
+	quiz.ShuffleOptions = req.ShuffleOptions
+
+	course, err := s.courseRepo.GetCourseByID(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.NotFound, "Курс с ID %d не найден", req.CourseId)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Нет доступа к созданию теста", zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+
+	if err := s.checkQuizLecture(ctx, quiz); err != nil {
+		return nil, err
+	}
+	if err := s.checkDrawRules(ctx, quiz, course.InstructorID); err != nil {
+		return nil, err
+	}
+
+	id, err := s.quizRepo.CreateQuiz(ctx, quiz)
+	if err != nil {
+		s.logger.Error("Ошибка при создании теста", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при создании теста: %v", err)
+	}
+	quiz.ID = id
+
+	s.logger.Info("Тест успешно создан", zap.Int64("quiz_id", id), zap.Int64("course_id", req.CourseId))
+	return quizToProto(quiz, true), nil
+}
+
+func (s *QuizService) UpdateQuiz(ctx context.Context, req *proto.UpdateQuizRequest) (*proto.Quiz, error) {
+	s.logger.Info("Обновление теста", zap.Int64("quiz_id", req.Id))
+
+	if req.Id == 0 {
+		s.logger.Warn("Некорректный ID теста", zap.Int64("quiz_id", req.Id))
+		return nil, status.Errorf(codes.InvalidArgument, "ID теста должен быть указан")
+	}
+
+	quiz, err := quizFromRequest(req.LectureId, req.Title, req.Description, req.TimeLimitSeconds, req.MaxAttempts, req.PassingScore, req.RequiredForCompletion, req.Questions, req.DrawRules)
+	if err != nil {
+		s.logger.Warn("Некорректные данные теста", zap.Error(err), zap.Int64("quiz_id", req.Id))
+		return nil, err
+	}
+	quiz.ShuffleQuestions = req.ShuffleQuestions
+	quiz.ShuffleOptions = req.ShuffleOptions
+
+	existing, err := s.getQuizForInstructor(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	quiz.ID = existing.ID
+	quiz.CourseID = existing.CourseID
+
+	if err := s.checkQuizLecture(ctx, quiz); err != nil {
+		return nil, err
+	}
+	if len(quiz.DrawRules) > 0 {
+		course, err := s.courseRepo.GetCourseByID(ctx, quiz.CourseID)
+		if err != nil || course == nil {
+			s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", quiz.CourseID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+		}
+		if err := s.checkDrawRules(ctx, quiz, course.InstructorID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.quizRepo.UpdateQuiz(ctx, quiz); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "Тест с ID %d не найден", req.Id)
+		}
+		if errors.Is(err, repository.ErrQuizQuestionNotFound) {
+			s.logger.Warn("Вопрос не относится к тесту", zap.Int64("quiz_id", req.Id))
+			return nil, status.Errorf(codes.InvalidArgument, "Вопрос с указанным ID не относится к тесту %d", req.Id)
+		}
+		s.logger.Error("Ошибка при обновлении теста", zap.Error(err), zap.Int64("quiz_id", req.Id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении теста: %v", err)
+	}
+
+	s.logger.Info("Тест успешно обновлён", zap.Int64("quiz_id", req.Id))
+	return quizToProto(quiz, true), nil
+}
+
+func (s *QuizService) DeleteQuiz(ctx context.Context, req *proto.QuizIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление теста", zap.Int64("quiz_id", req.QuizId))
+
+	if _, err := s.getQuizForInstructor(ctx, req.QuizId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.quizRepo.DeleteQuiz(ctx, req.QuizId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении теста", zap.Error(err), zap.Int64("quiz_id", req.QuizId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении теста: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Тест не найден", zap.Int64("quiz_id", req.QuizId))
+		return nil, status.Errorf(codes.NotFound, "Тест с ID %d не найден", req.QuizId)
+	}
+
+	s.logger.Info("Тест успешно удалён", zap.Int64("quiz_id", req.QuizId))
+	return &proto.Empty{}, nil
+}
+
+func (s *QuizService) GetQuiz(ctx context.Context, req *proto.QuizIDRequest) (*proto.Quiz, error) {
+	s.logger.Info("Получение теста", zap.Int64("quiz_id", req.QuizId))
+
+	quiz, err := s.getQuizForInstructor(ctx, req.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Тест успешно получен", zap.Int64("quiz_id", req.QuizId))
+	return quizToProto(quiz, true), nil
+}
+
+func (s *QuizService) ListQuizzes(ctx context.Context, req *proto.CourseIDRequest) (*proto.QuizList, error) {
+	s.logger.Info("Получение тестов курса", zap.Int64("course_id", req.CourseId))
+
+	if req.CourseId == 0 {
+		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
+	}
+
+	quizzes, err := s.quizRepo.GetQuizzesByCourse(ctx, req.CourseId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении тестов", zap.Error(err), zap.Int64("course_id", req.CourseId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении тестов: %v", err)
+	}
+
+	var grpcQuizzes []*proto.Quiz
+	for _, quiz := range quizzes {
+		grpcQuizzes = append(grpcQuizzes, quizToProto(quiz, false))
+	}
+
+	s.logger.Info("Тесты успешно получены", zap.Int("count", len(grpcQuizzes)), zap.Int64("course_id", req.CourseId))
+	return &proto.QuizList{Quizzes: grpcQuizzes}, nil
+}
+
+func (s *QuizService) StartQuizAttempt(ctx context.Context, req *proto.StartQuizAttemptRequest) (*proto.QuizAttempt, error) {
+	s.logger.Info("Начало попытки прохождения теста", zap.Int64("quiz_id", req.QuizId), zap.Int64("student_id", req.StudentId))
+
+	if req.QuizId == 0 || req.StudentId == 0 {
+		s.logger.Warn("Некорректные данные для начала попытки", zap.Int64("quiz_id", req.QuizId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID теста и ID студента должны быть указаны")
+	}
+
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка пройти тест от имени другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+
+	quiz, err := s.getQuiz(ctx, req.QuizId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkQuizOpen(ctx, quiz, req.StudentId); err != nil {
+		return nil, err
+	}
+	if len(quiz.Questions) == 0 && len(quiz.DrawRules) == 0 {
+		s.logger.Warn("В тесте нет вопросов", zap.Int64("quiz_id", req.QuizId))
+		return nil, status.Errorf(codes.FailedPrecondition, "В тесте с ID %d нет вопросов", req.QuizId)
+	}
+
+	open, err := s.quizRepo.GetOpenAttempt(ctx, quiz.ID, req.StudentId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении незавершённой попытки", zap.Error(err), zap.Int64("quiz_id", req.QuizId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении попытки: %v", err)
+	}
+	if open != nil {
+		questions, err := s.attemptQuestions(ctx, quiz, open)
+		if err != nil {
+			return nil, err
+		}
+		if !open.Expired(time.Now(), quizSubmitGrace) {
+			s.logger.Info("Продолжение незавершённой попытки", zap.Int64("attempt_id", open.ID))
+			open.MaxScore = questionsMaxScore(questions)
+			return attemptToProto(open, questions, nil), nil
+		}
+		if err := s.expireAttempt(ctx, open, questions); err != nil {
+			return nil, err
+		}
+	}
+
+	seed := quizgen.NewSeed()
+	drawn, err := s.drawQuestions(ctx, quiz, seed)
+	if err != nil {
+		return nil, err
+	}
+	if len(drawn) == 0 {
+		s.logger.Warn("В банке нет подходящих вопросов", zap.Int64("quiz_id", req.QuizId))
+		return nil, status.Errorf(codes.FailedPrecondition, "В тесте с ID %d нет вопросов", req.QuizId)
+	}
+	questionIDs := make([]int64, 0, len(drawn))
+	for _, question := range drawn {
+		questionIDs = append(questionIDs, question.ID)
+	}
+
+	attempt, err := s.quizRepo.StartAttempt(ctx, quiz, req.StudentId, seed, questionIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrQuizAttemptsExhausted):
+			s.logger.Warn("Попытки прохождения теста исчерпаны", zap.Int64("quiz_id", req.QuizId), zap.Int64("student_id", req.StudentId))
+			return nil, status.Errorf(codes.FailedPrecondition, "Использованы все попытки прохождения теста (%d)", *quiz.MaxAttempts)
+		case errors.Is(err, repository.ErrQuizAttemptConflict):
+			s.logger.Warn("Попытка уже начата параллельным запросом", zap.Int64("quiz_id", req.QuizId), zap.Int64("student_id", req.StudentId))
+			return nil, status.Errorf(codes.Aborted, "Попытка уже начата, повторите запрос")
+		}
+		s.logger.Error("Ошибка при создании попытки", zap.Error(err), zap.Int64("quiz_id", req.QuizId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при создании попытки: %v", err)
+	}
+	questions := s.presentQuestions(quiz, attempt, drawn)
+	attempt.MaxScore = questionsMaxScore(questions)
+
+	s.logger.Info("Попытка начата", zap.Int64("attempt_id", attempt.ID), zap.Int32("attempt_number", attempt.AttemptNumber))
+	return attemptToProto(attempt, questions, nil), nil
+}
+
+func (s *QuizService) SubmitQuizAttempt(ctx context.Context, req *proto.SubmitQuizAttemptRequest) (*proto.QuizAttempt, error) {
+	s.logger.Info("Отправка ответов теста", zap.Int64("attempt_id", req.AttemptId), zap.Int64("student_id", req.StudentId), zap.Int("answers", len(req.Answers)))
+
+	if req.AttemptId == 0 || req.StudentId == 0 {
+		s.logger.Warn("Некорректные данные для отправки ответов", zap.Int64("attempt_id", req.AttemptId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID попытки и ID студента должны быть указаны")
+	}
+
+	if err := checkStudentAccess(ctx, req.StudentId); err != nil {
+		s.logger.Warn("Попытка отправить ответы от имени другого студента", zap.Int64("student_id", req.StudentId))
+		return nil, err
+	}
+
+	attempt, err := s.quizRepo.GetAttemptByID(ctx, req.AttemptId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении попытки", zap.Error(err), zap.Int64("attempt_id", req.AttemptId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении попытки: %v", err)
+	}
+	if attempt == nil || attempt.StudentID != req.StudentId {
+		s.logger.Warn("Попытка не найдена", zap.Int64("attempt_id", req.AttemptId), zap.Int64("student_id", req.StudentId))
+		return nil, status.Errorf(codes.NotFound, "Попытка с ID %d не найдена", req.AttemptId)
+	}
+	if attempt.SubmittedAt != nil {
+		s.logger.Warn("Попытка уже завершена", zap.Int64("attempt_id", req.AttemptId))
+		return nil, status.Errorf(codes.FailedPrecondition, "Попытка с ID %d уже завершена", req.AttemptId)
+	}
+
+	quiz, err := s.getQuiz(ctx, attempt.QuizID)
+	if err != nil {
+		return nil, err
+	}
+	questions, err := s.attemptQuestions(ctx, quiz, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.Expired(time.Now(), quizSubmitGrace) {
+		if err := s.expireAttempt(ctx, attempt, questions); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "Время на попытку истекло %s", attempt.DeadlineAt.Format(time.RFC3339))
+	}
+
+	answers, err := gradeAnswers(questions, req.Answers)
+	if err != nil {
+		s.logger.Warn("Некорректные ответы", zap.Error(err), zap.Int64("attempt_id", req.AttemptId))
+		return nil, err
+	}
+
+	attempt.Score = 0
+	for _, answer := range answers {
+		attempt.Score += answer.Points
+	}
+	attempt.MaxScore = questionsMaxScore(questions)
+	attempt.Percent = models.ScorePercent(attempt.Score, attempt.MaxScore)
+	attempt.Passed = attempt.Percent >= quiz.PassingScore
+
+	if err := s.quizRepo.SubmitAttempt(ctx, attempt, answers); err != nil {
+		if errors.Is(err, repository.ErrQuizAttemptSubmitted) {
+			s.logger.Warn("Попытка уже завершена", zap.Int64("attempt_id", req.AttemptId))
+			return nil, status.Errorf(codes.FailedPrecondition, "Попытка с ID %d уже завершена", req.AttemptId)
+		}
+		s.logger.Error("Ошибка при сохранении результатов", zap.Error(err), zap.Int64("attempt_id", req.AttemptId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении результатов: %v", err)
+	}
+
+	s.logger.Info("Попытка завершена", zap.Int64("attempt_id", attempt.ID), zap.Int32("percent", attempt.Percent), zap.Bool("passed", attempt.Passed))
+	return attemptToProto(attempt, nil, answers), nil
+}
+
+func (s *QuizService) ListQuizAttempts(ctx context.Context, req *proto.QuizAttemptsRequest) (*proto.QuizAttemptList, error) {
+	s.logger.Info("Получение попыток прохождения теста", zap.Int64("quiz_id", req.QuizId), zap.Int64("student_id", req.StudentId))
+
+	if req.QuizId == 0 {
+		s.logger.Warn("Некорректный ID теста", zap.Int64("quiz_id", req.QuizId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID теста должен быть указан")
+	}
+
+	quiz, err := s.getQuiz(ctx, req.QuizId)
+	if err != nil {
+		return nil, err
+	}
+
+	studentID := req.StudentId
+	if user, _ := middleware.UserFromContext(ctx); user.Role == "student" {
+		if err := checkStudentAccess(ctx, studentID); err != nil {
+			s.logger.Warn("Попытка получить результаты другого студента", zap.Int64("student_id", studentID))
+			return nil, err
+		}
+		studentID = user.ID
+	} else if err := s.checkCourseInstructor(ctx, quiz.CourseID); err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.quizRepo.GetAttempts(ctx, quiz.ID, studentID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении попыток", zap.Error(err), zap.Int64("quiz_id", req.QuizId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении попыток: %v", err)
+	}
+
+	resp := &proto.QuizAttemptList{RemainingAttempts: -1}
+	for _, attempt := range attempts {
+		resp.Attempts = append(resp.Attempts, attemptToProto(attempt, nil, nil))
+	}
+	if studentID != 0 && quiz.MaxAttempts != nil {
+		resp.RemainingAttempts = max(*quiz.MaxAttempts-int32(len(attempts)), 0)
+	}
+
+	s.logger.Info("Попытки успешно получены", zap.Int64("quiz_id", req.QuizId), zap.Int("count", len(attempts)))
+	return resp, nil
+}
+
+// expireAttempt закрывает попытку с истёкшим временем с нулевым результатом, чтобы она учитывалась в лимите попыток.
+func (s *QuizService) expireAttempt(ctx context.Context, attempt *models.QuizAttempt, questions []*models.QuizQuestion) error {
+	attempt.Score, attempt.MaxScore, attempt.Percent, attempt.Passed = 0, questionsMaxScore(questions), 0, false
+	err := s.quizRepo.SubmitAttempt(ctx, attempt, nil)
+	if err != nil && !errors.Is(err, repository.ErrQuizAttemptSubmitted) {
+		s.logger.Error("Ошибка при закрытии просроченной попытки", zap.Error(err), zap.Int64("attempt_id", attempt.ID))
+		return status.Errorf(codes.Internal, "Ошибка при закрытии попытки: %v", err)
+	}
+	s.logger.Info("Просроченная попытка закрыта", zap.Int64("attempt_id", attempt.ID))
+	return nil
+}
+
+// drawQuestions выбирает вопросы новой попытки: постоянные вопросы теста и случайные вопросы
+// из банка преподавателя курса по правилам теста.
+func (s *QuizService) drawQuestions(ctx context.Context, quiz *models.Quiz, seed int64) ([]*models.QuizQuestion, error) {
+	var bank []*models.QuizQuestion
+	if len(quiz.DrawRules) > 0 {
+		course, err := s.courseRepo.GetCourseByID(ctx, quiz.CourseID)
+		if err != nil || course == nil {
+			s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", quiz.CourseID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+		}
+		bank, err = s.bankRepo.GetQuestions(ctx, course.InstructorID, nil, "")
+		if err != nil {
+			s.logger.Error("Ошибка при получении банка вопросов", zap.Error(err), zap.Int64("instructor_id", course.InstructorID))
+			return nil, status.Errorf(codes.Internal, "Ошибка при получении банка вопросов: %v", err)
+		}
+	}
+	return quizgen.Draw(seed, quiz, bank), nil
+}
+
+// attemptQuestions восстанавливает вопросы попытки в том порядке и с тем порядком вариантов,
+// в каком их видел студент. У попыток без сохранённого списка вопросов используются вопросы теста.
+func (s *QuizService) attemptQuestions(ctx context.Context, quiz *models.Quiz, attempt *models.QuizAttempt) ([]*models.QuizQuestion, error) {
+	if len(attempt.QuestionIDs) == 0 {
+		return quiz.Questions, nil
+	}
+
+	stored, err := s.quizRepo.GetQuestionsByIDs(ctx, attempt.QuestionIDs)
+	if err != nil {
+		s.logger.Error("Ошибка при получении вопросов попытки", zap.Error(err), zap.Int64("attempt_id", attempt.ID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении вопросов попытки: %v", err)
+	}
+	byID := make(map[int64]*models.QuizQuestion, len(stored))
+	for _, question := range stored {
+		byID[question.ID] = question
+	}
+
+	questions := make([]*models.QuizQuestion, 0, len(attempt.QuestionIDs))
+	for _, id := range attempt.QuestionIDs {
+		if question, ok := byID[id]; ok {
+			questions = append(questions, question)
+		}
+	}
+	return s.presentQuestions(quiz, attempt, questions), nil
+}
+
+// presentQuestions перемешивает варианты ответов, если это включено в тесте.
+func (s *QuizService) presentQuestions(quiz *models.Quiz, attempt *models.QuizAttempt, questions []*models.QuizQuestion) []*models.QuizQuestion {
+	if !quiz.ShuffleOptions {
+		return questions
+	}
+	shuffled := make([]*models.QuizQuestion, 0, len(questions))
+	for _, question := range questions {
+		shuffled = append(shuffled, quizgen.ShuffleOptions(attempt.Seed, question))
+	}
+	return shuffled
+}
+
+// checkDrawRules проверяет, что в банке преподавателя хватает вопросов для каждого правила теста.
+func (s *QuizService) checkDrawRules(ctx context.Context, quiz *models.Quiz, instructorID int64) error {
+	if len(quiz.DrawRules) == 0 {
+		return nil
+	}
+
+	bank, err := s.bankRepo.GetQuestions(ctx, instructorID, nil, "")
+	if err != nil {
+		s.logger.Error("Ошибка при получении банка вопросов", zap.Error(err), zap.Int64("instructor_id", instructorID))
+		return status.Errorf(codes.Internal, "Ошибка при получении банка вопросов: %v", err)
+	}
+	for i, rule := range quiz.DrawRules {
+		var available int32
+		for _, question := range bank {
+			if rule.Matches(question) {
+				available++
+			}
+		}
+		if available < rule.Count {
+			s.logger.Warn("Недостаточно вопросов в банке", zap.Int("rule", i+1), zap.Int32("count", rule.Count), zap.Int32("available", available))
+			return status.Errorf(codes.InvalidArgument, "Правило %d: в банке %d подходящих вопросов, а нужно %d", i+1, available, rule.Count)
+		}
+	}
+	return nil
+}
+
+func (s *QuizService) getQuiz(ctx context.Context, quizID int64) (*models.Quiz, error) {
+	quiz, err := s.quizRepo.GetQuizByID(ctx, quizID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении теста", zap.Error(err), zap.Int64("quiz_id", quizID))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении теста: %v", err)
+	}
+	if quiz == nil {
+		s.logger.Warn("Тест не найден", zap.Int64("quiz_id", quizID))
+		return nil, status.Errorf(codes.NotFound, "Тест с ID %d не найден", quizID)
+	}
+	return quiz, nil
+}
+
+// getQuizForInstructor возвращает тест, если его курс ведёт преподаватель из токена.
+func (s *QuizService) getQuizForInstructor(ctx context.Context, quizID int64) (*models.Quiz, error) {
+	if quizID == 0 {
+		s.logger.Warn("Некорректный ID теста", zap.Int64("quiz_id", quizID))
+		return nil, status.Errorf(codes.InvalidArgument, "ID теста должен быть указан")
+	}
+
+	quiz, err := s.getQuiz(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCourseInstructor(ctx, quiz.CourseID); err != nil {
+		return nil, err
+	}
+	return quiz, nil
+}
+
+func (s *QuizService) checkCourseInstructor(ctx context.Context, courseID int64) error {
+	course, err := s.courseRepo.GetCourseByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		return status.Errorf(codes.NotFound, "Курс с ID %d не найден", courseID)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Нет доступа к тестам курса", zap.Int64("course_id", courseID))
+		return err
+	}
+	return nil
+}
+
+// checkQuizLecture проверяет, что лекция теста принадлежит его курсу.
+func (s *QuizService) checkQuizLecture(ctx context.Context, quiz *models.Quiz) error {
+	if quiz.LectureID == nil {
+		return nil
+	}
+
+	lecture, err := s.lectureRepo.GetLectureContent(ctx, *quiz.LectureID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении лекции", zap.Error(err), zap.Int64("lecture_id", *quiz.LectureID))
+		return status.Errorf(codes.Internal, "Ошибка при получении лекции: %v", err)
+	}
+	if lecture == nil || lecture.CourseID != quiz.CourseID {
+		s.logger.Warn("Лекция не найдена в курсе", zap.Int64("lecture_id", *quiz.LectureID), zap.Int64("course_id", quiz.CourseID))
+		return status.Errorf(codes.InvalidArgument, "Лекция с ID %d не найдена в курсе с ID %d", *quiz.LectureID, quiz.CourseID)
+	}
+	return nil
+}
+
+// checkQuizOpen проверяет, что студент записан на курс, а лекция теста для него уже открыта.
+func (s *QuizService) checkQuizOpen(ctx context.Context, quiz *models.Quiz, studentID int64) error {
+	enrollment, err := s.enrollmentRepo.GetEnrollment(ctx, studentID, quiz.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке записи на курс", zap.Error(err), zap.Int64("student_id", studentID), zap.Int64("course_id", quiz.CourseID))
+		return status.Errorf(codes.Internal, "Ошибка при проверке записи на курс: %v", err)
+	}
+	if enrollment == nil {
+		s.logger.Warn("Студент не записан на курс", zap.Int64("student_id", studentID), zap.Int64("course_id", quiz.CourseID))
+		return status.Errorf(codes.FailedPrecondition, "Студент с ID %d не записан на курс с ID %d", studentID, quiz.CourseID)
+	}
+
+	if quiz.LectureID == nil {
+		return nil
+	}
+	lecture, err := s.lectureRepo.GetLectureContent(ctx, *quiz.LectureID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении лекции", zap.Error(err), zap.Int64("lecture_id", *quiz.LectureID))
+		return status.Errorf(codes.Internal, "Ошибка при получении лекции: %v", err)
+	}
+	if lecture == nil {
+		return nil
+	}
+	if availableAt, _ := lecture.AvailableAt(enrollment); availableAt.After(time.Now()) {
+		s.logger.Warn("Лекция теста ещё не открыта", zap.Int64("quiz_id", quiz.ID), zap.Time("available_at", availableAt))
+		return status.Errorf(codes.FailedPrecondition, "Тест откроется вместе с лекцией %s", availableAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func quizFromRequest(lectureID int64, title, description string, timeLimitSeconds, maxAttempts, passingScore int32, required bool, questions []*proto.QuizQuestion, rules []*proto.QuizDrawRule) (*models.Quiz, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Название теста должно быть заполнено")
+	}
+	if timeLimitSeconds < 0 || maxAttempts < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Лимит времени и число попыток не могут быть отрицательными")
+	}
+	if passingScore < 0 || passingScore > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "Проходной балл должен быть от 0 до 100")
+	}
+	if required && lectureID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Обязательным для завершения может быть только тест лекции")
+	}
+	if len(questions) == 0 && len(rules) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Тест должен содержать хотя бы один вопрос или правило выбора из банка")
+	}
+
+	quiz := &models.Quiz{
+		Title:                 title,
+		Description:           description,
+		PassingScore:          passingScore,
+		RequiredForCompletion: required,
+	}
+	if lectureID != 0 {
+		quiz.LectureID = &lectureID
+	}
+	if timeLimitSeconds > 0 {
+		quiz.TimeLimitSeconds = &timeLimitSeconds
+	}
+	if maxAttempts > 0 {
+		quiz.MaxAttempts = &maxAttempts
+	}
+
+	for i, q := range questions {
+		question, err := questionFromProto(q)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Вопрос %d: %v", i+1, err)
+		}
+		quiz.Questions = append(quiz.Questions, question)
+		quiz.MaxScore += question.Points
+	}
+	quiz.QuestionCount = int32(len(quiz.Questions))
+
+	for i, r := range rules {
+		if r.Count <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Правило %d: количество вопросов должно быть больше нуля", i+1)
+		}
+		rule := &models.QuizDrawRule{Count: r.Count, Difficulty: questionDifficulties[r.Difficulty]}
+		for _, tag := range r.Tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				rule.Tags = append(rule.Tags, tag)
+			}
+		}
+		quiz.DrawRules = append(quiz.DrawRules, rule)
+		quiz.QuestionCount += r.Count
+	}
+	return quiz, nil
+}
+
+func questionFromProto(q *proto.QuizQuestion) (*models.QuizQuestion, error) {
+	question := &models.QuizQuestion{
+		ID:              q.Id,
+		Type:            questionTypes[q.Type],
+		Text:            q.Text,
+		Points:          q.Points,
+		Options:         q.Options,
+		CorrectOptions:  q.CorrectOptions,
+		CorrectBool:     q.CorrectBool,
+		CorrectNumber:   q.CorrectNumber,
+		Tolerance:       q.Tolerance,
+		AcceptedAnswers: q.AcceptedAnswers,
+		Tags:            q.Tags,
+		Difficulty:      questionDifficulties[q.Difficulty],
+	}
+	if err := validateQuestion(question); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// validateQuestion проверяет вопрос и приводит его к виду для хранения: лишние для типа поля ответа
+// сбрасываются, баллы по умолчанию равны 1, пустые теги и допустимые ответы отбрасываются.
+func validateQuestion(question *models.QuizQuestion) error {
+	if strings.TrimSpace(question.Text) == "" {
+		return errors.New("текст вопроса должен быть заполнен")
+	}
+	if question.Points < 0 {
+		return errors.New("баллы не могут быть отрицательными")
+	}
+	if question.Points == 0 {
+		question.Points = 1
+	}
+	switch question.Difficulty {
+	case "", models.DifficultyEasy, models.DifficultyMedium, models.DifficultyHard:
+	default:
+		return fmt.Errorf("неизвестная сложность %q", question.Difficulty)
+	}
+
+	var tags []string
+	for _, tag := range question.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	question.Tags = tags
+
+	options, correctOptions := question.Options, question.CorrectOptions
+	correctBool, correctNumber, tolerance := question.CorrectBool, question.CorrectNumber, question.Tolerance
+	acceptedAnswers := question.AcceptedAnswers
+	question.Options, question.CorrectOptions = nil, nil
+	question.CorrectBool, question.CorrectNumber, question.Tolerance = nil, nil, 0
+	question.AcceptedAnswers = nil
+
+	switch question.Type {
+	case models.QuestionSingleChoice, models.QuestionMultipleChoice:
+		if len(options) < 2 {
+			return errors.New("нужно указать хотя бы два варианта ответа")
+		}
+		seen := make(map[int32]bool, len(correctOptions))
+		for _, option := range correctOptions {
+			if option < 0 || int(option) >= len(options) || seen[option] {
+				return fmt.Errorf("некорректный номер правильного варианта %d", option)
+			}
+			seen[option] = true
+		}
+		if len(correctOptions) == 0 || (question.Type == models.QuestionSingleChoice && len(correctOptions) != 1) {
+			return errors.New("некорректное количество правильных вариантов")
+		}
+		question.Options = options
+		question.CorrectOptions = correctOptions
+	case models.QuestionTrueFalse:
+		if correctBool == nil {
+			return errors.New("правильный ответ должен быть указан")
+		}
+		question.CorrectBool = correctBool
+	case models.QuestionNumeric:
+		if correctNumber == nil {
+			return errors.New("правильный ответ должен быть указан")
+		}
+		if tolerance < 0 {
+			return errors.New("погрешность не может быть отрицательной")
+		}
+		question.CorrectNumber = correctNumber
+		question.Tolerance = tolerance
+	case models.QuestionShortText:
+		for _, answer := range acceptedAnswers {
+			if models.NormalizeTextAnswer(answer) != "" {
+				question.AcceptedAnswers = append(question.AcceptedAnswers, strings.TrimSpace(answer))
+			}
+		}
+		if len(question.AcceptedAnswers) == 0 {
+			return errors.New("нужно указать хотя бы один допустимый ответ")
+		}
+	default:
+		return errors.New("тип вопроса должен быть указан")
+	}
+	return nil
+}
+
+// gradeAnswers проверяет ответы на все вопросы попытки; на вопросы без ответа засчитывается ноль баллов.
+func gradeAnswers(questions []*models.QuizQuestion, answers []*proto.QuizAnswer) ([]*models.QuizAnswer, error) {
+	byQuestion := make(map[int64]*proto.QuizAnswer, len(answers))
+	for _, answer := range answers {
+		if _, duplicate := byQuestion[answer.QuestionId]; duplicate {
+			return nil, status.Errorf(codes.InvalidArgument, "Повторный ответ на вопрос с ID %d", answer.QuestionId)
+		}
+		byQuestion[answer.QuestionId] = answer
+	}
+
+	graded := make([]*models.QuizAnswer, 0, len(questions))
+	for _, question := range questions {
+		answer := &models.QuizAnswer{QuestionID: question.ID}
+		if given, ok := byQuestion[question.ID]; ok {
+			answer.SelectedOptions = given.SelectedOptions
+			answer.BoolAnswer = given.BoolAnswer
+			answer.NumberAnswer = given.NumberAnswer
+			answer.TextAnswer = given.TextAnswer
+			delete(byQuestion, question.ID)
+		}
+		question.Grade(answer)
+		graded = append(graded, answer)
+	}
+
+	for questionID := range byQuestion {
+		return nil, status.Errorf(codes.InvalidArgument, "Вопрос с ID %d не относится к попытке", questionID)
+	}
+	return graded, nil
+}
+
+func questionsMaxScore(questions []*models.QuizQuestion) int32 {
+	var maxScore int32
+	for _, question := range questions {
+		maxScore += question.Points
+	}
+	return maxScore
+}
+
+// quizRequiredError сообщает, какой тест нужно сдать для завершения лекции.
+func quizRequiredError(quiz *models.Quiz) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("Для завершения лекции необходимо сдать тест «%s» (ID %d)", quiz.Title, quiz.ID))
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{
+			Type:        "QUIZ_NOT_PASSED",
+			Subject:     fmt.Sprintf("quizzes/%d", quiz.ID),
+			Description: fmt.Sprintf("Требуется не менее %d%% правильных ответов", quiz.PassingScore),
+		}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func quizToProto(quiz *models.Quiz, withQuestions bool) *proto.Quiz {
+	grpcQuiz := &proto.Quiz{
+		Id:                    quiz.ID,
+		CourseId:              quiz.CourseID,
+		Title:                 quiz.Title,
+		Description:           quiz.Description,
+		PassingScore:          quiz.PassingScore,
+		RequiredForCompletion: quiz.RequiredForCompletion,
+		QuestionCount:         quiz.QuestionCount,
+		MaxScore:              quiz.MaxScore,
+		ShuffleQuestions:      quiz.ShuffleQuestions,
+		ShuffleOptions:        quiz.ShuffleOptions,
+	}
+	if quiz.LectureID != nil {
+		grpcQuiz.LectureId = *quiz.LectureID
+	}
+	if quiz.TimeLimitSeconds != nil {
+		grpcQuiz.TimeLimitSeconds = *quiz.TimeLimitSeconds
+	}
+	if quiz.MaxAttempts != nil {
+		grpcQuiz.MaxAttempts = *quiz.MaxAttempts
+	}
+	if withQuestions {
+		for _, question := range quiz.Questions {
+			grpcQuiz.Questions = append(grpcQuiz.Questions, questionToProto(question, true))
+		}
+		for _, rule := range quiz.DrawRules {
+			grpcQuiz.DrawRules = append(grpcQuiz.DrawRules, &proto.QuizDrawRule{
+				Count:      rule.Count,
+				Tags:       rule.Tags,
+				Difficulty: difficultyToProto(rule.Difficulty),
+			})
+		}
+	}
+	return grpcQuiz
+}
+
+func questionToProto(question *models.QuizQuestion, withAnswers bool) *proto.QuizQuestion {
+	grpcQuestion := &proto.QuizQuestion{
+		Id:      question.ID,
+		Text:    question.Text,
+		Points:  question.Points,
+		Options: question.Options,
+	}
+	for protoType, modelType := range questionTypes {
+		if modelType == question.Type {
+			grpcQuestion.Type = protoType
+		}
+	}
+	if withAnswers {
+		grpcQuestion.CorrectOptions = question.CorrectOptions
+		grpcQuestion.CorrectBool = question.CorrectBool
+		grpcQuestion.CorrectNumber = question.CorrectNumber
+		grpcQuestion.Tolerance = question.Tolerance
+		grpcQuestion.AcceptedAnswers = question.AcceptedAnswers
+		grpcQuestion.Tags = question.Tags
+		grpcQuestion.Difficulty = difficultyToProto(question.Difficulty)
+	}
+	return grpcQuestion
+}
+
+func difficultyToProto(difficulty string) proto.QuestionDifficulty {
+	for protoDifficulty, modelDifficulty := range questionDifficulties {
+		if modelDifficulty == difficulty {
+			return protoDifficulty
+		}
+	}
+	return proto.QuestionDifficulty_QUESTION_DIFFICULTY_UNSPECIFIED
+}
+
+// attemptToProto передаёт вопросы без правильных ответов, а результаты — только после отправки попытки.
+func attemptToProto(attempt *models.QuizAttempt, questions []*models.QuizQuestion, answers []*models.QuizAnswer) *proto.QuizAttempt {
+	grpcAttempt := &proto.QuizAttempt{
+		Id:            attempt.ID,
+		QuizId:        attempt.QuizID,
+		StudentId:     attempt.StudentID,
+		AttemptNumber: attempt.AttemptNumber,
+		StartedAt:     attempt.StartedAt.Format(time.RFC3339),
+		DeadlineAt:    formatOptionalTime(attempt.DeadlineAt),
+		SubmittedAt:   formatOptionalTime(attempt.SubmittedAt),
+		Score:         attempt.Score,
+		MaxScore:      attempt.MaxScore,
+		Percent:       attempt.Percent,
+		Passed:        attempt.Passed,
+		Seed:          attempt.Seed,
+	}
+	for _, question := range questions {
+		grpcAttempt.Questions = append(grpcAttempt.Questions, questionToProto(question, false))
+	}
+	for _, answer := range answers {
+		grpcAttempt.Results = append(grpcAttempt.Results, &proto.QuizAnswerResult{
+			QuestionId: answer.QuestionID,
+			Correct:    answer.Correct,
+			Points:     answer.Points,
+		})
+	}
+	return grpcAttempt
+}
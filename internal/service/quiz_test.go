@@ -0,0 +1,297 @@
+package service
+
+import (
+	"GoEdu/proto"
+	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func prepareQuizTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"quiz_answers", "quiz_attempts", "quiz_questions", "quizzes", "lecture_completions", "lectures"},
+		Instructors: 2,
+		Students:    2,
+		Courses:     []int64{1, 2},
+		Enrollments: [][2]int64{{1, 1}},
+	})
+
+	_, err := db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content, position) VALUES (1, 1, 'Лекция 1', 'Содержание 1', 1), (2, 2, 'Лекция курса 2', 'Содержание 2', 1)")
+	require.NoError(t, err, "Не удалось добавить лекции")
+}
+
+func boolPtr(v bool) *bool          { return &v }
+func float64Ptr(v float64) *float64 { return &v }
+
+// sampleQuizQuestions возвращает по одному вопросу каждого типа.
+func sampleQuizQuestions() []*proto.QuizQuestion {
+	return []*proto.QuizQuestion{
+		{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_SINGLE_CHOICE, Text: "Столица России?", Options: []string{"Казань", "Москва"}, CorrectOptions: []int32{1}},
+		{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_MULTIPLE_CHOICE, Text: "Чётные числа?", Options: []string{"1", "2", "4"}, CorrectOptions: []int32{1, 2}, Points: 2},
+		{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE, Text: "Go — компилируемый язык", CorrectBool: boolPtr(true)},
+		{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_NUMERIC, Text: "Число пи с точностью 0.01", CorrectNumber: float64Ptr(3.14), Tolerance: 0.01},
+		{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_SHORT_TEXT, Text: "Ключевое слово для горутины", AcceptedAnswers: []string{"go"}},
+	}
+}
+
+func TestCreateQuiz(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.CreateQuizRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Тест лекции",
+			Ctx:     instructorCtx,
+			Request: &proto.CreateQuizRequest{CourseId: 1, LectureId: 1, Title: "Тест 1", PassingScore: 60, Questions: sampleQuizQuestions()},
+		},
+		{
+			Name:    "Итоговый тест курса",
+			Ctx:     instructorCtx,
+			Request: &proto.CreateQuizRequest{CourseId: 1, Title: "Итоговый тест", Questions: sampleQuizQuestions()},
+		},
+		{
+			Name:         "Пустое название",
+			Ctx:          instructorCtx,
+			Request:      &proto.CreateQuizRequest{CourseId: 1, Questions: sampleQuizQuestions()},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Обязательный тест без лекции",
+			Ctx:          instructorCtx,
+			Request:      &proto.CreateQuizRequest{CourseId: 1, Title: "Тест", RequiredForCompletion: true, Questions: sampleQuizQuestions()},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Два правильных варианта в вопросе с одним ответом",
+			Ctx:  instructorCtx,
+			Request: &proto.CreateQuizRequest{CourseId: 1, Title: "Тест", Questions: []*proto.QuizQuestion{
+				{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_SINGLE_CHOICE, Text: "Вопрос", Options: []string{"А", "Б"}, CorrectOptions: []int32{0, 1}},
+			}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Лекция другого курса",
+			Ctx:          instructorCtx,
+			Request:      &proto.CreateQuizRequest{CourseId: 1, LectureId: 2, Title: "Тест", Questions: sampleQuizQuestions()},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Курс не найден",
+			Ctx:          instructorCtx,
+			Request:      &proto.CreateQuizRequest{CourseId: 99, Title: "Тест", Questions: sampleQuizQuestions()},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+		{
+			Name:         "Преподаватель другого курса",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.CreateQuizRequest{CourseId: 1, Title: "Тест", Questions: sampleQuizQuestions()},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientQuiz.CreateQuiz(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова CreateQuiz")
+			assert.NotZero(t, resp.Id, "ID теста должен быть заполнен")
+			assert.Equal(t, int32(5), resp.QuestionCount, "Некорректное количество вопросов")
+			assert.Equal(t, int32(6), resp.MaxScore, "Некорректный максимальный балл")
+			require.Len(t, resp.Questions, 5, "Вопросы должны вернуться с ответами")
+			assert.NotZero(t, resp.Questions[0].Id, "ID вопроса должен быть заполнен")
+		})
+	}
+
+	list, err := clientQuiz.ListQuizzes(ctx, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка получения тестов")
+	require.Len(t, list.Quizzes, 2, "Некорректное количество тестов")
+	assert.Empty(t, list.Quizzes[0].Questions, "Список тестов не должен содержать вопросы")
+}
+
+func TestUpdateQuizKeepsQuestionIDs(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	quiz, err := clientQuiz.CreateQuiz(instructorCtx, &proto.CreateQuizRequest{CourseId: 1, Title: "Тест", Questions: sampleQuizQuestions()})
+	require.NoError(t, err, "Ошибка создания теста")
+
+	kept := quiz.Questions[2]
+	kept.Text = "Go — компилируемый язык со сборкой мусора"
+	added := &proto.QuizQuestion{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE, Text: "Go поддерживает дженерики", CorrectBool: boolPtr(true)}
+
+	updated, err := clientQuiz.UpdateQuiz(instructorCtx, &proto.UpdateQuizRequest{Id: quiz.Id, Title: "Тест", Questions: []*proto.QuizQuestion{added, kept}})
+	require.NoError(t, err, "Ошибка обновления теста")
+	require.Len(t, updated.Questions, 2, "Удалённые вопросы не должны остаться в тесте")
+	assert.NotZero(t, updated.Questions[0].Id, "Новый вопрос должен получить ID")
+	assert.Equal(t, kept.Id, updated.Questions[1].Id, "ID изменённого вопроса должен сохраниться")
+
+	stored, err := clientQuiz.GetQuiz(instructorCtx, &proto.QuizIDRequest{QuizId: quiz.Id})
+	require.NoError(t, err, "Ошибка получения теста")
+	require.Len(t, stored.Questions, 2, "Некорректное количество вопросов")
+	assert.Equal(t, kept.Id, stored.Questions[1].Id, "Вопросы должны сохранить порядок из запроса")
+	assert.Equal(t, kept.Text, stored.Questions[1].Text, "Текст вопроса не обновлён")
+
+	_, err = clientQuiz.UpdateQuiz(instructorCtx, &proto.UpdateQuizRequest{Id: quiz.Id, Title: "Тест", Questions: []*proto.QuizQuestion{{
+		Id: 9999, Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE, Text: "Чужой вопрос", CorrectBool: boolPtr(true),
+	}}})
+	require.Error(t, err, "Вопрос другого теста не должен изменяться")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Некорректный код ошибки")
+}
+
+func TestQuizAttemptGrading(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+
+	quiz, err := clientQuiz.CreateQuiz(instructorCtx, &proto.CreateQuizRequest{CourseId: 1, LectureId: 1, Title: "Тест 1", PassingScore: 70, TimeLimitSeconds: 600, Questions: sampleQuizQuestions()})
+	require.NoError(t, err, "Ошибка создания теста")
+
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	attempt, err := clientQuiz.StartQuizAttempt(studentCtx, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка начала попытки")
+	assert.Equal(t, int32(1), attempt.AttemptNumber, "Некорректный номер попытки")
+	assert.NotEmpty(t, attempt.DeadlineAt, "Для теста с лимитом времени должен быть дедлайн")
+	require.Len(t, attempt.Questions, 5, "Попытка должна содержать вопросы")
+	for _, question := range attempt.Questions {
+		assert.Empty(t, question.CorrectOptions, "Правильные ответы не должны передаваться студенту")
+		assert.Nil(t, question.CorrectBool, "Правильные ответы не должны передаваться студенту")
+		assert.Nil(t, question.CorrectNumber, "Правильные ответы не должны передаваться студенту")
+		assert.Empty(t, question.AcceptedAnswers, "Правильные ответы не должны передаваться студенту")
+	}
+
+	resumed, err := clientQuiz.StartQuizAttempt(studentCtx, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка повторного начала попытки")
+	assert.Equal(t, attempt.Id, resumed.Id, "Незавершённая попытка должна продолжаться")
+
+	q := attempt.Questions
+	result, err := clientQuiz.SubmitQuizAttempt(studentCtx, &proto.SubmitQuizAttemptRequest{
+		AttemptId: attempt.Id,
+		StudentId: 1,
+		Answers: []*proto.QuizAnswer{
+			{QuestionId: q[0].Id, SelectedOptions: []int32{1}},
+			{QuestionId: q[1].Id, SelectedOptions: []int32{1}},
+			{QuestionId: q[2].Id, BoolAnswer: boolPtr(true)},
+			{QuestionId: q[3].Id, NumberAnswer: float64Ptr(3.141)},
+			{QuestionId: q[4].Id, TextAnswer: "  GO "},
+		},
+	})
+	require.NoError(t, err, "Ошибка отправки ответов")
+	assert.Equal(t, int32(4), result.Score, "Некорректное количество баллов")
+	assert.Equal(t, int32(6), result.MaxScore, "Некорректный максимальный балл")
+	assert.Equal(t, int32(66), result.Percent, "Некорректный процент")
+	assert.False(t, result.Passed, "Тест не должен быть сдан")
+	require.Len(t, result.Results, 5, "Результаты должны быть по всем вопросам")
+	assert.False(t, result.Results[1].Correct, "Неполный набор вариантов не засчитывается")
+
+	_, err = clientQuiz.SubmitQuizAttempt(studentCtx, &proto.SubmitQuizAttemptRequest{AttemptId: attempt.Id, StudentId: 1})
+	require.Error(t, err, "Повторная отправка должна завершиться ошибкой")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+
+	_, err = clientQuiz.StartQuizAttempt(withToken(t, ctx, 2, "student"), &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 2})
+	require.Error(t, err, "Незаписанный студент не должен проходить тест")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+
+	attempts, err := clientQuiz.ListQuizAttempts(studentCtx, &proto.QuizAttemptsRequest{QuizId: quiz.Id})
+	require.NoError(t, err, "Ошибка получения попыток")
+	require.Len(t, attempts.Attempts, 1, "Некорректное количество попыток")
+	assert.Equal(t, int32(-1), attempts.RemainingAttempts, "Число попыток не ограничено")
+}
+
+func TestQuizAttemptLimits(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	quiz, err := clientQuiz.CreateQuiz(instructorCtx, &proto.CreateQuizRequest{CourseId: 1, Title: "Тест", MaxAttempts: 2, TimeLimitSeconds: 60, Questions: sampleQuizQuestions()})
+	require.NoError(t, err, "Ошибка создания теста")
+
+	first, err := clientQuiz.StartQuizAttempt(studentCtx, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка начала первой попытки")
+
+	_, err = db.Exec(ctx, "UPDATE quiz_attempts SET deadline_at = NOW() - INTERVAL '1 hour' WHERE id = $1", first.Id)
+	require.NoError(t, err, "Не удалось сдвинуть дедлайн")
+
+	_, err = clientQuiz.SubmitQuizAttempt(studentCtx, &proto.SubmitQuizAttemptRequest{AttemptId: first.Id, StudentId: 1})
+	require.Error(t, err, "Ответы после окончания времени не принимаются")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+
+	second, err := clientQuiz.StartQuizAttempt(studentCtx, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка начала второй попытки")
+	assert.Equal(t, int32(2), second.AttemptNumber, "Просроченная попытка должна учитываться")
+
+	_, err = clientQuiz.SubmitQuizAttempt(studentCtx, &proto.SubmitQuizAttemptRequest{AttemptId: second.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка отправки второй попытки")
+
+	_, err = clientQuiz.StartQuizAttempt(studentCtx, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.Error(t, err, "Попытки должны быть исчерпаны")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Некорректный код ошибки")
+
+	attempts, err := clientQuiz.ListQuizAttempts(studentCtx, &proto.QuizAttemptsRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка получения попыток")
+	assert.Len(t, attempts.Attempts, 2, "Некорректное количество попыток")
+	assert.Equal(t, int32(0), attempts.RemainingAttempts, "Попыток не должно остаться")
+}
+
+func TestRequiredQuizBlocksLectureCompletion(t *testing.T) {
+	ctx := context.Background()
+	prepareQuizTables(t, ctx)
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+	studentCtx := withToken(t, ctx, 1, "student")
+
+	quiz, err := clientQuiz.CreateQuiz(instructorCtx, &proto.CreateQuizRequest{
+		CourseId: 1, LectureId: 1, Title: "Допуск", PassingScore: 100, RequiredForCompletion: true,
+		Questions: []*proto.QuizQuestion{
+			{Type: proto.QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE, Text: "Лекция просмотрена", CorrectBool: boolPtr(true)},
+		},
+	})
+	require.NoError(t, err, "Ошибка создания теста")
+
+	_, err = clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 1})
+	require.Error(t, err, "Лекцию нельзя завершить без сдачи теста")
+	st, ok := status.FromError(err)
+	require.True(t, ok, "Ошибка не является статусной")
+	assert.Equal(t, codes.FailedPrecondition, st.Code(), "Некорректный код ошибки")
+	assert.Len(t, st.Details(), 1, "Ошибка должна содержать ссылку на тест")
+
+	attempt, err := clientQuiz.StartQuizAttempt(studentCtx, &proto.StartQuizAttemptRequest{QuizId: quiz.Id, StudentId: 1})
+	require.NoError(t, err, "Ошибка начала попытки")
+
+	result, err := clientQuiz.SubmitQuizAttempt(studentCtx, &proto.SubmitQuizAttemptRequest{
+		AttemptId: attempt.Id,
+		StudentId: 1,
+		Answers:   []*proto.QuizAnswer{{QuestionId: attempt.Questions[0].Id, BoolAnswer: boolPtr(true)}},
+	})
+	require.NoError(t, err, "Ошибка отправки ответов")
+	require.True(t, result.Passed, "Тест должен быть сдан")
+
+	_, err = clientLecture.MarkLectureAsCompleted(studentCtx, &proto.LectureCompletionRequest{StudentId: 1, LectureId: 1})
+	require.NoError(t, err, "После сдачи теста лекция должна завершаться")
+}
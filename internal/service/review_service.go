@@ -1,9 +1,15 @@
 package service
 
 import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/moderation"
 	"GoEdu/internal/repository"
 	"GoEdu/proto"
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -12,44 +18,103 @@ import (
 
 type ReviewService struct {
 	proto.UnimplementedReviewServiceServer
-	reviewRepo repository.ReviewRepository
-	logger     *zap.Logger
+	reviewRepo     repository.ReviewRepository
+	enrollmentRepo repository.EnrollmentRepository
+	lectureRepo    repository.LectureRepository
+	courseRepo     repository.CourseRepository
+	minProgress    int32
+	filter         *moderation.Filter
+	reportLimit    int
+	logger         *zap.Logger
 }
 
-func NewReviewService(reviewRepo repository.ReviewRepository, logger *zap.Logger) *ReviewService {
+// NewReviewService создаёт сервис отзывов. minProgress — доля пройденных лекций курса в процентах,
+// после которой студент может оставить отзыв. Отзывы, не прошедшие filter, ждут модерации;
+// опубликованный отзыв, набравший reportLimit открытых жалоб, снимается с публикации до решения модератора.
+func NewReviewService(reviewRepo repository.ReviewRepository, enrollmentRepo repository.EnrollmentRepository, lectureRepo repository.LectureRepository, courseRepo repository.CourseRepository, minProgress int32, filter *moderation.Filter, reportLimit int, logger *zap.Logger) *ReviewService {
 	return &ReviewService{
-		reviewRepo: reviewRepo,
-		logger:     logger,
+		reviewRepo:     reviewRepo,
+		enrollmentRepo: enrollmentRepo,
+		lectureRepo:    lectureRepo,
+		courseRepo:     courseRepo,
+		minProgress:    minProgress,
+		filter:         filter,
+		reportLimit:    reportLimit,
+		logger:         logger,
 	}
 }
 
+// AddReviewToCourse добавляет отзыв так же, как CreateReview, но, как и раньше, возвращает пустой ответ.
 func (s *ReviewService) AddReviewToCourse(ctx context.Context, req *proto.ReviewRequest) (*proto.Empty, error) {
-	s.logger.Info("Добавление отзыва к курсу", zap.Int64("student_id", req.StudentId), zap.Int64("course_id", req.CourseId), zap.Int("rating", int(req.Rating)))
+	if _, err := s.CreateReview(ctx, req); err != nil {
+		return nil, err
+	}
+	return &proto.Empty{}, nil
+}
+
+// CreateReview добавляет отзыв студента к курсу и возвращает его. Автор отзыва берётся из токена; студент должен быть
+// записан на курс и пройти не меньше minProgress процентов лекций. К курсу можно оставить только один отзыв.
+// Отзыв, задержанный фильтром, публикуется после одобрения модератором.
+func (s *ReviewService) CreateReview(ctx context.Context, req *proto.ReviewRequest) (*proto.Review, error) {
+	studentID, err := reviewerID(ctx)
+	if err != nil {
+		s.logger.Warn("Отзыв добавляет не студент", zap.Int64("course_id", req.CourseId))
+		return nil, err
+	}
+	s.logger.Info("Добавление отзыва к курсу", zap.Int64("student_id", studentID), zap.Int64("course_id", req.CourseId), zap.Int("rating", int(req.Rating)))
 
-	if req.StudentId == 0 || req.CourseId == 0 || req.Rating < 1 || req.Rating > 5 {
-		s.logger.Warn("Некорректные данные для отзыва", zap.Int64("student_id", req.StudentId), zap.Int64("course_id", req.CourseId), zap.Int("rating", int(req.Rating)))
+	if req.CourseId == 0 || req.Rating < 1 || req.Rating > 5 {
+		s.logger.Warn("Некорректные данные для отзыва", zap.Int64("student_id", studentID), zap.Int64("course_id", req.CourseId), zap.Int("rating", int(req.Rating)))
 		return nil, status.Errorf(codes.InvalidArgument, "Некорректные данные: ID студента, ID курса и оценка должны быть указаны корректно")
 	}
 
-	err := s.reviewRepo.AddReview(ctx, req.StudentId, req.CourseId, req.Comment, req.Rating)
+	if err := s.checkCanReview(ctx, studentID, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	review := &models.Review{StudentID: studentID, CourseID: req.CourseId, Comment: req.Comment, Rating: req.Rating, Status: models.ReviewApproved}
+	if note := s.filter.Check(req.Comment); note != "" {
+		s.logger.Info("Отзыв задержан фильтром", zap.Int64("student_id", studentID), zap.Int64("course_id", req.CourseId), zap.String("reason", note))
+		review.Status, review.ModerationNote = models.ReviewPending, note
+	}
+
+	review, err = s.reviewRepo.AddReview(ctx, review)
 	if err != nil {
-		s.logger.Error("Ошибка при добавлении отзыва", zap.Error(err), zap.Int64("student_id", req.StudentId), zap.Int64("course_id", req.CourseId))
+		if errors.Is(err, repository.ErrReviewExists) {
+			s.logger.Warn("Повторный отзыв к курсу", zap.Int64("student_id", studentID), zap.Int64("course_id", req.CourseId))
+			return nil, status.Errorf(codes.AlreadyExists, "Вы уже оставили отзыв к этому курсу; его можно изменить")
+		}
+		s.logger.Error("Ошибка при добавлении отзыва", zap.Error(err), zap.Int64("student_id", studentID), zap.Int64("course_id", req.CourseId))
 		return nil, status.Errorf(codes.Internal, "Ошибка при добавлении отзыва: %v", err)
 	}
 
-	s.logger.Info("Отзыв успешно добавлен", zap.Int64("student_id", req.StudentId), zap.Int64("course_id", req.CourseId))
-	return &proto.Empty{}, nil
+	s.logger.Info("Отзыв успешно добавлен", zap.Int64("review_id", review.ID), zap.Int64("student_id", studentID), zap.Int64("course_id", req.CourseId), zap.String("status", review.Status))
+	return reviewToProto(review), nil
+}
+
+// reviewOrders сопоставляет порядок отзывов из API с порядком в репозитории.
+var reviewOrders = map[proto.ReviewSort]repository.ReviewOrder{
+	proto.ReviewSort_REVIEW_SORT_DEFAULT: repository.ReviewOrderOldest,
+	proto.ReviewSort_REVIEW_SORT_HELPFUL: repository.ReviewOrderHelpful,
+	proto.ReviewSort_REVIEW_SORT_NEWEST:  repository.ReviewOrderNewest,
+	proto.ReviewSort_REVIEW_SORT_HIGHEST: repository.ReviewOrderHighest,
+	proto.ReviewSort_REVIEW_SORT_LOWEST:  repository.ReviewOrderLowest,
 }
 
-func (s *ReviewService) GetReviewsByCourse(ctx context.Context, req *proto.CourseIDRequest) (*proto.ReviewList, error) {
-	s.logger.Info("Получение отзывов для курса", zap.Int64("course_id", req.CourseId))
+func (s *ReviewService) GetReviewsByCourse(ctx context.Context, req *proto.ReviewsRequest) (*proto.ReviewList, error) {
+	s.logger.Info("Получение отзывов для курса", zap.Int64("course_id", req.CourseId), zap.String("sort", req.Sort.String()))
 
 	if req.CourseId == 0 {
 		s.logger.Warn("Некорректный ID курса", zap.Int64("course_id", req.CourseId))
 		return nil, status.Errorf(codes.InvalidArgument, "ID курса должен быть указан")
 	}
+	order, ok := reviewOrders[req.Sort]
+	if !ok {
+		s.logger.Warn("Неизвестный порядок отзывов", zap.Int32("sort", int32(req.Sort)))
+		return nil, status.Errorf(codes.InvalidArgument, "Неизвестный порядок отзывов")
+	}
 
-	reviews, err := s.reviewRepo.GetReviewsByCourse(ctx, req.CourseId)
+	reviews, err := s.reviewRepo.GetReviewsByCourse(ctx, req.CourseId, order)
 	if err != nil {
 		s.logger.Error("Ошибка при получении отзывов", zap.Error(err), zap.Int64("course_id", req.CourseId))
 		return nil, status.Errorf(codes.Internal, "Ошибка при получении отзывов: %v", err)
@@ -57,16 +122,390 @@ func (s *ReviewService) GetReviewsByCourse(ctx context.Context, req *proto.Cours
 
 	var grpcReviews []*proto.Review
 	for _, review := range reviews {
-		grpcReviews = append(grpcReviews, &proto.Review{
-			Id:        review.ID,
-			StudentId: review.StudentID,
-			CourseId:  review.CourseID,
-			Comment:   review.Comment,
-			Rating:    review.Rating,
-			CreatedAt: review.CreatedAt.Format("2006-01-02 15:04:05"),
-		})
+		grpcReviews = append(grpcReviews, reviewToProto(review))
 	}
 
 	s.logger.Info("Отзывы успешно получены", zap.Int("count", len(grpcReviews)), zap.Int64("course_id", req.CourseId))
 	return &proto.ReviewList{Reviews: grpcReviews}, nil
 }
+
+func (s *ReviewService) UpdateReview(ctx context.Context, req *proto.UpdateReviewRequest) (*proto.Review, error) {
+	s.logger.Info("Обновление отзыва", zap.Int64("review_id", req.ReviewId), zap.Int("rating", int(req.Rating)))
+
+	if req.ReviewId == 0 || req.Rating < 1 || req.Rating > 5 {
+		s.logger.Warn("Некорректные данные для отзыва", zap.Int64("review_id", req.ReviewId), zap.Int("rating", int(req.Rating)))
+		return nil, status.Errorf(codes.InvalidArgument, "Некорректные данные: ID отзыва и оценка от 1 до 5 должны быть указаны")
+	}
+	current, err := s.getOwnReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	reviewStatus, note := s.moderateEdit(current, req.Comment)
+
+	review, err := s.reviewRepo.UpdateReview(ctx, req.ReviewId, req.Comment, req.Rating, reviewStatus, note)
+	if err != nil {
+		s.logger.Error("Ошибка при обновлении отзыва", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении отзыва: %v", err)
+	}
+	if review == nil {
+		s.logger.Warn("Отзыв не найден", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.NotFound, "Отзыв с ID %d не найден", req.ReviewId)
+	}
+
+	s.logger.Info("Отзыв успешно обновлён", zap.Int64("review_id", req.ReviewId))
+	return reviewToProto(review), nil
+}
+
+func (s *ReviewService) DeleteReview(ctx context.Context, req *proto.ReviewIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление отзыва", zap.Int64("review_id", req.ReviewId))
+
+	if req.ReviewId == 0 {
+		s.logger.Warn("Некорректный ID отзыва", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID отзыва должен быть указан")
+	}
+	if _, err := s.getOwnReview(ctx, req.ReviewId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.reviewRepo.DeleteReview(ctx, req.ReviewId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении отзыва", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении отзыва: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Отзыв не найден", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.NotFound, "Отзыв с ID %d не найден", req.ReviewId)
+	}
+
+	s.logger.Info("Отзыв успешно удалён", zap.Int64("review_id", req.ReviewId))
+	return &proto.Empty{}, nil
+}
+
+// ReplyToReview сохраняет ответ преподавателя курса на опубликованный отзыв; повторный ответ заменяет прежний.
+func (s *ReviewService) ReplyToReview(ctx context.Context, req *proto.ReviewReplyRequest) (*proto.Review, error) {
+	s.logger.Info("Ответ на отзыв", zap.Int64("review_id", req.ReviewId))
+
+	comment := strings.TrimSpace(req.Comment)
+	if req.ReviewId == 0 || comment == "" {
+		s.logger.Warn("Некорректные данные для ответа на отзыв", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID отзыва и текст ответа должны быть указаны")
+	}
+	if len([]rune(comment)) > 2000 {
+		s.logger.Warn("Слишком длинный ответ на отзыв", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "Ответ не может быть длиннее 2000 символов")
+	}
+	review, instructorID, err := s.getReviewForReply(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewRepo.SetReply(ctx, &models.ReviewReply{ReviewID: review.ID, InstructorID: instructorID, Comment: comment}); err != nil {
+		s.logger.Error("Ошибка при сохранении ответа на отзыв", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении ответа на отзыв: %v", err)
+	}
+
+	s.logger.Info("Ответ на отзыв сохранён", zap.Int64("review_id", req.ReviewId), zap.Int64("instructor_id", instructorID))
+	return s.reloadReview(ctx, req.ReviewId)
+}
+
+func (s *ReviewService) DeleteReviewReply(ctx context.Context, req *proto.ReviewIDRequest) (*proto.Review, error) {
+	s.logger.Info("Удаление ответа на отзыв", zap.Int64("review_id", req.ReviewId))
+
+	if req.ReviewId == 0 {
+		s.logger.Warn("Некорректный ID отзыва", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID отзыва должен быть указан")
+	}
+	if _, _, err := s.getReviewForReply(ctx, req.ReviewId); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.reviewRepo.DeleteReply(ctx, req.ReviewId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении ответа на отзыв", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении ответа на отзыв: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Ответ на отзыв не найден", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.NotFound, "У отзыва с ID %d нет ответа", req.ReviewId)
+	}
+
+	s.logger.Info("Ответ на отзыв удалён", zap.Int64("review_id", req.ReviewId))
+	return s.reloadReview(ctx, req.ReviewId)
+}
+
+// VoteReview сохраняет оценку полезности отзыва от пользователя из токена. Голосовать за свой отзыв нельзя.
+func (s *ReviewService) VoteReview(ctx context.Context, req *proto.ReviewVoteRequest) (*proto.Review, error) {
+	s.logger.Info("Голос за отзыв", zap.Int64("review_id", req.ReviewId), zap.Bool("helpful", req.Helpful))
+
+	user, err := s.getReviewVoter(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewRepo.Vote(ctx, req.ReviewId, user.ID, user.Role, req.Helpful); err != nil {
+		s.logger.Error("Ошибка при сохранении голоса", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении голоса: %v", err)
+	}
+
+	s.logger.Info("Голос за отзыв сохранён", zap.Int64("review_id", req.ReviewId), zap.Int64("user_id", user.ID))
+	return s.reloadReview(ctx, req.ReviewId)
+}
+
+func (s *ReviewService) DeleteReviewVote(ctx context.Context, req *proto.ReviewIDRequest) (*proto.Review, error) {
+	s.logger.Info("Отзыв голоса за отзыв", zap.Int64("review_id", req.ReviewId))
+
+	user, err := s.getReviewVoter(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.reviewRepo.DeleteVote(ctx, req.ReviewId, user.ID, user.Role)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении голоса", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении голоса: %v", err)
+	}
+	if !deleted {
+		s.logger.Warn("Голос не найден", zap.Int64("review_id", req.ReviewId), zap.Int64("user_id", user.ID))
+		return nil, status.Errorf(codes.NotFound, "Вы не голосовали за этот отзыв")
+	}
+
+	s.logger.Info("Голос за отзыв удалён", zap.Int64("review_id", req.ReviewId), zap.Int64("user_id", user.ID))
+	return s.reloadReview(ctx, req.ReviewId)
+}
+
+// getPublishedReview возвращает опубликованный отзыв; скрытые и отклонённые отзывы считаются ненайденными.
+func (s *ReviewService) getPublishedReview(ctx context.Context, id int64) (*models.Review, error) {
+	review, err := s.reviewRepo.GetReviewByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении отзыва", zap.Error(err), zap.Int64("review_id", id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении отзыва: %v", err)
+	}
+	if review == nil || review.Status != models.ReviewApproved {
+		s.logger.Warn("Отзыв не найден", zap.Int64("review_id", id))
+		return nil, status.Errorf(codes.NotFound, "Отзыв с ID %d не найден", id)
+	}
+	return review, nil
+}
+
+// getReviewForReply возвращает опубликованный отзыв и ID преподавателя курса, проверяя,
+// что отвечает именно он.
+func (s *ReviewService) getReviewForReply(ctx context.Context, id int64) (*models.Review, int64, error) {
+	review, err := s.getPublishedReview(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	course, err := s.courseRepo.GetCourseByID(ctx, review.CourseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении курса", zap.Error(err), zap.Int64("course_id", review.CourseID))
+		return nil, 0, status.Errorf(codes.Internal, "Ошибка при получении курса: %v", err)
+	}
+	if course == nil {
+		s.logger.Warn("Курс не найден", zap.Int64("course_id", review.CourseID))
+		return nil, 0, status.Errorf(codes.NotFound, "Курс с ID %d не найден", review.CourseID)
+	}
+	if err := checkInstructorAccess(ctx, course.InstructorID); err != nil {
+		s.logger.Warn("Ответ на отзыв к чужому курсу", zap.Int64("review_id", id), zap.Int64("course_id", course.ID))
+		return nil, 0, err
+	}
+	return review, course.InstructorID, nil
+}
+
+// getReviewVoter возвращает пользователя из токена, если он может голосовать за отзыв.
+func (s *ReviewService) getReviewVoter(ctx context.Context, reviewID int64) (middleware.User, error) {
+	if reviewID == 0 {
+		s.logger.Warn("Некорректный ID отзыва", zap.Int64("review_id", reviewID))
+		return middleware.User{}, status.Errorf(codes.InvalidArgument, "ID отзыва должен быть указан")
+	}
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return middleware.User{}, status.Errorf(codes.Unauthenticated, "Голосовать за отзывы может только авторизованный пользователь")
+	}
+	review, err := s.getPublishedReview(ctx, reviewID)
+	if err != nil {
+		return middleware.User{}, err
+	}
+	if user.Role == "student" && user.ID == review.StudentID {
+		return middleware.User{}, status.Errorf(codes.InvalidArgument, "Нельзя голосовать за собственный отзыв")
+	}
+	return user, nil
+}
+
+// reloadReview возвращает отзыв в актуальном состоянии после изменения ответа или голосов.
+func (s *ReviewService) reloadReview(ctx context.Context, id int64) (*proto.Review, error) {
+	review, err := s.reviewRepo.GetReviewByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении отзыва", zap.Error(err), zap.Int64("review_id", id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении отзыва: %v", err)
+	}
+	if review == nil {
+		return nil, status.Errorf(codes.NotFound, "Отзыв с ID %d не найден", id)
+	}
+	return reviewToProto(review), nil
+}
+
+// ReportReview сохраняет жалобу пользователя на опубликованный отзыв. Когда открытых жалоб становится
+// не меньше reportLimit, отзыв скрывается и ждёт решения модератора.
+func (s *ReviewService) ReportReview(ctx context.Context, req *proto.ReportReviewRequest) (*proto.Empty, error) {
+	s.logger.Info("Жалоба на отзыв", zap.Int64("review_id", req.ReviewId))
+
+	reason := strings.TrimSpace(req.Reason)
+	if req.ReviewId == 0 || reason == "" {
+		s.logger.Warn("Некорректные данные для жалобы", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "ID отзыва и причина жалобы должны быть указаны")
+	}
+	if len([]rune(reason)) > 500 {
+		s.logger.Warn("Слишком длинная причина жалобы", zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.InvalidArgument, "Причина жалобы не может быть длиннее 500 символов")
+	}
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Пожаловаться на отзыв может только авторизованный пользователь")
+	}
+
+	review, err := s.getPublishedReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == "student" && user.ID == review.StudentID {
+		return nil, status.Errorf(codes.InvalidArgument, "Нельзя пожаловаться на собственный отзыв")
+	}
+
+	count, err := s.reviewRepo.AddReport(ctx, &models.ReviewReport{ReviewID: req.ReviewId, ReporterID: user.ID, ReporterRole: user.Role, Reason: reason})
+	if err != nil {
+		if errors.Is(err, repository.ErrReportExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "Вы уже пожаловались на этот отзыв")
+		}
+		s.logger.Error("Ошибка при сохранении жалобы", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при сохранении жалобы: %v", err)
+	}
+
+	if s.reportLimit > 0 && count >= s.reportLimit {
+		held, err := s.reviewRepo.HoldReview(ctx, req.ReviewId, fmt.Sprintf("жалобы пользователей: %d", count))
+		if err != nil {
+			s.logger.Error("Ошибка при снятии отзыва с публикации", zap.Error(err), zap.Int64("review_id", req.ReviewId))
+			return nil, status.Errorf(codes.Internal, "Ошибка при снятии отзыва с публикации: %v", err)
+		}
+		if held {
+			s.logger.Info("Отзыв снят с публикации до решения модератора", zap.Int64("review_id", req.ReviewId), zap.Int("reports", count))
+		}
+	}
+
+	s.logger.Info("Жалоба на отзыв сохранена", zap.Int64("review_id", req.ReviewId), zap.Int("reports", count))
+	return &proto.Empty{}, nil
+}
+
+// moderateEdit определяет статус отзыва после правки автором: текст, не прошедший фильтр, задерживается,
+// одобренный отзыв с чистым текстом остаётся опубликованным, а задержанный или отклонённый снова ждёт модератора.
+func (s *ReviewService) moderateEdit(current *models.Review, comment string) (string, string) {
+	if note := s.filter.Check(comment); note != "" {
+		return models.ReviewPending, note
+	}
+	switch current.Status {
+	case models.ReviewApproved:
+		return models.ReviewApproved, ""
+	case models.ReviewRejected:
+		return models.ReviewPending, "изменён после отклонения"
+	default:
+		return models.ReviewPending, current.ModerationNote
+	}
+}
+
+// reviewerID возвращает автора отзыва — студента из токена. Другие роли отзывы не пишут.
+func reviewerID(ctx context.Context) (int64, error) {
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return 0, status.Errorf(codes.Unauthenticated, "Требуется аутентификация")
+	}
+	if user.Role != "student" {
+		return 0, status.Errorf(codes.PermissionDenied, "Отзывы к курсам оставляют только студенты")
+	}
+	return user.ID, nil
+}
+
+// checkCanReview проверяет, что студент записан на курс и прошёл достаточно лекций.
+// Курсы без лекций требованием прогресса не ограничиваются.
+func (s *ReviewService) checkCanReview(ctx context.Context, studentID, courseID int64) error {
+	enrolled, err := s.enrollmentRepo.IsEnrolled(ctx, studentID, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при проверке записи на курс", zap.Error(err), zap.Int64("student_id", studentID), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.Internal, "Ошибка при проверке записи на курс: %v", err)
+	}
+	if !enrolled {
+		s.logger.Warn("Отзыв от студента, не записанного на курс", zap.Int64("student_id", studentID), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.PermissionDenied, "Оставить отзыв могут только студенты, записанные на курс")
+	}
+	if s.minProgress <= 0 {
+		return nil
+	}
+
+	progress, err := s.lectureRepo.GetCourseProgress(ctx, studentID, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении прогресса", zap.Error(err), zap.Int64("student_id", studentID), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.Internal, "Ошибка при получении прогресса: %v", err)
+	}
+	if progress >= s.minProgress {
+		return nil
+	}
+	lectures, err := s.lectureRepo.GetLecturesByCourse(ctx, courseID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении лекций", zap.Error(err), zap.Int64("course_id", courseID))
+		return status.Errorf(codes.Internal, "Ошибка при получении лекций: %v", err)
+	}
+	if len(lectures) == 0 {
+		return nil
+	}
+
+	s.logger.Warn("Недостаточный прогресс для отзыва", zap.Int64("student_id", studentID), zap.Int64("course_id", courseID), zap.Int32("progress", progress))
+	return status.Errorf(codes.FailedPrecondition, "Чтобы оставить отзыв, пройдите не меньше %d%% лекций курса (пройдено %d%%)", s.minProgress, progress)
+}
+
+// getOwnReview возвращает отзыв и проверяет, что студент из токена — его автор.
+func (s *ReviewService) getOwnReview(ctx context.Context, id int64) (*models.Review, error) {
+	review, err := s.reviewRepo.GetReviewByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении отзыва", zap.Error(err), zap.Int64("review_id", id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении отзыва: %v", err)
+	}
+	if review == nil {
+		s.logger.Warn("Отзыв не найден", zap.Int64("review_id", id))
+		return nil, status.Errorf(codes.NotFound, "Отзыв с ID %d не найден", id)
+	}
+	studentID, err := reviewerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if studentID != review.StudentID {
+		s.logger.Warn("Попытка изменить чужой отзыв", zap.Int64("review_id", id))
+		return nil, status.Errorf(codes.PermissionDenied, "Изменять и удалять отзыв может только его автор")
+	}
+	return review, nil
+}
+
+func reviewToProto(review *models.Review) *proto.Review {
+	grpcReview := &proto.Review{
+		Id:        review.ID,
+		StudentId: review.StudentID,
+		CourseId:  review.CourseID,
+		Comment:   review.Comment,
+		Rating:    review.Rating,
+		CreatedAt: review.CreatedAt.Format("2006-01-02 15:04:05"),
+		Status:    review.Status,
+
+		HelpfulCount:    review.HelpfulCount,
+		NotHelpfulCount: review.NotHelpfulCount,
+	}
+	if review.UpdatedAt != nil {
+		grpcReview.UpdatedAt = review.UpdatedAt.Format("2006-01-02 15:04:05")
+	}
+	if review.Reply != nil {
+		grpcReview.Reply = &proto.ReviewReply{
+			InstructorId: review.Reply.InstructorID,
+			Comment:      review.Reply.Comment,
+			CreatedAt:    review.Reply.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+		if review.Reply.UpdatedAt != nil {
+			grpcReview.Reply.UpdatedAt = review.Reply.UpdatedAt.Format("2006-01-02 15:04:05")
+		}
+	}
+	return grpcReview
+}
@@ -11,71 +11,215 @@ import (
 	"testing"
 )
 
-func TestAddReviewToCourse(t *testing.T) {
-	ctx := context.Background()
-
-	_, err := db.Exec(ctx, "TRUNCATE TABLE reviews, courses, students RESTART IDENTITY CASCADE")
+// prepareReviewTables создаёт курс 1 с двумя лекциями и курс 2 без лекций. Студент 1 записан на оба курса
+// и прошёл половину лекций курса 1, студент 2 записан на курс 1 без прогресса, студент 3 никуда не записан.
+func prepareReviewTables(t *testing.T, ctx context.Context) {
+	_, err := db.Exec(ctx, "TRUNCATE TABLE review_reports, reviews, lecture_completions, lectures, enrollments, courses, students RESTART IDENTITY CASCADE")
 	require.NoError(t, err, "Не удалось очистить таблицы")
 
-	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id) VALUES ($1, $2, $3, $4)", 1, "Курс 1", "Описание курса 1", 1)
-	require.NoError(t, err, "Не удалось добавить курс")
+	_, err = db.Exec(ctx, "INSERT INTO courses (id, name, description, instructor_id) VALUES (1, 'Курс 1', 'Описание курса 1', 1), (2, 'Курс 2', 'Описание курса 2', 1)")
+	require.NoError(t, err, "Не удалось добавить курсы")
 
-	_, err = db.Exec(ctx, "INSERT INTO students (id, name, email, password) VALUES ($1, $2, $3, $4)", 1, "Студент 1", "student1@domain.com", "securepassword")
-	require.NoError(t, err, "Не удалось добавить студента")
+	_, err = db.Exec(ctx, `INSERT INTO students (id, name, email, password) VALUES
+		(1, 'Студент 1', 'student1@domain.com', 'securepassword'),
+		(2, 'Студент 2', 'student2@domain.com', 'securepassword'),
+		(3, 'Студент 3', 'student3@domain.com', 'securepassword')`)
+	require.NoError(t, err, "Не удалось добавить студентов")
+
+	_, err = db.Exec(ctx, "INSERT INTO enrollments (student_id, course_id) VALUES (1, 1), (2, 1), (1, 2)")
+	require.NoError(t, err, "Не удалось добавить записи на курсы")
+
+	_, err = db.Exec(ctx, "INSERT INTO lectures (id, course_id, title, content, position) VALUES (1, 1, 'Лекция 1', 'Содержание 1', 1), (2, 1, 'Лекция 2', 'Содержание 2', 2)")
+	require.NoError(t, err, "Не удалось добавить лекции")
+
+	_, err = db.Exec(ctx, "INSERT INTO lecture_completions (student_id, lecture_id) VALUES (1, 1)")
+	require.NoError(t, err, "Не удалось отметить лекцию пройденной")
+}
+
+func TestCreateReview(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
 
 	testCases := []struct {
-		Name         string
-		Request      *proto.ReviewRequest
-		ShouldError  bool
-		ExpectedCode codes.Code
+		Name              string
+		Ctx               context.Context
+		Request           *proto.ReviewRequest
+		ShouldError       bool
+		ExpectedCode      codes.Code
+		ExpectedStudentID int64
 	}{
 		{
 			Name: "Успешное добавление отзыва",
+			Ctx:  withToken(t, ctx, 1, "student"),
 			Request: &proto.ReviewRequest{
-				StudentId: 1,
+				StudentId: 2,
 				CourseId:  1,
 				Comment:   "Отличный курс!",
 				Rating:    5,
 			},
-			ShouldError: false,
+			ShouldError:       false,
+			ExpectedStudentID: 1,
+		},
+		{
+			Name: "Повторный отзыв к курсу",
+			Ctx:  withToken(t, ctx, 1, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 1,
+				Comment:  "Ещё один отзыв",
+				Rating:   4,
+			},
+			ShouldError:  true,
+			ExpectedCode: codes.AlreadyExists,
 		},
 		{
 			Name: "Некорректный рейтинг",
+			Ctx:  withToken(t, ctx, 1, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 2,
+				Comment:  "Отзыв с некорректным рейтингом",
+				Rating:   0,
+			},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name: "Пустой отзыв к курсу без лекций",
+			Ctx:  withToken(t, ctx, 1, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 2,
+				Comment:  "",
+				Rating:   4,
+			},
+			ShouldError:       false,
+			ExpectedStudentID: 1,
+		},
+		{
+			Name: "Недостаточный прогресс",
+			Ctx:  withToken(t, ctx, 2, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 1,
+				Comment:  "Ещё не смотрел",
+				Rating:   1,
+			},
+			ShouldError:  true,
+			ExpectedCode: codes.FailedPrecondition,
+		},
+		{
+			Name: "Студент не записан на курс",
+			Ctx:  withToken(t, ctx, 3, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 1,
+				Comment:  "Чужой курс",
+				Rating:   1,
+			},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name: "Преподаватель не может оставлять отзывы",
+			Ctx:  withToken(t, ctx, 1, "instructor"),
 			Request: &proto.ReviewRequest{
 				StudentId: 1,
 				CourseId:  1,
-				Comment:   "Отзыв с некорректным рейтингом",
-				Rating:    0,
+				Rating:    5,
 			},
 			ShouldError:  true,
-			ExpectedCode: codes.InvalidArgument,
+			ExpectedCode: codes.PermissionDenied,
 		},
 		{
-			Name: "Пустой отзыв",
+			Name: "Отзыв без авторизации",
+			Ctx:  ctx,
 			Request: &proto.ReviewRequest{
 				StudentId: 1,
 				CourseId:  1,
-				Comment:   "",
+				Comment:   "Анонимный отзыв",
 				Rating:    4,
 			},
+			ShouldError:  true,
+			ExpectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientReview.CreateReview(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				t.Logf("Полученный код ошибки: %v", st.Code())
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова метода")
+			assert.NotNil(t, resp, "Ответ должен быть непустым")
+			assert.NotZero(t, resp.Id, "ID отзыва должен быть присвоен")
+			assert.Equal(t, tc.ExpectedStudentID, resp.StudentId, "Автор отзыва должен браться из токена")
+		})
+	}
+}
+
+func TestAddReviewToCourse(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.ReviewRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name: "Успешное добавление отзыва",
+			Ctx:  withToken(t, ctx, 1, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 1,
+				Comment:  "Отличный курс!",
+				Rating:   5,
+			},
 			ShouldError: false,
 		},
 		{
-			Name: "Некорректный ID студента",
+			Name: "Повторный отзыв к курсу",
+			Ctx:  withToken(t, ctx, 1, "student"),
 			Request: &proto.ReviewRequest{
-				StudentId: 0,
-				CourseId:  1,
-				Comment:   "Некорректный студент",
-				Rating:    4,
+				CourseId: 1,
+				Comment:  "Ещё один отзыв",
+				Rating:   4,
+			},
+			ShouldError:  true,
+			ExpectedCode: codes.AlreadyExists,
+		},
+		{
+			Name: "Некорректный рейтинг",
+			Ctx:  withToken(t, ctx, 1, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 2,
+				Comment:  "Отзыв с некорректным рейтингом",
+				Rating:   0,
 			},
 			ShouldError:  true,
 			ExpectedCode: codes.InvalidArgument,
 		},
+		{
+			Name: "Студент не записан на курс",
+			Ctx:  withToken(t, ctx, 3, "student"),
+			Request: &proto.ReviewRequest{
+				CourseId: 1,
+				Comment:  "Чужой курс",
+				Rating:   1,
+			},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			resp, err := clientReview.AddReviewToCourse(ctx, tc.Request)
+			resp, err := clientReview.AddReviewToCourse(tc.Ctx, tc.Request)
 
 			if tc.ShouldError {
 				require.Error(t, err, "Ожидалась ошибка, но её не было")
@@ -92,6 +236,126 @@ func TestAddReviewToCourse(t *testing.T) {
 	}
 }
 
+func TestUpdateReview(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+
+	review, err := clientReview.CreateReview(withToken(t, ctx, 1, "student"), &proto.ReviewRequest{CourseId: 1, Comment: "Неплохо", Rating: 3})
+	require.NoError(t, err, "Не удалось добавить отзыв")
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.UpdateReviewRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Автор изменяет отзыв",
+			Ctx:     withToken(t, ctx, 1, "student"),
+			Request: &proto.UpdateReviewRequest{ReviewId: review.Id, Comment: "Понравилось после второй лекции", Rating: 5},
+		},
+		{
+			Name:         "Чужой отзыв",
+			Ctx:          withToken(t, ctx, 2, "student"),
+			Request:      &proto.UpdateReviewRequest{ReviewId: review.Id, Comment: "Взлом", Rating: 1},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Некорректный рейтинг",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.UpdateReviewRequest{ReviewId: review.Id, Rating: 6},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Отзыв не найден",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.UpdateReviewRequest{ReviewId: 99, Rating: 5},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientReview.UpdateReview(tc.Ctx, tc.Request)
+
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+
+			require.NoError(t, err, "Ошибка вызова метода")
+			assert.Equal(t, tc.Request.Comment, resp.Comment, "Текст отзыва должен обновиться")
+			assert.Equal(t, tc.Request.Rating, resp.Rating, "Оценка должна обновиться")
+			assert.NotEmpty(t, resp.UpdatedAt, "Должна сохраняться дата изменения")
+		})
+	}
+}
+
+func TestDeleteReview(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+
+	student := withToken(t, ctx, 1, "student")
+	review, err := clientReview.CreateReview(student, &proto.ReviewRequest{CourseId: 1, Comment: "Неплохо", Rating: 3})
+	require.NoError(t, err, "Не удалось добавить отзыв")
+
+	_, err = clientReview.DeleteReview(withToken(t, ctx, 2, "student"), &proto.ReviewIDRequest{ReviewId: review.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "Удалить отзыв может только автор")
+
+	_, err = clientReview.DeleteReview(student, &proto.ReviewIDRequest{ReviewId: review.Id})
+	require.NoError(t, err, "Ошибка удаления отзыва")
+
+	_, err = clientReview.DeleteReview(student, &proto.ReviewIDRequest{ReviewId: review.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err), "Удалённый отзыв не должен находиться")
+
+	_, err = clientReview.AddReviewToCourse(student, &proto.ReviewRequest{CourseId: 1, Comment: "Новый отзыв", Rating: 4})
+	require.NoError(t, err, "После удаления можно оставить новый отзыв")
+}
+
+func TestCourseRating(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+	student := withToken(t, ctx, 1, "student")
+
+	review, err := clientReview.CreateReview(student, &proto.ReviewRequest{CourseId: 1, Comment: "Неплохо", Rating: 3})
+	require.NoError(t, err, "Ошибка при добавлении отзыва к курсу 1")
+	_, err = clientReview.AddReviewToCourse(student, &proto.ReviewRequest{CourseId: 2, Comment: "Отлично", Rating: 5})
+	require.NoError(t, err, "Ошибка при добавлении отзыва к курсу 2")
+	_, err = clientReview.UpdateReview(student, &proto.UpdateReviewRequest{ReviewId: review.Id, Comment: "Хуже, чем казалось", Rating: 2})
+	require.NoError(t, err, "Ошибка при обновлении отзыва")
+
+	course, err := clientEducation.GetCourseByID(ctx, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка при получении курса")
+	assert.Equal(t, int32(1), course.Rating.Count, "Некорректное число отзывов")
+	assert.Equal(t, 2.0, course.Rating.Average, "Некорректная средняя оценка")
+	assert.Equal(t, []int32{0, 1, 0, 0, 0}, course.Rating.Distribution, "Некорректное распределение оценок")
+	assert.InDelta(t, (5*3.5+2)/6.0, course.Rating.Score, 1e-9, "Некорректная сглаженная оценка")
+
+	list, err := clientEducation.GetCourses(ctx, &proto.CoursesRequest{Sort: proto.CourseSort_COURSE_SORT_RATING})
+	require.NoError(t, err, "Ошибка при получении курсов")
+	require.Len(t, list.Courses, 2, "Некорректное количество курсов")
+	assert.Equal(t, int64(2), list.Courses[0].Id, "Курс с лучшей оценкой должен быть первым")
+
+	list, err = clientEducation.SearchCourses(ctx, &proto.SearchRequest{Keyword: "Курс", Sort: proto.CourseSort_COURSE_SORT_RATING})
+	require.NoError(t, err, "Ошибка при поиске курсов")
+	require.Len(t, list.Courses, 2, "Некорректное количество найденных курсов")
+	assert.Equal(t, int64(2), list.Courses[0].Id, "Курс с лучшей оценкой должен быть первым в поиске")
+
+	_, err = clientReview.DeleteReview(student, &proto.ReviewIDRequest{ReviewId: review.Id})
+	require.NoError(t, err, "Ошибка при удалении отзыва")
+
+	course, err = clientEducation.GetCourseByID(ctx, &proto.CourseIDRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка при получении курса")
+	assert.Equal(t, int32(0), course.Rating.Count, "После удаления отзыва у курса не должно быть оценок")
+	assert.Equal(t, []int32{0, 0, 0, 0, 0}, course.Rating.Distribution, "Распределение оценок должно обнулиться")
+}
+
 func TestGetReviewsByCourse(t *testing.T) {
 	ctx := context.Background()
 
@@ -109,14 +373,14 @@ func TestGetReviewsByCourse(t *testing.T) {
 
 	testCases := []struct {
 		Name         string
-		Request      *proto.CourseIDRequest
+		Request      *proto.ReviewsRequest
 		Expected     *proto.ReviewList
 		ShouldError  bool
 		ExpectedCode codes.Code
 	}{
 		{
 			Name: "Успешное получение отзывов",
-			Request: &proto.CourseIDRequest{
+			Request: &proto.ReviewsRequest{
 				CourseId: 1,
 			},
 			Expected: &proto.ReviewList{
@@ -134,7 +398,7 @@ func TestGetReviewsByCourse(t *testing.T) {
 		},
 		{
 			Name: "Курс не найден",
-			Request: &proto.CourseIDRequest{
+			Request: &proto.ReviewsRequest{
 				CourseId: 99,
 			},
 			Expected: &proto.ReviewList{
@@ -144,7 +408,7 @@ func TestGetReviewsByCourse(t *testing.T) {
 		},
 		{
 			Name: "Некорректный ID курса",
-			Request: &proto.CourseIDRequest{
+			Request: &proto.ReviewsRequest{
 				CourseId: 0,
 			},
 			ShouldError:  true,
@@ -179,3 +443,208 @@ func TestGetReviewsByCourse(t *testing.T) {
 		})
 	}
 }
+
+func TestGetReviewsByCourseSorting(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+
+	_, err := db.Exec(ctx, `INSERT INTO reviews (id, student_id, course_id, comment, rating, created_at, helpful_count, not_helpful_count) VALUES
+		(1, 1, 1, 'Отлично', 5, NOW() - INTERVAL '3 days', 1, 0),
+		(2, 2, 1, 'Слабо', 2, NOW() - INTERVAL '2 days', 4, 1),
+		(3, 3, 1, 'Хорошо', 4, NOW() - INTERVAL '1 day', 0, 2)`)
+	require.NoError(t, err, "Не удалось добавить отзывы")
+
+	testCases := []struct {
+		Name        string
+		Sort        proto.ReviewSort
+		ExpectedIDs []int64
+	}{
+		{Name: "По умолчанию", Sort: proto.ReviewSort_REVIEW_SORT_DEFAULT, ExpectedIDs: []int64{1, 2, 3}},
+		{Name: "Сначала полезные", Sort: proto.ReviewSort_REVIEW_SORT_HELPFUL, ExpectedIDs: []int64{2, 1, 3}},
+		{Name: "Сначала новые", Sort: proto.ReviewSort_REVIEW_SORT_NEWEST, ExpectedIDs: []int64{3, 2, 1}},
+		{Name: "Сначала с высокой оценкой", Sort: proto.ReviewSort_REVIEW_SORT_HIGHEST, ExpectedIDs: []int64{1, 3, 2}},
+		{Name: "Сначала с низкой оценкой", Sort: proto.ReviewSort_REVIEW_SORT_LOWEST, ExpectedIDs: []int64{2, 3, 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientReview.GetReviewsByCourse(ctx, &proto.ReviewsRequest{CourseId: 1, Sort: tc.Sort})
+			require.NoError(t, err, "Ошибка при получении отзывов")
+
+			var ids []int64
+			for _, review := range resp.Reviews {
+				ids = append(ids, review.Id)
+			}
+			assert.Equal(t, tc.ExpectedIDs, ids, "Некорректный порядок отзывов")
+		})
+	}
+}
+
+func TestReplyToReview(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+	owner := withToken(t, ctx, 1, "instructor")
+
+	_, err := db.Exec(ctx, "INSERT INTO reviews (id, student_id, course_id, comment, rating) VALUES (1, 1, 1, 'Слишком сложно', 2)")
+	require.NoError(t, err, "Не удалось добавить отзыв")
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.ReviewReplyRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Успешный ответ преподавателя курса",
+			Ctx:     owner,
+			Request: &proto.ReviewReplyRequest{ReviewId: 1, Comment: "Добавили разбор задач"},
+		},
+		{
+			Name:         "Ответ преподавателя чужого курса",
+			Ctx:          withToken(t, ctx, 2, "instructor"),
+			Request:      &proto.ReviewReplyRequest{ReviewId: 1, Comment: "Не согласен"},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Ответ студента",
+			Ctx:          withToken(t, ctx, 2, "student"),
+			Request:      &proto.ReviewReplyRequest{ReviewId: 1, Comment: "Согласен"},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Пустой ответ",
+			Ctx:          owner,
+			Request:      &proto.ReviewReplyRequest{ReviewId: 1, Comment: " "},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Отзыв не найден",
+			Ctx:          owner,
+			Request:      &proto.ReviewReplyRequest{ReviewId: 999, Comment: "Спасибо"},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientReview.ReplyToReview(tc.Ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+			require.NoError(t, err, "Ошибка при ответе на отзыв")
+			require.NotNil(t, resp.Reply, "Ответ должен вернуться вместе с отзывом")
+			assert.Equal(t, tc.Request.Comment, resp.Reply.Comment, "Текст ответа не совпадает")
+			assert.Equal(t, int64(1), resp.Reply.InstructorId, "Некорректный автор ответа")
+		})
+	}
+
+	resp, err := clientReview.ReplyToReview(owner, &proto.ReviewReplyRequest{ReviewId: 1, Comment: "Добавили разбор задач и примеры"})
+	require.NoError(t, err, "Ошибка при изменении ответа")
+	assert.Equal(t, "Добавили разбор задач и примеры", resp.Reply.Comment, "Ответ должен замениться")
+	assert.NotEmpty(t, resp.Reply.UpdatedAt, "Дата изменения ответа должна заполниться")
+
+	reviews, err := clientReview.GetReviewsByCourse(ctx, &proto.ReviewsRequest{CourseId: 1})
+	require.NoError(t, err, "Ошибка при получении отзывов")
+	require.Len(t, reviews.Reviews, 1, "Некорректное количество отзывов")
+	require.NotNil(t, reviews.Reviews[0].Reply, "Ответ должен выводиться вместе с отзывом")
+
+	resp, err = clientReview.DeleteReviewReply(owner, &proto.ReviewIDRequest{ReviewId: 1})
+	require.NoError(t, err, "Ошибка при удалении ответа")
+	assert.Nil(t, resp.Reply, "Ответ должен быть удалён")
+
+	_, err = clientReview.DeleteReviewReply(owner, &proto.ReviewIDRequest{ReviewId: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err), "Повторное удаление ответа должно возвращать NotFound")
+}
+
+func TestVoteReview(t *testing.T) {
+	ctx := context.Background()
+	prepareReviewTables(t, ctx)
+	voter := withToken(t, ctx, 2, "student")
+
+	_, err := db.Exec(ctx, "INSERT INTO reviews (id, student_id, course_id, comment, rating) VALUES (1, 1, 1, 'Хороший курс', 4)")
+	require.NoError(t, err, "Не удалось добавить отзыв")
+
+	testCases := []struct {
+		Name               string
+		Ctx                context.Context
+		Request            *proto.ReviewVoteRequest
+		ExpectedHelpful    int32
+		ExpectedNotHelpful int32
+		ShouldError        bool
+		ExpectedCode       codes.Code
+	}{
+		{
+			Name:            "Голос «полезно»",
+			Ctx:             voter,
+			Request:         &proto.ReviewVoteRequest{ReviewId: 1, Helpful: true},
+			ExpectedHelpful: 1,
+		},
+		{
+			Name:               "Повторный голос заменяет предыдущий",
+			Ctx:                voter,
+			Request:            &proto.ReviewVoteRequest{ReviewId: 1, Helpful: false},
+			ExpectedNotHelpful: 1,
+		},
+		{
+			Name:               "Голос преподавателя",
+			Ctx:                withToken(t, ctx, 1, "instructor"),
+			Request:            &proto.ReviewVoteRequest{ReviewId: 1, Helpful: true},
+			ExpectedHelpful:    1,
+			ExpectedNotHelpful: 1,
+		},
+		{
+			Name:         "Голос за собственный отзыв",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.ReviewVoteRequest{ReviewId: 1, Helpful: true},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Голос без авторизации",
+			Ctx:          ctx,
+			Request:      &proto.ReviewVoteRequest{ReviewId: 1, Helpful: true},
+			ShouldError:  true,
+			ExpectedCode: codes.Unauthenticated,
+		},
+		{
+			Name:         "Отзыв не найден",
+			Ctx:          voter,
+			Request:      &proto.ReviewVoteRequest{ReviewId: 999, Helpful: true},
+			ShouldError:  true,
+			ExpectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientReview.VoteReview(tc.Ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				return
+			}
+			require.NoError(t, err, "Ошибка при голосовании")
+			assert.Equal(t, tc.ExpectedHelpful, resp.HelpfulCount, "Некорректное число голосов «полезно»")
+			assert.Equal(t, tc.ExpectedNotHelpful, resp.NotHelpfulCount, "Некорректное число голосов «бесполезно»")
+		})
+	}
+
+	resp, err := clientReview.DeleteReviewVote(voter, &proto.ReviewIDRequest{ReviewId: 1})
+	require.NoError(t, err, "Ошибка при отзыве голоса")
+	assert.Equal(t, int32(1), resp.HelpfulCount, "Некорректное число голосов «полезно»")
+	assert.Equal(t, int32(0), resp.NotHelpfulCount, "Голос «бесполезно» должен быть отозван")
+
+	_, err = clientReview.DeleteReviewVote(voter, &proto.ReviewIDRequest{ReviewId: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err), "Повторный отзыв голоса должен возвращать NotFound")
+}
@@ -7,6 +7,7 @@ import (
 	"GoEdu/internal/repository"
 	"GoEdu/proto"
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
@@ -167,3 +168,46 @@ func (s *StudentService) UpdateStudentProfile(ctx context.Context, req *proto.Up
 		Token: "",
 	}, nil
 }
+
+func (s *StudentService) AcceptInvitation(ctx context.Context, req *proto.AcceptInvitationRequest) (*proto.AuthResponse, error) {
+	s.logger.Info("Принятие приглашения студентом")
+
+	if req.Token == "" {
+		s.logger.Warn("Токен приглашения не указан")
+		return nil, status.Errorf(codes.InvalidArgument, "Токен приглашения должен быть указан")
+	}
+	if len(req.Password) < 6 {
+		s.logger.Warn("Слишком короткий пароль при принятии приглашения")
+		return nil, status.Errorf(codes.InvalidArgument, "Пароль должен содержать не менее 6 символов")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		s.logger.Error("Ошибка хэширования пароля", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Не удалось хэшировать пароль")
+	}
+
+	student, err := s.studentRepo.AcceptInvitation(ctx, hashInvitationToken(req.Token), string(hashedPassword))
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			s.logger.Warn("Приглашение не найдено или истекло")
+			return nil, status.Errorf(codes.NotFound, "Приглашение не найдено или истекло")
+		}
+		s.logger.Error("Ошибка при принятии приглашения", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при принятии приглашения: %v", err)
+	}
+
+	token, err := middleware.GenerateJWTToken(student.ID, student.Email, "student", []byte(s.cfg.JWTSecretKey), s.cfg.TokenExpiryHours, s.logger)
+	if err != nil {
+		s.logger.Error("Ошибка генерации JWT токена", zap.Error(err), zap.Int64("student_id", student.ID))
+		return nil, status.Errorf(codes.Internal, "Не удалось создать JWT токен")
+	}
+
+	s.logger.Info("Приглашение принято", zap.Int64("student_id", student.ID))
+	return &proto.AuthResponse{
+		Id:    student.ID,
+		Name:  student.Name,
+		Email: student.Email,
+		Token: token,
+	}, nil
+}
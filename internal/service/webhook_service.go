@@ -0,0 +1,309 @@
+package service
+
+import (
+	"GoEdu/internal/middleware"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/internal/webhook"
+	"GoEdu/proto"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultWebhookDeliveries = 50
+	maxWebhookDeliveries     = 500
+)
+
+type WebhookService struct {
+	proto.UnimplementedWebhookServiceServer
+	webhookRepo  repository.WebhookRepository
+	allowPrivate bool
+	logger       *zap.Logger
+}
+
+// NewWebhookService создаёт сервис подписок; allowPrivate разрешает адреса во внутренней сети (для разработки).
+func NewWebhookService(webhookRepo repository.WebhookRepository, allowPrivate bool, logger *zap.Logger) *WebhookService {
+	return &WebhookService{
+		webhookRepo:  webhookRepo,
+		allowPrivate: allowPrivate,
+		logger:       logger,
+	}
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *proto.NewWebhookRequest) (*proto.Webhook, error) {
+	owner, err := webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Создание подписки на вебхуки", zap.String("owner_role", owner.Role), zap.Int64("owner_id", owner.ID), zap.String("url", req.Url))
+
+	if err := s.validateWebhook(ctx, req.Url, req.EventTypes); err != nil {
+		s.logger.Warn("Некорректная подписка на вебхуки", zap.Error(err))
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			s.logger.Error("Не удалось сгенерировать секрет вебхука", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "Не удалось сгенерировать секрет: %v", err)
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	webhook := &models.WebhookSubscription{
+		OwnerRole:  owner.Role,
+		OwnerID:    owner.ID,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     secret,
+	}
+	if err := s.webhookRepo.CreateWebhook(ctx, webhook); err != nil {
+		s.logger.Error("Ошибка при создании подписки на вебхуки", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при создании подписки: %v", err)
+	}
+
+	s.logger.Info("Подписка на вебхуки успешно создана", zap.Int64("webhook_id", webhook.ID))
+	resp := webhookToProto(webhook)
+	resp.Secret = secret
+	return resp, nil
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *proto.UpdateWebhookRequest) (*proto.Webhook, error) {
+	s.logger.Info("Обновление подписки на вебхуки", zap.Int64("webhook_id", req.Id))
+
+	if _, err := s.getOwnedWebhook(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if err := s.validateWebhook(ctx, req.Url, req.EventTypes); err != nil {
+		s.logger.Warn("Некорректная подписка на вебхуки", zap.Error(err))
+		return nil, err
+	}
+
+	webhook, err := s.webhookRepo.UpdateWebhook(ctx, req.Id, req.Url, req.EventTypes, req.Active)
+	if err != nil {
+		s.logger.Error("Ошибка при обновлении подписки на вебхуки", zap.Error(err), zap.Int64("webhook_id", req.Id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при обновлении подписки: %v", err)
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "Подписка с ID %d не найдена", req.Id)
+	}
+
+	s.logger.Info("Подписка на вебхуки успешно обновлена", zap.Int64("webhook_id", req.Id), zap.Bool("active", webhook.Active))
+	return webhookToProto(webhook), nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *proto.WebhookIDRequest) (*proto.Empty, error) {
+	s.logger.Info("Удаление подписки на вебхуки", zap.Int64("webhook_id", req.WebhookId))
+
+	if _, err := s.getOwnedWebhook(ctx, req.WebhookId); err != nil {
+		return nil, err
+	}
+	deleted, err := s.webhookRepo.DeleteWebhook(ctx, req.WebhookId)
+	if err != nil {
+		s.logger.Error("Ошибка при удалении подписки на вебхуки", zap.Error(err), zap.Int64("webhook_id", req.WebhookId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при удалении подписки: %v", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "Подписка с ID %d не найдена", req.WebhookId)
+	}
+
+	s.logger.Info("Подписка на вебхуки успешно удалена", zap.Int64("webhook_id", req.WebhookId))
+	return &proto.Empty{}, nil
+}
+
+func (s *WebhookService) GetWebhook(ctx context.Context, req *proto.WebhookIDRequest) (*proto.Webhook, error) {
+	s.logger.Info("Получение подписки на вебхуки", zap.Int64("webhook_id", req.WebhookId))
+
+	webhook, err := s.getOwnedWebhook(ctx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	return webhookToProto(webhook), nil
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *proto.Empty) (*proto.WebhookList, error) {
+	owner, err := webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Получение подписок на вебхуки", zap.String("owner_role", owner.Role), zap.Int64("owner_id", owner.ID))
+
+	webhooks, err := s.webhookRepo.GetWebhooksByOwner(ctx, owner.Role, owner.ID)
+	if err != nil {
+		s.logger.Error("Ошибка при получении подписок на вебхуки", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении подписок: %v", err)
+	}
+
+	resp := &proto.WebhookList{}
+	for _, webhook := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToProto(webhook))
+	}
+	return resp, nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *proto.WebhookDeliveriesRequest) (*proto.WebhookDeliveryList, error) {
+	s.logger.Info("Получение журнала доставок вебхуков", zap.Int64("webhook_id", req.WebhookId))
+
+	if _, err := s.getOwnedWebhook(ctx, req.WebhookId); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultWebhookDeliveries
+	}
+	limit = min(limit, maxWebhookDeliveries)
+
+	deliveries, err := s.webhookRepo.GetDeliveries(ctx, req.WebhookId, limit)
+	if err != nil {
+		s.logger.Error("Ошибка при получении журнала доставок", zap.Error(err), zap.Int64("webhook_id", req.WebhookId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении журнала доставок: %v", err)
+	}
+
+	resp := &proto.WebhookDeliveryList{}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, webhookDeliveryToProto(delivery))
+	}
+	return resp, nil
+}
+
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, req *proto.WebhookDeliveryIDRequest) (*proto.WebhookDelivery, error) {
+	s.logger.Info("Повторная отправка вебхука", zap.Int64("delivery_id", req.DeliveryId))
+
+	delivery, err := s.webhookRepo.GetDeliveryByID(ctx, req.DeliveryId)
+	if err != nil {
+		s.logger.Error("Ошибка при получении доставки", zap.Error(err), zap.Int64("delivery_id", req.DeliveryId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении доставки: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "Доставка с ID %d не найдена", req.DeliveryId)
+	}
+
+	webhook, err := s.getOwnedWebhook(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !webhook.Active {
+		s.logger.Warn("Повторная отправка по выключенной подписке", zap.Int64("webhook_id", webhook.ID))
+		return nil, status.Errorf(codes.FailedPrecondition, "Подписка выключена; включите её перед повторной отправкой")
+	}
+
+	redelivery, err := s.webhookRepo.Redeliver(ctx, req.DeliveryId)
+	if err != nil {
+		s.logger.Error("Ошибка при повторной отправке вебхука", zap.Error(err), zap.Int64("delivery_id", req.DeliveryId))
+		return nil, status.Errorf(codes.Internal, "Ошибка при повторной отправке: %v", err)
+	}
+	if redelivery == nil {
+		return nil, status.Errorf(codes.NotFound, "Доставка с ID %d не найдена", req.DeliveryId)
+	}
+
+	s.logger.Info("Вебхук поставлен в очередь повторно", zap.Int64("delivery_id", redelivery.ID), zap.Int64("redelivery_of", req.DeliveryId))
+	return webhookDeliveryToProto(redelivery), nil
+}
+
+// webhookOwner возвращает пользователя, которому принадлежат подписки: вебхуки доступны только
+// преподавателям и администраторам.
+func webhookOwner(ctx context.Context) (middleware.User, error) {
+	user, ok := middleware.UserFromContext(ctx)
+	if !ok {
+		return middleware.User{}, status.Errorf(codes.Unauthenticated, "Требуется аутентификация")
+	}
+	if user.Role != models.WebhookOwnerInstructor && user.Role != models.WebhookOwnerAdmin {
+		return middleware.User{}, status.Errorf(codes.PermissionDenied, "Вебхуки доступны только преподавателям и администраторам")
+	}
+	return user, nil
+}
+
+// getOwnedWebhook возвращает подписку, если она принадлежит пользователю из токена.
+func (s *WebhookService) getOwnedWebhook(ctx context.Context, id int64) (*models.WebhookSubscription, error) {
+	owner, err := webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if id == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID подписки должен быть указан")
+	}
+
+	webhook, err := s.webhookRepo.GetWebhookByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Ошибка при получении подписки на вебхуки", zap.Error(err), zap.Int64("webhook_id", id))
+		return nil, status.Errorf(codes.Internal, "Ошибка при получении подписки: %v", err)
+	}
+	if webhook == nil {
+		s.logger.Warn("Подписка на вебхуки не найдена", zap.Int64("webhook_id", id))
+		return nil, status.Errorf(codes.NotFound, "Подписка с ID %d не найдена", id)
+	}
+	if webhook.OwnerRole != owner.Role || webhook.OwnerID != owner.ID {
+		s.logger.Warn("Попытка доступа к чужой подписке на вебхуки", zap.Int64("webhook_id", id), zap.Int64("user_id", owner.ID))
+		return nil, status.Errorf(codes.PermissionDenied, "Подписка принадлежит другому пользователю")
+	}
+	return webhook, nil
+}
+
+// validateWebhook проверяет, что адрес абсолютный с протоколом http или https и не ведёт во внутреннюю сеть,
+// а типы событий известны и не повторяются.
+func (s *WebhookService) validateWebhook(ctx context.Context, rawURL string, eventTypes []string) error {
+	if err := webhook.CheckURL(ctx, rawURL, s.allowPrivate); err != nil {
+		s.logger.Warn("Недопустимый адрес вебхука", zap.Error(err), zap.String("url", rawURL))
+		return status.Errorf(codes.InvalidArgument, "Недопустимый адрес вебхука: %v", err)
+	}
+	if len(eventTypes) == 0 {
+		return status.Errorf(codes.InvalidArgument, "Укажите хотя бы один тип событий")
+	}
+	for i, eventType := range eventTypes {
+		if !slices.Contains(models.EventTypes, eventType) {
+			return status.Errorf(codes.InvalidArgument, "Неизвестный тип события %q; допустимые: %s", eventType, strings.Join(models.EventTypes, ", "))
+		}
+		if slices.Contains(eventTypes[:i], eventType) {
+			return status.Errorf(codes.InvalidArgument, "Тип события %s указан дважды", eventType)
+		}
+	}
+	return nil
+}
+
+func webhookToProto(webhook *models.WebhookSubscription) *proto.Webhook {
+	return &proto.Webhook{
+		Id:                  webhook.ID,
+		OwnerRole:           webhook.OwnerRole,
+		OwnerId:             webhook.OwnerID,
+		Url:                 webhook.URL,
+		EventTypes:          webhook.EventTypes,
+		Active:              webhook.Active,
+		DisabledReason:      webhook.DisabledReason,
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		CreatedAt:           webhook.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func webhookDeliveryToProto(delivery *models.WebhookDelivery) *proto.WebhookDelivery {
+	resp := &proto.WebhookDelivery{
+		Id:           delivery.ID,
+		WebhookId:    delivery.SubscriptionID,
+		EventId:      delivery.EventID,
+		EventType:    delivery.EventType,
+		Status:       delivery.Status,
+		Attempts:     delivery.Attempts,
+		ResponseCode: delivery.ResponseCode,
+		Error:        delivery.LastError,
+		CreatedAt:    delivery.CreatedAt.Format(time.RFC3339),
+		DeliveredAt:  formatOptionalTime(delivery.DeliveredAt),
+	}
+	if delivery.Status == models.WebhookDeliveryPending {
+		resp.NextAttemptAt = delivery.NextAttemptAt.Format(time.RFC3339)
+	}
+	if delivery.RedeliveryOf != nil {
+		resp.RedeliveryOf = *delivery.RedeliveryOf
+	}
+	return resp
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"GoEdu/internal/events"
+	"GoEdu/internal/jobs"
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"GoEdu/internal/webhook"
+	"GoEdu/proto"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// webhookReceiver — локальный получатель вебхуков, проверяющий подпись и отвечающий кодом statusCode.
+type webhookReceiver struct {
+	mu         sync.Mutex
+	secret     string
+	statusCode int
+	received   []webhook.Envelope
+	headers    []http.Header
+	badSigns   int
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	timestamp, _ := strconv.ParseInt(req.Header.Get(webhook.HeaderTimestamp), 10, 64)
+	if req.Header.Get(webhook.HeaderSignature) != webhook.Sign(r.secret, timestamp, body) {
+		r.badSigns++
+	}
+	var envelope webhook.Envelope
+	_ = json.Unmarshal(body, &envelope)
+	r.received = append(r.received, envelope)
+	r.headers = append(r.headers, req.Header.Clone())
+
+	w.WriteHeader(r.statusCode)
+	_, _ = w.Write([]byte("принято"))
+}
+
+func (r *webhookReceiver) setStatus(code int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statusCode = code
+}
+
+func prepareWebhookTables(t *testing.T, ctx context.Context) {
+	prepareTables(t, ctx, testData{
+		Tables:      []string{"webhook_subscriptions", "outbox_events"},
+		Instructors: 2,
+		Students:    2,
+		Courses:     []int64{1},
+	})
+}
+
+func TestCreateWebhook(t *testing.T) {
+	ctx := context.Background()
+	prepareWebhookTables(t, ctx)
+
+	testCases := []struct {
+		Name         string
+		Ctx          context.Context
+		Request      *proto.NewWebhookRequest
+		ShouldError  bool
+		ExpectedCode codes.Code
+	}{
+		{
+			Name:    "Подписка преподавателя",
+			Ctx:     withToken(t, ctx, 1, "instructor"),
+			Request: &proto.NewWebhookRequest{Url: "https://hr.example.com/goedu", EventTypes: []string{models.EventCourseCompleted}},
+		},
+		{
+			Name:    "Подписка администратора",
+			Ctx:     withToken(t, ctx, 1, "admin"),
+			Request: &proto.NewWebhookRequest{Url: "http://hr.example.com/goedu", EventTypes: []string{models.EventStudentEnrolled}, Secret: "свой секрет"},
+		},
+		{
+			Name:         "Студенту вебхуки недоступны",
+			Ctx:          withToken(t, ctx, 1, "student"),
+			Request:      &proto.NewWebhookRequest{Url: "https://hr.example.com/goedu", EventTypes: []string{models.EventCourseCompleted}},
+			ShouldError:  true,
+			ExpectedCode: codes.PermissionDenied,
+		},
+		{
+			Name:         "Относительный адрес",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewWebhookRequest{Url: "/goedu", EventTypes: []string{models.EventCourseCompleted}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Неподдерживаемый протокол",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewWebhookRequest{Url: "ftp://hr.example.com/goedu", EventTypes: []string{models.EventCourseCompleted}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Неизвестный тип события",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewWebhookRequest{Url: "https://hr.example.com/goedu", EventTypes: []string{"CourseFinished"}},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+		{
+			Name:         "Без типов событий",
+			Ctx:          withToken(t, ctx, 1, "instructor"),
+			Request:      &proto.NewWebhookRequest{Url: "https://hr.example.com/goedu"},
+			ShouldError:  true,
+			ExpectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			resp, err := clientWebhook.CreateWebhook(tc.Ctx, tc.Request)
+			if tc.ShouldError {
+				require.Error(t, err, "Ожидалась ошибка, но её не было")
+				st, ok := status.FromError(err)
+				require.True(t, ok, "Ошибка не является статусной")
+				assert.Equal(t, tc.ExpectedCode, st.Code(), "Некорректный код ошибки")
+				t.Logf("Полученный код ошибки: %v", st.Code())
+				return
+			}
+
+			require.NoError(t, err, "Ошибка при создании подписки")
+			assert.True(t, resp.Active, "Новая подписка должна быть включена")
+			assert.NotEmpty(t, resp.Secret, "Секрет должен возвращаться при создании")
+			if tc.Request.Secret != "" {
+				assert.Equal(t, tc.Request.Secret, resp.Secret, "Заданный секрет не должен меняться")
+			}
+
+			got, err := clientWebhook.GetWebhook(tc.Ctx, &proto.WebhookIDRequest{WebhookId: resp.Id})
+			require.NoError(t, err, "Ошибка при получении подписки")
+			assert.Empty(t, got.Secret, "Секрет не должен возвращаться повторно")
+		})
+	}
+
+	t.Run("Чужая подписка недоступна", func(t *testing.T) {
+		_, err := clientWebhook.GetWebhook(withToken(t, ctx, 2, "instructor"), &proto.WebhookIDRequest{WebhookId: 1})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+
+		// Администратор с тем же ID, что и преподаватель, — другой владелец.
+		_, err = clientWebhook.GetWebhook(withToken(t, ctx, 1, "admin"), &proto.WebhookIDRequest{WebhookId: 1})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "Некорректный код ошибки")
+	})
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	prepareWebhookTables(t, ctx)
+
+	receiver := &webhookReceiver{secret: "секрет отдела кадров", statusCode: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	instructorCtx := withToken(t, ctx, 1, "instructor")
+	hook, err := clientWebhook.CreateWebhook(instructorCtx, &proto.NewWebhookRequest{
+		Url:        server.URL,
+		EventTypes: []string{models.EventStudentEnrolled},
+		Secret:     receiver.secret,
+	})
+	require.NoError(t, err, "Ошибка при создании подписки")
+
+	// Подписка преподавателя другого курса не должна получать события курса 1.
+	_, err = clientWebhook.CreateWebhook(withToken(t, ctx, 2, "instructor"), &proto.NewWebhookRequest{
+		Url:        server.URL,
+		EventTypes: []string{models.EventStudentEnrolled},
+	})
+	require.NoError(t, err, "Ошибка при создании подписки")
+
+	webhookRepo := repository.NewWebhookRepository(db)
+	bus := events.NewBus()
+	bus.Subscribe(webhook.NewSink(webhookRepo))
+	relay := jobs.NewOutboxRelay(repository.NewOutboxRepository(db), bus, 100, time.Hour, time.Minute, zapLogger)
+	dispatcher := jobs.NewWebhookDispatcher(webhookRepo, webhook.NewClient(5*time.Second, true), 3, 2, time.Minute, zapLogger)
+
+	t.Run("Подписанная доставка события", func(t *testing.T) {
+		_, err := clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: 1, CourseId: 1})
+		require.NoError(t, err, "Ошибка записи на курс")
+
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+		// Повторная публикация того же события (доставка как минимум один раз) не создаёт второй доставки.
+		_, err = db.Exec(ctx, "UPDATE outbox_events SET published_at = NULL, next_attempt_at = NOW()")
+		require.NoError(t, err, "Ошибка подготовки повторной публикации")
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка повторной публикации событий")
+
+		delivered, err := dispatcher.DispatchPending(ctx)
+		require.NoError(t, err, "Ошибка отправки вебхуков")
+		assert.Equal(t, 1, delivered, "Событие должно быть доставлено один раз и только владельцу курса")
+
+		require.Len(t, receiver.received, 1, "Получатель должен получить одно событие")
+		assert.Zero(t, receiver.badSigns, "Подпись запроса не совпадает")
+		assert.Equal(t, models.EventStudentEnrolled, receiver.received[0].Type, "Некорректный тип события")
+		assert.Equal(t, receiver.received[0].ID, receiver.headers[0].Get(webhook.HeaderEventID), "Ключ идемпотентности не совпадает с ID события")
+
+		var payload models.StudentEnrollmentPayload
+		require.NoError(t, json.Unmarshal(receiver.received[0].Data, &payload), "Некорректные данные события")
+		assert.Equal(t, int64(1), payload.StudentID, "Некорректный студент")
+		assert.Equal(t, int64(1), payload.CourseID, "Некорректный курс")
+
+		log, err := clientWebhook.ListWebhookDeliveries(instructorCtx, &proto.WebhookDeliveriesRequest{WebhookId: hook.Id})
+		require.NoError(t, err, "Ошибка при получении журнала доставок")
+		require.Len(t, log.Deliveries, 1, "В журнале должна быть одна доставка")
+		assert.Equal(t, models.WebhookDeliverySucceeded, log.Deliveries[0].Status, "Некорректный статус доставки")
+		assert.Equal(t, int32(http.StatusOK), log.Deliveries[0].ResponseCode, "Некорректный код ответа")
+	})
+
+	t.Run("Повторы с задержкой и выключение подписки", func(t *testing.T) {
+		receiver.setStatus(http.StatusInternalServerError)
+		_, err := clientEnrollments.EnrollStudent(ctx, &proto.EnrollmentRequest{StudentId: 2, CourseId: 1})
+		require.NoError(t, err, "Ошибка записи на курс")
+		_, err = relay.RelayPending(ctx)
+		require.NoError(t, err, "Ошибка публикации событий")
+
+		delivered, err := dispatcher.DispatchPending(ctx)
+		require.NoError(t, err, "Ошибка отправки вебхуков")
+		assert.Zero(t, delivered, "Доставка не должна считаться успешной")
+
+		log, err := clientWebhook.ListWebhookDeliveries(instructorCtx, &proto.WebhookDeliveriesRequest{WebhookId: hook.Id})
+		require.NoError(t, err, "Ошибка при получении журнала доставок")
+		failed := log.Deliveries[0]
+		assert.Equal(t, models.WebhookDeliveryPending, failed.Status, "Доставка должна ожидать повтора")
+		assert.Equal(t, int32(http.StatusInternalServerError), failed.ResponseCode, "Код ответа должен сохраняться в журнале")
+		assert.NotEmpty(t, failed.NextAttemptAt, "Должно быть назначено время повтора")
+
+		delivered, err = dispatcher.DispatchPending(ctx)
+		require.NoError(t, err, "Ошибка отправки вебхуков")
+		assert.Zero(t, delivered, "Повтор не должен выполняться раньше времени")
+
+		_, err = db.Exec(ctx, "UPDATE webhook_deliveries SET next_attempt_at = NOW() WHERE status = 'pending'")
+		require.NoError(t, err, "Ошибка подготовки повтора")
+		_, err = dispatcher.DispatchPending(ctx)
+		require.NoError(t, err, "Ошибка отправки вебхуков")
+
+		got, err := clientWebhook.GetWebhook(instructorCtx, &proto.WebhookIDRequest{WebhookId: hook.Id})
+		require.NoError(t, err, "Ошибка при получении подписки")
+		assert.False(t, got.Active, "Подписка должна выключиться после двух неудач подряд")
+		assert.NotEmpty(t, got.DisabledReason, "Должна быть указана причина выключения")
+
+		_, err = clientWebhook.RedeliverWebhook(instructorCtx, &proto.WebhookDeliveryIDRequest{DeliveryId: failed.Id})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Нельзя повторять доставку по выключенной подписке")
+	})
+
+	t.Run("Ручной повтор после включения подписки", func(t *testing.T) {
+		receiver.setStatus(http.StatusOK)
+		active := true
+		got, err := clientWebhook.UpdateWebhook(instructorCtx, &proto.UpdateWebhookRequest{
+			Id: hook.Id, Url: server.URL, EventTypes: []string{models.EventStudentEnrolled}, Active: &active,
+		})
+		require.NoError(t, err, "Ошибка при включении подписки")
+		assert.True(t, got.Active, "Подписка должна быть включена")
+		assert.Zero(t, got.ConsecutiveFailures, "Включение должно сбрасывать счётчик неудач")
+
+		original, err := clientWebhook.ListWebhookDeliveries(instructorCtx, &proto.WebhookDeliveriesRequest{WebhookId: hook.Id, Limit: 1})
+		require.NoError(t, err, "Ошибка при получении журнала доставок")
+		source := original.Deliveries[0]
+
+		redelivery, err := clientWebhook.RedeliverWebhook(instructorCtx, &proto.WebhookDeliveryIDRequest{DeliveryId: source.Id})
+		require.NoError(t, err, "Ошибка при повторной отправке")
+		assert.Equal(t, source.Id, redelivery.RedeliveryOf, "Повтор должен ссылаться на исходную доставку")
+		assert.Equal(t, source.EventId, redelivery.EventId, "Повтор должен сохранять ключ идемпотентности")
+
+		_, err = clientWebhook.RedeliverWebhook(withToken(t, ctx, 2, "instructor"), &proto.WebhookDeliveryIDRequest{DeliveryId: source.Id})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "Чужую доставку повторять нельзя")
+
+		// Исходная доставка исчерпала не все попытки и тоже отправляется после включения подписки.
+		_, err = db.Exec(ctx, "UPDATE webhook_deliveries SET next_attempt_at = NOW() WHERE status = 'pending'")
+		require.NoError(t, err, "Ошибка подготовки повтора")
+		delivered, err := dispatcher.DispatchPending(ctx)
+		require.NoError(t, err, "Ошибка отправки вебхуков")
+		assert.Equal(t, 2, delivered, "Должны быть доставлены исходная доставка и ручной повтор")
+
+		last := receiver.received[len(receiver.received)-1]
+		assert.Equal(t, source.EventId, last.ID, "Получатель должен получить то же событие")
+		assert.Zero(t, receiver.badSigns, "Подпись запроса не совпадает")
+	})
+
+	t.Run("Вебхуки во внутреннюю сеть запрещены", func(t *testing.T) {
+		for _, rawURL := range []string{"http://169.254.169.254/latest/meta-data", "http://127.0.0.1:8080/hook", "http://10.0.0.5/hook", "http://[::1]/hook", "http://0.0.0.0/hook"} {
+			err := webhook.CheckURL(ctx, rawURL, false)
+			assert.ErrorIs(t, err, webhook.ErrForbiddenAddress, "Адрес %s должен быть запрещён", rawURL)
+		}
+
+		// Подключение проверяется и при отправке, поэтому подписка на адрес, который позже стал внутренним, не срабатывает.
+		received := len(receiver.received)
+		result := webhook.NewClient(5*time.Second, false).Send(ctx, &models.WebhookDelivery{ID: 1, URL: server.URL, EventID: "event", EventType: models.EventStudentEnrolled, Payload: json.RawMessage(`{}`)})
+		assert.ErrorIs(t, result.Err, webhook.ErrForbiddenAddress, "Запрос на локальный адрес должен быть отклонён")
+		assert.Len(t, receiver.received, received, "Локальный получатель не должен получить запрос")
+	})
+}
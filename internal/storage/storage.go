@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"GoEdu/internal/config"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// ErrNotFound возвращается, если объекта с указанным ключом нет в хранилище.
+var ErrNotFound = errors.New("файл не найден в хранилище")
+
+// BlobStore хранит загруженные файлы по ключу вида "assignments/1/2/abc". Реализация может быть
+// заменена на объектное хранилище без изменений в сервисах.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewBlobStore возвращает хранилище файлов из конфигурации. Сейчас поддерживается только локальный диск.
+func NewBlobStore(cfg *config.Config, logger *zap.Logger) (BlobStore, error) {
+	logger.Info("Файлы хранятся на локальном диске", zap.String("dir", cfg.StorageDir))
+	return NewLocalStore(cfg.StorageDir)
+}
+
+type localStore struct {
+	root string
+}
+
+// NewLocalStore создаёт хранилище в каталоге root, создавая каталог при необходимости.
+func NewLocalStore(root string) (BlobStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("не удалось создать каталог хранилища %s: %w", root, err)
+	}
+	return &localStore{root: root}, nil
+}
+
+// Put записывает файл во временный файл и переименовывает его, чтобы читатели не видели недописанных данных.
+func (s *localStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	size, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+// Delete удаляет файл; отсутствие файла ошибкой не считается.
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path переводит ключ в путь внутри каталога хранилища и не допускает выхода за его пределы.
+func (s *localStore) path(key string) (string, error) {
+	if key == "" || strings.Contains(key, "\\") || !filepath.IsLocal(key) {
+		return "", fmt.Errorf("некорректный ключ файла %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,141 @@
+package textsim
+
+import (
+	"GoEdu/internal/models"
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// nameWeight — во сколько раз слово из названия курса весомее слова из описания или названия лекции.
+const nameWeight = 2
+
+var stopWords = map[string]bool{
+	// Русские.
+	"и": true, "в": true, "во": true, "не": true, "на": true, "с": true, "со": true, "по": true, "к": true,
+	"ко": true, "за": true, "из": true, "от": true, "до": true, "для": true, "о": true, "об": true, "при": true,
+	"как": true, "что": true, "это": true, "то": true, "а": true, "но": true, "или": true, "же": true, "ли": true,
+	"бы": true, "у": true, "так": true, "вы": true, "мы": true, "он": true, "она": true, "они": true, "его": true,
+	"их": true, "все": true, "без": true, "под": true, "над": true, "про": true, "чем": true,
+	"курс": true, "курса": true, "курсе": true, "лекция": true, "лекции": true, "урок": true, "часть": true,
+	// Английские.
+	"a": true, "an": true, "the": true, "and": true, "or": true, "of": true, "to": true, "in": true, "on": true,
+	"for": true, "with": true, "by": true, "at": true, "from": true, "is": true, "are": true, "be": true,
+	"this": true, "that": true, "it": true, "as": true, "you": true, "your": true, "how": true, "what": true,
+	"course": true, "lecture": true, "lesson": true, "part": true, "intro": true, "introduction": true,
+}
+
+// Окончания для грубого стемминга, от длинных к коротким.
+var (
+	russianEndings = []string{
+		"ами", "ями", "ого", "его", "ому", "ему", "ыми", "ими", "ией", "ия", "ие", "ий", "ый", "ой", "ая", "яя",
+		"ое", "ее", "ые", "ов", "ев", "ам", "ям", "ах", "ях", "ом", "ем", "ую", "юю", "ей",
+		"а", "я", "о", "е", "ы", "и", "у", "ю", "ь", "й",
+	}
+	englishEndings = []string{"ing", "ies", "ed", "es", "ly", "s"}
+)
+
+// Tokenize разбивает текст на слова, приводит их к нижнему регистру, отбрасывает стоп-слова
+// и обрезает типичные окончания русских и английских слов, чтобы формы одного слова совпадали.
+func Tokenize(text string) []string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		if stopWords[word] || len([]rune(word)) < 2 {
+			continue
+		}
+		tokens = append(tokens, stem(word))
+	}
+	return tokens
+}
+
+func stem(word string) string {
+	endings := englishEndings
+	if strings.ContainsFunc(word, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) {
+		endings = russianEndings
+	}
+	for _, ending := range endings {
+		if trimmed, ok := strings.CutSuffix(word, ending); ok && len([]rune(trimmed)) >= 3 {
+			return trimmed
+		}
+	}
+	return word
+}
+
+// Similar сравнивает курс courseID со всеми остальными курсами по TF-IDF-векторам их названий, описаний
+// и названий лекций и возвращает до limit курсов с ненулевой косинусной близостью, от самых похожих.
+// Если курса courseID нет среди documents, возвращает nil.
+func Similar(documents []*models.CourseDocument, courseID int64, limit int) []*models.SimilarCourse {
+	counts := make(map[int64]map[string]int, len(documents))
+	frequency := make(map[string]int)
+	for _, document := range documents {
+		terms := make(map[string]int)
+		for _, token := range Tokenize(document.Name) {
+			terms[token] += nameWeight
+		}
+		for _, token := range Tokenize(document.Description) {
+			terms[token]++
+		}
+		for _, title := range document.LectureTitles {
+			for _, token := range Tokenize(title) {
+				terms[token]++
+			}
+		}
+		counts[document.CourseID] = terms
+		for term := range terms {
+			frequency[term]++
+		}
+	}
+
+	total := float64(len(documents))
+	vectors := make(map[int64]map[string]float64, len(counts))
+	for id, terms := range counts {
+		vector := make(map[string]float64, len(terms))
+		var norm float64
+		for term, count := range terms {
+			weight := (1 + math.Log(float64(count))) * (math.Log((total+1)/float64(frequency[term]+1)) + 1)
+			vector[term] = weight
+			norm += weight * weight
+		}
+		norm = math.Sqrt(norm)
+		for term := range vector {
+			vector[term] /= norm
+		}
+		vectors[id] = vector
+	}
+
+	target, ok := vectors[courseID]
+	if !ok {
+		return nil
+	}
+
+	var similar []*models.SimilarCourse
+	for id, vector := range vectors {
+		if id == courseID {
+			continue
+		}
+		var score float64
+		for term, weight := range target {
+			score += weight * vector[term]
+		}
+		if score > 0 {
+			similar = append(similar, &models.SimilarCourse{CourseID: id, Score: score})
+		}
+	}
+	slices.SortFunc(similar, func(a, b *models.SimilarCourse) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.CourseID, b.CourseID)
+	})
+	if len(similar) > limit {
+		similar = similar[:limit]
+	}
+	return similar
+}
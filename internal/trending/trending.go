@@ -0,0 +1,78 @@
+package trending
+
+import (
+	"GoEdu/internal/models"
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// HalfLifeDivisor задаёт скорость затухания: вклад события уменьшается вдвое за window / HalfLifeDivisor,
+	// так что событие на границе окна весит 1/8 от свежего.
+	HalfLifeDivisor = 3.0
+
+	enrollmentWeight = 1.0
+	completionWeight = 3.0
+	// reviewWeight умножается на (оценка − 3): хорошие отзывы поднимают курс, плохие — опускают.
+	reviewWeight = 1.0
+)
+
+// Rank рассчитывает популярность курсов за окно window, заканчивающееся в now, и возвращает курсы
+// с событиями в окне по убыванию оценки; при равенстве выше курс с большим числом записей.
+// Вклад каждого события затухает экспоненциально с давностью, события вне окна не учитываются,
+// отрицательная итоговая оценка заменяется нулём.
+func Rank(events []*models.TrendingEvent, now time.Time, window time.Duration) []*models.TrendingCourse {
+	halfLife := float64(window) / HalfLifeDivisor
+
+	byCourse := make(map[int64]*models.TrendingCourse)
+	ratingSums := make(map[int64]int32)
+	for _, event := range events {
+		age := now.Sub(event.At)
+		if age > window {
+			continue
+		}
+		decay := math.Pow(0.5, float64(max(age, 0))/halfLife)
+
+		course, ok := byCourse[event.CourseID]
+		if !ok {
+			course = &models.TrendingCourse{CourseID: event.CourseID, WindowDays: int32(window / (24 * time.Hour)), ComputedAt: now}
+			byCourse[event.CourseID] = course
+		}
+		switch event.Kind {
+		case models.TrendingEnrollment:
+			course.Enrollments++
+			course.Score += enrollmentWeight * decay
+		case models.TrendingCompletion:
+			course.Completions++
+			course.Score += completionWeight * decay
+		case models.TrendingReview:
+			course.Reviews++
+			ratingSums[event.CourseID] += event.Rating
+			course.Score += reviewWeight * float64(event.Rating-3) * decay
+		}
+	}
+
+	courses := make([]*models.TrendingCourse, 0, len(byCourse))
+	for _, course := range byCourse {
+		course.Score = max(course.Score, 0)
+		if course.Reviews > 0 {
+			course.AverageRating = float64(ratingSums[course.CourseID]) / float64(course.Reviews)
+		}
+		courses = append(courses, course)
+	}
+	slices.SortFunc(courses, func(a, b *models.TrendingCourse) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Enrollments, a.Enrollments); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.CourseID, b.CourseID)
+	})
+	for i, course := range courses {
+		course.Rank = int32(i + 1)
+	}
+	return courses
+}
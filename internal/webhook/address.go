@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"syscall"
+)
+
+// ErrForbiddenAddress — адрес вебхука указывает во внутреннюю сеть сервера.
+var ErrForbiddenAddress = errors.New("адрес вебхука указывает на локальный или внутренний адрес")
+
+// sharedAddressSpace — диапазон адресов операторов (RFC 6598), который, как и частные сети, недоступен снаружи.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// publicAddress сообщает, можно ли отправлять вебхуки на адрес: запрещены loopback, частные,
+// link-local (в том числе адреса метаданных облака 169.254.169.254), multicast и неуказанные адреса.
+func publicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() && !addr.IsLoopback() && !addr.IsPrivate() && !addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() && !addr.IsInterfaceLocalMulticast() && !addr.IsMulticast() &&
+		!addr.IsUnspecified() && !sharedAddressSpace.Contains(addr)
+}
+
+// CheckURL проверяет, что адрес вебхука абсолютный с протоколом http или https, а все адреса его хоста
+// публичные. При allowPrivate (локальная разработка) проверяется только формат адреса.
+func CheckURL(ctx context.Context, rawURL string, allowPrivate bool) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return errors.New("адрес вебхука должен быть абсолютным адресом http или https")
+	}
+	if allowPrivate {
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", parsed.Hostname())
+	if err != nil {
+		return fmt.Errorf("не удалось определить адрес хоста %s: %w", parsed.Hostname(), err)
+	}
+	for _, addr := range addrs {
+		if !publicAddress(addr) {
+			return ErrForbiddenAddress
+		}
+	}
+	return nil
+}
+
+// dialControl запрещает соединения с непубличными адресами в момент подключения: проверка при создании
+// подписки не защищает от DNS rebinding, когда имя позже начинает указывать во внутреннюю сеть.
+func dialControl(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("некорректный адрес подключения %s: %w", address, err)
+	}
+	if !publicAddress(addrPort.Addr()) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
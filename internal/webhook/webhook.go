@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"GoEdu/internal/models"
+	"GoEdu/internal/repository"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Заголовки запроса с вебхуком.
+const (
+	HeaderEvent     = "X-GoEdu-Event"
+	HeaderEventID   = "X-GoEdu-Event-Id"
+	HeaderDelivery  = "X-GoEdu-Delivery"
+	HeaderTimestamp = "X-GoEdu-Timestamp"
+	HeaderSignature = "X-GoEdu-Signature"
+)
+
+// Envelope — тело запроса с вебхуком.
+type Envelope struct {
+	ID        string          `json:"id"` // ID события, ключ идемпотентности: одинаков во всех попытках и повторах.
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Sign возвращает подпись тела body для заголовка X-GoEdu-Signature: HMAC-SHA256 по секрету подписки
+// от строки "<timestamp>.<body>" в шестнадцатеричном виде с префиксом "sha256=". Метка времени в подписи
+// позволяет получателю отбрасывать старые перехваченные запросы.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Result — итог одной попытки доставки.
+type Result struct {
+	StatusCode int32 // HTTP-код ответа, 0 — ответа не было.
+	Err        error // Ошибка запроса или ответ с кодом не из 2xx.
+}
+
+// Client отправляет вебхуки по HTTP.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient возвращает клиент, который подключается только к публичным адресам; allowPrivate снимает
+// это ограничение для локальной разработки.
+func NewClient(timeout time.Duration, allowPrivate bool) *Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = dialControl
+	}
+	return &Client{http: &http.Client{
+		Timeout: timeout,
+		// Прокси из окружения не используется: соединение с ним обошло бы проверку адреса получателя.
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		// Перенаправления не выполняются: подпись относится к адресу подписки.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send отправляет доставку POST-запросом с JSON-телом на адрес подписки. Успешной считается
+// доставка с ответом 2xx.
+func (c *Client) Send(ctx context.Context, delivery *models.WebhookDelivery) Result {
+	body, err := json.Marshal(Envelope{
+		ID:        delivery.EventID,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.CreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return Result{Err: fmt.Errorf("не удалось сформировать тело запроса: %w", err)}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return Result{Err: fmt.Errorf("некорректный адрес: %w", err)}
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GoEdu-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderEventID, delivery.EventID)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Result{Err: err}
+	}
+	defer resp.Body.Close()
+
+	// Тело ответа не сохраняется: в журнале доставок достаточно кода ответа.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	result := Result{StatusCode: int32(resp.StatusCode)}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		result.Err = fmt.Errorf("получатель ответил %s", resp.Status)
+	}
+	return result
+}
+
+// Sink ставит опубликованные доменные события в очередь отправки по подпискам на вебхуки.
+type Sink struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewSink(webhookRepo repository.WebhookRepository) *Sink {
+	return &Sink{webhookRepo: webhookRepo}
+}
+
+func (s *Sink) Name() string {
+	return "webhooks"
+}
+
+// Publish создаёт доставки события. Подписки преподавателей подбираются по курсу и программе события.
+func (s *Sink) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	scope, err := event.Scope()
+	if err != nil {
+		return fmt.Errorf("некорректные данные события %s: %w", event.EventID, err)
+	}
+	_, err = s.webhookRepo.CreateDeliveries(ctx, event, scope.CourseID, scope.PathID)
+	return err
+}
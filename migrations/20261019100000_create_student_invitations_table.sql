@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE student_invitations
+(
+    token_hash  TEXT PRIMARY KEY,
+    student_id  INT       NOT NULL,
+    course_id   INT,
+    created_at  TIMESTAMP DEFAULT NOW(),
+    expires_at  TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP,
+    FOREIGN KEY (student_id) REFERENCES students (id) ON DELETE CASCADE,
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE SET NULL
+);
+
+CREATE INDEX student_invitations_student_id_idx ON student_invitations (student_id);
+
+-- +goose Down
+DROP TABLE student_invitations;
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE cohorts
+(
+    id         SERIAL PRIMARY KEY,
+    course_id  INT          NOT NULL,
+    name       VARCHAR(255) NOT NULL,
+    start_date DATE         NOT NULL,
+    end_date   DATE         NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+    CONSTRAINT cohorts_name_not_empty CHECK (char_length(name) > 0),
+    CONSTRAINT cohorts_dates_valid CHECK (end_date >= start_date)
+);
+
+CREATE UNIQUE INDEX cohorts_course_name_unique ON cohorts (course_id, name);
+
+CREATE TABLE cohort_instructors
+(
+    cohort_id     INT NOT NULL,
+    instructor_id INT NOT NULL,
+    PRIMARY KEY (cohort_id, instructor_id),
+    FOREIGN KEY (cohort_id) REFERENCES cohorts (id) ON DELETE CASCADE,
+    FOREIGN KEY (instructor_id) REFERENCES instructors (id) ON DELETE CASCADE
+);
+
+ALTER TABLE enrollments
+    ADD COLUMN cohort_id INT REFERENCES cohorts (id) ON DELETE SET NULL;
+
+CREATE INDEX enrollments_cohort_id_idx ON enrollments (cohort_id);
+
+ALTER TABLE lectures
+    ADD COLUMN cohort_offset_days INT CHECK (cohort_offset_days >= 0);
+
+-- +goose Down
+ALTER TABLE lectures
+    DROP COLUMN cohort_offset_days;
+ALTER TABLE enrollments
+    DROP COLUMN cohort_id;
+DROP TABLE cohort_instructors;
+DROP TABLE cohorts;
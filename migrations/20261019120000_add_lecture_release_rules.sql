@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE lectures
+    ADD COLUMN release_at         TIMESTAMP,
+    ADD COLUMN release_after_days INT CHECK (release_after_days >= 0);
+
+CREATE TABLE lecture_release_notifications
+(
+    student_id  INT NOT NULL,
+    lecture_id  INT NOT NULL,
+    notified_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (student_id, lecture_id),
+    FOREIGN KEY (student_id) REFERENCES students (id) ON DELETE CASCADE,
+    FOREIGN KEY (lecture_id) REFERENCES lectures (id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE lecture_release_notifications;
+ALTER TABLE lectures
+    DROP COLUMN release_after_days,
+    DROP COLUMN release_at;
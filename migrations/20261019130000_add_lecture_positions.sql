@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE lectures
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+UPDATE lectures l
+SET position = numbered.rn
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY id) AS rn FROM lectures) numbered
+WHERE l.id = numbered.id;
+
+CREATE INDEX idx_lectures_course_position ON lectures (course_id, position, id);
+
+ALTER TABLE courses
+    ADD COLUMN sequential BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE courses
+    DROP COLUMN sequential;
+DROP INDEX idx_lectures_course_position;
+ALTER TABLE lectures
+    DROP COLUMN position;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE lecture_progress
+(
+    student_id            INT    NOT NULL,
+    lecture_id            INT    NOT NULL,
+    started_at            TIMESTAMP DEFAULT NOW(),
+    last_activity_at      TIMESTAMP DEFAULT NOW(),
+    time_spent_seconds    BIGINT NOT NULL DEFAULT 0,
+    last_position_seconds INT    NOT NULL DEFAULT 0,
+    PRIMARY KEY (student_id, lecture_id),
+    FOREIGN KEY (student_id) REFERENCES students (id) ON DELETE CASCADE,
+    FOREIGN KEY (lecture_id) REFERENCES lectures (id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE lecture_progress;
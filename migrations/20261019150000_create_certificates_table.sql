@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE certificates
+(
+    id              SERIAL PRIMARY KEY,
+    serial          TEXT      NOT NULL UNIQUE,
+    student_id      INT       NOT NULL,
+    course_id       INT       NOT NULL,
+    student_name    TEXT      NOT NULL,
+    course_name     TEXT      NOT NULL,
+    instructor_name TEXT      NOT NULL,
+    issued_at       TIMESTAMP NOT NULL,
+    signature       TEXT      NOT NULL,
+    pdf             BYTEA     NOT NULL,
+    UNIQUE (student_id, course_id),
+    FOREIGN KEY (student_id) REFERENCES students (id) ON DELETE CASCADE,
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE certificates;
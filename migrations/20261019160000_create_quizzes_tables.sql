@@ -0,0 +1,80 @@
+-- +goose Up
+CREATE TABLE quizzes
+(
+    id                      SERIAL PRIMARY KEY,
+    course_id               INT          NOT NULL,
+    lecture_id              INT,
+    title                   VARCHAR(255) NOT NULL,
+    description             TEXT         NOT NULL DEFAULT '',
+    time_limit_seconds      INT CHECK (time_limit_seconds > 0),
+    max_attempts            INT CHECK (max_attempts > 0),
+    passing_score           INT          NOT NULL DEFAULT 0 CHECK (passing_score BETWEEN 0 AND 100),
+    required_for_completion BOOL         NOT NULL DEFAULT FALSE,
+    created_at              TIMESTAMP DEFAULT NOW(),
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+    FOREIGN KEY (lecture_id) REFERENCES lectures (id) ON DELETE CASCADE,
+    CONSTRAINT quizzes_title_not_empty CHECK (char_length(title) > 0),
+    CONSTRAINT quizzes_required_needs_lecture CHECK (NOT required_for_completion OR lecture_id IS NOT NULL)
+);
+
+CREATE INDEX quizzes_course_id_idx ON quizzes (course_id);
+CREATE INDEX quizzes_lecture_id_idx ON quizzes (lecture_id);
+
+CREATE TABLE quiz_questions
+(
+    id               SERIAL PRIMARY KEY,
+    quiz_id          INT         NOT NULL,
+    position         INT         NOT NULL,
+    type             VARCHAR(32) NOT NULL,
+    text             TEXT        NOT NULL,
+    points           INT         NOT NULL DEFAULT 1 CHECK (points >= 0),
+    options          TEXT[]      NOT NULL DEFAULT '{}',
+    correct_options  INT[]       NOT NULL DEFAULT '{}',
+    correct_bool     BOOL,
+    correct_number   DOUBLE PRECISION,
+    tolerance        DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0),
+    accepted_answers TEXT[]      NOT NULL DEFAULT '{}',
+    FOREIGN KEY (quiz_id) REFERENCES quizzes (id) ON DELETE CASCADE,
+    CONSTRAINT quiz_questions_type_valid CHECK (type IN ('single_choice', 'multiple_choice', 'true_false', 'numeric', 'short_text'))
+);
+
+CREATE INDEX quiz_questions_quiz_id_idx ON quiz_questions (quiz_id, position);
+
+CREATE TABLE quiz_attempts
+(
+    id             SERIAL PRIMARY KEY,
+    quiz_id        INT       NOT NULL,
+    student_id     INT       NOT NULL,
+    attempt_number INT       NOT NULL,
+    started_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    deadline_at    TIMESTAMP,
+    submitted_at   TIMESTAMP,
+    score          INT       NOT NULL DEFAULT 0,
+    max_score      INT       NOT NULL DEFAULT 0,
+    percent        INT       NOT NULL DEFAULT 0,
+    passed         BOOL      NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (quiz_id) REFERENCES quizzes (id) ON DELETE CASCADE,
+    FOREIGN KEY (student_id) REFERENCES students (id) ON DELETE CASCADE,
+    UNIQUE (quiz_id, student_id, attempt_number)
+);
+
+CREATE TABLE quiz_answers
+(
+    attempt_id       INT  NOT NULL,
+    question_id      INT  NOT NULL,
+    selected_options INT[] NOT NULL DEFAULT '{}',
+    bool_answer      BOOL,
+    number_answer    DOUBLE PRECISION,
+    text_answer      TEXT NOT NULL DEFAULT '',
+    correct          BOOL NOT NULL DEFAULT FALSE,
+    points           INT  NOT NULL DEFAULT 0,
+    PRIMARY KEY (attempt_id, question_id),
+    FOREIGN KEY (attempt_id) REFERENCES quiz_attempts (id) ON DELETE CASCADE,
+    FOREIGN KEY (question_id) REFERENCES quiz_questions (id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE quiz_answers;
+DROP TABLE quiz_attempts;
+DROP TABLE quiz_questions;
+DROP TABLE quizzes;
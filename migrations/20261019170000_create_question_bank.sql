@@ -0,0 +1,46 @@
+-- +goose Up
+ALTER TABLE quiz_questions
+    ALTER COLUMN quiz_id DROP NOT NULL,
+    ADD COLUMN instructor_id INT REFERENCES instructors (id) ON DELETE CASCADE,
+    ADD COLUMN tags          TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN difficulty    VARCHAR(16),
+    ADD CONSTRAINT quiz_questions_owner CHECK ((quiz_id IS NULL) <> (instructor_id IS NULL)),
+    ADD CONSTRAINT quiz_questions_difficulty_valid CHECK (difficulty IN ('easy', 'medium', 'hard'));
+
+CREATE INDEX quiz_questions_bank_idx ON quiz_questions (instructor_id) WHERE quiz_id IS NULL;
+
+ALTER TABLE quizzes
+    ADD COLUMN shuffle_questions BOOL NOT NULL DEFAULT FALSE,
+    ADD COLUMN shuffle_options   BOOL NOT NULL DEFAULT FALSE;
+
+CREATE TABLE quiz_draw_rules
+(
+    quiz_id    INT    NOT NULL,
+    position   INT    NOT NULL,
+    count      INT    NOT NULL CHECK (count > 0),
+    tags       TEXT[] NOT NULL DEFAULT '{}',
+    difficulty VARCHAR(16),
+    PRIMARY KEY (quiz_id, position),
+    FOREIGN KEY (quiz_id) REFERENCES quizzes (id) ON DELETE CASCADE
+);
+
+ALTER TABLE quiz_attempts
+    ADD COLUMN seed         BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN question_ids INT[]  NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE quiz_attempts
+    DROP COLUMN question_ids,
+    DROP COLUMN seed;
+DROP TABLE quiz_draw_rules;
+ALTER TABLE quizzes
+    DROP COLUMN shuffle_options,
+    DROP COLUMN shuffle_questions;
+DELETE FROM quiz_questions WHERE quiz_id IS NULL;
+ALTER TABLE quiz_questions
+    DROP CONSTRAINT quiz_questions_difficulty_valid,
+    DROP CONSTRAINT quiz_questions_owner,
+    DROP COLUMN difficulty,
+    DROP COLUMN tags,
+    DROP COLUMN instructor_id,
+    ALTER COLUMN quiz_id SET NOT NULL;
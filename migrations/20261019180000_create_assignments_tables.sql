@@ -0,0 +1,85 @@
+-- +goose Up
+CREATE TABLE assignments
+(
+    id                   SERIAL PRIMARY KEY,
+    course_id            INT          NOT NULL,
+    title                VARCHAR(255) NOT NULL,
+    description          TEXT         NOT NULL DEFAULT '',
+    due_at               TIMESTAMP,
+    late_policy          VARCHAR(16)  NOT NULL DEFAULT 'accept',
+    late_penalty_percent INT          NOT NULL DEFAULT 0 CHECK (late_penalty_percent BETWEEN 0 AND 100),
+    max_score            INT          NOT NULL CHECK (max_score > 0),
+    max_submissions      INT CHECK (max_submissions > 0),
+    max_files            INT          NOT NULL DEFAULT 5 CHECK (max_files >= 0),
+    created_at           TIMESTAMP DEFAULT NOW(),
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+    CONSTRAINT assignments_title_not_empty CHECK (char_length(title) > 0),
+    CONSTRAINT assignments_late_policy_valid CHECK (late_policy IN ('accept', 'penalty', 'reject'))
+);
+
+CREATE INDEX assignments_course_id_idx ON assignments (course_id);
+
+CREATE TABLE assignment_rubric_criteria
+(
+    id            SERIAL PRIMARY KEY,
+    assignment_id INT          NOT NULL,
+    position      INT          NOT NULL,
+    title         VARCHAR(255) NOT NULL,
+    description   TEXT         NOT NULL DEFAULT '',
+    max_points    INT          NOT NULL CHECK (max_points > 0),
+    FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE
+);
+
+CREATE INDEX assignment_rubric_criteria_assignment_id_idx ON assignment_rubric_criteria (assignment_id);
+
+CREATE TABLE assignment_submissions
+(
+    id              SERIAL PRIMARY KEY,
+    assignment_id   INT       NOT NULL,
+    student_id      INT       NOT NULL,
+    version         INT       NOT NULL,
+    text            TEXT      NOT NULL DEFAULT '',
+    submitted_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    late            BOOL      NOT NULL DEFAULT FALSE,
+    penalty_percent INT       NOT NULL DEFAULT 0 CHECK (penalty_percent BETWEEN 0 AND 100),
+    raw_score       INT,
+    score           INT,
+    feedback        TEXT      NOT NULL DEFAULT '',
+    graded_at       TIMESTAMP,
+    graded_by       INT,
+    FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE,
+    FOREIGN KEY (student_id) REFERENCES students (id) ON DELETE CASCADE,
+    FOREIGN KEY (graded_by) REFERENCES instructors (id) ON DELETE SET NULL,
+    UNIQUE (assignment_id, student_id, version)
+);
+
+CREATE TABLE submission_files
+(
+    id            SERIAL PRIMARY KEY,
+    submission_id INT          NOT NULL,
+    filename      VARCHAR(255) NOT NULL,
+    content_type  VARCHAR(255) NOT NULL,
+    size          BIGINT       NOT NULL,
+    storage_key   TEXT         NOT NULL UNIQUE,
+    FOREIGN KEY (submission_id) REFERENCES assignment_submissions (id) ON DELETE CASCADE
+);
+
+CREATE INDEX submission_files_submission_id_idx ON submission_files (submission_id);
+
+CREATE TABLE submission_rubric_scores
+(
+    submission_id INT  NOT NULL,
+    criterion_id  INT  NOT NULL,
+    points        INT  NOT NULL CHECK (points >= 0),
+    comment       TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (submission_id, criterion_id),
+    FOREIGN KEY (submission_id) REFERENCES assignment_submissions (id) ON DELETE CASCADE,
+    FOREIGN KEY (criterion_id) REFERENCES assignment_rubric_criteria (id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE submission_rubric_scores;
+DROP TABLE submission_files;
+DROP TABLE assignment_submissions;
+DROP TABLE assignment_rubric_criteria;
+DROP TABLE assignments;
@@ -0,0 +1,46 @@
+-- +goose Up
+ALTER TABLE assignments
+    ADD COLUMN peer_review_count         INT NOT NULL DEFAULT 0 CHECK (peer_review_count >= 0),
+    ADD COLUMN peer_review_due_at        TIMESTAMP,
+    ADD COLUMN peer_reviews_allocated_at TIMESTAMP;
+
+ALTER TABLE assignment_submissions
+    ADD COLUMN peer_graded BOOL NOT NULL DEFAULT FALSE;
+
+CREATE TABLE peer_reviews
+(
+    id            SERIAL PRIMARY KEY,
+    submission_id INT       NOT NULL,
+    reviewer_id   INT       NOT NULL,
+    assigned_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    submitted_at  TIMESTAMP,
+    comment       TEXT      NOT NULL DEFAULT '',
+    FOREIGN KEY (submission_id) REFERENCES assignment_submissions (id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES students (id) ON DELETE CASCADE,
+    UNIQUE (submission_id, reviewer_id)
+);
+
+CREATE INDEX peer_reviews_reviewer_id_idx ON peer_reviews (reviewer_id);
+
+CREATE TABLE peer_review_scores
+(
+    peer_review_id INT  NOT NULL,
+    criterion_id   INT  NOT NULL,
+    points         INT  NOT NULL CHECK (points >= 0),
+    comment        TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (peer_review_id, criterion_id),
+    FOREIGN KEY (peer_review_id) REFERENCES peer_reviews (id) ON DELETE CASCADE,
+    FOREIGN KEY (criterion_id) REFERENCES assignment_rubric_criteria (id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE peer_review_scores;
+DROP TABLE peer_reviews;
+
+ALTER TABLE assignment_submissions
+    DROP COLUMN peer_graded;
+
+ALTER TABLE assignments
+    DROP COLUMN peer_reviews_allocated_at,
+    DROP COLUMN peer_review_due_at,
+    DROP COLUMN peer_review_count;
@@ -0,0 +1,60 @@
+-- +goose Up
+CREATE TABLE grade_categories
+(
+    id        SERIAL PRIMARY KEY,
+    course_id INT              NOT NULL,
+    name      VARCHAR(255)     NOT NULL,
+    weight    DOUBLE PRECISION NOT NULL CHECK (weight > 0),
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+    CONSTRAINT grade_categories_name_not_empty CHECK (char_length(name) > 0)
+);
+
+CREATE INDEX grade_categories_course_id_idx ON grade_categories (course_id);
+
+ALTER TABLE quizzes
+    ADD COLUMN grade_category_id INT REFERENCES grade_categories (id) ON DELETE SET NULL;
+
+ALTER TABLE assignments
+    ADD COLUMN grade_category_id INT REFERENCES grade_categories (id) ON DELETE SET NULL;
+
+CREATE TABLE letter_grades
+(
+    course_id   INT              NOT NULL,
+    letter      VARCHAR(8)       NOT NULL,
+    min_percent DOUBLE PRECISION NOT NULL CHECK (min_percent BETWEEN 0 AND 100),
+    PRIMARY KEY (course_id, letter),
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE
+);
+
+CREATE TABLE grade_adjustments
+(
+    id             SERIAL PRIMARY KEY,
+    course_id      INT              NOT NULL,
+    student_id     INT              NOT NULL,
+    item_type      VARCHAR(16)      NOT NULL,
+    item_id        INT              NOT NULL DEFAULT 0,
+    score          DOUBLE PRECISION,
+    previous_score DOUBLE PRECISION,
+    reason         TEXT             NOT NULL,
+    instructor_id  INT,
+    created_at     TIMESTAMP        NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+    FOREIGN KEY (student_id) REFERENCES students (id) ON DELETE CASCADE,
+    FOREIGN KEY (instructor_id) REFERENCES instructors (id) ON DELETE SET NULL,
+    CONSTRAINT grade_adjustments_item_type_valid CHECK (item_type IN ('quiz', 'assignment', 'final')),
+    CONSTRAINT grade_adjustments_reason_not_empty CHECK (char_length(reason) > 0)
+);
+
+CREATE INDEX grade_adjustments_course_student_idx ON grade_adjustments (course_id, student_id);
+
+-- +goose Down
+DROP TABLE grade_adjustments;
+DROP TABLE letter_grades;
+
+ALTER TABLE assignments
+    DROP COLUMN grade_category_id;
+
+ALTER TABLE quizzes
+    DROP COLUMN grade_category_id;
+
+DROP TABLE grade_categories;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- Оставляем по одному отзыву студента на курс — самый поздний. Более ранние дубликаты не удаляются
+-- бесследно, а переносятся в reviews_duplicates_archive: их можно просмотреть и восстановить откатом миграции.
+CREATE TABLE reviews_duplicates_archive
+(
+    id          INT       PRIMARY KEY,
+    student_id  INT       NOT NULL,
+    course_id   INT       NOT NULL,
+    comment     TEXT,
+    rating      INT,
+    created_at  TIMESTAMP,
+    kept_id     INT       NOT NULL,
+    archived_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO reviews_duplicates_archive (id, student_id, course_id, comment, rating, created_at, kept_id)
+SELECT r.id, r.student_id, r.course_id, r.comment, r.rating, r.created_at, latest.id
+FROM reviews r
+JOIN (
+    SELECT student_id, course_id, MAX(id) AS id FROM reviews GROUP BY student_id, course_id
+) latest ON latest.student_id = r.student_id AND latest.course_id = r.course_id
+WHERE r.id < latest.id;
+
+DELETE FROM reviews r
+USING reviews_duplicates_archive a
+WHERE a.id = r.id;
+
+ALTER TABLE reviews
+    ADD COLUMN updated_at TIMESTAMP,
+    ADD CONSTRAINT reviews_student_course_unique UNIQUE (student_id, course_id);
+
+-- +goose Down
+ALTER TABLE reviews
+    DROP CONSTRAINT reviews_student_course_unique,
+    DROP COLUMN updated_at;
+
+INSERT INTO reviews (id, student_id, course_id, comment, rating, created_at)
+SELECT id, student_id, course_id, comment, rating, created_at
+FROM reviews_duplicates_archive
+WHERE student_id IN (SELECT id FROM students)
+  AND course_id IN (SELECT id FROM courses);
+
+DROP TABLE reviews_duplicates_archive;
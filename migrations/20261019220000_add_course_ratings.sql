@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE courses
+    ADD COLUMN rating_count  INT    NOT NULL DEFAULT 0,
+    ADD COLUMN rating_sum    BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN rating_counts INT[]  NOT NULL DEFAULT '{0,0,0,0,0}';
+
+UPDATE courses c
+SET rating_count  = stats.count,
+    rating_sum    = stats.sum,
+    rating_counts = stats.counts
+FROM (
+    SELECT course_id,
+           COUNT(*)    AS count,
+           SUM(rating) AS sum,
+           ARRAY[COUNT(*) FILTER (WHERE rating = 1), COUNT(*) FILTER (WHERE rating = 2), COUNT(*) FILTER (WHERE rating = 3),
+                 COUNT(*) FILTER (WHERE rating = 4), COUNT(*) FILTER (WHERE rating = 5)]::INT[] AS counts
+    FROM reviews
+    WHERE rating IS NOT NULL
+    GROUP BY course_id
+) stats
+WHERE stats.course_id = c.id;
+
+-- +goose Down
+ALTER TABLE courses
+    DROP COLUMN rating_counts,
+    DROP COLUMN rating_sum,
+    DROP COLUMN rating_count;
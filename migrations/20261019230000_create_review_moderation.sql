@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE admins
+(
+    id       SERIAL PRIMARY KEY,
+    name     TEXT NOT NULL,
+    email    TEXT NOT NULL UNIQUE,
+    password TEXT NOT NULL
+);
+
+-- Уже опубликованные отзывы считаются одобренными.
+ALTER TABLE reviews
+    ADD COLUMN status          TEXT NOT NULL DEFAULT 'approved' CHECK (status IN ('pending', 'approved', 'rejected')),
+    ADD COLUMN moderation_note TEXT NOT NULL DEFAULT '',
+    ADD COLUMN moderated_by    BIGINT,
+    ADD COLUMN moderated_at    TIMESTAMP;
+
+CREATE INDEX reviews_pending_idx ON reviews (created_at) WHERE status = 'pending';
+
+CREATE TABLE review_reports
+(
+    id            SERIAL PRIMARY KEY,
+    review_id     BIGINT    NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    reporter_id   BIGINT    NOT NULL,
+    reporter_role TEXT      NOT NULL,
+    reason        TEXT      NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    resolved_at   TIMESTAMP,
+    UNIQUE (review_id, reporter_id, reporter_role)
+);
+
+-- +goose Down
+DROP TABLE review_reports;
+
+ALTER TABLE reviews
+    DROP COLUMN moderated_at,
+    DROP COLUMN moderated_by,
+    DROP COLUMN moderation_note,
+    DROP COLUMN status;
+
+DROP TABLE admins;
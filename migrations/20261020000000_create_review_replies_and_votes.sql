@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE review_replies
+(
+    review_id     BIGINT PRIMARY KEY REFERENCES reviews (id) ON DELETE CASCADE,
+    instructor_id BIGINT    NOT NULL,
+    comment       TEXT      NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMP
+);
+
+CREATE TABLE review_votes
+(
+    review_id  BIGINT    NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    voter_id   BIGINT    NOT NULL,
+    voter_role TEXT      NOT NULL,
+    helpful    BOOLEAN   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (review_id, voter_id, voter_role)
+);
+
+-- Счётчики голосов хранятся в отзыве, чтобы сортировать по полезности без агрегации.
+ALTER TABLE reviews
+    ADD COLUMN helpful_count     INT NOT NULL DEFAULT 0,
+    ADD COLUMN not_helpful_count INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE reviews
+    DROP COLUMN not_helpful_count,
+    DROP COLUMN helpful_count;
+
+DROP TABLE review_votes;
+DROP TABLE review_replies;
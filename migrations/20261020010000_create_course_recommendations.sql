@@ -0,0 +1,24 @@
+-- +goose Up
+ALTER TABLE courses
+    ADD COLUMN category TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN tags     TEXT[] NOT NULL DEFAULT '{}';
+
+-- Похожие курсы, пересчитываемые фоновой задачей: совместные записи и прогресс студентов (cf_score)
+-- и совпадение категории и тегов (content_score). Для каждого курса хранится ограниченное число соседей.
+CREATE TABLE course_similarities
+(
+    course_id         INT              NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    similar_course_id INT              NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    cf_score          DOUBLE PRECISION NOT NULL DEFAULT 0,
+    content_score     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    common_students   INT              NOT NULL DEFAULT 0,
+    computed_at       TIMESTAMP        NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (course_id, similar_course_id)
+);
+
+-- +goose Down
+DROP TABLE course_similarities;
+
+ALTER TABLE courses
+    DROP COLUMN tags,
+    DROP COLUMN category;
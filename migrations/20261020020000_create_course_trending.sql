@@ -0,0 +1,21 @@
+-- +goose Up
+-- Популярность курсов за скользящие окна (например, 7/30/90 дней), пересчитываемая фоновой задачей:
+-- записи, завершения и отзывы с затуханием по давности.
+CREATE TABLE course_trending
+(
+    course_id      INT              NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    window_days    INT              NOT NULL,
+    rank           INT              NOT NULL,
+    score          DOUBLE PRECISION NOT NULL,
+    enrollments    INT              NOT NULL DEFAULT 0,
+    completions    INT              NOT NULL DEFAULT 0,
+    reviews        INT              NOT NULL DEFAULT 0,
+    average_rating DOUBLE PRECISION NOT NULL DEFAULT 0,
+    computed_at    TIMESTAMP        NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (course_id, window_days)
+);
+
+CREATE INDEX course_trending_window_rank_idx ON course_trending (window_days, rank);
+
+-- +goose Down
+DROP TABLE course_trending;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Кэш похожих по текстам курсов (TF-IDF по названию, описанию и названиям лекций), рассчитываемый
+-- при первом запросе. Сбрасывается целиком при изменении текстов любого курса: от них зависят веса слов.
+CREATE TABLE similar_courses_cache
+(
+    course_id          INT                NOT NULL PRIMARY KEY REFERENCES courses (id) ON DELETE CASCADE,
+    similar_course_ids INT[]              NOT NULL,
+    scores             DOUBLE PRECISION[] NOT NULL,
+    generation         BIGINT             NOT NULL,
+    computed_at        TIMESTAMP          NOT NULL DEFAULT NOW()
+);
+
+-- Поколение кэша увеличивается при каждом сбросе. Строка кэша действительна, только если рассчитана
+-- в текущем поколении: так расчёт, начатый до сброса, не может сохранить устаревший результат.
+CREATE TABLE similar_courses_generation
+(
+    generation BIGINT NOT NULL
+);
+
+INSERT INTO similar_courses_generation (generation) VALUES (0);
+
+-- +goose Down
+DROP TABLE similar_courses_generation;
+DROP TABLE similar_courses_cache;
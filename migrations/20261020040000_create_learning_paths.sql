@@ -0,0 +1,48 @@
+-- +goose Up
+CREATE TABLE learning_paths
+(
+    id            SERIAL PRIMARY KEY,
+    name          TEXT      NOT NULL UNIQUE,
+    description   TEXT      NOT NULL DEFAULT '',
+    instructor_id INT       NOT NULL REFERENCES instructors (id) ON DELETE CASCADE,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE learning_path_courses
+(
+    path_id   INT     NOT NULL REFERENCES learning_paths (id) ON DELETE CASCADE,
+    course_id INT     NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    position  INT     NOT NULL,
+    required  BOOLEAN NOT NULL DEFAULT TRUE,
+    PRIMARY KEY (path_id, course_id)
+);
+
+CREATE INDEX learning_path_courses_course_id_idx ON learning_path_courses (course_id);
+
+CREATE TABLE learning_path_enrollments
+(
+    path_id     INT       NOT NULL REFERENCES learning_paths (id) ON DELETE CASCADE,
+    student_id  INT       NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    enrolled_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (path_id, student_id)
+);
+
+-- Сертификат выдаётся либо за курс, либо за учебную программу.
+ALTER TABLE certificates
+    ALTER COLUMN course_id DROP NOT NULL,
+    ADD COLUMN path_id INT REFERENCES learning_paths (id) ON DELETE CASCADE,
+    ADD CONSTRAINT certificates_subject_check CHECK ((course_id IS NULL) <> (path_id IS NULL)),
+    ADD CONSTRAINT certificates_student_id_path_id_key UNIQUE (student_id, path_id);
+
+-- +goose Down
+DELETE FROM certificates WHERE path_id IS NOT NULL;
+
+ALTER TABLE certificates
+    DROP CONSTRAINT certificates_student_id_path_id_key,
+    DROP CONSTRAINT certificates_subject_check,
+    DROP COLUMN path_id,
+    ALTER COLUMN course_id SET NOT NULL;
+
+DROP TABLE learning_path_enrollments;
+DROP TABLE learning_path_courses;
+DROP TABLE learning_paths;
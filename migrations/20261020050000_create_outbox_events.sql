@@ -0,0 +1,24 @@
+-- +goose Up
+-- Outbox доменных событий: событие пишется в одной транзакции с изменением, которое его породило,
+-- и затем публикуется ретранслятором. event_id — ключ идемпотентности, одинаковый во всех попытках
+-- доставки одного события: получатели должны по нему отбрасывать повторы.
+CREATE TABLE outbox_events
+(
+    id              BIGSERIAL PRIMARY KEY,
+    event_id        UUID      NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    event_type      TEXT      NOT NULL,
+    aggregate_type  TEXT      NOT NULL,
+    aggregate_id    BIGINT    NOT NULL,
+    payload         JSONB     NOT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    attempts        INT       NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error      TEXT      NOT NULL DEFAULT '',
+    published_at    TIMESTAMP
+);
+
+CREATE INDEX outbox_events_pending_idx ON outbox_events (next_attempt_at, id) WHERE published_at IS NULL;
+CREATE INDEX outbox_events_published_idx ON outbox_events (published_at) WHERE published_at IS NOT NULL;
+
+-- +goose Down
+DROP TABLE outbox_events;
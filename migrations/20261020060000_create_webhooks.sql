@@ -0,0 +1,47 @@
+-- +goose Up
+-- Подписки преподавателей и администраторов на доменные события. Преподаватель получает события
+-- только своих курсов и программ, администратор — все. После серии неудачных доставок подряд
+-- подписка выключается автоматически (disabled_reason).
+CREATE TABLE webhook_subscriptions
+(
+    id                   SERIAL PRIMARY KEY,
+    owner_role           TEXT      NOT NULL CHECK (owner_role IN ('instructor', 'admin')),
+    owner_id             BIGINT    NOT NULL,
+    url                  TEXT      NOT NULL,
+    event_types          TEXT[]    NOT NULL,
+    secret               TEXT      NOT NULL,
+    active               BOOLEAN   NOT NULL DEFAULT TRUE,
+    disabled_reason      TEXT      NOT NULL DEFAULT '',
+    consecutive_failures INT       NOT NULL DEFAULT 0,
+    created_at           TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX webhook_subscriptions_owner_idx ON webhook_subscriptions (owner_role, owner_id);
+
+-- Журнал доставок: одна строка на событие и подписку. Ручной повтор создаёт новую строку
+-- с тем же event_id и ссылкой на исходную доставку.
+CREATE TABLE webhook_deliveries
+(
+    id              BIGSERIAL PRIMARY KEY,
+    subscription_id INT       NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id        UUID      NOT NULL,
+    event_type      TEXT      NOT NULL,
+    payload         JSONB     NOT NULL,
+    status          TEXT      NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts        INT       NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    response_code   INT       NOT NULL DEFAULT 0,
+    last_error      TEXT      NOT NULL DEFAULT '',
+    redelivery_of   BIGINT REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    delivered_at    TIMESTAMP
+);
+
+-- Повторная публикация события из outbox не должна создавать вторую доставку.
+CREATE UNIQUE INDEX webhook_deliveries_event_idx ON webhook_deliveries (subscription_id, event_id) WHERE redelivery_of IS NULL;
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_subscription_idx ON webhook_deliveries (subscription_id, id DESC);
+
+-- +goose Down
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_subscriptions;
@@ -0,0 +1,33 @@
+-- +goose Up
+-- Уведомления студентов во встроенном почтовом ящике. Уведомления создаются из доменных событий;
+-- event_id защищает от повторов при повторной публикации события.
+CREATE TABLE notifications
+(
+    id         BIGSERIAL PRIMARY KEY,
+    student_id INT       NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    type       TEXT      NOT NULL,
+    event_id   UUID,
+    course_id  INT,
+    title      TEXT      NOT NULL,
+    body       TEXT      NOT NULL DEFAULT '',
+    link       TEXT      NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    read_at    TIMESTAMP,
+    UNIQUE (student_id, event_id)
+);
+
+CREATE INDEX notifications_student_idx ON notifications (student_id, id DESC);
+CREATE INDEX notifications_unread_idx ON notifications (student_id) WHERE read_at IS NULL;
+
+-- Настройки уведомлений: строка хранится только для изменённых типов, по умолчанию уведомления включены.
+CREATE TABLE notification_preferences
+(
+    student_id INT     NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    type       TEXT    NOT NULL,
+    enabled    BOOLEAN NOT NULL,
+    PRIMARY KEY (student_id, type)
+);
+
+-- +goose Down
+DROP TABLE notification_preferences;
+DROP TABLE notifications;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Параметры уведомления для шаблонов писем на разных языках и отметка об отправке по почте.
+ALTER TABLE notifications
+    ADD COLUMN data       JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN emailed_at TIMESTAMP;
+
+CREATE INDEX notifications_email_pending_idx ON notifications (student_id) WHERE emailed_at IS NULL AND read_at IS NULL;
+
+-- Настройки почтовых уведомлений студента; без строки действует ежедневная сводка на русском.
+CREATE TABLE notification_settings
+(
+    student_id      INT  NOT NULL PRIMARY KEY REFERENCES students (id) ON DELETE CASCADE,
+    email_frequency TEXT NOT NULL CHECK (email_frequency IN ('off', 'immediate', 'daily', 'weekly')),
+    locale          TEXT NOT NULL CHECK (locale IN ('ru', 'en')),
+    last_emailed_at TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE notification_settings;
+DROP INDEX notifications_email_pending_idx;
+ALTER TABLE notifications
+    DROP COLUMN emailed_at,
+    DROP COLUMN data;
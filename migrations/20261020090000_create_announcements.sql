@@ -0,0 +1,22 @@
+-- +goose Up
+-- Объявления преподавателей для студентов курса или одного потока. Объявление с publish_at в будущем
+-- публикуется фоновой задачей; published_at заполняется при публикации.
+CREATE TABLE announcements
+(
+    id            SERIAL PRIMARY KEY,
+    course_id     INT          NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    cohort_id     INT REFERENCES cohorts (id) ON DELETE CASCADE,
+    instructor_id INT REFERENCES instructors (id) ON DELETE SET NULL,
+    title         VARCHAR(255) NOT NULL,
+    body          TEXT         NOT NULL,
+    publish_at    TIMESTAMP    NOT NULL DEFAULT NOW(),
+    published_at  TIMESTAMP,
+    created_at    TIMESTAMP    NOT NULL DEFAULT NOW(),
+    CONSTRAINT announcements_title_not_empty CHECK (char_length(title) > 0)
+);
+
+CREATE INDEX announcements_course_idx ON announcements (course_id, publish_at DESC);
+CREATE INDEX announcements_scheduled_idx ON announcements (publish_at) WHERE published_at IS NULL;
+
+-- +goose Down
+DROP TABLE announcements;
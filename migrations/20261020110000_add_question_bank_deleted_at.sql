@@ -0,0 +1,17 @@
+-- +goose Up
+-- Попытки тестов ссылаются на вопросы банка через question_ids, а ответы — через quiz_answers.question_id,
+-- поэтому вопросы банка больше не удаляются и не изменяются на месте: удалённый вопрос помечается deleted_at,
+-- а изменение использованного в попытках вопроса создаёт новую строку и помечает старую.
+ALTER TABLE quiz_questions
+    ADD COLUMN deleted_at TIMESTAMP;
+
+DROP INDEX quiz_questions_bank_idx;
+CREATE INDEX quiz_questions_bank_idx ON quiz_questions (instructor_id) WHERE quiz_id IS NULL AND deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX quiz_questions_bank_idx;
+CREATE INDEX quiz_questions_bank_idx ON quiz_questions (instructor_id) WHERE quiz_id IS NULL;
+
+DELETE FROM quiz_questions WHERE deleted_at IS NOT NULL;
+ALTER TABLE quiz_questions
+    DROP COLUMN deleted_at;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Ссылки отписки, выданные раньше этого времени, недействительны: время обновляется, когда студент
+-- снова включает письма, чтобы старая ссылка не отписала его повторно.
+ALTER TABLE notification_settings
+    ADD COLUMN unsubscribe_revoked_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE notification_settings
+    DROP COLUMN unsubscribe_revoked_at;
@@ -21,6 +21,526 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CourseSort int32
+
+const (
+	CourseSort_COURSE_SORT_DEFAULT  CourseSort = 0 // В порядке создания.
+	CourseSort_COURSE_SORT_RATING   CourseSort = 1 // По байесовской оценке, от лучших.
+	CourseSort_COURSE_SORT_TRENDING CourseSort = 2 // По популярности за окно по умолчанию, от популярных.
+)
+
+// Enum value maps for CourseSort.
+var (
+	CourseSort_name = map[int32]string{
+		0: "COURSE_SORT_DEFAULT",
+		1: "COURSE_SORT_RATING",
+		2: "COURSE_SORT_TRENDING",
+	}
+	CourseSort_value = map[string]int32{
+		"COURSE_SORT_DEFAULT":  0,
+		"COURSE_SORT_RATING":   1,
+		"COURSE_SORT_TRENDING": 2,
+	}
+)
+
+func (x CourseSort) Enum() *CourseSort {
+	p := new(CourseSort)
+	*p = x
+	return p
+}
+
+func (x CourseSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CourseSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[0].Descriptor()
+}
+
+func (CourseSort) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[0]
+}
+
+func (x CourseSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CourseSort.Descriptor instead.
+func (CourseSort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{0}
+}
+
+// Тип события просмотра лекции.
+type LectureProgressEventType int32
+
+const (
+	LectureProgressEventType_LECTURE_PROGRESS_EVENT_UNSPECIFIED LectureProgressEventType = 0
+	LectureProgressEventType_LECTURE_PROGRESS_EVENT_STARTED     LectureProgressEventType = 1 // Студент открыл лекцию.
+	LectureProgressEventType_LECTURE_PROGRESS_EVENT_HEARTBEAT   LectureProgressEventType = 2 // Студент продолжает просмотр.
+	LectureProgressEventType_LECTURE_PROGRESS_EVENT_COMPLETED   LectureProgressEventType = 3 // Студент завершил лекцию.
+)
+
+// Enum value maps for LectureProgressEventType.
+var (
+	LectureProgressEventType_name = map[int32]string{
+		0: "LECTURE_PROGRESS_EVENT_UNSPECIFIED",
+		1: "LECTURE_PROGRESS_EVENT_STARTED",
+		2: "LECTURE_PROGRESS_EVENT_HEARTBEAT",
+		3: "LECTURE_PROGRESS_EVENT_COMPLETED",
+	}
+	LectureProgressEventType_value = map[string]int32{
+		"LECTURE_PROGRESS_EVENT_UNSPECIFIED": 0,
+		"LECTURE_PROGRESS_EVENT_STARTED":     1,
+		"LECTURE_PROGRESS_EVENT_HEARTBEAT":   2,
+		"LECTURE_PROGRESS_EVENT_COMPLETED":   3,
+	}
+)
+
+func (x LectureProgressEventType) Enum() *LectureProgressEventType {
+	p := new(LectureProgressEventType)
+	*p = x
+	return p
+}
+
+func (x LectureProgressEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LectureProgressEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[1].Descriptor()
+}
+
+func (LectureProgressEventType) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[1]
+}
+
+func (x LectureProgressEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LectureProgressEventType.Descriptor instead.
+func (LectureProgressEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{1}
+}
+
+// Состояние лекции для студента.
+type LectureProgressStatus int32
+
+const (
+	LectureProgressStatus_LECTURE_PROGRESS_STATUS_NOT_STARTED LectureProgressStatus = 0
+	LectureProgressStatus_LECTURE_PROGRESS_STATUS_IN_PROGRESS LectureProgressStatus = 1
+	LectureProgressStatus_LECTURE_PROGRESS_STATUS_COMPLETED   LectureProgressStatus = 2
+)
+
+// Enum value maps for LectureProgressStatus.
+var (
+	LectureProgressStatus_name = map[int32]string{
+		0: "LECTURE_PROGRESS_STATUS_NOT_STARTED",
+		1: "LECTURE_PROGRESS_STATUS_IN_PROGRESS",
+		2: "LECTURE_PROGRESS_STATUS_COMPLETED",
+	}
+	LectureProgressStatus_value = map[string]int32{
+		"LECTURE_PROGRESS_STATUS_NOT_STARTED": 0,
+		"LECTURE_PROGRESS_STATUS_IN_PROGRESS": 1,
+		"LECTURE_PROGRESS_STATUS_COMPLETED":   2,
+	}
+)
+
+func (x LectureProgressStatus) Enum() *LectureProgressStatus {
+	p := new(LectureProgressStatus)
+	*p = x
+	return p
+}
+
+func (x LectureProgressStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LectureProgressStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[2].Descriptor()
+}
+
+func (LectureProgressStatus) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[2]
+}
+
+func (x LectureProgressStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LectureProgressStatus.Descriptor instead.
+func (LectureProgressStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{2}
+}
+
+// Порядок отзывов курса.
+type ReviewSort int32
+
+const (
+	ReviewSort_REVIEW_SORT_DEFAULT ReviewSort = 0 // В порядке добавления.
+	ReviewSort_REVIEW_SORT_HELPFUL ReviewSort = 1 // Сначала самые полезные.
+	ReviewSort_REVIEW_SORT_NEWEST  ReviewSort = 2 // Сначала новые.
+	ReviewSort_REVIEW_SORT_HIGHEST ReviewSort = 3 // Сначала с высокой оценкой.
+	ReviewSort_REVIEW_SORT_LOWEST  ReviewSort = 4 // Сначала с низкой оценкой.
+)
+
+// Enum value maps for ReviewSort.
+var (
+	ReviewSort_name = map[int32]string{
+		0: "REVIEW_SORT_DEFAULT",
+		1: "REVIEW_SORT_HELPFUL",
+		2: "REVIEW_SORT_NEWEST",
+		3: "REVIEW_SORT_HIGHEST",
+		4: "REVIEW_SORT_LOWEST",
+	}
+	ReviewSort_value = map[string]int32{
+		"REVIEW_SORT_DEFAULT": 0,
+		"REVIEW_SORT_HELPFUL": 1,
+		"REVIEW_SORT_NEWEST":  2,
+		"REVIEW_SORT_HIGHEST": 3,
+		"REVIEW_SORT_LOWEST":  4,
+	}
+)
+
+func (x ReviewSort) Enum() *ReviewSort {
+	p := new(ReviewSort)
+	*p = x
+	return p
+}
+
+func (x ReviewSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[3].Descriptor()
+}
+
+func (ReviewSort) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[3]
+}
+
+func (x ReviewSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewSort.Descriptor instead.
+func (ReviewSort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{3}
+}
+
+// Сообщения, связанные с тестами.
+type QuizQuestionType int32
+
+const (
+	QuizQuestionType_QUIZ_QUESTION_TYPE_UNSPECIFIED     QuizQuestionType = 0
+	QuizQuestionType_QUIZ_QUESTION_TYPE_SINGLE_CHOICE   QuizQuestionType = 1 // Один правильный вариант.
+	QuizQuestionType_QUIZ_QUESTION_TYPE_MULTIPLE_CHOICE QuizQuestionType = 2 // Несколько правильных вариантов.
+	QuizQuestionType_QUIZ_QUESTION_TYPE_TRUE_FALSE      QuizQuestionType = 3 // Верно или неверно.
+	QuizQuestionType_QUIZ_QUESTION_TYPE_NUMERIC         QuizQuestionType = 4 // Числовой ответ с допустимой погрешностью.
+	QuizQuestionType_QUIZ_QUESTION_TYPE_SHORT_TEXT      QuizQuestionType = 5 // Короткий текстовый ответ.
+)
+
+// Enum value maps for QuizQuestionType.
+var (
+	QuizQuestionType_name = map[int32]string{
+		0: "QUIZ_QUESTION_TYPE_UNSPECIFIED",
+		1: "QUIZ_QUESTION_TYPE_SINGLE_CHOICE",
+		2: "QUIZ_QUESTION_TYPE_MULTIPLE_CHOICE",
+		3: "QUIZ_QUESTION_TYPE_TRUE_FALSE",
+		4: "QUIZ_QUESTION_TYPE_NUMERIC",
+		5: "QUIZ_QUESTION_TYPE_SHORT_TEXT",
+	}
+	QuizQuestionType_value = map[string]int32{
+		"QUIZ_QUESTION_TYPE_UNSPECIFIED":     0,
+		"QUIZ_QUESTION_TYPE_SINGLE_CHOICE":   1,
+		"QUIZ_QUESTION_TYPE_MULTIPLE_CHOICE": 2,
+		"QUIZ_QUESTION_TYPE_TRUE_FALSE":      3,
+		"QUIZ_QUESTION_TYPE_NUMERIC":         4,
+		"QUIZ_QUESTION_TYPE_SHORT_TEXT":      5,
+	}
+)
+
+func (x QuizQuestionType) Enum() *QuizQuestionType {
+	p := new(QuizQuestionType)
+	*p = x
+	return p
+}
+
+func (x QuizQuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuizQuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[4].Descriptor()
+}
+
+func (QuizQuestionType) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[4]
+}
+
+func (x QuizQuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuizQuestionType.Descriptor instead.
+func (QuizQuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{4}
+}
+
+type QuestionDifficulty int32
+
+const (
+	QuestionDifficulty_QUESTION_DIFFICULTY_UNSPECIFIED QuestionDifficulty = 0
+	QuestionDifficulty_QUESTION_DIFFICULTY_EASY        QuestionDifficulty = 1 // Лёгкий.
+	QuestionDifficulty_QUESTION_DIFFICULTY_MEDIUM      QuestionDifficulty = 2 // Средний.
+	QuestionDifficulty_QUESTION_DIFFICULTY_HARD        QuestionDifficulty = 3 // Сложный.
+)
+
+// Enum value maps for QuestionDifficulty.
+var (
+	QuestionDifficulty_name = map[int32]string{
+		0: "QUESTION_DIFFICULTY_UNSPECIFIED",
+		1: "QUESTION_DIFFICULTY_EASY",
+		2: "QUESTION_DIFFICULTY_MEDIUM",
+		3: "QUESTION_DIFFICULTY_HARD",
+	}
+	QuestionDifficulty_value = map[string]int32{
+		"QUESTION_DIFFICULTY_UNSPECIFIED": 0,
+		"QUESTION_DIFFICULTY_EASY":        1,
+		"QUESTION_DIFFICULTY_MEDIUM":      2,
+		"QUESTION_DIFFICULTY_HARD":        3,
+	}
+)
+
+func (x QuestionDifficulty) Enum() *QuestionDifficulty {
+	p := new(QuestionDifficulty)
+	*p = x
+	return p
+}
+
+func (x QuestionDifficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionDifficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[5].Descriptor()
+}
+
+func (QuestionDifficulty) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[5]
+}
+
+func (x QuestionDifficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionDifficulty.Descriptor instead.
+func (QuestionDifficulty) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{5}
+}
+
+// Сообщения, связанные с банком вопросов.
+type QuestionBankFormat int32
+
+const (
+	QuestionBankFormat_QUESTION_BANK_FORMAT_UNSPECIFIED QuestionBankFormat = 0
+	QuestionBankFormat_QUESTION_BANK_FORMAT_GIFT        QuestionBankFormat = 1 // Текстовый формат GIFT (Moodle).
+	QuestionBankFormat_QUESTION_BANK_FORMAT_QTI         QuestionBankFormat = 2 // XML в духе IMS QTI.
+)
+
+// Enum value maps for QuestionBankFormat.
+var (
+	QuestionBankFormat_name = map[int32]string{
+		0: "QUESTION_BANK_FORMAT_UNSPECIFIED",
+		1: "QUESTION_BANK_FORMAT_GIFT",
+		2: "QUESTION_BANK_FORMAT_QTI",
+	}
+	QuestionBankFormat_value = map[string]int32{
+		"QUESTION_BANK_FORMAT_UNSPECIFIED": 0,
+		"QUESTION_BANK_FORMAT_GIFT":        1,
+		"QUESTION_BANK_FORMAT_QTI":         2,
+	}
+)
+
+func (x QuestionBankFormat) Enum() *QuestionBankFormat {
+	p := new(QuestionBankFormat)
+	*p = x
+	return p
+}
+
+func (x QuestionBankFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionBankFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[6].Descriptor()
+}
+
+func (QuestionBankFormat) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[6]
+}
+
+func (x QuestionBankFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionBankFormat.Descriptor instead.
+func (QuestionBankFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{6}
+}
+
+// Сообщения, связанные с заданиями.
+type LatePolicy int32
+
+const (
+	LatePolicy_LATE_POLICY_UNSPECIFIED LatePolicy = 0 // Принимать без штрафа.
+	LatePolicy_LATE_POLICY_ACCEPT      LatePolicy = 1 // Принимать без штрафа, отмечая просрочку.
+	LatePolicy_LATE_POLICY_PENALTY     LatePolicy = 2 // Снижать оценку за каждые начатые сутки просрочки.
+	LatePolicy_LATE_POLICY_REJECT      LatePolicy = 3 // Не принимать решения после срока.
+)
+
+// Enum value maps for LatePolicy.
+var (
+	LatePolicy_name = map[int32]string{
+		0: "LATE_POLICY_UNSPECIFIED",
+		1: "LATE_POLICY_ACCEPT",
+		2: "LATE_POLICY_PENALTY",
+		3: "LATE_POLICY_REJECT",
+	}
+	LatePolicy_value = map[string]int32{
+		"LATE_POLICY_UNSPECIFIED": 0,
+		"LATE_POLICY_ACCEPT":      1,
+		"LATE_POLICY_PENALTY":     2,
+		"LATE_POLICY_REJECT":      3,
+	}
+)
+
+func (x LatePolicy) Enum() *LatePolicy {
+	p := new(LatePolicy)
+	*p = x
+	return p
+}
+
+func (x LatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[7].Descriptor()
+}
+
+func (LatePolicy) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[7]
+}
+
+func (x LatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LatePolicy.Descriptor instead.
+func (LatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{7}
+}
+
+type GradeItemType int32
+
+const (
+	GradeItemType_GRADE_ITEM_TYPE_UNSPECIFIED GradeItemType = 0
+	GradeItemType_GRADE_ITEM_TYPE_QUIZ        GradeItemType = 1 // Тест; оценка — лучший процент среди завершённых попыток.
+	GradeItemType_GRADE_ITEM_TYPE_ASSIGNMENT  GradeItemType = 2 // Задание; оценка — баллы последней проверенной версии решения.
+	GradeItemType_GRADE_ITEM_TYPE_FINAL       GradeItemType = 3 // Итоговая оценка за курс в процентах (только для ручного изменения).
+)
+
+// Enum value maps for GradeItemType.
+var (
+	GradeItemType_name = map[int32]string{
+		0: "GRADE_ITEM_TYPE_UNSPECIFIED",
+		1: "GRADE_ITEM_TYPE_QUIZ",
+		2: "GRADE_ITEM_TYPE_ASSIGNMENT",
+		3: "GRADE_ITEM_TYPE_FINAL",
+	}
+	GradeItemType_value = map[string]int32{
+		"GRADE_ITEM_TYPE_UNSPECIFIED": 0,
+		"GRADE_ITEM_TYPE_QUIZ":        1,
+		"GRADE_ITEM_TYPE_ASSIGNMENT":  2,
+		"GRADE_ITEM_TYPE_FINAL":       3,
+	}
+)
+
+func (x GradeItemType) Enum() *GradeItemType {
+	p := new(GradeItemType)
+	*p = x
+	return p
+}
+
+func (x GradeItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradeItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[8].Descriptor()
+}
+
+func (GradeItemType) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[8]
+}
+
+func (x GradeItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradeItemType.Descriptor instead.
+func (GradeItemType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{8}
+}
+
+type GradebookFormat int32
+
+const (
+	GradebookFormat_GRADEBOOK_FORMAT_CSV  GradebookFormat = 0
+	GradebookFormat_GRADEBOOK_FORMAT_XLSX GradebookFormat = 1
+)
+
+// Enum value maps for GradebookFormat.
+var (
+	GradebookFormat_name = map[int32]string{
+		0: "GRADEBOOK_FORMAT_CSV",
+		1: "GRADEBOOK_FORMAT_XLSX",
+	}
+	GradebookFormat_value = map[string]int32{
+		"GRADEBOOK_FORMAT_CSV":  0,
+		"GRADEBOOK_FORMAT_XLSX": 1,
+	}
+)
+
+func (x GradebookFormat) Enum() *GradebookFormat {
+	p := new(GradebookFormat)
+	*p = x
+	return p
+}
+
+func (x GradebookFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GradebookFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_education_proto_enumTypes[9].Descriptor()
+}
+
+func (GradebookFormat) Type() protoreflect.EnumType {
+	return &file_proto_education_proto_enumTypes[9]
+}
+
+func (x GradebookFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GradebookFormat.Descriptor instead.
+func (GradebookFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{9}
+}
+
 // Сообщение для пустых ответов.
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -149,39 +669,10310 @@ func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
 	return file_proto_education_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *HealthCheckResponse) GetStatus() bool {
+func (x *HealthCheckResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+// Сообщения, связанные с курсами.
+type Course struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID курса.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // Название курса.
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                        // Описание курса.
+	InstructorId  int64                  `protobuf:"varint,4,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
+	Sequential    bool                   `protobuf:"varint,5,opt,name=sequential,proto3" json:"sequential,omitempty"`                         // Лекции курса проходятся строго по порядку.
+	Rating        *CourseRating          `protobuf:"bytes,6,opt,name=rating,proto3" json:"rating,omitempty"`                                  // Рейтинг курса по отзывам студентов.
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`                              // Категория курса.
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                      // Теги курса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Course) Reset() {
+	*x = Course{}
+	mi := &file_proto_education_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Course) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Course) ProtoMessage() {}
+
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Course) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Course) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Course) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Course) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *Course) GetSequential() bool {
+	if x != nil {
+		return x.Sequential
+	}
+	return false
+}
+
+func (x *Course) GetRating() *CourseRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *Course) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Course) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CourseTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // Теги курса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseTags) Reset() {
+	*x = CourseTags{}
+	mi := &file_proto_education_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseTags) ProtoMessage() {}
+
+func (x *CourseTags) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseTags.ProtoReflect.Descriptor instead.
+func (*CourseTags) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CourseTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CourseRecommendation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Course          *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`                                             // Рекомендованный курс.
+	Score           float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`                                             // Оценка релевантности от 0 до 1.
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                             // Пояснение, почему курс рекомендован.
+	BecauseCourseId int64                  `protobuf:"varint,4,opt,name=because_course_id,json=becauseCourseId,proto3" json:"because_course_id,omitempty"` // Курс студента, на основе которого сделана рекомендация; 0 — популярный курс.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CourseRecommendation) Reset() {
+	*x = CourseRecommendation{}
+	mi := &file_proto_education_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRecommendation) ProtoMessage() {}
+
+func (x *CourseRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRecommendation.ProtoReflect.Descriptor instead.
+func (*CourseRecommendation) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CourseRecommendation) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *CourseRecommendation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CourseRecommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CourseRecommendation) GetBecauseCourseId() int64 {
+	if x != nil {
+		return x.BecauseCourseId
+	}
+	return 0
+}
+
+type RecommendationList struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Recommendations []*CourseRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"` // Рекомендации, начиная с самой релевантной.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecommendationList) Reset() {
+	*x = RecommendationList{}
+	mi := &file_proto_education_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationList) ProtoMessage() {}
+
+func (x *RecommendationList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationList.ProtoReflect.Descriptor instead.
+func (*RecommendationList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecommendationList) GetRecommendations() []*CourseRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type CourseRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Average       float64                `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`                 // Средняя оценка, 0 — отзывов нет.
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                      // Число отзывов.
+	Distribution  []int32                `protobuf:"varint,3,rep,packed,name=distribution,proto3" json:"distribution,omitempty"` // Число отзывов с оценками 1–5 (по порядку).
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`                     // Байесовская оценка: средняя, сглаженная к средней по всем курсам; используется для сортировки.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseRating) Reset() {
+	*x = CourseRating{}
+	mi := &file_proto_education_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRating) ProtoMessage() {}
+
+func (x *CourseRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRating.ProtoReflect.Descriptor instead.
+func (*CourseRating) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CourseRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *CourseRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CourseRating) GetDistribution() []int32 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *CourseRating) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type CoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          CourseSort             `protobuf:"varint,1,opt,name=sort,proto3,enum=GoEdu.CourseSort" json:"sort,omitempty"` // Порядок курсов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoursesRequest) Reset() {
+	*x = CoursesRequest{}
+	mi := &file_proto_education_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursesRequest) ProtoMessage() {}
+
+func (x *CoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursesRequest.ProtoReflect.Descriptor instead.
+func (*CoursesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CoursesRequest) GetSort() CourseSort {
+	if x != nil {
+		return x.Sort
+	}
+	return CourseSort_COURSE_SORT_DEFAULT
+}
+
+type CourseList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"` // Список курсов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseList) Reset() {
+	*x = CourseList{}
+	mi := &file_proto_education_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseList) ProtoMessage() {}
+
+func (x *CourseList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseList.ProtoReflect.Descriptor instead.
+func (*CourseList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CourseList) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type SimilarCoursesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Число курсов; 0 — 5, не больше 20.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarCoursesRequest) Reset() {
+	*x = SimilarCoursesRequest{}
+	mi := &file_proto_education_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarCoursesRequest) ProtoMessage() {}
+
+func (x *SimilarCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarCoursesRequest.ProtoReflect.Descriptor instead.
+func (*SimilarCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SimilarCoursesRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SimilarCoursesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SimilarCourse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // Близость текстов курсов от 0 до 1.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarCourse) Reset() {
+	*x = SimilarCourse{}
+	mi := &file_proto_education_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarCourse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarCourse) ProtoMessage() {}
+
+func (x *SimilarCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarCourse.ProtoReflect.Descriptor instead.
+func (*SimilarCourse) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SimilarCourse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *SimilarCourse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SimilarCourseList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*SimilarCourse       `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"` // От самых похожих.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarCourseList) Reset() {
+	*x = SimilarCourseList{}
+	mi := &file_proto_education_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarCourseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarCourseList) ProtoMessage() {}
+
+func (x *SimilarCourseList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarCourseList.ProtoReflect.Descriptor instead.
+func (*SimilarCourseList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SimilarCourseList) GetCourses() []*SimilarCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type TrendingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowDays    int32                  `protobuf:"varint,1,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"` // Окно в днях из настроенных; 0 — окно по умолчанию.
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                             // Число курсов; 0 — 10, не больше 100.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingRequest) Reset() {
+	*x = TrendingRequest{}
+	mi := &file_proto_education_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingRequest) ProtoMessage() {}
+
+func (x *TrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingRequest.ProtoReflect.Descriptor instead.
+func (*TrendingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TrendingRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *TrendingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingCourse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	Rank          int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`                                         // Место в рейтинге, начиная с 1.
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`                                      // Оценка популярности с учётом давности событий.
+	Enrollments   int32                  `protobuf:"varint,4,opt,name=enrollments,proto3" json:"enrollments,omitempty"`                           // Записей за окно.
+	Completions   int32                  `protobuf:"varint,5,opt,name=completions,proto3" json:"completions,omitempty"`                           // Завершений за окно.
+	Reviews       int32                  `protobuf:"varint,6,opt,name=reviews,proto3" json:"reviews,omitempty"`                                   // Опубликованных отзывов за окно.
+	AverageRating float64                `protobuf:"fixed64,7,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"` // Средняя оценка отзывов за окно, 0 — отзывов нет.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingCourse) Reset() {
+	*x = TrendingCourse{}
+	mi := &file_proto_education_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingCourse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingCourse) ProtoMessage() {}
+
+func (x *TrendingCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingCourse.ProtoReflect.Descriptor instead.
+func (*TrendingCourse) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TrendingCourse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *TrendingCourse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TrendingCourse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendingCourse) GetEnrollments() int32 {
+	if x != nil {
+		return x.Enrollments
+	}
+	return 0
+}
+
+func (x *TrendingCourse) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *TrendingCourse) GetReviews() int32 {
+	if x != nil {
+		return x.Reviews
+	}
+	return 0
+}
+
+func (x *TrendingCourse) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+type TrendingCourseList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowDays    int32                  `protobuf:"varint,1,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	ComputedAt    string                 `protobuf:"bytes,2,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"` // Время последнего пересчёта (RFC3339), пусто — рейтинг ещё не рассчитан.
+	Courses       []*TrendingCourse      `protobuf:"bytes,3,rep,name=courses,proto3" json:"courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingCourseList) Reset() {
+	*x = TrendingCourseList{}
+	mi := &file_proto_education_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingCourseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingCourseList) ProtoMessage() {}
+
+func (x *TrendingCourseList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingCourseList.ProtoReflect.Descriptor instead.
+func (*TrendingCourseList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrendingCourseList) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *TrendingCourseList) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+func (x *TrendingCourseList) GetCourses() []*TrendingCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type CourseIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseIDRequest) Reset() {
+	*x = CourseIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseIDRequest) ProtoMessage() {}
+
+func (x *CourseIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseIDRequest.ProtoReflect.Descriptor instead.
+func (*CourseIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CourseIDRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+type NewCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Название нового курса.
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                        // Описание нового курса.
+	InstructorId  int64                  `protobuf:"varint,3,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
+	Sequential    bool                   `protobuf:"varint,4,opt,name=sequential,proto3" json:"sequential,omitempty"`                         // Лекции курса проходятся строго по порядку.
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                              // Категория курса.
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                      // Теги курса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewCourseRequest) Reset() {
+	*x = NewCourseRequest{}
+	mi := &file_proto_education_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewCourseRequest) ProtoMessage() {}
+
+func (x *NewCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewCourseRequest.ProtoReflect.Descriptor instead.
+func (*NewCourseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NewCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NewCourseRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *NewCourseRequest) GetSequential() bool {
+	if x != nil {
+		return x.Sequential
+	}
+	return false
+}
+
+func (x *NewCourseRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NewCourseRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                       // ID курса для обновления.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // Новое название курса.
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`      // Новое описание курса.
+	Sequential    *bool                  `protobuf:"varint,4,opt,name=sequential,proto3,oneof" json:"sequential,omitempty"` // Включить или выключить последовательное прохождение; не задано — без изменений.
+	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`      // Новая категория курса; не задана — без изменений.
+	Tags          *CourseTags            `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`                    // Новый набор тегов; не задан — без изменений.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseRequest) Reset() {
+	*x = UpdateCourseRequest{}
+	mi := &file_proto_education_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseRequest) ProtoMessage() {}
+
+func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateCourseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetSequential() bool {
+	if x != nil && x.Sequential != nil {
+		return *x.Sequential
+	}
+	return false
+}
+
+func (x *UpdateCourseRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetTags() *CourseTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Сообщения, связанные с регистрацией студентов.
+type RegisterStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // Имя студента.
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`       // Email студента.
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // Пароль студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterStudentRequest) Reset() {
+	*x = RegisterStudentRequest{}
+	mi := &file_proto_education_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterStudentRequest) ProtoMessage() {}
+
+func (x *RegisterStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterStudentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterStudentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RegisterStudentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterStudentRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterStudentRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type Student struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID студента.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // Имя студента.
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // Email студента.
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"` // Токен авторизации студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Student) Reset() {
+	*x = Student{}
+	mi := &file_proto_education_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Student) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Student) ProtoMessage() {}
+
+func (x *Student) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Student.ProtoReflect.Descriptor instead.
+func (*Student) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Student) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Student) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Student) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Student) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`       // Email для входа.
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // Пароль для входа.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_proto_education_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AuthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // Имя.
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // Email.
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"` // Токен авторизации.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	mi := &file_proto_education_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AuthResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuthResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type StudentIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentIDRequest) Reset() {
+	*x = StudentIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentIDRequest) ProtoMessage() {}
+
+func (x *StudentIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentIDRequest.ProtoReflect.Descriptor instead.
+func (*StudentIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StudentIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // ID студента для обновления.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // Новое имя студента.
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`       // Новый email студента.
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"` // Новый пароль студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStudentRequest) Reset() {
+	*x = UpdateStudentRequest{}
+	mi := &file_proto_education_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStudentRequest) ProtoMessage() {}
+
+func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateStudentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStudentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Сообщения для управления записями.
+type EnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
+	mi := &file_proto_education_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentRequest) ProtoMessage() {}
+
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EnrollmentRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *EnrollmentRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+type StudentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"` // Список студентов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentList) Reset() {
+	*x = StudentList{}
+	mi := &file_proto_education_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentList) ProtoMessage() {}
+
+func (x *StudentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentList.ProtoReflect.Descriptor instead.
+func (*StudentList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StudentList) GetStudents() []*Student {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+// Запрос на массовую запись студентов.
+type BulkEnrollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`               // ID курса.
+	Csv           []byte                 `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`                                          // Содержимое CSV: email, name.
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                     // Только проверить файл, ничего не записывая.
+	AllOrNothing  bool                   `protobuf:"varint,4,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"` // Откатить всю загрузку при ошибке в любой строке.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkEnrollRequest) Reset() {
+	*x = BulkEnrollRequest{}
+	mi := &file_proto_education_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkEnrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEnrollRequest) ProtoMessage() {}
+
+func (x *BulkEnrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEnrollRequest.ProtoReflect.Descriptor instead.
+func (*BulkEnrollRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BulkEnrollRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *BulkEnrollRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *BulkEnrollRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkEnrollRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+// Результат обработки одной строки CSV.
+type BulkEnrollRowResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Line            int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`                                              // Номер строки в файле.
+	Email           string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                             // Email студента.
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                               // Имя студента.
+	StudentId       int64                  `protobuf:"varint,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                   // ID студента (0 при dry_run для новых студентов).
+	StudentCreated  bool                   `protobuf:"varint,5,opt,name=student_created,json=studentCreated,proto3" json:"student_created,omitempty"`    // Создан новый аккаунт студента.
+	Enrolled        bool                   `protobuf:"varint,6,opt,name=enrolled,proto3" json:"enrolled,omitempty"`                                      // Студент записан на курс этой загрузкой.
+	AlreadyEnrolled bool                   `protobuf:"varint,7,opt,name=already_enrolled,json=alreadyEnrolled,proto3" json:"already_enrolled,omitempty"` // Студент уже был записан на курс.
+	Error           string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                                             // Описание ошибки, если строка не обработана.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BulkEnrollRowResult) Reset() {
+	*x = BulkEnrollRowResult{}
+	mi := &file_proto_education_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkEnrollRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEnrollRowResult) ProtoMessage() {}
+
+func (x *BulkEnrollRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEnrollRowResult.ProtoReflect.Descriptor instead.
+func (*BulkEnrollRowResult) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkEnrollRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *BulkEnrollRowResult) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BulkEnrollRowResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BulkEnrollRowResult) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *BulkEnrollRowResult) GetStudentCreated() bool {
+	if x != nil {
+		return x.StudentCreated
+	}
+	return false
+}
+
+func (x *BulkEnrollRowResult) GetEnrolled() bool {
+	if x != nil {
+		return x.Enrolled
+	}
+	return false
+}
+
+func (x *BulkEnrollRowResult) GetAlreadyEnrolled() bool {
+	if x != nil {
+		return x.AlreadyEnrolled
+	}
+	return false
+}
+
+func (x *BulkEnrollRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkEnrollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                // ID курса.
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                      // Загрузка выполнена в режиме проверки.
+	Committed     bool                   `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`                              // Изменения сохранены в базе.
+	TotalRows     int32                  `protobuf:"varint,4,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`             // Количество строк с данными.
+	EnrolledCount int32                  `protobuf:"varint,5,opt,name=enrolled_count,json=enrolledCount,proto3" json:"enrolled_count,omitempty"` // Количество новых записей на курс.
+	CreatedCount  int32                  `protobuf:"varint,6,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`    // Количество созданных аккаунтов.
+	FailedCount   int32                  `protobuf:"varint,7,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`       // Количество строк с ошибками.
+	Rows          []*BulkEnrollRowResult `protobuf:"bytes,8,rep,name=rows,proto3" json:"rows,omitempty"`                                         // Результаты по строкам.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkEnrollResponse) Reset() {
+	*x = BulkEnrollResponse{}
+	mi := &file_proto_education_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkEnrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkEnrollResponse) ProtoMessage() {}
+
+func (x *BulkEnrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkEnrollResponse.ProtoReflect.Descriptor instead.
+func (*BulkEnrollResponse) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BulkEnrollResponse) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *BulkEnrollResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkEnrollResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *BulkEnrollResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *BulkEnrollResponse) GetEnrolledCount() int32 {
+	if x != nil {
+		return x.EnrolledCount
+	}
+	return 0
+}
+
+func (x *BulkEnrollResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *BulkEnrollResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BulkEnrollResponse) GetRows() []*BulkEnrollRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Запрос на принятие приглашения.
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // Токен из письма-приглашения.
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // Новый пароль студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_proto_education_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Сообщения для управления лекциями.
+type LectureRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                                 // ID курса, к которому относится лекция.
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                                        // Название лекции.
+	Content          string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                                    // Содержание лекции.
+	CohortOffsetDays *int32                 `protobuf:"varint,4,opt,name=cohort_offset_days,json=cohortOffsetDays,proto3,oneof" json:"cohort_offset_days,omitempty"` // Через сколько дней после старта потока открывается лекция.
+	ReleaseAt        string                 `protobuf:"bytes,5,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`                               // Дата открытия лекции (RFC3339 или YYYY-MM-DD), пусто — без ограничения.
+	ReleaseAfterDays *int32                 `protobuf:"varint,6,opt,name=release_after_days,json=releaseAfterDays,proto3,oneof" json:"release_after_days,omitempty"` // Через сколько дней после записи студента открывается лекция.
+	Position         *int32                 `protobuf:"varint,7,opt,name=position,proto3,oneof" json:"position,omitempty"`                                           // Позиция лекции в курсе; не задана — лекция добавляется в конец.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LectureRequest) Reset() {
+	*x = LectureRequest{}
+	mi := &file_proto_education_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureRequest) ProtoMessage() {}
+
+func (x *LectureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureRequest.ProtoReflect.Descriptor instead.
+func (*LectureRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LectureRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *LectureRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LectureRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *LectureRequest) GetCohortOffsetDays() int32 {
+	if x != nil && x.CohortOffsetDays != nil {
+		return *x.CohortOffsetDays
+	}
+	return 0
+}
+
+func (x *LectureRequest) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+func (x *LectureRequest) GetReleaseAfterDays() int32 {
+	if x != nil && x.ReleaseAfterDays != nil {
+		return *x.ReleaseAfterDays
+	}
+	return 0
+}
+
+func (x *LectureRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type Lecture struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                // ID лекции.
+	CourseId           int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                                    // ID курса.
+	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                                           // Название лекции.
+	Content            string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                                                       // Содержание лекции.
+	CohortOffsetDays   *int32                 `protobuf:"varint,5,opt,name=cohort_offset_days,json=cohortOffsetDays,proto3,oneof" json:"cohort_offset_days,omitempty"`    // Смещение открытия лекции относительно старта потока (в днях).
+	ReleaseAt          string                 `protobuf:"bytes,6,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`                                  // Дата открытия лекции (RFC3339).
+	ReleaseAfterDays   *int32                 `protobuf:"varint,7,opt,name=release_after_days,json=releaseAfterDays,proto3,oneof" json:"release_after_days,omitempty"`    // Через сколько дней после записи студента открывается лекция.
+	Locked             bool                   `protobuf:"varint,8,opt,name=locked,proto3" json:"locked,omitempty"`                                                        // Лекция ещё закрыта для текущего пользователя, содержание не передаётся.
+	AvailableAt        string                 `protobuf:"bytes,9,opt,name=available_at,json=availableAt,proto3" json:"available_at,omitempty"`                            // Когда лекция откроется для текущего пользователя (RFC3339), если известно.
+	Position           int32                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`                                                   // Позиция лекции в курсе.
+	BlockedByLectureId int64                  `protobuf:"varint,11,opt,name=blocked_by_lecture_id,json=blockedByLectureId,proto3" json:"blocked_by_lecture_id,omitempty"` // Лекция, которую нужно завершить перед этой (для последовательных курсов).
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Lecture) Reset() {
+	*x = Lecture{}
+	mi := &file_proto_education_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lecture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lecture) ProtoMessage() {}
+
+func (x *Lecture) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lecture.ProtoReflect.Descriptor instead.
+func (*Lecture) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Lecture) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Lecture) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Lecture) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Lecture) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Lecture) GetCohortOffsetDays() int32 {
+	if x != nil && x.CohortOffsetDays != nil {
+		return *x.CohortOffsetDays
+	}
+	return 0
+}
+
+func (x *Lecture) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+func (x *Lecture) GetReleaseAfterDays() int32 {
+	if x != nil && x.ReleaseAfterDays != nil {
+		return *x.ReleaseAfterDays
+	}
+	return 0
+}
+
+func (x *Lecture) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Lecture) GetAvailableAt() string {
+	if x != nil {
+		return x.AvailableAt
+	}
+	return ""
+}
+
+func (x *Lecture) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Lecture) GetBlockedByLectureId() int64 {
+	if x != nil {
+		return x.BlockedByLectureId
+	}
+	return 0
+}
+
+type LectureList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lectures      []*Lecture             `protobuf:"bytes,1,rep,name=lectures,proto3" json:"lectures,omitempty"` // Список лекций.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LectureList) Reset() {
+	*x = LectureList{}
+	mi := &file_proto_education_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureList) ProtoMessage() {}
+
+func (x *LectureList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureList.ProtoReflect.Descriptor instead.
+func (*LectureList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LectureList) GetLectures() []*Lecture {
+	if x != nil {
+		return x.Lectures
+	}
+	return nil
+}
+
+type LectureIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LectureId     int64                  `protobuf:"varint,1,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"` // ID лекции.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LectureIDRequest) Reset() {
+	*x = LectureIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureIDRequest) ProtoMessage() {}
+
+func (x *LectureIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureIDRequest.ProtoReflect.Descriptor instead.
+func (*LectureIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LectureIDRequest) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+type LectureContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID лекции.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                        // Название лекции.
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                    // Содержание лекции.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LectureContent) Reset() {
+	*x = LectureContent{}
+	mi := &file_proto_education_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureContent) ProtoMessage() {}
+
+func (x *LectureContent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureContent.ProtoReflect.Descriptor instead.
+func (*LectureContent) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LectureContent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LectureContent) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *LectureContent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LectureContent) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateLectureRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                             // ID лекции для обновления.
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                                        // Новое название лекции.
+	Content           string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                                    // Новое содержание лекции.
+	CohortOffsetDays  *int32                 `protobuf:"varint,4,opt,name=cohort_offset_days,json=cohortOffsetDays,proto3,oneof" json:"cohort_offset_days,omitempty"` // Новое смещение открытия лекции относительно старта потока.
+	ReleaseAt         string                 `protobuf:"bytes,5,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`                               // Новая дата открытия лекции (RFC3339 или YYYY-MM-DD).
+	ReleaseAfterDays  *int32                 `protobuf:"varint,6,opt,name=release_after_days,json=releaseAfterDays,proto3,oneof" json:"release_after_days,omitempty"` // Новое количество дней после записи до открытия лекции.
+	ClearReleaseRules bool                   `protobuf:"varint,7,opt,name=clear_release_rules,json=clearReleaseRules,proto3" json:"clear_release_rules,omitempty"`    // Заменить правила открытия переданными в запросе; незаданные правила снимаются.
+	Position          *int32                 `protobuf:"varint,8,opt,name=position,proto3,oneof" json:"position,omitempty"`                                           // Новая позиция лекции в курсе.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateLectureRequest) Reset() {
+	*x = UpdateLectureRequest{}
+	mi := &file_proto_education_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLectureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLectureRequest) ProtoMessage() {}
+
+func (x *UpdateLectureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLectureRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLectureRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateLectureRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateLectureRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateLectureRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateLectureRequest) GetCohortOffsetDays() int32 {
+	if x != nil && x.CohortOffsetDays != nil {
+		return *x.CohortOffsetDays
+	}
+	return 0
+}
+
+func (x *UpdateLectureRequest) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+func (x *UpdateLectureRequest) GetReleaseAfterDays() int32 {
+	if x != nil && x.ReleaseAfterDays != nil {
+		return *x.ReleaseAfterDays
+	}
+	return 0
+}
+
+func (x *UpdateLectureRequest) GetClearReleaseRules() bool {
+	if x != nil {
+		return x.ClearReleaseRules
+	}
+	return false
+}
+
+func (x *UpdateLectureRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type LectureCompletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
+	LectureId     int64                  `protobuf:"varint,2,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"` // ID лекции.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LectureCompletionRequest) Reset() {
+	*x = LectureCompletionRequest{}
+	mi := &file_proto_education_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureCompletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureCompletionRequest) ProtoMessage() {}
+
+func (x *LectureCompletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureCompletionRequest.ProtoReflect.Descriptor instead.
+func (*LectureCompletionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LectureCompletionRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *LectureCompletionRequest) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+type CourseProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseProgressRequest) Reset() {
+	*x = CourseProgressRequest{}
+	mi := &file_proto_education_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseProgressRequest) ProtoMessage() {}
+
+func (x *CourseProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseProgressRequest.ProtoReflect.Descriptor instead.
+func (*CourseProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CourseProgressRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *CourseProgressRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+type CourseProgress struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                         // ID курса.
+	StudentId        int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                      // ID студента.
+	CompletedPercent int32                  `protobuf:"varint,3,opt,name=completed_percent,json=completedPercent,proto3" json:"completed_percent,omitempty"` // Процент завершения курса.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CourseProgress) Reset() {
+	*x = CourseProgress{}
+	mi := &file_proto_education_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseProgress) ProtoMessage() {}
+
+func (x *CourseProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseProgress.ProtoReflect.Descriptor instead.
+func (*CourseProgress) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CourseProgress) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetCompletedPercent() int32 {
+	if x != nil {
+		return x.CompletedPercent
+	}
+	return 0
+}
+
+type LectureProgressEvent struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	StudentId       int64                    `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                   // ID студента.
+	LectureId       int64                    `protobuf:"varint,2,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"`                   // ID лекции.
+	Event           LectureProgressEventType `protobuf:"varint,3,opt,name=event,proto3,enum=GoEdu.LectureProgressEventType" json:"event,omitempty"`        // Тип события.
+	PositionSeconds int32                    `protobuf:"varint,4,opt,name=position_seconds,json=positionSeconds,proto3" json:"position_seconds,omitempty"` // Текущая позиция просмотра в секундах.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LectureProgressEvent) Reset() {
+	*x = LectureProgressEvent{}
+	mi := &file_proto_education_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureProgressEvent) ProtoMessage() {}
+
+func (x *LectureProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureProgressEvent.ProtoReflect.Descriptor instead.
+func (*LectureProgressEvent) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LectureProgressEvent) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *LectureProgressEvent) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+func (x *LectureProgressEvent) GetEvent() LectureProgressEventType {
+	if x != nil {
+		return x.Event
+	}
+	return LectureProgressEventType_LECTURE_PROGRESS_EVENT_UNSPECIFIED
+}
+
+func (x *LectureProgressEvent) GetPositionSeconds() int32 {
+	if x != nil {
+		return x.PositionSeconds
+	}
+	return 0
+}
+
+type LectureProgress struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	LectureId           int64                  `protobuf:"varint,1,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"`                                 // ID лекции.
+	Title               string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                                           // Название лекции.
+	Position            int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`                                                    // Позиция лекции в курсе.
+	Status              LectureProgressStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=GoEdu.LectureProgressStatus" json:"status,omitempty"`                       // Состояние лекции.
+	TimeSpentSeconds    int64                  `protobuf:"varint,5,opt,name=time_spent_seconds,json=timeSpentSeconds,proto3" json:"time_spent_seconds,omitempty"`          // Время, проведённое в лекции, в секундах.
+	LastPositionSeconds int32                  `protobuf:"varint,6,opt,name=last_position_seconds,json=lastPositionSeconds,proto3" json:"last_position_seconds,omitempty"` // Последняя позиция просмотра в секундах.
+	LastActivityAt      string                 `protobuf:"bytes,7,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`                 // Время последней активности (RFC3339), пусто — лекция не открывалась.
+	CompletedAt         string                 `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`                            // Время завершения (RFC3339), пусто — лекция не завершена.
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LectureProgress) Reset() {
+	*x = LectureProgress{}
+	mi := &file_proto_education_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureProgress) ProtoMessage() {}
+
+func (x *LectureProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureProgress.ProtoReflect.Descriptor instead.
+func (*LectureProgress) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LectureProgress) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+func (x *LectureProgress) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LectureProgress) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LectureProgress) GetStatus() LectureProgressStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LectureProgressStatus_LECTURE_PROGRESS_STATUS_NOT_STARTED
+}
+
+func (x *LectureProgress) GetTimeSpentSeconds() int64 {
+	if x != nil {
+		return x.TimeSpentSeconds
+	}
+	return 0
+}
+
+func (x *LectureProgress) GetLastPositionSeconds() int32 {
+	if x != nil {
+		return x.LastPositionSeconds
+	}
+	return 0
+}
+
+func (x *LectureProgress) GetLastActivityAt() string {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return ""
+}
+
+func (x *LectureProgress) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type DetailedCourseProgress struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CourseId              int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                                            // ID курса.
+	StudentId             int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                                         // ID студента.
+	CompletedPercent      int32                  `protobuf:"varint,3,opt,name=completed_percent,json=completedPercent,proto3" json:"completed_percent,omitempty"`                    // Процент завершения курса.
+	CompletedLectures     int32                  `protobuf:"varint,4,opt,name=completed_lectures,json=completedLectures,proto3" json:"completed_lectures,omitempty"`                 // Количество завершённых лекций.
+	TotalLectures         int32                  `protobuf:"varint,5,opt,name=total_lectures,json=totalLectures,proto3" json:"total_lectures,omitempty"`                             // Общее количество лекций.
+	TotalTimeSpentSeconds int64                  `protobuf:"varint,6,opt,name=total_time_spent_seconds,json=totalTimeSpentSeconds,proto3" json:"total_time_spent_seconds,omitempty"` // Общее время обучения по курсу в секундах.
+	LastActivityAt        string                 `protobuf:"bytes,7,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`                         // Время последней активности по курсу (RFC3339).
+	ResumeLectureId       int64                  `protobuf:"varint,8,opt,name=resume_lecture_id,json=resumeLectureId,proto3" json:"resume_lecture_id,omitempty"`                     // Лекция, с которой стоит продолжить обучение; 0 — курс пройден.
+	Lectures              []*LectureProgress     `protobuf:"bytes,9,rep,name=lectures,proto3" json:"lectures,omitempty"`                                                             // Прогресс по лекциям в порядке прохождения.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DetailedCourseProgress) Reset() {
+	*x = DetailedCourseProgress{}
+	mi := &file_proto_education_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailedCourseProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailedCourseProgress) ProtoMessage() {}
+
+func (x *DetailedCourseProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailedCourseProgress.ProtoReflect.Descriptor instead.
+func (*DetailedCourseProgress) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DetailedCourseProgress) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *DetailedCourseProgress) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *DetailedCourseProgress) GetCompletedPercent() int32 {
+	if x != nil {
+		return x.CompletedPercent
+	}
+	return 0
+}
+
+func (x *DetailedCourseProgress) GetCompletedLectures() int32 {
+	if x != nil {
+		return x.CompletedLectures
+	}
+	return 0
+}
+
+func (x *DetailedCourseProgress) GetTotalLectures() int32 {
+	if x != nil {
+		return x.TotalLectures
+	}
+	return 0
+}
+
+func (x *DetailedCourseProgress) GetTotalTimeSpentSeconds() int64 {
+	if x != nil {
+		return x.TotalTimeSpentSeconds
+	}
+	return 0
+}
+
+func (x *DetailedCourseProgress) GetLastActivityAt() string {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return ""
+}
+
+func (x *DetailedCourseProgress) GetResumeLectureId() int64 {
+	if x != nil {
+		return x.ResumeLectureId
+	}
+	return 0
+}
+
+func (x *DetailedCourseProgress) GetLectures() []*LectureProgress {
+	if x != nil {
+		return x.Lectures
+	}
+	return nil
+}
+
+type CourseProgressSummary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CourseId          int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                            // ID курса.
+	CourseName        string                 `protobuf:"bytes,2,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`                       // Название курса.
+	CompletedPercent  int32                  `protobuf:"varint,3,opt,name=completed_percent,json=completedPercent,proto3" json:"completed_percent,omitempty"`    // Процент завершения курса.
+	CompletedLectures int32                  `protobuf:"varint,4,opt,name=completed_lectures,json=completedLectures,proto3" json:"completed_lectures,omitempty"` // Количество завершённых лекций.
+	TotalLectures     int32                  `protobuf:"varint,5,opt,name=total_lectures,json=totalLectures,proto3" json:"total_lectures,omitempty"`             // Общее количество лекций.
+	TimeSpentSeconds  int64                  `protobuf:"varint,6,opt,name=time_spent_seconds,json=timeSpentSeconds,proto3" json:"time_spent_seconds,omitempty"`  // Время обучения по курсу в секундах.
+	LastActivityAt    string                 `protobuf:"bytes,7,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`         // Время последней активности по курсу (RFC3339).
+	ResumeLectureId   int64                  `protobuf:"varint,8,opt,name=resume_lecture_id,json=resumeLectureId,proto3" json:"resume_lecture_id,omitempty"`     // Лекция, с которой стоит продолжить обучение; 0 — курс пройден.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CourseProgressSummary) Reset() {
+	*x = CourseProgressSummary{}
+	mi := &file_proto_education_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseProgressSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseProgressSummary) ProtoMessage() {}
+
+func (x *CourseProgressSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseProgressSummary.ProtoReflect.Descriptor instead.
+func (*CourseProgressSummary) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CourseProgressSummary) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CourseProgressSummary) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *CourseProgressSummary) GetCompletedPercent() int32 {
+	if x != nil {
+		return x.CompletedPercent
+	}
+	return 0
+}
+
+func (x *CourseProgressSummary) GetCompletedLectures() int32 {
+	if x != nil {
+		return x.CompletedLectures
+	}
+	return 0
+}
+
+func (x *CourseProgressSummary) GetTotalLectures() int32 {
+	if x != nil {
+		return x.TotalLectures
+	}
+	return 0
+}
+
+func (x *CourseProgressSummary) GetTimeSpentSeconds() int64 {
+	if x != nil {
+		return x.TimeSpentSeconds
+	}
+	return 0
+}
+
+func (x *CourseProgressSummary) GetLastActivityAt() string {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return ""
+}
+
+func (x *CourseProgressSummary) GetResumeLectureId() int64 {
+	if x != nil {
+		return x.ResumeLectureId
+	}
+	return 0
+}
+
+type StudentDashboard struct {
+	state                 protoimpl.MessageState   `protogen:"open.v1"`
+	StudentId             int64                    `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                                         // ID студента.
+	EnrolledCourses       int32                    `protobuf:"varint,2,opt,name=enrolled_courses,json=enrolledCourses,proto3" json:"enrolled_courses,omitempty"`                       // Количество курсов, на которые записан студент.
+	CompletedCourses      int32                    `protobuf:"varint,3,opt,name=completed_courses,json=completedCourses,proto3" json:"completed_courses,omitempty"`                    // Количество полностью пройденных курсов.
+	TotalTimeSpentSeconds int64                    `protobuf:"varint,4,opt,name=total_time_spent_seconds,json=totalTimeSpentSeconds,proto3" json:"total_time_spent_seconds,omitempty"` // Общее время обучения в секундах.
+	Courses               []*CourseProgressSummary `protobuf:"bytes,5,rep,name=courses,proto3" json:"courses,omitempty"`                                                               // Курсы, отсортированные по последней активности.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StudentDashboard) Reset() {
+	*x = StudentDashboard{}
+	mi := &file_proto_education_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentDashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentDashboard) ProtoMessage() {}
+
+func (x *StudentDashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentDashboard.ProtoReflect.Descriptor instead.
+func (*StudentDashboard) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StudentDashboard) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *StudentDashboard) GetEnrolledCourses() int32 {
+	if x != nil {
+		return x.EnrolledCourses
+	}
+	return 0
+}
+
+func (x *StudentDashboard) GetCompletedCourses() int32 {
+	if x != nil {
+		return x.CompletedCourses
+	}
+	return 0
+}
+
+func (x *StudentDashboard) GetTotalTimeSpentSeconds() int64 {
+	if x != nil {
+		return x.TotalTimeSpentSeconds
+	}
+	return 0
+}
+
+func (x *StudentDashboard) GetCourses() []*CourseProgressSummary {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+// Сообщения для регистрации преподавателей.
+type RegisterInstructorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // Имя преподавателя.
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`       // Email преподавателя.
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // Пароль преподавателя.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterInstructorRequest) Reset() {
+	*x = RegisterInstructorRequest{}
+	mi := &file_proto_education_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterInstructorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterInstructorRequest) ProtoMessage() {}
+
+func (x *RegisterInstructorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterInstructorRequest.ProtoReflect.Descriptor instead.
+func (*RegisterInstructorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RegisterInstructorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterInstructorRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterInstructorRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type Instructor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID преподавателя.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // Имя преподавателя.
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // Email преподавателя.
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"` // Токен авторизации преподавателя.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Instructor) Reset() {
+	*x = Instructor{}
+	mi := &file_proto_education_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instructor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instructor) ProtoMessage() {}
+
+func (x *Instructor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instructor.ProtoReflect.Descriptor instead.
+func (*Instructor) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Instructor) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Instructor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Instructor) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Instructor) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type InstructorIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstructorIDRequest) Reset() {
+	*x = InstructorIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstructorIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstructorIDRequest) ProtoMessage() {}
+
+func (x *InstructorIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstructorIDRequest.ProtoReflect.Descriptor instead.
+func (*InstructorIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InstructorIDRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+// Сообщения для управления отзывами.
+type ReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента; при запросе с токеном студента берётся из токена.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                       // Текст отзыва.
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`                        // Оценка (рейтинг).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	mi := &file_proto_education_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReviewRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type Review struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // ID отзыва.
+	StudentId       int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                      // ID студента.
+	CourseId        int64                  `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                         // ID курса.
+	Comment         string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`                                            // Текст отзыва.
+	Rating          int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`                                             // Оценка.
+	CreatedAt       string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                       // Дата создания отзыва.
+	UpdatedAt       string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                       // Дата последнего изменения отзыва, пусто — отзыв не изменялся.
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                              // Статус модерации: pending, approved или rejected.
+	Reply           *ReviewReply           `protobuf:"bytes,9,opt,name=reply,proto3" json:"reply,omitempty"`                                                // Ответ преподавателя, если есть.
+	HelpfulCount    int32                  `protobuf:"varint,10,opt,name=helpful_count,json=helpfulCount,proto3" json:"helpful_count,omitempty"`            // Сколько пользователей сочли отзыв полезным.
+	NotHelpfulCount int32                  `protobuf:"varint,11,opt,name=not_helpful_count,json=notHelpfulCount,proto3" json:"not_helpful_count,omitempty"` // Сколько пользователей сочли отзыв бесполезным.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_proto_education_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Review) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Review) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *Review) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Review) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Review) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Review) GetReply() *ReviewReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *Review) GetHelpfulCount() int32 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+func (x *Review) GetNotHelpfulCount() int32 {
+	if x != nil {
+		return x.NotHelpfulCount
+	}
+	return 0
+}
+
+type ReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя, ответившего на отзыв.
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`                                // Текст ответа.
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`           // Дата ответа.
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`           // Дата последнего изменения ответа, пусто — ответ не изменялся.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewReply) Reset() {
+	*x = ReviewReply{}
+	mi := &file_proto_education_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReply) ProtoMessage() {}
+
+func (x *ReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReply.ProtoReflect.Descriptor instead.
+func (*ReviewReply) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReviewReply) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ReviewReply) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReviewReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReviewReply) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Sort          ReviewSort             `protobuf:"varint,2,opt,name=sort,proto3,enum=GoEdu.ReviewSort" json:"sort,omitempty"`   // Порядок отзывов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewsRequest) Reset() {
+	*x = ReviewsRequest{}
+	mi := &file_proto_education_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewsRequest) ProtoMessage() {}
+
+func (x *ReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReviewsRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ReviewsRequest) GetSort() ReviewSort {
+	if x != nil {
+		return x.Sort
+	}
+	return ReviewSort_REVIEW_SORT_DEFAULT
+}
+
+type ReviewReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // ID отзыва.
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`                    // Текст ответа.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewReplyRequest) Reset() {
+	*x = ReviewReplyRequest{}
+	mi := &file_proto_education_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReplyRequest) ProtoMessage() {}
+
+func (x *ReviewReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReplyRequest.ProtoReflect.Descriptor instead.
+func (*ReviewReplyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReviewReplyRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewReplyRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ReviewVoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // ID отзыва.
+	Helpful       bool                   `protobuf:"varint,2,opt,name=helpful,proto3" json:"helpful,omitempty"`                   // true — отзыв полезен, false — бесполезен.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewVoteRequest) Reset() {
+	*x = ReviewVoteRequest{}
+	mi := &file_proto_education_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewVoteRequest) ProtoMessage() {}
+
+func (x *ReviewVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewVoteRequest.ProtoReflect.Descriptor instead.
+func (*ReviewVoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReviewVoteRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewVoteRequest) GetHelpful() bool {
+	if x != nil {
+		return x.Helpful
+	}
+	return false
+}
+
+type ReviewList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // Список отзывов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewList) Reset() {
+	*x = ReviewList{}
+	mi := &file_proto_education_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewList) ProtoMessage() {}
+
+func (x *ReviewList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewList.ProtoReflect.Descriptor instead.
+func (*ReviewList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReviewList) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type UpdateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // ID отзыва.
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`                    // Новый текст отзыва.
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`                     // Новая оценка от 1 до 5.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_proto_education_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type ReviewIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // ID отзыва.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewIDRequest) Reset() {
+	*x = ReviewIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewIDRequest) ProtoMessage() {}
+
+func (x *ReviewIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewIDRequest.ProtoReflect.Descriptor instead.
+func (*ReviewIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReviewIDRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+// Сообщения для поиска.
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                  // Ключевое слово для поиска.
+	Sort          CourseSort             `protobuf:"varint,2,opt,name=sort,proto3,enum=GoEdu.CourseSort" json:"sort,omitempty"` // Порядок найденных курсов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_proto_education_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SearchRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSort() CourseSort {
+	if x != nil {
+		return x.Sort
+	}
+	return CourseSort_COURSE_SORT_DEFAULT
+}
+
+// Сообщения для управления преподавателями.
+type UpdateInstructorRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID преподавателя
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Новое имя (опционально)
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                            // Новый email (опционально)
+	CurrentPassword string                 `protobuf:"bytes,4,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"` // Текущий пароль (для проверки при изменении пароля)
+	NewPassword     string                 `protobuf:"bytes,5,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`             // Новый пароль (опционально)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateInstructorRequest) Reset() {
+	*x = UpdateInstructorRequest{}
+	mi := &file_proto_education_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstructorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstructorRequest) ProtoMessage() {}
+
+func (x *UpdateInstructorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstructorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstructorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateInstructorRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateInstructorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateInstructorRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateInstructorRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *UpdateInstructorRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// Запрос для удаления преподавателя
+type DeleteInstructorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInstructorRequest) Reset() {
+	*x = DeleteInstructorRequest{}
+	mi := &file_proto_education_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInstructorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstructorRequest) ProtoMessage() {}
+
+func (x *DeleteInstructorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstructorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstructorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteInstructorRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Запрос для получения преподавателя
+type GetInstructorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstructorRequest) Reset() {
+	*x = GetInstructorRequest{}
+	mi := &file_proto_education_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstructorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstructorRequest) ProtoMessage() {}
+
+func (x *GetInstructorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstructorRequest.ProtoReflect.Descriptor instead.
+func (*GetInstructorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetInstructorRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Сообщения для управления потоками.
+type Cohort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                   // ID потока.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                       // ID курса.
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                // Название потока.
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                     // Дата начала (YYYY-MM-DD).
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                           // Дата окончания (YYYY-MM-DD).
+	InstructorIds []int64                `protobuf:"varint,6,rep,packed,name=instructor_ids,json=instructorIds,proto3" json:"instructor_ids,omitempty"` // Преподаватели-наставники потока.
+	StudentCount  int32                  `protobuf:"varint,7,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`           // Количество студентов в потоке.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cohort) Reset() {
+	*x = Cohort{}
+	mi := &file_proto_education_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cohort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cohort) ProtoMessage() {}
+
+func (x *Cohort) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cohort.ProtoReflect.Descriptor instead.
+func (*Cohort) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Cohort) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Cohort) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Cohort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cohort) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Cohort) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Cohort) GetInstructorIds() []int64 {
+	if x != nil {
+		return x.InstructorIds
+	}
+	return nil
+}
+
+func (x *Cohort) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+type CohortList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cohorts       []*Cohort              `protobuf:"bytes,1,rep,name=cohorts,proto3" json:"cohorts,omitempty"` // Список потоков.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CohortList) Reset() {
+	*x = CohortList{}
+	mi := &file_proto_education_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortList) ProtoMessage() {}
+
+func (x *CohortList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortList.ProtoReflect.Descriptor instead.
+func (*CohortList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CohortList) GetCohorts() []*Cohort {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+type CohortIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      int64                  `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"` // ID потока.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CohortIDRequest) Reset() {
+	*x = CohortIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortIDRequest) ProtoMessage() {}
+
+func (x *CohortIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortIDRequest.ProtoReflect.Descriptor instead.
+func (*CohortIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CohortIDRequest) GetCohortId() int64 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+type CreateCohortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                       // ID курса.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                // Название потока.
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                     // Дата начала (YYYY-MM-DD).
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                           // Дата окончания (YYYY-MM-DD).
+	InstructorIds []int64                `protobuf:"varint,5,rep,packed,name=instructor_ids,json=instructorIds,proto3" json:"instructor_ids,omitempty"` // Преподаватели-наставники потока.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCohortRequest) Reset() {
+	*x = CreateCohortRequest{}
+	mi := &file_proto_education_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCohortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCohortRequest) ProtoMessage() {}
+
+func (x *CreateCohortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCohortRequest.ProtoReflect.Descriptor instead.
+func (*CreateCohortRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateCohortRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateCohortRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCohortRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateCohortRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateCohortRequest) GetInstructorIds() []int64 {
+	if x != nil {
+		return x.InstructorIds
+	}
+	return nil
+}
+
+type UpdateCohortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                   // ID потока.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                // Новое название потока.
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                     // Новая дата начала (YYYY-MM-DD).
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                           // Новая дата окончания (YYYY-MM-DD).
+	InstructorIds []int64                `protobuf:"varint,5,rep,packed,name=instructor_ids,json=instructorIds,proto3" json:"instructor_ids,omitempty"` // Новый список преподавателей потока.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCohortRequest) Reset() {
+	*x = UpdateCohortRequest{}
+	mi := &file_proto_education_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCohortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCohortRequest) ProtoMessage() {}
+
+func (x *UpdateCohortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCohortRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCohortRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateCohortRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCohortRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCohortRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateCohortRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *UpdateCohortRequest) GetInstructorIds() []int64 {
+	if x != nil {
+		return x.InstructorIds
+	}
+	return nil
+}
+
+type CohortStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      int64                  `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`              // ID потока.
+	StudentIds    []int64                `protobuf:"varint,2,rep,packed,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"` // ID студентов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CohortStudentsRequest) Reset() {
+	*x = CohortStudentsRequest{}
+	mi := &file_proto_education_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortStudentsRequest) ProtoMessage() {}
+
+func (x *CohortStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortStudentsRequest.ProtoReflect.Descriptor instead.
+func (*CohortStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CohortStudentsRequest) GetCohortId() int64 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *CohortStudentsRequest) GetStudentIds() []int64 {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type StudentProgress struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentId         int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                         // ID студента.
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                     // Имя студента.
+	CompletedLectures int32                  `protobuf:"varint,3,opt,name=completed_lectures,json=completedLectures,proto3" json:"completed_lectures,omitempty"` // Количество завершённых лекций.
+	TotalLectures     int32                  `protobuf:"varint,4,opt,name=total_lectures,json=totalLectures,proto3" json:"total_lectures,omitempty"`             // Всего лекций в курсе.
+	CompletedPercent  int32                  `protobuf:"varint,5,opt,name=completed_percent,json=completedPercent,proto3" json:"completed_percent,omitempty"`    // Процент завершения курса.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StudentProgress) Reset() {
+	*x = StudentProgress{}
+	mi := &file_proto_education_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentProgress) ProtoMessage() {}
+
+func (x *StudentProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentProgress.ProtoReflect.Descriptor instead.
+func (*StudentProgress) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StudentProgress) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *StudentProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StudentProgress) GetCompletedLectures() int32 {
+	if x != nil {
+		return x.CompletedLectures
+	}
+	return 0
+}
+
+func (x *StudentProgress) GetTotalLectures() int32 {
+	if x != nil {
+		return x.TotalLectures
+	}
+	return 0
+}
+
+func (x *StudentProgress) GetCompletedPercent() int32 {
+	if x != nil {
+		return x.CompletedPercent
+	}
+	return 0
+}
+
+type CohortProgress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CohortId       int64                  `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`                   // ID потока.
+	CourseId       int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                   // ID курса.
+	AveragePercent int32                  `protobuf:"varint,3,opt,name=average_percent,json=averagePercent,proto3" json:"average_percent,omitempty"` // Средний процент завершения по потоку.
+	Students       []*StudentProgress     `protobuf:"bytes,4,rep,name=students,proto3" json:"students,omitempty"`                                    // Прогресс по студентам.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CohortProgress) Reset() {
+	*x = CohortProgress{}
+	mi := &file_proto_education_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortProgress) ProtoMessage() {}
+
+func (x *CohortProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortProgress.ProtoReflect.Descriptor instead.
+func (*CohortProgress) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CohortProgress) GetCohortId() int64 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *CohortProgress) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CohortProgress) GetAveragePercent() int32 {
+	if x != nil {
+		return x.AveragePercent
+	}
+	return 0
+}
+
+func (x *CohortProgress) GetStudents() []*StudentProgress {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type LectureRelease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LectureId     int64                  `protobuf:"varint,1,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"`      // ID лекции.
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                // Название лекции.
+	ReleaseDate   string                 `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"` // Дата открытия для потока (YYYY-MM-DD), пусто — доступна сразу.
+	Released      bool                   `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`                         // Лекция уже открыта.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LectureRelease) Reset() {
+	*x = LectureRelease{}
+	mi := &file_proto_education_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LectureRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LectureRelease) ProtoMessage() {}
+
+func (x *LectureRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LectureRelease.ProtoReflect.Descriptor instead.
+func (*LectureRelease) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LectureRelease) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+func (x *LectureRelease) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LectureRelease) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *LectureRelease) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+type CohortSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      int64                  `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"` // ID потока.
+	Lectures      []*LectureRelease      `protobuf:"bytes,2,rep,name=lectures,proto3" json:"lectures,omitempty"`                  // Расписание лекций.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CohortSchedule) Reset() {
+	*x = CohortSchedule{}
+	mi := &file_proto_education_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortSchedule) ProtoMessage() {}
+
+func (x *CohortSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortSchedule.ProtoReflect.Descriptor instead.
+func (*CohortSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CohortSchedule) GetCohortId() int64 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *CohortSchedule) GetLectures() []*LectureRelease {
+	if x != nil {
+		return x.Lectures
+	}
+	return nil
+}
+
+// Сообщения, связанные с сертификатами.
+type Certificate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                              // ID сертификата.
+	Serial         string                 `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`                                       // Уникальный серийный номер.
+	StudentId      int64                  `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`               // ID студента.
+	StudentName    string                 `protobuf:"bytes,4,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`          // Имя студента на момент выдачи.
+	CourseId       int64                  `protobuf:"varint,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                  // ID курса.
+	CourseName     string                 `protobuf:"bytes,6,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`             // Название курса на момент выдачи.
+	InstructorName string                 `protobuf:"bytes,7,opt,name=instructor_name,json=instructorName,proto3" json:"instructor_name,omitempty"` // Имя преподавателя на момент выдачи.
+	IssuedAt       string                 `protobuf:"bytes,8,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`                   // Дата выдачи (RFC3339).
+	Signature      string                 `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`                                 // Подпись сертификата.
+	VerifyUrl      string                 `protobuf:"bytes,10,opt,name=verify_url,json=verifyUrl,proto3" json:"verify_url,omitempty"`               // Ссылка для публичной проверки.
+	Pdf            []byte                 `protobuf:"bytes,11,opt,name=pdf,proto3" json:"pdf,omitempty"`                                            // PDF-файл сертификата (только в GetCertificate).
+	PathId         int64                  `protobuf:"varint,12,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`                       // ID учебной программы, если сертификат выдан за программу (course_id = 0, course_name — название программы).
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	mi := &file_proto_education_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Certificate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Certificate) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *Certificate) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *Certificate) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *Certificate) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Certificate) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *Certificate) GetInstructorName() string {
+	if x != nil {
+		return x.InstructorName
+	}
+	return ""
+}
+
+func (x *Certificate) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *Certificate) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *Certificate) GetVerifyUrl() string {
+	if x != nil {
+		return x.VerifyUrl
+	}
+	return ""
+}
+
+func (x *Certificate) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+func (x *Certificate) GetPathId() int64 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+type CertificateList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*Certificate         `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"` // Список сертификатов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateList) Reset() {
+	*x = CertificateList{}
+	mi := &file_proto_education_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateList) ProtoMessage() {}
+
+func (x *CertificateList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateList.ProtoReflect.Descriptor instead.
+func (*CertificateList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CertificateList) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+type CertificateIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CertificateId int64                  `protobuf:"varint,1,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"` // ID сертификата.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateIDRequest) Reset() {
+	*x = CertificateIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateIDRequest) ProtoMessage() {}
+
+func (x *CertificateIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateIDRequest.ProtoReflect.Descriptor instead.
+func (*CertificateIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CertificateIDRequest) GetCertificateId() int64 {
+	if x != nil {
+		return x.CertificateId
+	}
+	return 0
+}
+
+type VerifyCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Serial        string                 `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`       // Серийный номер сертификата.
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"` // Подпись из сертификата; если не задана, проверяется только запись в реестре.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyCertificateRequest) Reset() {
+	*x = VerifyCertificateRequest{}
+	mi := &file_proto_education_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCertificateRequest) ProtoMessage() {}
+
+func (x *VerifyCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCertificateRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *VerifyCertificateRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *VerifyCertificateRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type CertificateVerification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`            // Сертификат подлинный.
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`           // Причина, если сертификат не прошёл проверку.
+	Certificate   *Certificate           `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"` // Данные сертификата (без PDF), если серийный номер найден.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateVerification) Reset() {
+	*x = CertificateVerification{}
+	mi := &file_proto_education_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateVerification) ProtoMessage() {}
+
+func (x *CertificateVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateVerification.ProtoReflect.Descriptor instead.
+func (*CertificateVerification) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CertificateVerification) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *CertificateVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CertificateVerification) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type QuizQuestion struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                 // ID вопроса.
+	Type    QuizQuestionType       `protobuf:"varint,2,opt,name=type,proto3,enum=GoEdu.QuizQuestionType" json:"type,omitempty"` // Тип вопроса.
+	Text    string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`                              // Текст вопроса.
+	Points  int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`                         // Баллы за правильный ответ (по умолчанию 1).
+	Options []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`                        // Варианты ответа для вопросов с выбором.
+	// Поля ниже содержат правильные ответы и не передаются студентам.
+	CorrectOptions  []int32            `protobuf:"varint,6,rep,packed,name=correct_options,json=correctOptions,proto3" json:"correct_options,omitempty"` // Номера правильных вариантов (с нуля).
+	CorrectBool     *bool              `protobuf:"varint,7,opt,name=correct_bool,json=correctBool,proto3,oneof" json:"correct_bool,omitempty"`           // Правильный ответ на вопрос «верно/неверно».
+	CorrectNumber   *float64           `protobuf:"fixed64,8,opt,name=correct_number,json=correctNumber,proto3,oneof" json:"correct_number,omitempty"`    // Правильный числовой ответ.
+	Tolerance       float64            `protobuf:"fixed64,9,opt,name=tolerance,proto3" json:"tolerance,omitempty"`                                       // Допустимая погрешность числового ответа.
+	AcceptedAnswers []string           `protobuf:"bytes,10,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`     // Допустимые текстовые ответы (без учёта регистра).
+	Tags            []string           `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`                                                  // Теги вопроса банка.
+	Difficulty      QuestionDifficulty `protobuf:"varint,12,opt,name=difficulty,proto3,enum=GoEdu.QuestionDifficulty" json:"difficulty,omitempty"`       // Сложность вопроса банка.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuizQuestion) Reset() {
+	*x = QuizQuestion{}
+	mi := &file_proto_education_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestion) ProtoMessage() {}
+
+func (x *QuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestion.ProtoReflect.Descriptor instead.
+func (*QuizQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *QuizQuestion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetType() QuizQuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuizQuestionType_QUIZ_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *QuizQuestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetCorrectOptions() []int32 {
+	if x != nil {
+		return x.CorrectOptions
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetCorrectBool() bool {
+	if x != nil && x.CorrectBool != nil {
+		return *x.CorrectBool
+	}
+	return false
+}
+
+func (x *QuizQuestion) GetCorrectNumber() float64 {
+	if x != nil && x.CorrectNumber != nil {
+		return *x.CorrectNumber
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetDifficulty() QuestionDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return QuestionDifficulty_QUESTION_DIFFICULTY_UNSPECIFIED
+}
+
+type QuizDrawRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`                                         // Сколько вопросов взять из банка.
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`                                            // Вопрос должен иметь хотя бы один из тегов; пусто — любые теги.
+	Difficulty    QuestionDifficulty     `protobuf:"varint,3,opt,name=difficulty,proto3,enum=GoEdu.QuestionDifficulty" json:"difficulty,omitempty"` // Сложность вопросов; не задана — любая.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizDrawRule) Reset() {
+	*x = QuizDrawRule{}
+	mi := &file_proto_education_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizDrawRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizDrawRule) ProtoMessage() {}
+
+func (x *QuizDrawRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizDrawRule.ProtoReflect.Descriptor instead.
+func (*QuizDrawRule) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *QuizDrawRule) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QuizDrawRule) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuizDrawRule) GetDifficulty() QuestionDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return QuestionDifficulty_QUESTION_DIFFICULTY_UNSPECIFIED
+}
+
+type Quiz struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                      // ID теста.
+	CourseId              int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                                          // ID курса.
+	LectureId             int64                  `protobuf:"varint,3,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"`                                       // ID лекции, 0 — тест всего курса.
+	Title                 string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                                                 // Название теста.
+	Description           string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                                                     // Описание теста.
+	TimeLimitSeconds      int32                  `protobuf:"varint,6,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`                // Лимит времени на попытку, 0 — без ограничения.
+	MaxAttempts           int32                  `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                 // Максимальное число попыток, 0 — без ограничения.
+	PassingScore          int32                  `protobuf:"varint,8,opt,name=passing_score,json=passingScore,proto3" json:"passing_score,omitempty"`                              // Проходной балл в процентах.
+	RequiredForCompletion bool                   `protobuf:"varint,9,opt,name=required_for_completion,json=requiredForCompletion,proto3" json:"required_for_completion,omitempty"` // Лекцию нельзя завершить, не сдав тест.
+	QuestionCount         int32                  `protobuf:"varint,10,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`                          // Количество вопросов.
+	MaxScore              int32                  `protobuf:"varint,11,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`                                         // Максимальное количество баллов.
+	Questions             []*QuizQuestion        `protobuf:"bytes,12,rep,name=questions,proto3" json:"questions,omitempty"`                                                        // Постоянные вопросы (только в GetQuiz и при создании).
+	DrawRules             []*QuizDrawRule        `protobuf:"bytes,13,rep,name=draw_rules,json=drawRules,proto3" json:"draw_rules,omitempty"`                                       // Правила случайного выбора вопросов из банка.
+	ShuffleQuestions      bool                   `protobuf:"varint,14,opt,name=shuffle_questions,json=shuffleQuestions,proto3" json:"shuffle_questions,omitempty"`                 // Перемешивать вопросы в каждой попытке.
+	ShuffleOptions        bool                   `protobuf:"varint,15,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`                       // Перемешивать варианты ответов в каждой попытке.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Quiz) Reset() {
+	*x = Quiz{}
+	mi := &file_proto_education_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quiz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quiz) ProtoMessage() {}
+
+func (x *Quiz) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quiz.ProtoReflect.Descriptor instead.
+func (*Quiz) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Quiz) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Quiz) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Quiz) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+func (x *Quiz) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Quiz) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Quiz) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *Quiz) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Quiz) GetPassingScore() int32 {
+	if x != nil {
+		return x.PassingScore
+	}
+	return 0
+}
+
+func (x *Quiz) GetRequiredForCompletion() bool {
+	if x != nil {
+		return x.RequiredForCompletion
+	}
+	return false
+}
+
+func (x *Quiz) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *Quiz) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Quiz) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *Quiz) GetDrawRules() []*QuizDrawRule {
+	if x != nil {
+		return x.DrawRules
+	}
+	return nil
+}
+
+func (x *Quiz) GetShuffleQuestions() bool {
+	if x != nil {
+		return x.ShuffleQuestions
+	}
+	return false
+}
+
+func (x *Quiz) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
+	}
+	return false
+}
+
+type QuizList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quizzes       []*Quiz                `protobuf:"bytes,1,rep,name=quizzes,proto3" json:"quizzes,omitempty"` // Список тестов.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizList) Reset() {
+	*x = QuizList{}
+	mi := &file_proto_education_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizList) ProtoMessage() {}
+
+func (x *QuizList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizList.ProtoReflect.Descriptor instead.
+func (*QuizList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *QuizList) GetQuizzes() []*Quiz {
+	if x != nil {
+		return x.Quizzes
+	}
+	return nil
+}
+
+type QuizIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        int64                  `protobuf:"varint,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"` // ID теста.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizIDRequest) Reset() {
+	*x = QuizIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizIDRequest) ProtoMessage() {}
+
+func (x *QuizIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizIDRequest.ProtoReflect.Descriptor instead.
+func (*QuizIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *QuizIDRequest) GetQuizId() int64 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+type CreateQuizRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CourseId              int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                                          // ID курса.
+	LectureId             int64                  `protobuf:"varint,2,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"`                                       // ID лекции, 0 — тест всего курса.
+	Title                 string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                                                 // Название теста.
+	Description           string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                                     // Описание теста.
+	TimeLimitSeconds      int32                  `protobuf:"varint,5,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`                // Лимит времени на попытку, 0 — без ограничения.
+	MaxAttempts           int32                  `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                 // Максимальное число попыток, 0 — без ограничения.
+	PassingScore          int32                  `protobuf:"varint,7,opt,name=passing_score,json=passingScore,proto3" json:"passing_score,omitempty"`                              // Проходной балл в процентах (0–100).
+	RequiredForCompletion bool                   `protobuf:"varint,8,opt,name=required_for_completion,json=requiredForCompletion,proto3" json:"required_for_completion,omitempty"` // Требовать сдачи теста для завершения лекции.
+	Questions             []*QuizQuestion        `protobuf:"bytes,9,rep,name=questions,proto3" json:"questions,omitempty"`                                                         // Постоянные вопросы теста.
+	DrawRules             []*QuizDrawRule        `protobuf:"bytes,10,rep,name=draw_rules,json=drawRules,proto3" json:"draw_rules,omitempty"`                                       // Правила случайного выбора вопросов из банка преподавателя курса.
+	ShuffleQuestions      bool                   `protobuf:"varint,11,opt,name=shuffle_questions,json=shuffleQuestions,proto3" json:"shuffle_questions,omitempty"`                 // Перемешивать вопросы в каждой попытке.
+	ShuffleOptions        bool                   `protobuf:"varint,12,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`                       // Перемешивать варианты ответов в каждой попытке.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateQuizRequest) Reset() {
+	*x = CreateQuizRequest{}
+	mi := &file_proto_education_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizRequest) ProtoMessage() {}
+
+func (x *CreateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateQuizRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetPassingScore() int32 {
+	if x != nil {
+		return x.PassingScore
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetRequiredForCompletion() bool {
+	if x != nil {
+		return x.RequiredForCompletion
+	}
+	return false
+}
+
+func (x *CreateQuizRequest) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetDrawRules() []*QuizDrawRule {
+	if x != nil {
+		return x.DrawRules
+	}
+	return nil
+}
+
+func (x *CreateQuizRequest) GetShuffleQuestions() bool {
+	if x != nil {
+		return x.ShuffleQuestions
+	}
+	return false
+}
+
+func (x *CreateQuizRequest) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
+	}
+	return false
+}
+
+type UpdateQuizRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                      // ID теста.
+	LectureId             int64                  `protobuf:"varint,2,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"`                                       // ID лекции, 0 — тест всего курса.
+	Title                 string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                                                 // Название теста.
+	Description           string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                                     // Описание теста.
+	TimeLimitSeconds      int32                  `protobuf:"varint,5,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`                // Лимит времени на попытку, 0 — без ограничения.
+	MaxAttempts           int32                  `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                 // Максимальное число попыток, 0 — без ограничения.
+	PassingScore          int32                  `protobuf:"varint,7,opt,name=passing_score,json=passingScore,proto3" json:"passing_score,omitempty"`                              // Проходной балл в процентах (0–100).
+	RequiredForCompletion bool                   `protobuf:"varint,8,opt,name=required_for_completion,json=requiredForCompletion,proto3" json:"required_for_completion,omitempty"` // Требовать сдачи теста для завершения лекции.
+	Questions             []*QuizQuestion        `protobuf:"bytes,9,rep,name=questions,proto3" json:"questions,omitempty"`                                                         // Новый список постоянных вопросов: вопросы с id изменяются, без id — добавляются, остальные удаляются.
+	DrawRules             []*QuizDrawRule        `protobuf:"bytes,10,rep,name=draw_rules,json=drawRules,proto3" json:"draw_rules,omitempty"`                                       // Новые правила выбора вопросов из банка.
+	ShuffleQuestions      bool                   `protobuf:"varint,11,opt,name=shuffle_questions,json=shuffleQuestions,proto3" json:"shuffle_questions,omitempty"`                 // Перемешивать вопросы в каждой попытке.
+	ShuffleOptions        bool                   `protobuf:"varint,12,opt,name=shuffle_options,json=shuffleOptions,proto3" json:"shuffle_options,omitempty"`                       // Перемешивать варианты ответов в каждой попытке.
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateQuizRequest) Reset() {
+	*x = UpdateQuizRequest{}
+	mi := &file_proto_education_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuizRequest) ProtoMessage() {}
+
+func (x *UpdateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuizRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateQuizRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetLectureId() int64 {
+	if x != nil {
+		return x.LectureId
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateQuizRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateQuizRequest) GetTimeLimitSeconds() int32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetPassingScore() int32 {
+	if x != nil {
+		return x.PassingScore
+	}
+	return 0
+}
+
+func (x *UpdateQuizRequest) GetRequiredForCompletion() bool {
+	if x != nil {
+		return x.RequiredForCompletion
+	}
+	return false
+}
+
+func (x *UpdateQuizRequest) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetDrawRules() []*QuizDrawRule {
+	if x != nil {
+		return x.DrawRules
+	}
+	return nil
+}
+
+func (x *UpdateQuizRequest) GetShuffleQuestions() bool {
+	if x != nil {
+		return x.ShuffleQuestions
+	}
+	return false
+}
+
+func (x *UpdateQuizRequest) GetShuffleOptions() bool {
+	if x != nil {
+		return x.ShuffleOptions
+	}
+	return false
+}
+
+type StartQuizAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        int64                  `protobuf:"varint,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`          // ID теста.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartQuizAttemptRequest) Reset() {
+	*x = StartQuizAttemptRequest{}
+	mi := &file_proto_education_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartQuizAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartQuizAttemptRequest) ProtoMessage() {}
+
+func (x *StartQuizAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartQuizAttemptRequest.ProtoReflect.Descriptor instead.
+func (*StartQuizAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *StartQuizAttemptRequest) GetQuizId() int64 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+func (x *StartQuizAttemptRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type QuizAnswer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId      int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`                       // ID вопроса.
+	SelectedOptions []int32                `protobuf:"varint,2,rep,packed,name=selected_options,json=selectedOptions,proto3" json:"selected_options,omitempty"` // Выбранные варианты (с нуля).
+	BoolAnswer      *bool                  `protobuf:"varint,3,opt,name=bool_answer,json=boolAnswer,proto3,oneof" json:"bool_answer,omitempty"`                 // Ответ на вопрос «верно/неверно».
+	NumberAnswer    *float64               `protobuf:"fixed64,4,opt,name=number_answer,json=numberAnswer,proto3,oneof" json:"number_answer,omitempty"`          // Числовой ответ.
+	TextAnswer      string                 `protobuf:"bytes,5,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`                        // Текстовый ответ.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuizAnswer) Reset() {
+	*x = QuizAnswer{}
+	mi := &file_proto_education_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAnswer) ProtoMessage() {}
+
+func (x *QuizAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAnswer.ProtoReflect.Descriptor instead.
+func (*QuizAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *QuizAnswer) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetSelectedOptions() []int32 {
+	if x != nil {
+		return x.SelectedOptions
+	}
+	return nil
+}
+
+func (x *QuizAnswer) GetBoolAnswer() bool {
+	if x != nil && x.BoolAnswer != nil {
+		return *x.BoolAnswer
+	}
+	return false
+}
+
+func (x *QuizAnswer) GetNumberAnswer() float64 {
+	if x != nil && x.NumberAnswer != nil {
+		return *x.NumberAnswer
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetTextAnswer() string {
+	if x != nil {
+		return x.TextAnswer
+	}
+	return ""
+}
+
+type QuizAnswerResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` // ID вопроса.
+	Correct       bool                   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`                         // Ответ верный.
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`                           // Начисленные баллы.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAnswerResult) Reset() {
+	*x = QuizAnswerResult{}
+	mi := &file_proto_education_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAnswerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAnswerResult) ProtoMessage() {}
+
+func (x *QuizAnswerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAnswerResult.ProtoReflect.Descriptor instead.
+func (*QuizAnswerResult) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *QuizAnswerResult) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *QuizAnswerResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *QuizAnswerResult) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type SubmitQuizAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttemptId     int64                  `protobuf:"varint,1,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"` // ID попытки.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
+	Answers       []*QuizAnswer          `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`                       // Ответы; вопросы без ответа считаются неверными.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitQuizAttemptRequest) Reset() {
+	*x = SubmitQuizAttemptRequest{}
+	mi := &file_proto_education_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitQuizAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuizAttemptRequest) ProtoMessage() {}
+
+func (x *SubmitQuizAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuizAttemptRequest.ProtoReflect.Descriptor instead.
+func (*SubmitQuizAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SubmitQuizAttemptRequest) GetAttemptId() int64 {
+	if x != nil {
+		return x.AttemptId
+	}
+	return 0
+}
+
+func (x *SubmitQuizAttemptRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *SubmitQuizAttemptRequest) GetAnswers() []*QuizAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type QuizAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                            // ID попытки.
+	QuizId        int64                  `protobuf:"varint,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`                      // ID теста.
+	StudentId     int64                  `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`             // ID студента.
+	AttemptNumber int32                  `protobuf:"varint,4,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"` // Порядковый номер попытки.
+	StartedAt     string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`              // Время начала (RFC3339).
+	DeadlineAt    string                 `protobuf:"bytes,6,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`           // Время окончания по лимиту (RFC3339), пусто — без ограничения.
+	SubmittedAt   string                 `protobuf:"bytes,7,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`        // Время отправки ответов (RFC3339), пусто — попытка не завершена.
+	Score         int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`                                      // Набранные баллы.
+	MaxScore      int32                  `protobuf:"varint,9,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`                // Максимальное количество баллов.
+	Percent       int32                  `protobuf:"varint,10,opt,name=percent,proto3" json:"percent,omitempty"`                                 // Процент набранных баллов.
+	Passed        bool                   `protobuf:"varint,11,opt,name=passed,proto3" json:"passed,omitempty"`                                   // Набран проходной балл.
+	Questions     []*QuizQuestion        `protobuf:"bytes,12,rep,name=questions,proto3" json:"questions,omitempty"`                              // Вопросы без ответов (при начале попытки).
+	Results       []*QuizAnswerResult    `protobuf:"bytes,13,rep,name=results,proto3" json:"results,omitempty"`                                  // Результаты по вопросам (после отправки).
+	Seed          int64                  `protobuf:"varint,14,opt,name=seed,proto3" json:"seed,omitempty"`                                       // Зерно, по которому выбраны и перемешаны вопросы попытки.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAttempt) Reset() {
+	*x = QuizAttempt{}
+	mi := &file_proto_education_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAttempt) ProtoMessage() {}
+
+func (x *QuizAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAttempt.ProtoReflect.Descriptor instead.
+func (*QuizAttempt) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *QuizAttempt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetQuizId() int64 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetAttemptNumber() int32 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *QuizAttempt) GetDeadlineAt() string {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return ""
+}
+
+func (x *QuizAttempt) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *QuizAttempt) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *QuizAttempt) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuizAttempt) GetResults() []*QuizAnswerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *QuizAttempt) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type QuizAttemptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        int64                  `protobuf:"varint,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`          // ID теста.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента; 0 — попытки всех студентов (для преподавателя).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAttemptsRequest) Reset() {
+	*x = QuizAttemptsRequest{}
+	mi := &file_proto_education_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAttemptsRequest) ProtoMessage() {}
+
+func (x *QuizAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*QuizAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *QuizAttemptsRequest) GetQuizId() int64 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+func (x *QuizAttemptsRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type QuizAttemptList struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Attempts          []*QuizAttempt         `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`                                             // Список попыток.
+	RemainingAttempts int32                  `protobuf:"varint,2,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"` // Оставшиеся попытки студента, -1 — без ограничения.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QuizAttemptList) Reset() {
+	*x = QuizAttemptList{}
+	mi := &file_proto_education_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAttemptList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAttemptList) ProtoMessage() {}
+
+func (x *QuizAttemptList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAttemptList.ProtoReflect.Descriptor instead.
+func (*QuizAttemptList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *QuizAttemptList) GetAttempts() []*QuizAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *QuizAttemptList) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+type BankQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя — владельца банка.
+	Question      *QuizQuestion          `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`                              // Вопрос.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BankQuestionRequest) Reset() {
+	*x = BankQuestionRequest{}
+	mi := &file_proto_education_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankQuestionRequest) ProtoMessage() {}
+
+func (x *BankQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankQuestionRequest.ProtoReflect.Descriptor instead.
+func (*BankQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *BankQuestionRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *BankQuestionRequest) GetQuestion() *QuizQuestion {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type BankQuestionIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
+	QuestionId    int64                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`       // ID вопроса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BankQuestionIDRequest) Reset() {
+	*x = BankQuestionIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankQuestionIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankQuestionIDRequest) ProtoMessage() {}
+
+func (x *BankQuestionIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankQuestionIDRequest.ProtoReflect.Descriptor instead.
+func (*BankQuestionIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *BankQuestionIDRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *BankQuestionIDRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+type ListBankQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`       // ID преподавателя.
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`                                            // Фильтр по тегам (любой из).
+	Difficulty    QuestionDifficulty     `protobuf:"varint,3,opt,name=difficulty,proto3,enum=GoEdu.QuestionDifficulty" json:"difficulty,omitempty"` // Фильтр по сложности.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBankQuestionsRequest) Reset() {
+	*x = ListBankQuestionsRequest{}
+	mi := &file_proto_education_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankQuestionsRequest) ProtoMessage() {}
+
+func (x *ListBankQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBankQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListBankQuestionsRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ListBankQuestionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListBankQuestionsRequest) GetDifficulty() QuestionDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return QuestionDifficulty_QUESTION_DIFFICULTY_UNSPECIFIED
+}
+
+type BankQuestionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*QuizQuestion        `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"` // Вопросы банка.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BankQuestionList) Reset() {
+	*x = BankQuestionList{}
+	mi := &file_proto_education_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankQuestionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankQuestionList) ProtoMessage() {}
+
+func (x *BankQuestionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankQuestionList.ProtoReflect.Descriptor instead.
+func (*BankQuestionList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *BankQuestionList) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type ImportQuestionBankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
+	Format        QuestionBankFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=GoEdu.QuestionBankFormat" json:"format,omitempty"`   // Формат файла.
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                // Содержимое файла.
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                      // Теги, добавляемые ко всем импортированным вопросам.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportQuestionBankRequest) Reset() {
+	*x = ImportQuestionBankRequest{}
+	mi := &file_proto_education_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportQuestionBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQuestionBankRequest) ProtoMessage() {}
+
+func (x *ImportQuestionBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQuestionBankRequest.ProtoReflect.Descriptor instead.
+func (*ImportQuestionBankRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ImportQuestionBankRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ImportQuestionBankRequest) GetFormat() QuestionBankFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QuestionBankFormat_QUESTION_BANK_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportQuestionBankRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportQuestionBankRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ImportQuestionBankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`  // Количество импортированных вопросов.
+	Questions     []*QuizQuestion        `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"` // Импортированные вопросы.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportQuestionBankResponse) Reset() {
+	*x = ImportQuestionBankResponse{}
+	mi := &file_proto_education_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportQuestionBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQuestionBankResponse) ProtoMessage() {}
+
+func (x *ImportQuestionBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQuestionBankResponse.ProtoReflect.Descriptor instead.
+func (*ImportQuestionBankResponse) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ImportQuestionBankResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportQuestionBankResponse) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type ExportQuestionBankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`       // ID преподавателя.
+	Format        QuestionBankFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=GoEdu.QuestionBankFormat" json:"format,omitempty"`         // Формат файла.
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`                                            // Фильтр по тегам (любой из).
+	Difficulty    QuestionDifficulty     `protobuf:"varint,4,opt,name=difficulty,proto3,enum=GoEdu.QuestionDifficulty" json:"difficulty,omitempty"` // Фильтр по сложности.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportQuestionBankRequest) Reset() {
+	*x = ExportQuestionBankRequest{}
+	mi := &file_proto_education_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportQuestionBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportQuestionBankRequest) ProtoMessage() {}
+
+func (x *ExportQuestionBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportQuestionBankRequest.ProtoReflect.Descriptor instead.
+func (*ExportQuestionBankRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ExportQuestionBankRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ExportQuestionBankRequest) GetFormat() QuestionBankFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QuestionBankFormat_QUESTION_BANK_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportQuestionBankRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExportQuestionBankRequest) GetDifficulty() QuestionDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return QuestionDifficulty_QUESTION_DIFFICULTY_UNSPECIFIED
+}
+
+type QuestionBankFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        QuestionBankFormat     `protobuf:"varint,1,opt,name=format,proto3,enum=GoEdu.QuestionBankFormat" json:"format,omitempty"` // Формат файла.
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                            // Имя файла.
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                              // Содержимое файла.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionBankFile) Reset() {
+	*x = QuestionBankFile{}
+	mi := &file_proto_education_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionBankFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionBankFile) ProtoMessage() {}
+
+func (x *QuestionBankFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionBankFile.ProtoReflect.Descriptor instead.
+func (*QuestionBankFile) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *QuestionBankFile) GetFormat() QuestionBankFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QuestionBankFormat_QUESTION_BANK_FORMAT_UNSPECIFIED
+}
+
+func (x *QuestionBankFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *QuestionBankFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type RubricCriterion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // ID критерия.
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                           // Название критерия.
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`               // Описание критерия.
+	MaxPoints     int32                  `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"` // Максимальные баллы по критерию.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
+	mi := &file_proto_education_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricCriterion) ProtoMessage() {}
+
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RubricCriterion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RubricCriterion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+type Assignment struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                    // ID задания.
+	CourseId             int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                                        // ID курса.
+	Title                string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                                               // Название задания.
+	Description          string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                                   // Описание задания.
+	DueAt                string                 `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                                                  // Срок сдачи (RFC3339), пусто — без срока.
+	LatePolicy           LatePolicy             `protobuf:"varint,6,opt,name=late_policy,json=latePolicy,proto3,enum=GoEdu.LatePolicy" json:"late_policy,omitempty"`            // Политика приёма просроченных решений.
+	LatePenaltyPercent   int32                  `protobuf:"varint,7,opt,name=late_penalty_percent,json=latePenaltyPercent,proto3" json:"late_penalty_percent,omitempty"`        // Штраф в процентах за каждые сутки просрочки.
+	MaxScore             int32                  `protobuf:"varint,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`                                        // Максимальная оценка; при наличии рубрики — сумма баллов критериев.
+	MaxSubmissions       int32                  `protobuf:"varint,9,opt,name=max_submissions,json=maxSubmissions,proto3" json:"max_submissions,omitempty"`                      // Максимальное число отправок, 0 — без ограничения.
+	MaxFiles             int32                  `protobuf:"varint,10,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`                                       // Максимальное число файлов в решении.
+	Rubric               []*RubricCriterion     `protobuf:"bytes,11,rep,name=rubric,proto3" json:"rubric,omitempty"`                                                            // Критерии оценивания.
+	PeerReviewCount      int32                  `protobuf:"varint,12,opt,name=peer_review_count,json=peerReviewCount,proto3" json:"peer_review_count,omitempty"`                // Сколько студентов проверяют каждое решение, 0 — без взаимной проверки.
+	PeerReviewDueAt      string                 `protobuf:"bytes,13,opt,name=peer_review_due_at,json=peerReviewDueAt,proto3" json:"peer_review_due_at,omitempty"`               // Срок взаимной проверки (RFC3339), пусто — без срока.
+	PeerReviewsAllocated bool                   `protobuf:"varint,14,opt,name=peer_reviews_allocated,json=peerReviewsAllocated,proto3" json:"peer_reviews_allocated,omitempty"` // Решения уже распределены между рецензентами.
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	mi := &file_proto_education_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Assignment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Assignment) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Assignment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Assignment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Assignment) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *Assignment) GetLatePolicy() LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return LatePolicy_LATE_POLICY_UNSPECIFIED
+}
+
+func (x *Assignment) GetLatePenaltyPercent() int32 {
+	if x != nil {
+		return x.LatePenaltyPercent
+	}
+	return 0
+}
+
+func (x *Assignment) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Assignment) GetMaxSubmissions() int32 {
+	if x != nil {
+		return x.MaxSubmissions
+	}
+	return 0
+}
+
+func (x *Assignment) GetMaxFiles() int32 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *Assignment) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *Assignment) GetPeerReviewCount() int32 {
+	if x != nil {
+		return x.PeerReviewCount
+	}
+	return 0
+}
+
+func (x *Assignment) GetPeerReviewDueAt() string {
+	if x != nil {
+		return x.PeerReviewDueAt
+	}
+	return ""
+}
+
+func (x *Assignment) GetPeerReviewsAllocated() bool {
+	if x != nil {
+		return x.PeerReviewsAllocated
+	}
+	return false
+}
+
+type CreateAssignmentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CourseId           int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                                 // ID курса.
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                                        // Название задания.
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                            // Описание задания.
+	DueAt              string                 `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                                           // Срок сдачи (RFC3339 или YYYY-MM-DD — до конца дня), пусто — без срока.
+	LatePolicy         LatePolicy             `protobuf:"varint,5,opt,name=late_policy,json=latePolicy,proto3,enum=GoEdu.LatePolicy" json:"late_policy,omitempty"`     // Политика приёма просроченных решений.
+	LatePenaltyPercent int32                  `protobuf:"varint,6,opt,name=late_penalty_percent,json=latePenaltyPercent,proto3" json:"late_penalty_percent,omitempty"` // Штраф в процентах за каждые сутки просрочки (для LATE_POLICY_PENALTY).
+	MaxScore           int32                  `protobuf:"varint,7,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`                                 // Максимальная оценка; не указывается при наличии рубрики.
+	MaxSubmissions     int32                  `protobuf:"varint,8,opt,name=max_submissions,json=maxSubmissions,proto3" json:"max_submissions,omitempty"`               // Максимальное число отправок, 0 — без ограничения.
+	MaxFiles           int32                  `protobuf:"varint,9,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`                                 // Максимальное число файлов в решении (не больше 10), 0 — по умолчанию (5).
+	Rubric             []*RubricCriterion     `protobuf:"bytes,10,rep,name=rubric,proto3" json:"rubric,omitempty"`                                                     // Критерии оценивания.
+	PeerReviewCount    int32                  `protobuf:"varint,11,opt,name=peer_review_count,json=peerReviewCount,proto3" json:"peer_review_count,omitempty"`         // Сколько студентов проверяют каждое решение (не больше 10), 0 — без взаимной проверки.
+	PeerReviewDueAt    string                 `protobuf:"bytes,12,opt,name=peer_review_due_at,json=peerReviewDueAt,proto3" json:"peer_review_due_at,omitempty"`        // Срок взаимной проверки (RFC3339 или YYYY-MM-DD — до конца дня), пусто — без срока.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
+	mi := &file_proto_education_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateAssignmentRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetLatePolicy() LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return LatePolicy_LATE_POLICY_UNSPECIFIED
+}
+
+func (x *CreateAssignmentRequest) GetLatePenaltyPercent() int32 {
+	if x != nil {
+		return x.LatePenaltyPercent
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetMaxSubmissions() int32 {
+	if x != nil {
+		return x.MaxSubmissions
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetMaxFiles() int32 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *CreateAssignmentRequest) GetPeerReviewCount() int32 {
+	if x != nil {
+		return x.PeerReviewCount
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetPeerReviewDueAt() string {
+	if x != nil {
+		return x.PeerReviewDueAt
+	}
+	return ""
+}
+
+type UpdateAssignmentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                             // ID задания.
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                                        // Название задания.
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                            // Описание задания.
+	DueAt              string                 `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                                           // Срок сдачи (RFC3339 или YYYY-MM-DD — до конца дня), пусто — без срока.
+	LatePolicy         LatePolicy             `protobuf:"varint,5,opt,name=late_policy,json=latePolicy,proto3,enum=GoEdu.LatePolicy" json:"late_policy,omitempty"`     // Политика приёма просроченных решений.
+	LatePenaltyPercent int32                  `protobuf:"varint,6,opt,name=late_penalty_percent,json=latePenaltyPercent,proto3" json:"late_penalty_percent,omitempty"` // Штраф в процентах за каждые сутки просрочки (для LATE_POLICY_PENALTY).
+	MaxScore           int32                  `protobuf:"varint,7,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`                                 // Максимальная оценка; не указывается при наличии рубрики.
+	MaxSubmissions     int32                  `protobuf:"varint,8,opt,name=max_submissions,json=maxSubmissions,proto3" json:"max_submissions,omitempty"`               // Максимальное число отправок, 0 — без ограничения.
+	MaxFiles           int32                  `protobuf:"varint,9,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`                                 // Максимальное число файлов в решении (не больше 10), 0 — по умолчанию (5).
+	Rubric             []*RubricCriterion     `protobuf:"bytes,10,rep,name=rubric,proto3" json:"rubric,omitempty"`                                                     // Новая рубрика.
+	PeerReviewCount    int32                  `protobuf:"varint,11,opt,name=peer_review_count,json=peerReviewCount,proto3" json:"peer_review_count,omitempty"`         // Сколько студентов проверяют каждое решение (не больше 10), 0 — без взаимной проверки.
+	PeerReviewDueAt    string                 `protobuf:"bytes,12,opt,name=peer_review_due_at,json=peerReviewDueAt,proto3" json:"peer_review_due_at,omitempty"`        // Срок взаимной проверки (RFC3339 или YYYY-MM-DD — до конца дня), пусто — без срока.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentRequest) Reset() {
+	*x = UpdateAssignmentRequest{}
+	mi := &file_proto_education_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateAssignmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetLatePolicy() LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return LatePolicy_LATE_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateAssignmentRequest) GetLatePenaltyPercent() int32 {
+	if x != nil {
+		return x.LatePenaltyPercent
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetMaxSubmissions() int32 {
+	if x != nil {
+		return x.MaxSubmissions
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetMaxFiles() int32 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentRequest) GetPeerReviewCount() int32 {
+	if x != nil {
+		return x.PeerReviewCount
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetPeerReviewDueAt() string {
+	if x != nil {
+		return x.PeerReviewDueAt
+	}
+	return ""
+}
+
+type AssignmentIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"` // ID задания.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentIDRequest) Reset() {
+	*x = AssignmentIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentIDRequest) ProtoMessage() {}
+
+func (x *AssignmentIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentIDRequest.ProtoReflect.Descriptor instead.
+func (*AssignmentIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *AssignmentIDRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type AssignmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"` // Список заданий.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentList) Reset() {
+	*x = AssignmentList{}
+	mi := &file_proto_education_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentList) ProtoMessage() {}
+
+func (x *AssignmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentList.ProtoReflect.Descriptor instead.
+func (*AssignmentList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AssignmentList) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type UploadedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`                          // Имя файла.
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME-тип; если не указан, определяется по содержимому.
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                            // Содержимое файла, не больше 10 МБ.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadedFile) Reset() {
+	*x = UploadedFile{}
+	mi := &file_proto_education_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedFile) ProtoMessage() {}
+
+func (x *UploadedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedFile.ProtoReflect.Descriptor instead.
+func (*UploadedFile) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UploadedFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadedFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadedFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type SubmitAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"` // ID задания.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`          // ID студента.
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`                                      // Текст решения.
+	Files         []*UploadedFile        `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`                                    // Файлы решения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAssignmentRequest) Reset() {
+	*x = SubmitAssignmentRequest{}
+	mi := &file_proto_education_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAssignmentRequest) ProtoMessage() {}
+
+func (x *SubmitAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SubmitAssignmentRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SubmitAssignmentRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *SubmitAssignmentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SubmitAssignmentRequest) GetFiles() []*UploadedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type SubmissionFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                     // ID файла.
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                          // Имя файла.
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME-тип.
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                                 // Размер в байтах.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionFile) Reset() {
+	*x = SubmissionFile{}
+	mi := &file_proto_education_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionFile) ProtoMessage() {}
+
+func (x *SubmissionFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionFile.ProtoReflect.Descriptor instead.
+func (*SubmissionFile) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SubmissionFile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubmissionFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *SubmissionFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *SubmissionFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type RubricScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CriterionId   int64                  `protobuf:"varint,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"` // ID критерия рубрики.
+	Points        int32                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`                              // Баллы по критерию.
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                             // Комментарий преподавателя.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricScore) Reset() {
+	*x = RubricScore{}
+	mi := &file_proto_education_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricScore) ProtoMessage() {}
+
+func (x *RubricScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricScore.ProtoReflect.Descriptor instead.
+func (*RubricScore) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RubricScore) GetCriterionId() int64 {
+	if x != nil {
+		return x.CriterionId
+	}
+	return 0
+}
+
+func (x *RubricScore) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RubricScore) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type Submission struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                               // ID решения.
+	AssignmentId   int64                  `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`       // ID задания.
+	StudentId      int64                  `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                // ID студента.
+	Version        int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                                     // Номер версии решения.
+	Text           string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`                                            // Текст решения.
+	SubmittedAt    string                 `protobuf:"bytes,6,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`           // Время отправки (RFC3339).
+	Late           bool                   `protobuf:"varint,7,opt,name=late,proto3" json:"late,omitempty"`                                           // Решение отправлено после срока.
+	PenaltyPercent int32                  `protobuf:"varint,8,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"` // Штраф за просрочку в процентах.
+	Graded         bool                   `protobuf:"varint,9,opt,name=graded,proto3" json:"graded,omitempty"`                                       // Решение проверено.
+	RawScore       int32                  `protobuf:"varint,10,opt,name=raw_score,json=rawScore,proto3" json:"raw_score,omitempty"`                  // Оценка без учёта штрафа.
+	Score          int32                  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`                                        // Итоговая оценка.
+	Feedback       string                 `protobuf:"bytes,12,opt,name=feedback,proto3" json:"feedback,omitempty"`                                   // Отзыв преподавателя.
+	GradedAt       string                 `protobuf:"bytes,13,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`                   // Время проверки (RFC3339).
+	Files          []*SubmissionFile      `protobuf:"bytes,14,rep,name=files,proto3" json:"files,omitempty"`                                         // Файлы решения.
+	RubricScores   []*RubricScore         `protobuf:"bytes,15,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`       // Баллы по критериям рубрики.
+	PeerGraded     bool                   `protobuf:"varint,16,opt,name=peer_graded,json=peerGraded,proto3" json:"peer_graded,omitempty"`            // Оценка выставлена по рецензиям студентов, а не преподавателем.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	mi := &file_proto_education_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Submission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Submission) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *Submission) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *Submission) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Submission) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Submission) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *Submission) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *Submission) GetPenaltyPercent() int32 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+func (x *Submission) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *Submission) GetRawScore() int32 {
+	if x != nil {
+		return x.RawScore
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Submission) GetGradedAt() string {
+	if x != nil {
+		return x.GradedAt
+	}
+	return ""
+}
+
+func (x *Submission) GetFiles() []*SubmissionFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Submission) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *Submission) GetPeerGraded() bool {
+	if x != nil {
+		return x.PeerGraded
+	}
+	return false
+}
+
+type SubmissionIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"` // ID решения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionIDRequest) Reset() {
+	*x = SubmissionIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionIDRequest) ProtoMessage() {}
+
+func (x *SubmissionIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionIDRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SubmissionIDRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+type ListSubmissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"` // ID задания.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`          // ID студента, 0 — все студенты.
+	UngradedOnly  bool                   `protobuf:"varint,3,opt,name=ungraded_only,json=ungradedOnly,proto3" json:"ungraded_only,omitempty"` // Только непроверенные решения.
+	LateOnly      bool                   `protobuf:"varint,4,opt,name=late_only,json=lateOnly,proto3" json:"late_only,omitempty"`             // Только просроченные решения.
+	AllVersions   bool                   `protobuf:"varint,5,opt,name=all_versions,json=allVersions,proto3" json:"all_versions,omitempty"`    // Все версии решений, а не только последние.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsRequest) Reset() {
+	*x = ListSubmissionsRequest{}
+	mi := &file_proto_education_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListSubmissionsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ListSubmissionsRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *ListSubmissionsRequest) GetUngradedOnly() bool {
+	if x != nil {
+		return x.UngradedOnly
+	}
+	return false
+}
+
+func (x *ListSubmissionsRequest) GetLateOnly() bool {
+	if x != nil {
+		return x.LateOnly
+	}
+	return false
+}
+
+func (x *ListSubmissionsRequest) GetAllVersions() bool {
+	if x != nil {
+		return x.AllVersions
+	}
+	return false
+}
+
+type SubmissionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*Submission          `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"` // Список решений.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionList) Reset() {
+	*x = SubmissionList{}
+	mi := &file_proto_education_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionList) ProtoMessage() {}
+
+func (x *SubmissionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionList.ProtoReflect.Descriptor instead.
+func (*SubmissionList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SubmissionList) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type GradeSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"` // ID решения.
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`                                   // Оценка без учёта штрафа; при наличии рубрики считается по баллам критериев.
+	Feedback      string                 `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`                              // Отзыв преподавателя.
+	RubricScores  []*RubricScore         `protobuf:"bytes,4,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`  // Баллы по всем критериям рубрики.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeSubmissionRequest) Reset() {
+	*x = GradeSubmissionRequest{}
+	mi := &file_proto_education_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeSubmissionRequest) ProtoMessage() {}
+
+func (x *GradeSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GradeSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GradeSubmissionRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *GradeSubmissionRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GradeSubmissionRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *GradeSubmissionRequest) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+type SubmissionFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"` // ID файла.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionFileRequest) Reset() {
+	*x = SubmissionFileRequest{}
+	mi := &file_proto_education_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionFileRequest) ProtoMessage() {}
+
+func (x *SubmissionFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionFileRequest.ProtoReflect.Descriptor instead.
+func (*SubmissionFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SubmissionFileRequest) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+type SubmissionFileContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`                          // Имя файла.
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME-тип.
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                            // Содержимое файла.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionFileContent) Reset() {
+	*x = SubmissionFileContent{}
+	mi := &file_proto_education_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionFileContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionFileContent) ProtoMessage() {}
+
+func (x *SubmissionFileContent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionFileContent.ProtoReflect.Descriptor instead.
+func (*SubmissionFileContent) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SubmissionFileContent) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *SubmissionFileContent) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *SubmissionFileContent) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type PeerReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID рецензии.
+	SubmissionId  int64                  `protobuf:"varint,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"` // ID решения.
+	ReviewerId    int64                  `protobuf:"varint,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`       // ID студента-рецензента; скрыт от автора решения.
+	AssignedAt    string                 `protobuf:"bytes,4,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`        // Время назначения (RFC3339).
+	Submitted     bool                   `protobuf:"varint,5,opt,name=submitted,proto3" json:"submitted,omitempty"`                           // Рецензия отправлена.
+	SubmittedAt   string                 `protobuf:"bytes,6,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`     // Время отправки (RFC3339).
+	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`                                // Общий комментарий рецензента.
+	Scores        []*RubricScore         `protobuf:"bytes,8,rep,name=scores,proto3" json:"scores,omitempty"`                                  // Баллы по критериям рубрики.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerReview) Reset() {
+	*x = PeerReview{}
+	mi := &file_proto_education_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReview) ProtoMessage() {}
+
+func (x *PeerReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReview.ProtoReflect.Descriptor instead.
+func (*PeerReview) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PeerReview) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PeerReview) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *PeerReview) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *PeerReview) GetAssignedAt() string {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return ""
+}
+
+func (x *PeerReview) GetSubmitted() bool {
+	if x != nil {
+		return x.Submitted
+	}
+	return false
+}
+
+func (x *PeerReview) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *PeerReview) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PeerReview) GetScores() []*RubricScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type PeerReviewTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`       // ID студента-рецензента.
+	PendingOnly   bool                   `protobuf:"varint,2,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"` // Только неотправленные рецензии.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerReviewTasksRequest) Reset() {
+	*x = PeerReviewTasksRequest{}
+	mi := &file_proto_education_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerReviewTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviewTasksRequest) ProtoMessage() {}
+
+func (x *PeerReviewTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviewTasksRequest.ProtoReflect.Descriptor instead.
+func (*PeerReviewTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PeerReviewTasksRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *PeerReviewTasksRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+type PeerReviewTask struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Review          *PeerReview            `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`                                          // Рецензия.
+	AssignmentId    int64                  `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`         // ID задания.
+	AssignmentTitle string                 `protobuf:"bytes,3,opt,name=assignment_title,json=assignmentTitle,proto3" json:"assignment_title,omitempty"` // Название задания.
+	DueAt           string                 `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                               // Срок рецензирования (RFC3339), пусто — без срока.
+	Rubric          []*RubricCriterion     `protobuf:"bytes,5,rep,name=rubric,proto3" json:"rubric,omitempty"`                                          // Критерии оценивания.
+	Text            string                 `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`                                              // Текст решения.
+	Files           []*SubmissionFile      `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`                                            // Файлы решения.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PeerReviewTask) Reset() {
+	*x = PeerReviewTask{}
+	mi := &file_proto_education_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerReviewTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviewTask) ProtoMessage() {}
+
+func (x *PeerReviewTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviewTask.ProtoReflect.Descriptor instead.
+func (*PeerReviewTask) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PeerReviewTask) GetReview() *PeerReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *PeerReviewTask) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *PeerReviewTask) GetAssignmentTitle() string {
+	if x != nil {
+		return x.AssignmentTitle
+	}
+	return ""
+}
+
+func (x *PeerReviewTask) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *PeerReviewTask) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *PeerReviewTask) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PeerReviewTask) GetFiles() []*SubmissionFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type PeerReviewTaskList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*PeerReviewTask      `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // Назначенные рецензии.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerReviewTaskList) Reset() {
+	*x = PeerReviewTaskList{}
+	mi := &file_proto_education_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerReviewTaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviewTaskList) ProtoMessage() {}
+
+func (x *PeerReviewTaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviewTaskList.ProtoReflect.Descriptor instead.
+func (*PeerReviewTaskList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *PeerReviewTaskList) GetTasks() []*PeerReviewTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type SubmitPeerReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // ID рецензии.
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`                    // Общий комментарий.
+	Scores        []*RubricScore         `protobuf:"bytes,3,rep,name=scores,proto3" json:"scores,omitempty"`                      // Баллы по всем критериям рубрики.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitPeerReviewRequest) Reset() {
+	*x = SubmitPeerReviewRequest{}
+	mi := &file_proto_education_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPeerReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPeerReviewRequest) ProtoMessage() {}
+
+func (x *SubmitPeerReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPeerReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPeerReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SubmitPeerReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *SubmitPeerReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SubmitPeerReviewRequest) GetScores() []*RubricScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type PeerReviewList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*PeerReview          `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // Список рецензий.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerReviewList) Reset() {
+	*x = PeerReviewList{}
+	mi := &file_proto_education_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerReviewList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviewList) ProtoMessage() {}
+
+func (x *PeerReviewList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviewList.ProtoReflect.Descriptor instead.
+func (*PeerReviewList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PeerReviewList) GetReviews() []*PeerReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type GradeCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID категории.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                          // Название категории.
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`                    // Вес категории в итоговой оценке.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeCategory) Reset() {
+	*x = GradeCategory{}
+	mi := &file_proto_education_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeCategory) ProtoMessage() {}
+
+func (x *GradeCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeCategory.ProtoReflect.Descriptor instead.
+func (*GradeCategory) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GradeCategory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GradeCategory) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GradeCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GradeCategory) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type CreateGradeCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                          // Название категории.
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`                    // Вес категории, больше нуля; веса нормируются по категориям с оценками.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGradeCategoryRequest) Reset() {
+	*x = CreateGradeCategoryRequest{}
+	mi := &file_proto_education_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGradeCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGradeCategoryRequest) ProtoMessage() {}
+
+func (x *CreateGradeCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGradeCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateGradeCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreateGradeCategoryRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateGradeCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGradeCategoryRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type UpdateGradeCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // ID категории.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // Название категории.
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"` // Вес категории, больше нуля.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGradeCategoryRequest) Reset() {
+	*x = UpdateGradeCategoryRequest{}
+	mi := &file_proto_education_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGradeCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGradeCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateGradeCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGradeCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGradeCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *UpdateGradeCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGradeCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGradeCategoryRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type GradeCategoryIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // ID категории.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeCategoryIDRequest) Reset() {
+	*x = GradeCategoryIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeCategoryIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeCategoryIDRequest) ProtoMessage() {}
+
+func (x *GradeCategoryIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeCategoryIDRequest.ProtoReflect.Descriptor instead.
+func (*GradeCategoryIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GradeCategoryIDRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type GradeCategoryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*GradeCategory       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // Список категорий.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeCategoryList) Reset() {
+	*x = GradeCategoryList{}
+	mi := &file_proto_education_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeCategoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeCategoryList) ProtoMessage() {}
+
+func (x *GradeCategoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeCategoryList.ProtoReflect.Descriptor instead.
+func (*GradeCategoryList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GradeCategoryList) GetCategories() []*GradeCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type SetGradeItemCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                          // ID курса.
+	ItemType      GradeItemType          `protobuf:"varint,2,opt,name=item_type,json=itemType,proto3,enum=GoEdu.GradeItemType" json:"item_type,omitempty"` // Тип элемента: тест или задание.
+	ItemId        int64                  `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                // ID теста или задания.
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                    // ID категории, 0 — убрать из категории.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeItemCategoryRequest) Reset() {
+	*x = SetGradeItemCategoryRequest{}
+	mi := &file_proto_education_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeItemCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeItemCategoryRequest) ProtoMessage() {}
+
+func (x *SetGradeItemCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeItemCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SetGradeItemCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SetGradeItemCategoryRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SetGradeItemCategoryRequest) GetItemType() GradeItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return GradeItemType_GRADE_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *SetGradeItemCategoryRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *SetGradeItemCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type GradeItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          GradeItemType          `protobuf:"varint,1,opt,name=type,proto3,enum=GoEdu.GradeItemType" json:"type,omitempty"`      // Тип элемента.
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                                   // ID теста или задания.
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                              // Название.
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // ID категории, 0 — без категории.
+	MaxScore      float64                `protobuf:"fixed64,5,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`      // Максимальная оценка (для тестов — 100%).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeItem) Reset() {
+	*x = GradeItem{}
+	mi := &file_proto_education_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeItem) ProtoMessage() {}
+
+func (x *GradeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeItem.ProtoReflect.Descriptor instead.
+func (*GradeItem) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GradeItem) GetType() GradeItemType {
+	if x != nil {
+		return x.Type
+	}
+	return GradeItemType_GRADE_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *GradeItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GradeItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GradeItem) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GradeItem) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+type LetterGrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Letter        string                 `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`                             // Буквенная оценка.
+	MinPercent    float64                `protobuf:"fixed64,2,opt,name=min_percent,json=minPercent,proto3" json:"min_percent,omitempty"` // Минимальный итоговый процент для этой оценки.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LetterGrade) Reset() {
+	*x = LetterGrade{}
+	mi := &file_proto_education_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LetterGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LetterGrade) ProtoMessage() {}
+
+func (x *LetterGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LetterGrade.ProtoReflect.Descriptor instead.
+func (*LetterGrade) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *LetterGrade) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *LetterGrade) GetMinPercent() float64 {
+	if x != nil {
+		return x.MinPercent
+	}
+	return 0
+}
+
+type LetterScheme struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
+	Letters       []*LetterGrade         `protobuf:"bytes,2,rep,name=letters,proto3" json:"letters,omitempty"`                       // Оценки по убыванию порога.
+	IsDefault     bool                   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // Курс использует шкалу по умолчанию.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LetterScheme) Reset() {
+	*x = LetterScheme{}
+	mi := &file_proto_education_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LetterScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LetterScheme) ProtoMessage() {}
+
+func (x *LetterScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LetterScheme.ProtoReflect.Descriptor instead.
+func (*LetterScheme) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *LetterScheme) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *LetterScheme) GetLetters() []*LetterGrade {
+	if x != nil {
+		return x.Letters
+	}
+	return nil
+}
+
+func (x *LetterScheme) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type SetLetterSchemeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Letters       []*LetterGrade         `protobuf:"bytes,2,rep,name=letters,proto3" json:"letters,omitempty"`                    // Оценки; одна из них должна иметь порог 0.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLetterSchemeRequest) Reset() {
+	*x = SetLetterSchemeRequest{}
+	mi := &file_proto_education_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLetterSchemeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLetterSchemeRequest) ProtoMessage() {}
+
+func (x *SetLetterSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLetterSchemeRequest.ProtoReflect.Descriptor instead.
+func (*SetLetterSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SetLetterSchemeRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SetLetterSchemeRequest) GetLetters() []*LetterGrade {
+	if x != nil {
+		return x.Letters
+	}
+	return nil
+}
+
+type ItemGrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemType      GradeItemType          `protobuf:"varint,1,opt,name=item_type,json=itemType,proto3,enum=GoEdu.GradeItemType" json:"item_type,omitempty"` // Тип элемента.
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                // ID теста или задания.
+	Graded        bool                   `protobuf:"varint,3,opt,name=graded,proto3" json:"graded,omitempty"`                                              // Оценка есть.
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`                                               // Оценка с учётом ручного изменения.
+	Adjusted      bool                   `protobuf:"varint,5,opt,name=adjusted,proto3" json:"adjusted,omitempty"`                                          // Оценка изменена вручную.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemGrade) Reset() {
+	*x = ItemGrade{}
+	mi := &file_proto_education_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemGrade) ProtoMessage() {}
+
+func (x *ItemGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemGrade.ProtoReflect.Descriptor instead.
+func (*ItemGrade) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ItemGrade) GetItemType() GradeItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return GradeItemType_GRADE_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *ItemGrade) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ItemGrade) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *ItemGrade) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ItemGrade) GetAdjusted() bool {
+	if x != nil {
+		return x.Adjusted
+	}
+	return false
+}
+
+type CategoryGrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // ID категории.
+	Graded        bool                   `protobuf:"varint,2,opt,name=graded,proto3" json:"graded,omitempty"`                           // В категории есть оценки.
+	Percent       float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`                        // Средний процент по оценённым элементам категории.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryGrade) Reset() {
+	*x = CategoryGrade{}
+	mi := &file_proto_education_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryGrade) ProtoMessage() {}
+
+func (x *CategoryGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryGrade.ProtoReflect.Descriptor instead.
+func (*CategoryGrade) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *CategoryGrade) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryGrade) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *CategoryGrade) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type StudentGrades struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`             // ID студента.
+	StudentName   string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`        // Имя студента.
+	StudentEmail  string                 `protobuf:"bytes,3,opt,name=student_email,json=studentEmail,proto3" json:"student_email,omitempty"`     // Email студента.
+	Items         []*ItemGrade           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                                       // Оценки за тесты и задания.
+	Categories    []*CategoryGrade       `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`                             // Оценки по категориям.
+	Graded        bool                   `protobuf:"varint,6,opt,name=graded,proto3" json:"graded,omitempty"`                                    // Итоговая оценка рассчитана.
+	FinalPercent  float64                `protobuf:"fixed64,7,opt,name=final_percent,json=finalPercent,proto3" json:"final_percent,omitempty"`   // Итоговый процент.
+	FinalAdjusted bool                   `protobuf:"varint,8,opt,name=final_adjusted,json=finalAdjusted,proto3" json:"final_adjusted,omitempty"` // Итоговая оценка изменена вручную.
+	Letter        string                 `protobuf:"bytes,9,opt,name=letter,proto3" json:"letter,omitempty"`                                     // Буквенная оценка.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGrades) Reset() {
+	*x = StudentGrades{}
+	mi := &file_proto_education_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGrades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGrades) ProtoMessage() {}
+
+func (x *StudentGrades) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGrades.ProtoReflect.Descriptor instead.
+func (*StudentGrades) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *StudentGrades) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *StudentGrades) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentGrades) GetStudentEmail() string {
+	if x != nil {
+		return x.StudentEmail
+	}
+	return ""
+}
+
+func (x *StudentGrades) GetItems() []*ItemGrade {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StudentGrades) GetCategories() []*CategoryGrade {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *StudentGrades) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *StudentGrades) GetFinalPercent() float64 {
+	if x != nil {
+		return x.FinalPercent
+	}
+	return 0
+}
+
+func (x *StudentGrades) GetFinalAdjusted() bool {
+	if x != nil {
+		return x.FinalAdjusted
+	}
+	return false
+}
+
+func (x *StudentGrades) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+type Gradebook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Categories    []*GradeCategory       `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`              // Категории оценок.
+	Items         []*GradeItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`                        // Тесты и задания курса.
+	Letters       []*LetterGrade         `protobuf:"bytes,4,rep,name=letters,proto3" json:"letters,omitempty"`                    // Шкала буквенных оценок.
+	Students      []*StudentGrades       `protobuf:"bytes,5,rep,name=students,proto3" json:"students,omitempty"`                  // Оценки студентов курса.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Gradebook) Reset() {
+	*x = Gradebook{}
+	mi := &file_proto_education_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gradebook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gradebook) ProtoMessage() {}
+
+func (x *Gradebook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gradebook.ProtoReflect.Descriptor instead.
+func (*Gradebook) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *Gradebook) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Gradebook) GetCategories() []*GradeCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Gradebook) GetItems() []*GradeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Gradebook) GetLetters() []*LetterGrade {
+	if x != nil {
+		return x.Letters
+	}
+	return nil
+}
+
+func (x *Gradebook) GetStudents() []*StudentGrades {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type StudentGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGradesRequest) Reset() {
+	*x = StudentGradesRequest{}
+	mi := &file_proto_education_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGradesRequest) ProtoMessage() {}
+
+func (x *StudentGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGradesRequest.ProtoReflect.Descriptor instead.
+func (*StudentGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *StudentGradesRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *StudentGradesRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type AdjustGradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                          // ID курса.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                       // ID студента.
+	ItemType      GradeItemType          `protobuf:"varint,3,opt,name=item_type,json=itemType,proto3,enum=GoEdu.GradeItemType" json:"item_type,omitempty"` // Что изменяется: тест, задание или итоговая оценка.
+	ItemId        int64                  `protobuf:"varint,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                // ID теста или задания; для итоговой оценки не указывается.
+	Score         *float64               `protobuf:"fixed64,5,opt,name=score,proto3,oneof" json:"score,omitempty"`                                         // Новая оценка (для тестов и итоговой — в процентах); не задана — отменить ручное изменение.
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                               // Причина изменения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustGradeRequest) Reset() {
+	*x = AdjustGradeRequest{}
+	mi := &file_proto_education_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustGradeRequest) ProtoMessage() {}
+
+func (x *AdjustGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustGradeRequest.ProtoReflect.Descriptor instead.
+func (*AdjustGradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *AdjustGradeRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *AdjustGradeRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *AdjustGradeRequest) GetItemType() GradeItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return GradeItemType_GRADE_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *AdjustGradeRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *AdjustGradeRequest) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *AdjustGradeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GradeAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                      // ID изменения.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                          // ID курса.
+	StudentId     int64                  `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                       // ID студента.
+	ItemType      GradeItemType          `protobuf:"varint,4,opt,name=item_type,json=itemType,proto3,enum=GoEdu.GradeItemType" json:"item_type,omitempty"` // Что изменено.
+	ItemId        int64                  `protobuf:"varint,5,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                // ID теста или задания.
+	Score         *float64               `protobuf:"fixed64,6,opt,name=score,proto3,oneof" json:"score,omitempty"`                                         // Новая оценка; не задана — ручное изменение отменено.
+	PreviousScore *float64               `protobuf:"fixed64,7,opt,name=previous_score,json=previousScore,proto3,oneof" json:"previous_score,omitempty"`    // Оценка до изменения; не задана — оценки не было.
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`                                               // Причина изменения.
+	InstructorId  int64                  `protobuf:"varint,9,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`              // ID преподавателя, изменившего оценку.
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                       // Время изменения (RFC3339).
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeAdjustment) Reset() {
+	*x = GradeAdjustment{}
+	mi := &file_proto_education_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeAdjustment) ProtoMessage() {}
+
+func (x *GradeAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeAdjustment.ProtoReflect.Descriptor instead.
+func (*GradeAdjustment) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GradeAdjustment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GradeAdjustment) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GradeAdjustment) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *GradeAdjustment) GetItemType() GradeItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return GradeItemType_GRADE_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *GradeAdjustment) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *GradeAdjustment) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *GradeAdjustment) GetPreviousScore() float64 {
+	if x != nil && x.PreviousScore != nil {
+		return *x.PreviousScore
+	}
+	return 0
+}
+
+func (x *GradeAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GradeAdjustment) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GradeAdjustment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListGradeAdjustmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента, 0 — все студенты.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradeAdjustmentsRequest) Reset() {
+	*x = ListGradeAdjustmentsRequest{}
+	mi := &file_proto_education_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradeAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeAdjustmentsRequest) ProtoMessage() {}
+
+func (x *ListGradeAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListGradeAdjustmentsRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ListGradeAdjustmentsRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type GradeAdjustmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adjustments   []*GradeAdjustment     `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"` // Изменения в порядке внесения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeAdjustmentList) Reset() {
+	*x = GradeAdjustmentList{}
+	mi := &file_proto_education_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeAdjustmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeAdjustmentList) ProtoMessage() {}
+
+func (x *GradeAdjustmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeAdjustmentList.ProtoReflect.Descriptor instead.
+func (*GradeAdjustmentList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GradeAdjustmentList) GetAdjustments() []*GradeAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type ExportGradebookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`        // ID курса.
+	Format        GradebookFormat        `protobuf:"varint,2,opt,name=format,proto3,enum=GoEdu.GradebookFormat" json:"format,omitempty"` // Формат файла.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGradebookRequest) Reset() {
+	*x = ExportGradebookRequest{}
+	mi := &file_proto_education_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGradebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGradebookRequest) ProtoMessage() {}
+
+func (x *ExportGradebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGradebookRequest.ProtoReflect.Descriptor instead.
+func (*ExportGradebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ExportGradebookRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ExportGradebookRequest) GetFormat() GradebookFormat {
+	if x != nil {
+		return x.Format
+	}
+	return GradebookFormat_GRADEBOOK_FORMAT_CSV
+}
+
+type GradebookFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`                          // Имя файла.
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME-тип.
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                            // Содержимое файла.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradebookFile) Reset() {
+	*x = GradebookFile{}
+	mi := &file_proto_education_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradebookFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradebookFile) ProtoMessage() {}
+
+func (x *GradebookFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradebookFile.ProtoReflect.Descriptor instead.
+func (*GradebookFile) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GradebookFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GradebookFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GradebookFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ReportReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // ID отзыва.
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                      // Причина жалобы.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportReviewRequest) Reset() {
+	*x = ReportReviewRequest{}
+	mi := &file_proto_education_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportReviewRequest) ProtoMessage() {}
+
+func (x *ReportReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReportReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ReportReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReportReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModerateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // ID отзыва.
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`                          // Комментарий модератора; для отклонения обязателен.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_proto_education_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ModerateReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ModerationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`                                    // Отзыв.
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                    // Почему отзыв попал в очередь.
+	ReportCount   int32                  `protobuf:"varint,3,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`      // Число открытых жалоб.
+	ReportReasons []string               `protobuf:"bytes,4,rep,name=report_reasons,json=reportReasons,proto3" json:"report_reasons,omitempty"` // Причины открытых жалоб.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationItem) Reset() {
+	*x = ModerationItem{}
+	mi := &file_proto_education_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationItem) ProtoMessage() {}
+
+func (x *ModerationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationItem.ProtoReflect.Descriptor instead.
+func (*ModerationItem) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ModerationItem) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *ModerationItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetReportReasons() []string {
+	if x != nil {
+		return x.ReportReasons
+	}
+	return nil
+}
+
+type ModerationQueue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ModerationItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Отзывы, ожидающие решения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationQueue) Reset() {
+	*x = ModerationQueue{}
+	mi := &file_proto_education_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationQueue) ProtoMessage() {}
+
+func (x *ModerationQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationQueue.ProtoReflect.Descriptor instead.
+func (*ModerationQueue) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ModerationQueue) GetItems() []*ModerationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type LearningPathItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`                 // Порядковый номер курса в программе, начиная с 1.
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`                 // Обязателен ли курс для завершения программы.
+	Course        *Course                `protobuf:"bytes,4,opt,name=course,proto3" json:"course,omitempty"`                      // Курс.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearningPathItem) Reset() {
+	*x = LearningPathItem{}
+	mi := &file_proto_education_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPathItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPathItem) ProtoMessage() {}
+
+func (x *LearningPathItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPathItem.ProtoReflect.Descriptor instead.
+func (*LearningPathItem) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *LearningPathItem) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *LearningPathItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LearningPathItem) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *LearningPathItem) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type LearningPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID программы.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // Название программы.
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                        // Описание программы.
+	InstructorId  int64                  `protobuf:"varint,4,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // Преподаватель, составивший программу.
+	Items         []*LearningPathItem    `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`                                    // Курсы в порядке прохождения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearningPath) Reset() {
+	*x = LearningPath{}
+	mi := &file_proto_education_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPath) ProtoMessage() {}
+
+func (x *LearningPath) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPath.ProtoReflect.Descriptor instead.
+func (*LearningPath) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *LearningPath) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LearningPath) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LearningPath) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LearningPath) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *LearningPath) GetItems() []*LearningPathItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type LearningPathList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paths         []*LearningPath        `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"` // Список программ.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearningPathList) Reset() {
+	*x = LearningPathList{}
+	mi := &file_proto_education_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPathList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPathList) ProtoMessage() {}
+
+func (x *LearningPathList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPathList.ProtoReflect.Descriptor instead.
+func (*LearningPathList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *LearningPathList) GetPaths() []*LearningPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type LearningPathItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
+	Required      *bool                  `protobuf:"varint,2,opt,name=required,proto3,oneof" json:"required,omitempty"`           // Обязателен ли курс; по умолчанию обязателен.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearningPathItemRequest) Reset() {
+	*x = LearningPathItemRequest{}
+	mi := &file_proto_education_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPathItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPathItemRequest) ProtoMessage() {}
+
+func (x *LearningPathItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPathItemRequest.ProtoReflect.Descriptor instead.
+func (*LearningPathItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *LearningPathItemRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *LearningPathItemRequest) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+type NewLearningPathRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Name          string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Название программы.
+	Description   string                     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                        // Описание программы.
+	InstructorId  int64                      `protobuf:"varint,3,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // Преподаватель, составивший программу.
+	Items         []*LearningPathItemRequest `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                                    // Курсы в порядке прохождения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewLearningPathRequest) Reset() {
+	*x = NewLearningPathRequest{}
+	mi := &file_proto_education_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewLearningPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewLearningPathRequest) ProtoMessage() {}
+
+func (x *NewLearningPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewLearningPathRequest.ProtoReflect.Descriptor instead.
+func (*NewLearningPathRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *NewLearningPathRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewLearningPathRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NewLearningPathRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *NewLearningPathRequest) GetItems() []*LearningPathItemRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateLearningPathRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            int64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // ID программы.
+	Name          string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Новое название.
+	Description   string                     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Новое описание.
+	Items         []*LearningPathItemRequest `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`             // Новый список курсов в порядке прохождения.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLearningPathRequest) Reset() {
+	*x = UpdateLearningPathRequest{}
+	mi := &file_proto_education_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLearningPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLearningPathRequest) ProtoMessage() {}
+
+func (x *UpdateLearningPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLearningPathRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLearningPathRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *UpdateLearningPathRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateLearningPathRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLearningPathRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateLearningPathRequest) GetItems() []*LearningPathItemRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type LearningPathIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PathId        int64                  `protobuf:"varint,1,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"` // ID программы.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearningPathIDRequest) Reset() {
+	*x = LearningPathIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPathIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPathIDRequest) ProtoMessage() {}
+
+func (x *LearningPathIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPathIDRequest.ProtoReflect.Descriptor instead.
+func (*LearningPathIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *LearningPathIDRequest) GetPathId() int64 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+type LearningPathStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PathId        int64                  `protobuf:"varint,1,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`          // ID программы.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearningPathStudentRequest) Reset() {
+	*x = LearningPathStudentRequest{}
+	mi := &file_proto_education_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPathStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPathStudentRequest) ProtoMessage() {}
+
+func (x *LearningPathStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPathStudentRequest.ProtoReflect.Descriptor instead.
+func (*LearningPathStudentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *LearningPathStudentRequest) GetPathId() int64 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *LearningPathStudentRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type LearningPathEnrollment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PathId          int64                  `protobuf:"varint,1,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`                            // ID программы.
+	StudentId       int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                   // ID студента.
+	EnrolledCourses int32                  `protobuf:"varint,3,opt,name=enrolled_courses,json=enrolledCourses,proto3" json:"enrolled_courses,omitempty"` // На сколько курсов программы студент записан этим запросом.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LearningPathEnrollment) Reset() {
+	*x = LearningPathEnrollment{}
+	mi := &file_proto_education_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearningPathEnrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearningPathEnrollment) ProtoMessage() {}
+
+func (x *LearningPathEnrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearningPathEnrollment.ProtoReflect.Descriptor instead.
+func (*LearningPathEnrollment) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *LearningPathEnrollment) GetPathId() int64 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *LearningPathEnrollment) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *LearningPathEnrollment) GetEnrolledCourses() int32 {
 	if x != nil {
-		return x.Status
+		return x.EnrolledCourses
 	}
-	return false
+	return 0
 }
 
-// Сообщения, связанные с курсами.
-type Course struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID курса.
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // Название курса.
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                        // Описание курса.
-	InstructorId  int64                  `protobuf:"varint,4,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type LearningPathCourseProgress struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                         // ID курса.
+	CourseName       string                 `protobuf:"bytes,2,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`                    // Название курса.
+	Required         bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`                                         // Обязателен ли курс.
+	CompletedPercent int32                  `protobuf:"varint,4,opt,name=completed_percent,json=completedPercent,proto3" json:"completed_percent,omitempty"` // Доля пройденных лекций курса.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Course) Reset() {
-	*x = Course{}
-	mi := &file_proto_education_proto_msgTypes[3]
+func (x *LearningPathCourseProgress) Reset() {
+	*x = LearningPathCourseProgress{}
+	mi := &file_proto_education_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Course) String() string {
+func (x *LearningPathCourseProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Course) ProtoMessage() {}
+func (*LearningPathCourseProgress) ProtoMessage() {}
 
-func (x *Course) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[3]
+func (x *LearningPathCourseProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,61 +10983,68 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Course.ProtoReflect.Descriptor instead.
-func (*Course) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use LearningPathCourseProgress.ProtoReflect.Descriptor instead.
+func (*LearningPathCourseProgress) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *Course) GetId() int64 {
+func (x *LearningPathCourseProgress) GetCourseId() int64 {
 	if x != nil {
-		return x.Id
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Course) GetName() string {
+func (x *LearningPathCourseProgress) GetCourseName() string {
 	if x != nil {
-		return x.Name
+		return x.CourseName
 	}
 	return ""
 }
 
-func (x *Course) GetDescription() string {
+func (x *LearningPathCourseProgress) GetRequired() bool {
 	if x != nil {
-		return x.Description
+		return x.Required
 	}
-	return ""
+	return false
 }
 
-func (x *Course) GetInstructorId() int64 {
+func (x *LearningPathCourseProgress) GetCompletedPercent() int32 {
 	if x != nil {
-		return x.InstructorId
+		return x.CompletedPercent
 	}
 	return 0
 }
 
-type CourseList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"` // Список курсов.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type LearningPathProgress struct {
+	state             protoimpl.MessageState        `protogen:"open.v1"`
+	PathId            int64                         `protobuf:"varint,1,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`                                  // ID программы.
+	StudentId         int64                         `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                         // ID студента.
+	CompletedPercent  int32                         `protobuf:"varint,3,opt,name=completed_percent,json=completedPercent,proto3" json:"completed_percent,omitempty"`    // Средний прогресс по обязательным курсам.
+	RequiredCompleted int32                         `protobuf:"varint,4,opt,name=required_completed,json=requiredCompleted,proto3" json:"required_completed,omitempty"` // Пройдено обязательных курсов.
+	RequiredTotal     int32                         `protobuf:"varint,5,opt,name=required_total,json=requiredTotal,proto3" json:"required_total,omitempty"`             // Всего обязательных курсов.
+	Completed         bool                          `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`                                          // Все обязательные курсы пройдены.
+	Courses           []*LearningPathCourseProgress `protobuf:"bytes,7,rep,name=courses,proto3" json:"courses,omitempty"`                                               // Прогресс по курсам в порядке прохождения.
+	Certificate       *Certificate                  `protobuf:"bytes,8,opt,name=certificate,proto3" json:"certificate,omitempty"`                                       // Сертификат программы, если выдан.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CourseList) Reset() {
-	*x = CourseList{}
-	mi := &file_proto_education_proto_msgTypes[4]
+func (x *LearningPathProgress) Reset() {
+	*x = LearningPathProgress{}
+	mi := &file_proto_education_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CourseList) String() string {
+func (x *LearningPathProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseList) ProtoMessage() {}
+func (*LearningPathProgress) ProtoMessage() {}
 
-func (x *CourseList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[4]
+func (x *LearningPathProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,40 +11055,98 @@ func (x *CourseList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseList.ProtoReflect.Descriptor instead.
-func (*CourseList) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LearningPathProgress.ProtoReflect.Descriptor instead.
+func (*LearningPathProgress) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *CourseList) GetCourses() []*Course {
+func (x *LearningPathProgress) GetPathId() int64 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *LearningPathProgress) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *LearningPathProgress) GetCompletedPercent() int32 {
+	if x != nil {
+		return x.CompletedPercent
+	}
+	return 0
+}
+
+func (x *LearningPathProgress) GetRequiredCompleted() int32 {
+	if x != nil {
+		return x.RequiredCompleted
+	}
+	return 0
+}
+
+func (x *LearningPathProgress) GetRequiredTotal() int32 {
+	if x != nil {
+		return x.RequiredTotal
+	}
+	return 0
+}
+
+func (x *LearningPathProgress) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *LearningPathProgress) GetCourses() []*LearningPathCourseProgress {
 	if x != nil {
 		return x.Courses
 	}
 	return nil
 }
 
-type CourseIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LearningPathProgress) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
 }
 
-func (x *CourseIDRequest) Reset() {
-	*x = CourseIDRequest{}
-	mi := &file_proto_education_proto_msgTypes[5]
+type Webhook struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                              // ID подписки.
+	OwnerRole           string                 `protobuf:"bytes,2,opt,name=owner_role,json=ownerRole,proto3" json:"owner_role,omitempty"`                                // Роль владельца: instructor или admin.
+	OwnerId             int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                                     // ID владельца.
+	Url                 string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                                                             // Адрес, на который отправляются события.
+	EventTypes          []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`                             // Типы событий, например CourseCompleted.
+	Secret              string                 `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`                                                       // Секрет подписи HMAC-SHA256; возвращается только при создании.
+	Active              bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`                                                      // Отправляются ли события.
+	DisabledReason      string                 `protobuf:"bytes,8,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"`                 // Почему подписка выключена автоматически.
+	ConsecutiveFailures int32                  `protobuf:"varint,9,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"` // Число неудачных доставок подряд.
+	CreatedAt           string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                               // Дата создания (RFC3339).
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_proto_education_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CourseIDRequest) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseIDRequest) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *CourseIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[5]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,102 +11157,103 @@ func (x *CourseIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseIDRequest.ProtoReflect.Descriptor instead.
-func (*CourseIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *CourseIDRequest) GetCourseId() int64 {
+func (x *Webhook) GetId() int64 {
 	if x != nil {
-		return x.CourseId
+		return x.Id
 	}
 	return 0
 }
 
-type NewCourseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Название нового курса.
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                        // Описание нового курса.
-	InstructorId  int64                  `protobuf:"varint,3,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Webhook) GetOwnerRole() string {
+	if x != nil {
+		return x.OwnerRole
+	}
+	return ""
 }
 
-func (x *NewCourseRequest) Reset() {
-	*x = NewCourseRequest{}
-	mi := &file_proto_education_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Webhook) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
 }
 
-func (x *NewCourseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-func (*NewCourseRequest) ProtoMessage() {}
-
-func (x *NewCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[6]
+func (x *Webhook) GetEventTypes() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EventTypes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NewCourseRequest.ProtoReflect.Descriptor instead.
-func (*NewCourseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{6}
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
 }
 
-func (x *NewCourseRequest) GetName() string {
+func (x *Webhook) GetActive() bool {
 	if x != nil {
-		return x.Name
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-func (x *NewCourseRequest) GetDescription() string {
+func (x *Webhook) GetDisabledReason() string {
 	if x != nil {
-		return x.Description
+		return x.DisabledReason
 	}
 	return ""
 }
 
-func (x *NewCourseRequest) GetInstructorId() int64 {
+func (x *Webhook) GetConsecutiveFailures() int32 {
 	if x != nil {
-		return x.InstructorId
+		return x.ConsecutiveFailures
 	}
 	return 0
 }
 
-type UpdateCourseRequest struct {
+func (x *Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WebhookList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // ID курса для обновления.
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Новое название курса.
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Новое описание курса.
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"` // Подписки.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCourseRequest) Reset() {
-	*x = UpdateCourseRequest{}
-	mi := &file_proto_education_proto_msgTypes[7]
+func (x *WebhookList) Reset() {
+	*x = WebhookList{}
+	mi := &file_proto_education_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCourseRequest) String() string {
+func (x *WebhookList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCourseRequest) ProtoMessage() {}
+func (*WebhookList) ProtoMessage() {}
 
-func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[7]
+func (x *WebhookList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,57 +11264,42 @@ func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *UpdateCourseRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateCourseRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use WebhookList.ProtoReflect.Descriptor instead.
+func (*WebhookList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *UpdateCourseRequest) GetDescription() string {
+func (x *WebhookList) GetWebhooks() []*Webhook {
 	if x != nil {
-		return x.Description
+		return x.Webhooks
 	}
-	return ""
+	return nil
 }
 
-// Сообщения, связанные с регистрацией студентов.
-type RegisterStudentRequest struct {
+type NewWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // Имя студента.
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`       // Email студента.
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // Пароль студента.
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                 // Адрес http или https.
+	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // Типы событий.
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`                           // Секрет подписи; если пуст, генерируется.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterStudentRequest) Reset() {
-	*x = RegisterStudentRequest{}
-	mi := &file_proto_education_proto_msgTypes[8]
+func (x *NewWebhookRequest) Reset() {
+	*x = NewWebhookRequest{}
+	mi := &file_proto_education_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterStudentRequest) String() string {
+func (x *NewWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterStudentRequest) ProtoMessage() {}
+func (*NewWebhookRequest) ProtoMessage() {}
 
-func (x *RegisterStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[8]
+func (x *NewWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,57 +11310,57 @@ func (x *RegisterStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterStudentRequest.ProtoReflect.Descriptor instead.
-func (*RegisterStudentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use NewWebhookRequest.ProtoReflect.Descriptor instead.
+func (*NewWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *RegisterStudentRequest) GetName() string {
+func (x *NewWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *RegisterStudentRequest) GetEmail() string {
+func (x *NewWebhookRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.Email
+		return x.EventTypes
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterStudentRequest) GetPassword() string {
+func (x *NewWebhookRequest) GetSecret() string {
 	if x != nil {
-		return x.Password
+		return x.Secret
 	}
 	return ""
 }
 
-type Student struct {
+type UpdateWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID студента.
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // Имя студента.
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // Email студента.
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"` // Токен авторизации студента.
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // ID подписки.
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                 // Новый адрес.
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // Новые типы событий.
+	Active        *bool                  `protobuf:"varint,4,opt,name=active,proto3,oneof" json:"active,omitempty"`                    // Включить или выключить подписку; не задано — не менять.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Student) Reset() {
-	*x = Student{}
-	mi := &file_proto_education_proto_msgTypes[9]
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_proto_education_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) String() string {
+func (x *UpdateWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Student) ProtoMessage() {}
+func (*UpdateWebhookRequest) ProtoMessage() {}
 
-func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[9]
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,62 +11371,61 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Student.ProtoReflect.Descriptor instead.
-func (*Student) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *Student) GetId() int64 {
+func (x *UpdateWebhookRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Student) GetName() string {
+func (x *UpdateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Student) GetEmail() string {
+func (x *UpdateWebhookRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.Email
+		return x.EventTypes
 	}
-	return ""
+	return nil
 }
 
-func (x *Student) GetToken() string {
-	if x != nil {
-		return x.Token
+func (x *UpdateWebhookRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
 	}
-	return ""
+	return false
 }
 
-type LoginRequest struct {
+type WebhookIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`       // Email для входа.
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // Пароль для входа.
+	WebhookId     int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // ID подписки.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_proto_education_proto_msgTypes[10]
+func (x *WebhookIDRequest) Reset() {
+	*x = WebhookIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *WebhookIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*WebhookIDRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[10]
+func (x *WebhookIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,50 +11436,41 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *LoginRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use WebhookIDRequest.ProtoReflect.Descriptor instead.
+func (*WebhookIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *WebhookIDRequest) GetWebhookId() int64 {
 	if x != nil {
-		return x.Password
+		return x.WebhookId
 	}
-	return ""
+	return 0
 }
 
-type AuthResponse struct {
+type WebhookDeliveriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID.
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // Имя.
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // Email.
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"` // Токен авторизации.
+	WebhookId     int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // ID подписки.
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                          // Максимум записей; по умолчанию 50.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthResponse) Reset() {
-	*x = AuthResponse{}
-	mi := &file_proto_education_proto_msgTypes[11]
+func (x *WebhookDeliveriesRequest) Reset() {
+	*x = WebhookDeliveriesRequest{}
+	mi := &file_proto_education_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthResponse) String() string {
+func (x *WebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResponse) ProtoMessage() {}
+func (*WebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[11]
+func (x *WebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,61 +11481,47 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
-func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use WebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *AuthResponse) GetId() int64 {
+func (x *WebhookDeliveriesRequest) GetWebhookId() int64 {
 	if x != nil {
-		return x.Id
+		return x.WebhookId
 	}
 	return 0
 }
 
-func (x *AuthResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AuthResponse) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *AuthResponse) GetToken() string {
+func (x *WebhookDeliveriesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Token
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type StudentIDRequest struct {
+type WebhookDeliveryIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID студента.
+	DeliveryId    int64                  `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"` // ID доставки.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentIDRequest) Reset() {
-	*x = StudentIDRequest{}
-	mi := &file_proto_education_proto_msgTypes[12]
+func (x *WebhookDeliveryIDRequest) Reset() {
+	*x = WebhookDeliveryIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentIDRequest) String() string {
+func (x *WebhookDeliveryIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentIDRequest) ProtoMessage() {}
+func (*WebhookDeliveryIDRequest) ProtoMessage() {}
 
-func (x *StudentIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[12]
+func (x *WebhookDeliveryIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,43 +11532,51 @@ func (x *StudentIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentIDRequest.ProtoReflect.Descriptor instead.
-func (*StudentIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use WebhookDeliveryIDRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *StudentIDRequest) GetId() int64 {
+func (x *WebhookDeliveryIDRequest) GetDeliveryId() int64 {
 	if x != nil {
-		return x.Id
+		return x.DeliveryId
 	}
 	return 0
 }
 
-type UpdateStudentRequest struct {
+type WebhookDelivery struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // ID студента для обновления.
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // Новое имя студента.
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`       // Новый email студента.
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"` // Новый пароль студента.
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                              // ID доставки.
+	WebhookId     int64                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`               // ID подписки.
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                      // ID события, ключ идемпотентности для получателя.
+	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`                // Тип события.
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                       // Статус: pending, succeeded или failed.
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`                                  // Число выполненных попыток.
+	ResponseCode  int32                  `protobuf:"varint,7,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`      // HTTP-код последнего ответа, 0 — ответа не было.
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                         // Последняя ошибка доставки.
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // Дата создания (RFC3339).
+	NextAttemptAt string                 `protobuf:"bytes,11,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"` // Время следующей попытки для ожидающих доставок (RFC3339).
+	DeliveredAt   string                 `protobuf:"bytes,12,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`         // Время успешной доставки (RFC3339).
+	RedeliveryOf  int64                  `protobuf:"varint,13,opt,name=redelivery_of,json=redeliveryOf,proto3" json:"redelivery_of,omitempty"`     // ID исходной доставки, если это ручной повтор.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateStudentRequest) Reset() {
-	*x = UpdateStudentRequest{}
-	mi := &file_proto_education_proto_msgTypes[13]
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_education_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStudentRequest) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStudentRequest) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[13]
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,114 +11587,117 @@ func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *UpdateStudentRequest) GetId() int64 {
+func (x *WebhookDelivery) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateStudentRequest) GetName() string {
+func (x *WebhookDelivery) GetWebhookId() int64 {
 	if x != nil {
-		return x.Name
+		return x.WebhookId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateStudentRequest) GetEmail() string {
+func (x *WebhookDelivery) GetEventId() string {
 	if x != nil {
-		return x.Email
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetPassword() string {
+func (x *WebhookDelivery) GetEventType() string {
 	if x != nil {
-		return x.Password
+		return x.EventType
 	}
 	return ""
 }
 
-// Сообщения для управления записями.
-type EnrollmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
-	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *EnrollmentRequest) Reset() {
-	*x = EnrollmentRequest{}
-	mi := &file_proto_education_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
 }
 
-func (x *EnrollmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
 }
 
-func (*EnrollmentRequest) ProtoMessage() {}
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
 
-func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[14]
+func (x *WebhookDelivery) GetCreatedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
-func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{14}
+func (x *WebhookDelivery) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
 }
 
-func (x *EnrollmentRequest) GetStudentId() int64 {
+func (x *WebhookDelivery) GetDeliveredAt() string {
 	if x != nil {
-		return x.StudentId
+		return x.DeliveredAt
 	}
-	return 0
+	return ""
 }
 
-func (x *EnrollmentRequest) GetCourseId() int64 {
+func (x *WebhookDelivery) GetRedeliveryOf() int64 {
 	if x != nil {
-		return x.CourseId
+		return x.RedeliveryOf
 	}
 	return 0
 }
 
-type StudentList struct {
+type WebhookDeliveryList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"` // Список студентов.
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"` // Доставки.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentList) Reset() {
-	*x = StudentList{}
-	mi := &file_proto_education_proto_msgTypes[15]
+func (x *WebhookDeliveryList) Reset() {
+	*x = WebhookDeliveryList{}
+	mi := &file_proto_education_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentList) String() string {
+func (x *WebhookDeliveryList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentList) ProtoMessage() {}
+func (*WebhookDeliveryList) ProtoMessage() {}
 
-func (x *StudentList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[15]
+func (x *WebhookDeliveryList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,43 +11708,43 @@ func (x *StudentList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentList.ProtoReflect.Descriptor instead.
-func (*StudentList) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use WebhookDeliveryList.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *StudentList) GetStudents() []*Student {
+func (x *WebhookDeliveryList) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Students
+		return x.Deliveries
 	}
 	return nil
 }
 
-// Сообщения для управления лекциями.
-type LectureRequest struct {
+type SubscribeEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса, к которому относится лекция.
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                        // Название лекции.
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                    // Содержание лекции.
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`           // Только события курса.
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`        // Только события студента.
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`      // Только события этих типов; пусто — все.
+	LastEventId   string                 `protobuf:"bytes,4,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"` // ID последнего полученного события, чтобы продолжить с места разрыва.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LectureRequest) Reset() {
-	*x = LectureRequest{}
-	mi := &file_proto_education_proto_msgTypes[16]
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_proto_education_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LectureRequest) String() string {
+func (x *SubscribeEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LectureRequest) ProtoMessage() {}
+func (*SubscribeEventsRequest) ProtoMessage() {}
 
-func (x *LectureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[16]
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,57 +11755,68 @@ func (x *LectureRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LectureRequest.ProtoReflect.Descriptor instead.
-func (*LectureRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *LectureRequest) GetCourseId() int64 {
+func (x *SubscribeEventsRequest) GetCourseId() int64 {
 	if x != nil {
 		return x.CourseId
 	}
 	return 0
 }
 
-func (x *LectureRequest) GetTitle() string {
+func (x *SubscribeEventsRequest) GetStudentId() int64 {
 	if x != nil {
-		return x.Title
+		return x.StudentId
 	}
-	return ""
+	return 0
 }
 
-func (x *LectureRequest) GetContent() string {
+func (x *SubscribeEventsRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.Content
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
 	}
 	return ""
 }
 
-type Lecture struct {
+type DomainEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID лекции.
-	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                        // Название лекции.
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                    // Содержание лекции.
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                            // ID события, уникальный и неизменный; используется для возобновления потока.
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                        // Тип события, например LectureCompleted.
+	AggregateType string                 `protobuf:"bytes,3,opt,name=aggregate_type,json=aggregateType,proto3" json:"aggregate_type,omitempty"` // Тип объекта: course, lecture, review, learning_path или submission.
+	AggregateId   int64                  `protobuf:"varint,4,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`      // ID объекта.
+	CourseId      int64                  `protobuf:"varint,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`               // Курс события, 0 — нет.
+	StudentId     int64                  `protobuf:"varint,6,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`            // Студент события, 0 — нет.
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`             // Время события (RFC3339).
+	Payload       string                 `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`                                  // Данные события в JSON.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Lecture) Reset() {
-	*x = Lecture{}
-	mi := &file_proto_education_proto_msgTypes[17]
+func (x *DomainEvent) Reset() {
+	*x = DomainEvent{}
+	mi := &file_proto_education_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Lecture) String() string {
+func (x *DomainEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lecture) ProtoMessage() {}
+func (*DomainEvent) ProtoMessage() {}
 
-func (x *Lecture) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[17]
+func (x *DomainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,61 +11827,89 @@ func (x *Lecture) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lecture.ProtoReflect.Descriptor instead.
-func (*Lecture) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DomainEvent.ProtoReflect.Descriptor instead.
+func (*DomainEvent) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *Lecture) GetId() int64 {
+func (x *DomainEvent) GetId() string {
 	if x != nil {
 		return x.Id
 	}
+	return ""
+}
+
+func (x *DomainEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetAggregateType() string {
+	if x != nil {
+		return x.AggregateType
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetAggregateId() int64 {
+	if x != nil {
+		return x.AggregateId
+	}
 	return 0
 }
 
-func (x *Lecture) GetCourseId() int64 {
+func (x *DomainEvent) GetCourseId() int64 {
 	if x != nil {
 		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Lecture) GetTitle() string {
+func (x *DomainEvent) GetStudentId() int64 {
 	if x != nil {
-		return x.Title
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *DomainEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *Lecture) GetContent() string {
+func (x *DomainEvent) GetPayload() string {
 	if x != nil {
-		return x.Content
+		return x.Payload
 	}
 	return ""
 }
 
-type LectureList struct {
+type NotificationStudentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lectures      []*Lecture             `protobuf:"bytes,1,rep,name=lectures,proto3" json:"lectures,omitempty"` // Список лекций.
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LectureList) Reset() {
-	*x = LectureList{}
-	mi := &file_proto_education_proto_msgTypes[18]
+func (x *NotificationStudentRequest) Reset() {
+	*x = NotificationStudentRequest{}
+	mi := &file_proto_education_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LectureList) String() string {
+func (x *NotificationStudentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LectureList) ProtoMessage() {}
+func (*NotificationStudentRequest) ProtoMessage() {}
 
-func (x *LectureList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[18]
+func (x *NotificationStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,40 +11920,43 @@ func (x *LectureList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LectureList.ProtoReflect.Descriptor instead.
-func (*LectureList) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use NotificationStudentRequest.ProtoReflect.Descriptor instead.
+func (*NotificationStudentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *LectureList) GetLectures() []*Lecture {
+func (x *NotificationStudentRequest) GetStudentId() int64 {
 	if x != nil {
-		return x.Lectures
+		return x.StudentId
 	}
-	return nil
+	return 0
 }
 
-type LectureIDRequest struct {
+type ListNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LectureId     int64                  `protobuf:"varint,1,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"` // ID лекции.
+	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`    // ID студента.
+	UnreadOnly    bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"` // Только непрочитанные.
+	BeforeId      int64                  `protobuf:"varint,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`       // Только уведомления старше этого ID, для постраничной загрузки.
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                             // Максимум записей; по умолчанию 50, не больше 200.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LectureIDRequest) Reset() {
-	*x = LectureIDRequest{}
-	mi := &file_proto_education_proto_msgTypes[19]
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_proto_education_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LectureIDRequest) String() string {
+func (x *ListNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LectureIDRequest) ProtoMessage() {}
+func (*ListNotificationsRequest) ProtoMessage() {}
 
-func (x *LectureIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[19]
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,46 +11964,72 @@ func (x *LectureIDRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ListNotificationsRequest) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
 }
 
-// Deprecated: Use LectureIDRequest.ProtoReflect.Descriptor instead.
-func (*LectureIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{19}
+func (x *ListNotificationsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
 }
 
-func (x *LectureIDRequest) GetLectureId() int64 {
+func (x *ListNotificationsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.LectureId
+		return x.Limit
 	}
 	return 0
 }
 
-type LectureContent struct {
+type Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID лекции.
-	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"` // ID курса.
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                        // Название лекции.
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                    // Содержание лекции.
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // ID уведомления.
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                            // Тип: lecture_added, review_reply, enrollment_approved, assignment_graded или announcement.
+	CourseId      int64                  `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`   // Курс, к которому относится уведомление, 0 — нет.
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                          // Заголовок.
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`                            // Текст.
+	Link          string                 `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`                            // Относительный адрес страницы, к которой относится уведомление.
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Дата создания (RFC3339).
+	Read          bool                   `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"`                           // Прочитано ли уведомление.
+	ReadAt        string                 `protobuf:"bytes,9,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`          // Когда уведомление прочитано (RFC3339).
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LectureContent) Reset() {
-	*x = LectureContent{}
-	mi := &file_proto_education_proto_msgTypes[20]
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_education_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LectureContent) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LectureContent) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *LectureContent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[20]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,63 +12040,98 @@ func (x *LectureContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LectureContent.ProtoReflect.Descriptor instead.
-func (*LectureContent) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *LectureContent) GetId() int64 {
+func (x *Notification) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *LectureContent) GetCourseId() int64 {
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetCourseId() int64 {
 	if x != nil {
 		return x.CourseId
 	}
 	return 0
 }
 
-func (x *LectureContent) GetTitle() string {
+func (x *Notification) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *LectureContent) GetContent() string {
+func (x *Notification) GetBody() string {
 	if x != nil {
-		return x.Content
+		return x.Body
 	}
 	return ""
 }
 
-type UpdateLectureRequest struct {
+func (x *Notification) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetReadAt() string {
+	if x != nil {
+		return x.ReadAt
+	}
+	return ""
+}
+
+type NotificationList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // ID лекции для обновления.
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`     // Новое название лекции.
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // Новое содержание лекции.
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`                      // Уведомления.
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`      // Всего непрочитанных уведомлений.
+	NextBeforeId  int64                  `protobuf:"varint,3,opt,name=next_before_id,json=nextBeforeId,proto3" json:"next_before_id,omitempty"` // Значение before_id для следующей страницы, 0 — страниц больше нет.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLectureRequest) Reset() {
-	*x = UpdateLectureRequest{}
-	mi := &file_proto_education_proto_msgTypes[21]
+func (x *NotificationList) Reset() {
+	*x = NotificationList{}
+	mi := &file_proto_education_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLectureRequest) String() string {
+func (x *NotificationList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLectureRequest) ProtoMessage() {}
+func (*NotificationList) ProtoMessage() {}
 
-func (x *UpdateLectureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[21]
+func (x *NotificationList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,55 +12142,55 @@ func (x *UpdateLectureRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLectureRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLectureRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use NotificationList.ProtoReflect.Descriptor instead.
+func (*NotificationList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *UpdateLectureRequest) GetId() int64 {
+func (x *NotificationList) GetNotifications() []*Notification {
 	if x != nil {
-		return x.Id
+		return x.Notifications
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateLectureRequest) GetTitle() string {
+func (x *NotificationList) GetUnreadCount() int32 {
 	if x != nil {
-		return x.Title
+		return x.UnreadCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLectureRequest) GetContent() string {
+func (x *NotificationList) GetNextBeforeId() int64 {
 	if x != nil {
-		return x.Content
+		return x.NextBeforeId
 	}
-	return ""
+	return 0
 }
 
-type LectureCompletionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
-	LectureId     int64                  `protobuf:"varint,2,opt,name=lecture_id,json=lectureId,proto3" json:"lecture_id,omitempty"` // ID лекции.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type MarkNotificationsReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StudentId       int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                          // ID студента.
+	NotificationIds []int64                `protobuf:"varint,2,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"` // ID уведомлений.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *LectureCompletionRequest) Reset() {
-	*x = LectureCompletionRequest{}
-	mi := &file_proto_education_proto_msgTypes[22]
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_proto_education_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LectureCompletionRequest) String() string {
+func (x *MarkNotificationsReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LectureCompletionRequest) ProtoMessage() {}
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
 
-func (x *LectureCompletionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[22]
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,48 +12201,48 @@ func (x *LectureCompletionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LectureCompletionRequest.ProtoReflect.Descriptor instead.
-func (*LectureCompletionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *LectureCompletionRequest) GetStudentId() int64 {
+func (x *MarkNotificationsReadRequest) GetStudentId() int64 {
 	if x != nil {
 		return x.StudentId
 	}
 	return 0
 }
 
-func (x *LectureCompletionRequest) GetLectureId() int64 {
+func (x *MarkNotificationsReadRequest) GetNotificationIds() []int64 {
 	if x != nil {
-		return x.LectureId
+		return x.NotificationIds
 	}
-	return 0
+	return nil
 }
 
-type CourseProgressRequest struct {
+type MarkNotificationsReadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
-	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`                            // Сколько уведомлений отмечено прочитанными.
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"` // Сколько непрочитанных осталось.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CourseProgressRequest) Reset() {
-	*x = CourseProgressRequest{}
-	mi := &file_proto_education_proto_msgTypes[23]
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_proto_education_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CourseProgressRequest) String() string {
+func (x *MarkNotificationsReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseProgressRequest) ProtoMessage() {}
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
 
-func (x *CourseProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[23]
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,49 +12253,48 @@ func (x *CourseProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseProgressRequest.ProtoReflect.Descriptor instead.
-func (*CourseProgressRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *CourseProgressRequest) GetStudentId() int64 {
+func (x *MarkNotificationsReadResponse) GetUpdated() int32 {
 	if x != nil {
-		return x.StudentId
+		return x.Updated
 	}
 	return 0
 }
 
-func (x *CourseProgressRequest) GetCourseId() int64 {
+func (x *MarkNotificationsReadResponse) GetUnreadCount() int32 {
 	if x != nil {
-		return x.CourseId
+		return x.UnreadCount
 	}
 	return 0
 }
 
-type CourseProgress struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	CourseId         int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                         // ID курса.
-	StudentId        int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                      // ID студента.
-	CompletedPercent int32                  `protobuf:"varint,3,opt,name=completed_percent,json=completedPercent,proto3" json:"completed_percent,omitempty"` // Процент завершения курса.
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`        // Тип уведомления.
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"` // Получать ли уведомления этого типа.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CourseProgress) Reset() {
-	*x = CourseProgress{}
-	mi := &file_proto_education_proto_msgTypes[24]
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_proto_education_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CourseProgress) String() string {
+func (x *NotificationPreference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CourseProgress) ProtoMessage() {}
+func (*NotificationPreference) ProtoMessage() {}
 
-func (x *CourseProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[24]
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,57 +12305,50 @@ func (x *CourseProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CourseProgress.ProtoReflect.Descriptor instead.
-func (*CourseProgress) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *CourseProgress) GetCourseId() int64 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *CourseProgress) GetStudentId() int64 {
+func (x *NotificationPreference) GetType() string {
 	if x != nil {
-		return x.StudentId
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *CourseProgress) GetCompletedPercent() int32 {
+func (x *NotificationPreference) GetEnabled() bool {
 	if x != nil {
-		return x.CompletedPercent
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-// Сообщения для регистрации преподавателей.
-type RegisterInstructorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // Имя преподавателя.
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`       // Email преподавателя.
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // Пароль преподавателя.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type NotificationPreferences struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	StudentId      int64                     `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`               // ID студента.
+	Preferences    []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`                             // Настройки по всем типам уведомлений.
+	EmailFrequency string                    `protobuf:"bytes,3,opt,name=email_frequency,json=emailFrequency,proto3" json:"email_frequency,omitempty"` // Письма: off, immediate (каждое уведомление сразу), daily или weekly (сводка).
+	Locale         string                    `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`                                       // Язык писем: ru или en.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RegisterInstructorRequest) Reset() {
-	*x = RegisterInstructorRequest{}
-	mi := &file_proto_education_proto_msgTypes[25]
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_proto_education_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterInstructorRequest) String() string {
+func (x *NotificationPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterInstructorRequest) ProtoMessage() {}
+func (*NotificationPreferences) ProtoMessage() {}
 
-func (x *RegisterInstructorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[25]
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,57 +12359,64 @@ func (x *RegisterInstructorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterInstructorRequest.ProtoReflect.Descriptor instead.
-func (*RegisterInstructorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *RegisterInstructorRequest) GetName() string {
+func (x *NotificationPreferences) GetStudentId() int64 {
 	if x != nil {
-		return x.Name
+		return x.StudentId
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterInstructorRequest) GetEmail() string {
+func (x *NotificationPreferences) GetPreferences() []*NotificationPreference {
 	if x != nil {
-		return x.Email
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetEmailFrequency() string {
+	if x != nil {
+		return x.EmailFrequency
 	}
 	return ""
 }
 
-func (x *RegisterInstructorRequest) GetPassword() string {
+func (x *NotificationPreferences) GetLocale() string {
 	if x != nil {
-		return x.Password
+		return x.Locale
 	}
 	return ""
 }
 
-type Instructor struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID преподавателя.
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // Имя преподавателя.
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // Email преподавателя.
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"` // Токен авторизации преподавателя.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateNotificationPreferencesRequest struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	StudentId      int64                     `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`                     // ID студента.
+	Preferences    []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`                                   // Изменяемые настройки типов уведомлений.
+	EmailFrequency *string                   `protobuf:"bytes,3,opt,name=email_frequency,json=emailFrequency,proto3,oneof" json:"email_frequency,omitempty"` // Новая частота писем; не задана — без изменений.
+	Locale         *string                   `protobuf:"bytes,4,opt,name=locale,proto3,oneof" json:"locale,omitempty"`                                       // Новый язык писем; не задан — без изменений.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Instructor) Reset() {
-	*x = Instructor{}
-	mi := &file_proto_education_proto_msgTypes[26]
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_proto_education_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Instructor) String() string {
+func (x *UpdateNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Instructor) ProtoMessage() {}
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *Instructor) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[26]
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,61 +12427,61 @@ func (x *Instructor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Instructor.ProtoReflect.Descriptor instead.
-func (*Instructor) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *Instructor) GetId() int64 {
+func (x *UpdateNotificationPreferencesRequest) GetStudentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.StudentId
 	}
 	return 0
 }
 
-func (x *Instructor) GetName() string {
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
 	if x != nil {
-		return x.Name
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-func (x *Instructor) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (x *UpdateNotificationPreferencesRequest) GetEmailFrequency() string {
+	if x != nil && x.EmailFrequency != nil {
+		return *x.EmailFrequency
 	}
 	return ""
 }
 
-func (x *Instructor) GetToken() string {
-	if x != nil {
-		return x.Token
+func (x *UpdateNotificationPreferencesRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
 	}
 	return ""
 }
 
-type InstructorIDRequest struct {
+type UnsubscribeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя.
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Токен из ссылки в письме; действует 90 дней и отзывается повторной подпиской на письма.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstructorIDRequest) Reset() {
-	*x = InstructorIDRequest{}
-	mi := &file_proto_education_proto_msgTypes[27]
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_proto_education_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstructorIDRequest) String() string {
+func (x *UnsubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstructorIDRequest) ProtoMessage() {}
+func (*UnsubscribeRequest) ProtoMessage() {}
 
-func (x *InstructorIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[27]
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,44 +12492,41 @@ func (x *InstructorIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstructorIDRequest.ProtoReflect.Descriptor instead.
-func (*InstructorIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *InstructorIDRequest) GetInstructorId() int64 {
+func (x *UnsubscribeRequest) GetToken() string {
 	if x != nil {
-		return x.InstructorId
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-// Сообщения для управления отзывами.
-type ReviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
-	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                       // Текст отзыва.
-	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`                        // Оценка (рейтинг).
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UnsubscribeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`               // ID студента.
+	EmailFrequency string                 `protobuf:"bytes,2,opt,name=email_frequency,json=emailFrequency,proto3" json:"email_frequency,omitempty"` // Новая частота писем — off.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ReviewRequest) Reset() {
-	*x = ReviewRequest{}
-	mi := &file_proto_education_proto_msgTypes[28]
+func (x *UnsubscribeResponse) Reset() {
+	*x = UnsubscribeResponse{}
+	mi := &file_proto_education_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewRequest) String() string {
+func (x *UnsubscribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewRequest) ProtoMessage() {}
-
-func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[28]
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,66 +12537,55 @@ func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
-func (*ReviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *ReviewRequest) GetStudentId() int64 {
+func (x *UnsubscribeResponse) GetStudentId() int64 {
 	if x != nil {
 		return x.StudentId
 	}
 	return 0
 }
 
-func (x *ReviewRequest) GetCourseId() int64 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
-}
-
-func (x *ReviewRequest) GetComment() string {
+func (x *UnsubscribeResponse) GetEmailFrequency() string {
 	if x != nil {
-		return x.Comment
+		return x.EmailFrequency
 	}
 	return ""
 }
 
-func (x *ReviewRequest) GetRating() int32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
-type Review struct {
+type Announcement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // ID отзыва.
-	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // ID студента.
-	CourseId      int64                  `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`    // ID курса.
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`                       // Текст отзыва.
-	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`                        // Оценка.
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // Дата создания отзыва.
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID объявления.
+	CourseId      int64                  `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`             // ID курса.
+	CohortId      int64                  `protobuf:"varint,3,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`             // ID потока, 0 — объявление для всех студентов курса.
+	InstructorId  int64                  `protobuf:"varint,4,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"` // ID преподавателя, создавшего объявление.
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`                                    // Заголовок.
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`                                      // Текст объявления.
+	PublishAt     string                 `protobuf:"bytes,7,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`           // Запланированное время публикации (RFC3339).
+	PublishedAt   string                 `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`     // Время публикации (RFC3339), пусто — объявление ещё не опубликовано.
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`           // Время создания (RFC3339).
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
-	mi := &file_proto_education_proto_msgTypes[29]
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_proto_education_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Review) String() string {
+func (x *Announcement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*Announcement) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[29]
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,75 +12596,100 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *Review) GetId() int64 {
+func (x *Announcement) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Review) GetStudentId() int64 {
+func (x *Announcement) GetCourseId() int64 {
 	if x != nil {
-		return x.StudentId
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Review) GetCourseId() int64 {
+func (x *Announcement) GetCohortId() int64 {
 	if x != nil {
-		return x.CourseId
+		return x.CohortId
 	}
 	return 0
 }
 
-func (x *Review) GetComment() string {
+func (x *Announcement) GetInstructorId() int64 {
 	if x != nil {
-		return x.Comment
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Review) GetRating() int32 {
+func (x *Announcement) GetBody() string {
 	if x != nil {
-		return x.Rating
+		return x.Body
 	}
-	return 0
+	return ""
 }
 
-func (x *Review) GetCreatedAt() string {
+func (x *Announcement) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *Announcement) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-type ReviewList struct {
+type CreateAnnouncementRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // Список отзывов.
+	CourseId      int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`   // ID курса.
+	CohortId      int64                  `protobuf:"varint,2,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`   // ID потока, 0 — для всех студентов курса.
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                          // Заголовок.
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`                            // Текст объявления.
+	PublishAt     string                 `protobuf:"bytes,5,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Время публикации (RFC3339), пусто — опубликовать сразу.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReviewList) Reset() {
-	*x = ReviewList{}
-	mi := &file_proto_education_proto_msgTypes[30]
+func (x *CreateAnnouncementRequest) Reset() {
+	*x = CreateAnnouncementRequest{}
+	mi := &file_proto_education_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewList) String() string {
+func (x *CreateAnnouncementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewList) ProtoMessage() {}
+func (*CreateAnnouncementRequest) ProtoMessage() {}
 
-func (x *ReviewList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[30]
+func (x *CreateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,90 +12700,70 @@ func (x *ReviewList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewList.ProtoReflect.Descriptor instead.
-func (*ReviewList) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CreateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *ReviewList) GetReviews() []*Review {
+func (x *CreateAnnouncementRequest) GetCourseId() int64 {
 	if x != nil {
-		return x.Reviews
+		return x.CourseId
 	}
-	return nil
-}
-
-// Сообщения для поиска.
-type SearchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // Ключевое слово для поиска.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
-	mi := &file_proto_education_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *SearchRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateAnnouncementRequest) GetCohortId() int64 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
 }
 
-func (*SearchRequest) ProtoMessage() {}
-
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[31]
+func (x *CreateAnnouncementRequest) GetTitle() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Title
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{31}
+func (x *CreateAnnouncementRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
 }
 
-func (x *SearchRequest) GetKeyword() string {
+func (x *CreateAnnouncementRequest) GetPublishAt() string {
 	if x != nil {
-		return x.Keyword
+		return x.PublishAt
 	}
 	return ""
 }
 
-// Сообщения для управления преподавателями.
-type UpdateInstructorRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID преподавателя
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                              // Новое имя (опционально)
-	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                            // Новый email (опционально)
-	CurrentPassword string                 `protobuf:"bytes,4,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"` // Текущий пароль (для проверки при изменении пароля)
-	NewPassword     string                 `protobuf:"bytes,5,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`             // Новый пароль (опционально)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListAnnouncementsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         int64                  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`                         // ID курса.
+	CohortId         int64                  `protobuf:"varint,2,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`                         // Только объявления для всего курса и этого потока, 0 — все.
+	IncludeScheduled bool                   `protobuf:"varint,3,opt,name=include_scheduled,json=includeScheduled,proto3" json:"include_scheduled,omitempty"` // Вместе с запланированными объявлениями (только для преподавателей).
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateInstructorRequest) Reset() {
-	*x = UpdateInstructorRequest{}
-	mi := &file_proto_education_proto_msgTypes[32]
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	mi := &file_proto_education_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInstructorRequest) String() string {
+func (x *ListAnnouncementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstructorRequest) ProtoMessage() {}
+func (*ListAnnouncementsRequest) ProtoMessage() {}
 
-func (x *UpdateInstructorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[32]
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,69 +12774,54 @@ func (x *UpdateInstructorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstructorRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInstructorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *UpdateInstructorRequest) GetId() int64 {
+func (x *ListAnnouncementsRequest) GetCourseId() int64 {
 	if x != nil {
-		return x.Id
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *UpdateInstructorRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateInstructorRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *UpdateInstructorRequest) GetCurrentPassword() string {
+func (x *ListAnnouncementsRequest) GetCohortId() int64 {
 	if x != nil {
-		return x.CurrentPassword
+		return x.CohortId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateInstructorRequest) GetNewPassword() string {
+func (x *ListAnnouncementsRequest) GetIncludeScheduled() bool {
 	if x != nil {
-		return x.NewPassword
+		return x.IncludeScheduled
 	}
-	return ""
+	return false
 }
 
-// Запрос для удаления преподавателя
-type DeleteInstructorRequest struct {
+type AnnouncementList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"` // Объявления.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteInstructorRequest) Reset() {
-	*x = DeleteInstructorRequest{}
-	mi := &file_proto_education_proto_msgTypes[33]
+func (x *AnnouncementList) Reset() {
+	*x = AnnouncementList{}
+	mi := &file_proto_education_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteInstructorRequest) String() string {
+func (x *AnnouncementList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInstructorRequest) ProtoMessage() {}
+func (*AnnouncementList) ProtoMessage() {}
 
-func (x *DeleteInstructorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[33]
+func (x *AnnouncementList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,41 +12832,40 @@ func (x *DeleteInstructorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInstructorRequest.ProtoReflect.Descriptor instead.
-func (*DeleteInstructorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use AnnouncementList.ProtoReflect.Descriptor instead.
+func (*AnnouncementList) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *DeleteInstructorRequest) GetId() int64 {
+func (x *AnnouncementList) GetAnnouncements() []*Announcement {
 	if x != nil {
-		return x.Id
+		return x.Announcements
 	}
-	return 0
+	return nil
 }
 
-// Запрос для получения преподавателя
-type GetInstructorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AnnouncementIDRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId int64                  `protobuf:"varint,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"` // ID объявления.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetInstructorRequest) Reset() {
-	*x = GetInstructorRequest{}
-	mi := &file_proto_education_proto_msgTypes[34]
+func (x *AnnouncementIDRequest) Reset() {
+	*x = AnnouncementIDRequest{}
+	mi := &file_proto_education_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInstructorRequest) String() string {
+func (x *AnnouncementIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstructorRequest) ProtoMessage() {}
+func (*AnnouncementIDRequest) ProtoMessage() {}
 
-func (x *GetInstructorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_education_proto_msgTypes[34]
+func (x *AnnouncementIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_education_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1970,14 +12876,14 @@ func (x *GetInstructorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstructorRequest.ProtoReflect.Descriptor instead.
-func (*GetInstructorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_education_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AnnouncementIDRequest.ProtoReflect.Descriptor instead.
+func (*AnnouncementIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_education_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *GetInstructorRequest) GetId() int64 {
+func (x *AnnouncementIDRequest) GetAnnouncementId() int64 {
 	if x != nil {
-		return x.Id
+		return x.AnnouncementId
 	}
 	return 0
 }
@@ -1997,373 +12903,2704 @@ var file_proto_education_proto_rawDesc = []byte{
 	0x73, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
 	0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x74, 0x75, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x65, 0x63, 0x61, 0x75, 0x73,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x62, 0x65, 0x63, 0x61, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x64, 0x22, 0x5b, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x78, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x37, 0x0a, 0x0e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x22, 0x35, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x15, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4c, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x87, 0x01, 0x0a, 0x12,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22,
-	0x2e, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22,
-	0x6d, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x5b,
-	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x5e, 0x0a, 0x16,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x59, 0x0a, 0x07,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5e, 0x0a, 0x0c, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x10, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x16, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x59, 0x0a, 0x07, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5e, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x10, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x14,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4f, 0x0a, 0x11, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x0b, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47,
-	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x0e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x07, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a,
-	0x0b, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08,
-	0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x08,
-	0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x4c, 0x65, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x0e, 0x4c,
-	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x22, 0x58, 0x0a, 0x18, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x15,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x64, 0x22, 0x79, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4f, 0x0a, 0x11, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x19,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x5c, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x0b,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x6c, 0x6b,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73,
+	0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f,
+	0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xf8, 0x01, 0x0a, 0x13,
+	0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa6, 0x02, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22,
+	0x4b, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xbe, 0x02, 0x0a,
+	0x0e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x12,
+	0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x68, 0x6f,
+	0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12, 0x31,
+	0x0a, 0x12, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x10, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa3, 0x03,
+	0x0a, 0x07, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x4c, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x49, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x31,
+	0x0a, 0x10, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x22, 0x6d, 0x0a, 0x0e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0xe7, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x6f, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e,
+	0x0a, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x18, 0x4c, 0x65,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x0e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xc7, 0x02,
+	0x0a, 0x0f, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9a, 0x03, 0x0a, 0x16, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x6c,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x08, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x6c, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0xdc, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x5f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x49, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x70,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x22, 0x61, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x5c, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x3a, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x7d, 0x0a,
+	0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xd7, 0x02, 0x0a,
+	0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x65,
+	0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f,
+	0x74, 0x5f, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x4b, 0x0a, 0x12, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x6c, 0x70,
+	0x66, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x6c, 0x70, 0x66,
+	0x75, 0x6c, 0x22, 0x35, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x64, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0x2e, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22,
+	0x50, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3a, 0x0a,
-	0x13, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x0d, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x68,
+	0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0a, 0x43, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x6f, 0x68, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74,
+	0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49,
+	0x64, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x68, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x13,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x43, 0x6f, 0x68, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22,
+	0xc7, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
 	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xa5, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0x35, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27,
-	0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x32, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x22, 0x60, 0x0a, 0x0e, 0x43, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x6c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xe3, 0x02, 0x0a,
+	0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x64, 0x66, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x64, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x74,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x74, 0x68,
+	0x49, 0x64, 0x22, 0x49, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x3d, 0x0a,
+	0x14, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x18,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7d,
+	0x0a, 0x17, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xca, 0x03,
+	0x0a, 0x0c, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x0c, 0x51, 0x75,
+	0x69, 0x7a, 0x44, 0x72, 0x61, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x22,
+	0xb9, 0x04, 0x0a, 0x04, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x31,
+	0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75,
+	0x69, 0x7a, 0x44, 0x72, 0x61, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65,
+	0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x75,
+	0x66, 0x66, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x31, 0x0a, 0x08, 0x51,
+	0x75, 0x69, 0x7a, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x7a,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x22, 0x28,
+	0x0a, 0x0d, 0x51, 0x75, 0x69, 0x7a, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x22, 0xf2, 0x03, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x44, 0x72, 0x61, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73,
+	0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe5, 0x03,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x44, 0x72, 0x61, 0x77,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x64, 0x72, 0x61, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x75, 0x66,
+	0x66, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x51, 0x75,
+	0x69, 0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x69,
+	0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x65, 0x0a, 0x10, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x85, 0x01,
+	0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0xbe, 0x03, 0x0a, 0x0b, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x09,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x31, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x13, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x0f, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x13, 0x42, 0x61, 0x6e, 0x6b, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75,
+	0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x15, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x6b,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x69, 0x66,
+	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x22, 0x45, 0x0a, 0x10, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x19,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22,
+	0x6b, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc2, 0x01, 0x0a,
+	0x19, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x61, 0x6e, 0x6b, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x22, 0x7b, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e,
+	0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x78,
+	0x0a, 0x0f, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x90, 0x04, 0x0a, 0x0a, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x75,
+	0x65, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x2a, 0x0a, 0x11,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x44, 0x75, 0x65, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xd7, 0x03, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x75,
+	0x65, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x2a, 0x0a, 0x11,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x44, 0x75, 0x65, 0x41, 0x74, 0x22, 0xca, 0x03, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x32, 0x84, 0x04, 0x0a, 0x10, 0x45, 0x64,
-	0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x47,
-	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45,
-	0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x13, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x12, 0x57, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42,
-	0x79, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f,
-	0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x4e, 0x0a, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x75, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x75, 0x65, 0x41, 0x74,
+	0x12, 0x32, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x75, 0x65,
+	0x41, 0x74, 0x22, 0x3a, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x45,
+	0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x9c,
+	0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x73, 0x0a,
+	0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x62, 0x0a, 0x0b, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xf9, 0x03, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x61, 0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc1,
+	0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x75, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x45, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x16, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x0d, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x0a, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x16, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x93, 0x02, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x7c, 0x0a, 0x17, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x68, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x65, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x58, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x39, 0x0a, 0x16, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x49, 0x0a,
+	0x11, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x46,
+	0x0a, 0x0b, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x0c, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x07, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x22, 0x63, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x07, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x69, 0x74,
+	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x62, 0x0a, 0x0d, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xd0, 0x02,
+	0x0a, 0x0d, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x34,
+	0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x22, 0xe6, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x07,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x14, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xd9, 0x01,
+	0x0a, 0x12, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x31, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xe9, 0x02, 0x0a, 0x0f, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x4f, 0x0a, 0x13, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x65, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x68, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48,
+	0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x22, 0x3e, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x3d, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22,
+	0x64, 0x0a, 0x17, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x16, 0x4e, 0x65, 0x77, 0x4c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x97, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x4c,
+	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x22, 0x54, 0x0a,
+	0x1a, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61,
+	0x74, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x16, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x74, 0x68, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x22, 0xa3, 0x01, 0x0a, 0x1a, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74,
+	0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x07,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
+	0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x39, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x5e, 0x0a, 0x11, 0x4e, 0x65,
+	0x77, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x31,
+	0x0a, 0x10, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49,
+	0x64, 0x22, 0x4f, 0x0a, 0x18, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x3b, 0x0a, 0x18, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x22,
+	0xfe, 0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x6f, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x66, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09,
+	0x22, 0x4d, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x99, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0b,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3b,
+	0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0c,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65,
+	0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x64, 0x41, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64,
+	0x22, 0x68, 0x0a, 0x1c, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x1d, 0x4d, 0x61,
+	0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0xba, 0x01, 0x0a, 0x17, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xf0, 0x01,
+	0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x2a, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x0a, 0x13,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x88, 0x02, 0x0a, 0x0c,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x10, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x15, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2a, 0x57, 0x0a, 0x0a,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f,
+	0x55, 0x52, 0x53, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43,
+	0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0xb2, 0x01, 0x0a, 0x18, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x45, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x45,
+	0x43, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x24,
+	0x0a, 0x20, 0x4c, 0x45, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45,
+	0x53, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45,
+	0x41, 0x54, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x45, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x90, 0x01, 0x0a, 0x15, 0x4c,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x45, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a,
+	0x23, 0x4c, 0x45, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53,
+	0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47,
+	0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x45, 0x43, 0x54, 0x55, 0x52,
+	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x87, 0x01,
+	0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x13,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x48, 0x45, 0x4c, 0x50, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x45,
+	0x57, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x45, 0x53, 0x54, 0x10, 0x03, 0x12,
+	0x16, 0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4c,
+	0x4f, 0x57, 0x45, 0x53, 0x54, 0x10, 0x04, 0x2a, 0xea, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x69, 0x7a,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e,
+	0x51, 0x55, 0x49, 0x5a, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x24, 0x0a, 0x20, 0x51, 0x55, 0x49, 0x5a, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x43, 0x48,
+	0x4f, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x51, 0x55, 0x49, 0x5a, 0x5f, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c,
+	0x54, 0x49, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x21,
+	0x0a, 0x1d, 0x51, 0x55, 0x49, 0x5a, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x45, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10,
+	0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x51, 0x55, 0x49, 0x5a, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x49, 0x43, 0x10,
+	0x04, 0x12, 0x21, 0x0a, 0x1d, 0x51, 0x55, 0x49, 0x5a, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x45,
+	0x58, 0x54, 0x10, 0x05, 0x2a, 0x95, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x1f, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c,
+	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x18, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x46,
+	0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x41, 0x53, 0x59, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49,
+	0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49,
+	0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x48, 0x41, 0x52, 0x44, 0x10, 0x03, 0x2a, 0x77, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x24, 0x0a, 0x20, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42,
+	0x41, 0x4e, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x47, 0x49, 0x46, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x51, 0x54, 0x49, 0x10, 0x02, 0x2a, 0x72, 0x0a, 0x0a, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x85, 0x01, 0x0a, 0x0d, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x47,
+	0x52, 0x41, 0x44, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x51, 0x55, 0x49, 0x5a, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f,
+	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f,
+	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x10,
+	0x03, 0x2a, 0x46, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x52, 0x41, 0x44, 0x45, 0x42, 0x4f, 0x4f,
+	0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x52, 0x41, 0x44, 0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x01, 0x32, 0xea, 0x05, 0x0a, 0x10, 0x45, 0x64,
+	0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01,
-	0x2a, 0x1a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0x55, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x32, 0x89, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a,
-	0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47,
-	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x57, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x19,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x1c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x32, 0xa6, 0x03, 0x0a,
-	0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
-	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x16,
-	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f,
-	0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x42, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x47, 0x6f,
+	0x72, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x4e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x65, 0x77,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x1a, 0x10, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x55, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x74, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x1c,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x12,
+	0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x32, 0xfe, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x57, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a,
+	0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x57, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x47, 0x6f,
 	0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
-	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x0f, 0x55, 0x6e,
-	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e,
-	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x6e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x76, 0x31,
-	0x2f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x32, 0xea, 0x06, 0x0a, 0x0e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4c,
-	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15,
-	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a,
-	0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x61, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x73, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x32, 0x9e, 0x04, 0x0a, 0x11, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a,
+	0x0d, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01,
+	0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
 	0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64,
 	0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f,
+	0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f,
 	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
-	0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76,
-	0x31, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x65, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x5a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x1c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x59, 0x0a, 0x0d, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x47,
-	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x76, 0x31,
-	0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x65, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x76, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x4c, 0x65,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x41, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f,
-	0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x72,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x42, 0x79, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x0f, 0x55, 0x6e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x55, 0x6e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x76, 0x0a, 0x0a, 0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x18,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x32, 0xe9, 0x0a, 0x0a, 0x0e, 0x4c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x12, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
 	0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
-	0x21, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x32, 0xa7, 0x04, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e,
-	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45,
-	0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x1c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x66, 0x0a, 0x10, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x6f, 0x72, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x32, 0x14, 0x2f,
-	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x2e, 0x47, 0x6f, 0x45, 0x64,
-	0x75, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x23,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x69,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f,
-	0x45, 0x64, 0x75, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31,
-	0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x78, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x42, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x47,
-	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
-	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x32, 0xde, 0x01, 0x0a,
-	0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63,
-	0x0a, 0x11, 0x41, 0x64, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64,
-	0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a,
-	0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f,
-	0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x12, 0x68, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
+	0x21, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6c,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x59,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x65,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x76, 0x0a, 0x16, 0x4d, 0x61, 0x72,
+	0x6b, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x41, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x72, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x17,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x7b, 0x0a, 0x15, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x6c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x17, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x61, 0x73, 0x68, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x32, 0xa7, 0x04, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12,
+	0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x66, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x32,
+	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f,
+	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x78, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x42, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x32, 0xcf,
+	0x07, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x63, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x5d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x55, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x12, 0x63, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x19, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x1a, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x61, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5e, 0x0a, 0x0a, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x1a, 0x1c, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x2a, 0x1c, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x64, 0x0a, 0x0c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22,
+	0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x32, 0xbe, 0x07, 0x0a, 0x0d, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x65, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x68, 0x6f,
+	0x72, 0x74, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x1a,
+	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x55, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x68, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x71, 0x0a, 0x16, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x43,
+	0x6f, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7a,
+	0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01,
+	0x2a, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b,
+	0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x69, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x68, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b,
+	0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x32, 0xdb, 0x02, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x57, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x65, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x7e, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x7d, 0x32,
+	0x9e, 0x06, 0x0a, 0x0b, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x5f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x18, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22,
+	0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73,
+	0x12, 0x50, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x18,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69,
+	0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x51, 0x75, 0x69, 0x7a, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a,
+	0x1a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x4f, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a,
+	0x12, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x2f, 0x7b, 0x71, 0x75, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x14,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69,
+	0x7a, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x71,
+	0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x2f, 0x7b, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x5f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x12,
+	0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65,
+	0x73, 0x12, 0x71, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75,
+	0x69, 0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65,
+	0x73, 0x2f, 0x7b, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x7a, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75,
+	0x69, 0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x71,
+	0x75, 0x69, 0x7a, 0x2d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x12, 0x6e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69,
+	0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x7a, 0x65, 0x73, 0x2f, 0x7b, 0x71,
+	0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x32, 0xe2, 0x06, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e,
+	0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x61, 0x6e, 0x6b, 0x12, 0x8d, 0x01, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x3a, 0x01, 0x2a, 0x1a, 0x3b, 0x2f, 0x76,
+	0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x7b, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x43, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3d, 0x2a, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62,
+	0x61, 0x6e, 0x6b, 0x2f, 0x7b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x84, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x61, 0x6e, 0x6b, 0x12, 0x9a, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x12,
+	0x20, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x3a, 0x01, 0x2a, 0x22,
+	0x34, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x8d, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x6e, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12,
+	0x34, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x32, 0x87, 0x09, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x66, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x3a, 0x01, 0x2a, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x2a, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x67, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7d, 0x0a, 0x10, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x67, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x7c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x75, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22,
+	0x25, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x77, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
+	0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2d,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x32,
+	0x8b, 0x03, 0x0a, 0x11, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x61,
+	0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2d, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x6e, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x27, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65,
+	0x65, 0x72, 0x2d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12,
+	0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x65, 0x65, 0x72, 0x2d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x32, 0xe9, 0x0b,
+	0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x74, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x21, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x3a, 0x01, 0x2a, 0x1a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6e,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x2a, 0x22, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x2f, 0x7b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x79,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12,
+	0x28, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x22, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31,
+	0x3a, 0x01, 0x2a, 0x1a, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x2d, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x6d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x12, 0x77, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x65, 0x74, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a,
+	0x01, 0x2a, 0x1a, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f,
+	0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x63, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64,
 	0x75, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x32, 0x53, 0x0a,
-	0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42,
-	0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x42, 0x0f, 0x5a, 0x0d, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x1a, 0x10, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x83,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x3a,
+	0x01, 0x2a, 0x22, 0x3f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f,
+	0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x31, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x2d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x78, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12,
+	0x28, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x32, 0xab, 0x03, 0x0a, 0x11, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x52, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x13, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
+	0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x5a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12,
+	0x73, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x35, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x12, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x32, 0xbe, 0x06, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x65,
+	0x77, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x6f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a,
+	0x1a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x66, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x2a, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x73, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x56, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2d,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x14, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x49, 0x6e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x74, 0x68, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x73, 0x2f,
+	0x7b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x12, 0x95, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74,
+	0x68, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x73, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x32, 0xcb, 0x05, 0x0a, 0x0e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x65, 0x77, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01,
+	0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x5a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x12, 0x1b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x59, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x46, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x12, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x86, 0x01,
+	0x0a, 0x10, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0x39, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x32, 0x6a, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x12, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x30, 0x01, 0x32, 0xf8, 0x06, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7e, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x08, 0x4d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x12, 0x23, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x93, 0x01, 0x0a, 0x0b,
+	0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x21, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22,
+	0x30, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x2d, 0x61, 0x6c,
+	0x6c, 0x12, 0x9b, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x21, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0xab, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x2b, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x3d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x1a, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a,
+	0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x19, 0x2e, 0x47,
+	0x6f, 0x45, 0x64, 0x75, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d,
+	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x32, 0x81, 0x03,
+	0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x6f, 0x45,
+	0x64, 0x75, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x6d, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x6f, 0x45, 0x64, 0x75, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x32, 0x53, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0c, 0x2e, 0x47, 0x6f,
+	0x45, 0x64, 0x75, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x47, 0x6f, 0x45, 0x64,
+	0x75, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x0f, 0x5a, 0x0d, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2378,114 +15615,558 @@ func file_proto_education_proto_rawDescGZIP() []byte {
 	return file_proto_education_proto_rawDescData
 }
 
-var file_proto_education_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_proto_education_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_education_proto_msgTypes = make([]protoimpl.MessageInfo, 184)
 var file_proto_education_proto_goTypes = []any{
-	(*Empty)(nil),                     // 0: GoEdu.Empty
-	(*UnEnrollRequest)(nil),           // 1: GoEdu.UnEnrollRequest
-	(*HealthCheckResponse)(nil),       // 2: GoEdu.HealthCheckResponse
-	(*Course)(nil),                    // 3: GoEdu.Course
-	(*CourseList)(nil),                // 4: GoEdu.CourseList
-	(*CourseIDRequest)(nil),           // 5: GoEdu.CourseIDRequest
-	(*NewCourseRequest)(nil),          // 6: GoEdu.NewCourseRequest
-	(*UpdateCourseRequest)(nil),       // 7: GoEdu.UpdateCourseRequest
-	(*RegisterStudentRequest)(nil),    // 8: GoEdu.RegisterStudentRequest
-	(*Student)(nil),                   // 9: GoEdu.Student
-	(*LoginRequest)(nil),              // 10: GoEdu.LoginRequest
-	(*AuthResponse)(nil),              // 11: GoEdu.AuthResponse
-	(*StudentIDRequest)(nil),          // 12: GoEdu.StudentIDRequest
-	(*UpdateStudentRequest)(nil),      // 13: GoEdu.UpdateStudentRequest
-	(*EnrollmentRequest)(nil),         // 14: GoEdu.EnrollmentRequest
-	(*StudentList)(nil),               // 15: GoEdu.StudentList
-	(*LectureRequest)(nil),            // 16: GoEdu.LectureRequest
-	(*Lecture)(nil),                   // 17: GoEdu.Lecture
-	(*LectureList)(nil),               // 18: GoEdu.LectureList
-	(*LectureIDRequest)(nil),          // 19: GoEdu.LectureIDRequest
-	(*LectureContent)(nil),            // 20: GoEdu.LectureContent
-	(*UpdateLectureRequest)(nil),      // 21: GoEdu.UpdateLectureRequest
-	(*LectureCompletionRequest)(nil),  // 22: GoEdu.LectureCompletionRequest
-	(*CourseProgressRequest)(nil),     // 23: GoEdu.CourseProgressRequest
-	(*CourseProgress)(nil),            // 24: GoEdu.CourseProgress
-	(*RegisterInstructorRequest)(nil), // 25: GoEdu.RegisterInstructorRequest
-	(*Instructor)(nil),                // 26: GoEdu.Instructor
-	(*InstructorIDRequest)(nil),       // 27: GoEdu.InstructorIDRequest
-	(*ReviewRequest)(nil),             // 28: GoEdu.ReviewRequest
-	(*Review)(nil),                    // 29: GoEdu.Review
-	(*ReviewList)(nil),                // 30: GoEdu.ReviewList
-	(*SearchRequest)(nil),             // 31: GoEdu.SearchRequest
-	(*UpdateInstructorRequest)(nil),   // 32: GoEdu.UpdateInstructorRequest
-	(*DeleteInstructorRequest)(nil),   // 33: GoEdu.DeleteInstructorRequest
-	(*GetInstructorRequest)(nil),      // 34: GoEdu.GetInstructorRequest
+	(CourseSort)(0),                              // 0: GoEdu.CourseSort
+	(LectureProgressEventType)(0),                // 1: GoEdu.LectureProgressEventType
+	(LectureProgressStatus)(0),                   // 2: GoEdu.LectureProgressStatus
+	(ReviewSort)(0),                              // 3: GoEdu.ReviewSort
+	(QuizQuestionType)(0),                        // 4: GoEdu.QuizQuestionType
+	(QuestionDifficulty)(0),                      // 5: GoEdu.QuestionDifficulty
+	(QuestionBankFormat)(0),                      // 6: GoEdu.QuestionBankFormat
+	(LatePolicy)(0),                              // 7: GoEdu.LatePolicy
+	(GradeItemType)(0),                           // 8: GoEdu.GradeItemType
+	(GradebookFormat)(0),                         // 9: GoEdu.GradebookFormat
+	(*Empty)(nil),                                // 10: GoEdu.Empty
+	(*UnEnrollRequest)(nil),                      // 11: GoEdu.UnEnrollRequest
+	(*HealthCheckResponse)(nil),                  // 12: GoEdu.HealthCheckResponse
+	(*Course)(nil),                               // 13: GoEdu.Course
+	(*CourseTags)(nil),                           // 14: GoEdu.CourseTags
+	(*CourseRecommendation)(nil),                 // 15: GoEdu.CourseRecommendation
+	(*RecommendationList)(nil),                   // 16: GoEdu.RecommendationList
+	(*CourseRating)(nil),                         // 17: GoEdu.CourseRating
+	(*CoursesRequest)(nil),                       // 18: GoEdu.CoursesRequest
+	(*CourseList)(nil),                           // 19: GoEdu.CourseList
+	(*SimilarCoursesRequest)(nil),                // 20: GoEdu.SimilarCoursesRequest
+	(*SimilarCourse)(nil),                        // 21: GoEdu.SimilarCourse
+	(*SimilarCourseList)(nil),                    // 22: GoEdu.SimilarCourseList
+	(*TrendingRequest)(nil),                      // 23: GoEdu.TrendingRequest
+	(*TrendingCourse)(nil),                       // 24: GoEdu.TrendingCourse
+	(*TrendingCourseList)(nil),                   // 25: GoEdu.TrendingCourseList
+	(*CourseIDRequest)(nil),                      // 26: GoEdu.CourseIDRequest
+	(*NewCourseRequest)(nil),                     // 27: GoEdu.NewCourseRequest
+	(*UpdateCourseRequest)(nil),                  // 28: GoEdu.UpdateCourseRequest
+	(*RegisterStudentRequest)(nil),               // 29: GoEdu.RegisterStudentRequest
+	(*Student)(nil),                              // 30: GoEdu.Student
+	(*LoginRequest)(nil),                         // 31: GoEdu.LoginRequest
+	(*AuthResponse)(nil),                         // 32: GoEdu.AuthResponse
+	(*StudentIDRequest)(nil),                     // 33: GoEdu.StudentIDRequest
+	(*UpdateStudentRequest)(nil),                 // 34: GoEdu.UpdateStudentRequest
+	(*EnrollmentRequest)(nil),                    // 35: GoEdu.EnrollmentRequest
+	(*StudentList)(nil),                          // 36: GoEdu.StudentList
+	(*BulkEnrollRequest)(nil),                    // 37: GoEdu.BulkEnrollRequest
+	(*BulkEnrollRowResult)(nil),                  // 38: GoEdu.BulkEnrollRowResult
+	(*BulkEnrollResponse)(nil),                   // 39: GoEdu.BulkEnrollResponse
+	(*AcceptInvitationRequest)(nil),              // 40: GoEdu.AcceptInvitationRequest
+	(*LectureRequest)(nil),                       // 41: GoEdu.LectureRequest
+	(*Lecture)(nil),                              // 42: GoEdu.Lecture
+	(*LectureList)(nil),                          // 43: GoEdu.LectureList
+	(*LectureIDRequest)(nil),                     // 44: GoEdu.LectureIDRequest
+	(*LectureContent)(nil),                       // 45: GoEdu.LectureContent
+	(*UpdateLectureRequest)(nil),                 // 46: GoEdu.UpdateLectureRequest
+	(*LectureCompletionRequest)(nil),             // 47: GoEdu.LectureCompletionRequest
+	(*CourseProgressRequest)(nil),                // 48: GoEdu.CourseProgressRequest
+	(*CourseProgress)(nil),                       // 49: GoEdu.CourseProgress
+	(*LectureProgressEvent)(nil),                 // 50: GoEdu.LectureProgressEvent
+	(*LectureProgress)(nil),                      // 51: GoEdu.LectureProgress
+	(*DetailedCourseProgress)(nil),               // 52: GoEdu.DetailedCourseProgress
+	(*CourseProgressSummary)(nil),                // 53: GoEdu.CourseProgressSummary
+	(*StudentDashboard)(nil),                     // 54: GoEdu.StudentDashboard
+	(*RegisterInstructorRequest)(nil),            // 55: GoEdu.RegisterInstructorRequest
+	(*Instructor)(nil),                           // 56: GoEdu.Instructor
+	(*InstructorIDRequest)(nil),                  // 57: GoEdu.InstructorIDRequest
+	(*ReviewRequest)(nil),                        // 58: GoEdu.ReviewRequest
+	(*Review)(nil),                               // 59: GoEdu.Review
+	(*ReviewReply)(nil),                          // 60: GoEdu.ReviewReply
+	(*ReviewsRequest)(nil),                       // 61: GoEdu.ReviewsRequest
+	(*ReviewReplyRequest)(nil),                   // 62: GoEdu.ReviewReplyRequest
+	(*ReviewVoteRequest)(nil),                    // 63: GoEdu.ReviewVoteRequest
+	(*ReviewList)(nil),                           // 64: GoEdu.ReviewList
+	(*UpdateReviewRequest)(nil),                  // 65: GoEdu.UpdateReviewRequest
+	(*ReviewIDRequest)(nil),                      // 66: GoEdu.ReviewIDRequest
+	(*SearchRequest)(nil),                        // 67: GoEdu.SearchRequest
+	(*UpdateInstructorRequest)(nil),              // 68: GoEdu.UpdateInstructorRequest
+	(*DeleteInstructorRequest)(nil),              // 69: GoEdu.DeleteInstructorRequest
+	(*GetInstructorRequest)(nil),                 // 70: GoEdu.GetInstructorRequest
+	(*Cohort)(nil),                               // 71: GoEdu.Cohort
+	(*CohortList)(nil),                           // 72: GoEdu.CohortList
+	(*CohortIDRequest)(nil),                      // 73: GoEdu.CohortIDRequest
+	(*CreateCohortRequest)(nil),                  // 74: GoEdu.CreateCohortRequest
+	(*UpdateCohortRequest)(nil),                  // 75: GoEdu.UpdateCohortRequest
+	(*CohortStudentsRequest)(nil),                // 76: GoEdu.CohortStudentsRequest
+	(*StudentProgress)(nil),                      // 77: GoEdu.StudentProgress
+	(*CohortProgress)(nil),                       // 78: GoEdu.CohortProgress
+	(*LectureRelease)(nil),                       // 79: GoEdu.LectureRelease
+	(*CohortSchedule)(nil),                       // 80: GoEdu.CohortSchedule
+	(*Certificate)(nil),                          // 81: GoEdu.Certificate
+	(*CertificateList)(nil),                      // 82: GoEdu.CertificateList
+	(*CertificateIDRequest)(nil),                 // 83: GoEdu.CertificateIDRequest
+	(*VerifyCertificateRequest)(nil),             // 84: GoEdu.VerifyCertificateRequest
+	(*CertificateVerification)(nil),              // 85: GoEdu.CertificateVerification
+	(*QuizQuestion)(nil),                         // 86: GoEdu.QuizQuestion
+	(*QuizDrawRule)(nil),                         // 87: GoEdu.QuizDrawRule
+	(*Quiz)(nil),                                 // 88: GoEdu.Quiz
+	(*QuizList)(nil),                             // 89: GoEdu.QuizList
+	(*QuizIDRequest)(nil),                        // 90: GoEdu.QuizIDRequest
+	(*CreateQuizRequest)(nil),                    // 91: GoEdu.CreateQuizRequest
+	(*UpdateQuizRequest)(nil),                    // 92: GoEdu.UpdateQuizRequest
+	(*StartQuizAttemptRequest)(nil),              // 93: GoEdu.StartQuizAttemptRequest
+	(*QuizAnswer)(nil),                           // 94: GoEdu.QuizAnswer
+	(*QuizAnswerResult)(nil),                     // 95: GoEdu.QuizAnswerResult
+	(*SubmitQuizAttemptRequest)(nil),             // 96: GoEdu.SubmitQuizAttemptRequest
+	(*QuizAttempt)(nil),                          // 97: GoEdu.QuizAttempt
+	(*QuizAttemptsRequest)(nil),                  // 98: GoEdu.QuizAttemptsRequest
+	(*QuizAttemptList)(nil),                      // 99: GoEdu.QuizAttemptList
+	(*BankQuestionRequest)(nil),                  // 100: GoEdu.BankQuestionRequest
+	(*BankQuestionIDRequest)(nil),                // 101: GoEdu.BankQuestionIDRequest
+	(*ListBankQuestionsRequest)(nil),             // 102: GoEdu.ListBankQuestionsRequest
+	(*BankQuestionList)(nil),                     // 103: GoEdu.BankQuestionList
+	(*ImportQuestionBankRequest)(nil),            // 104: GoEdu.ImportQuestionBankRequest
+	(*ImportQuestionBankResponse)(nil),           // 105: GoEdu.ImportQuestionBankResponse
+	(*ExportQuestionBankRequest)(nil),            // 106: GoEdu.ExportQuestionBankRequest
+	(*QuestionBankFile)(nil),                     // 107: GoEdu.QuestionBankFile
+	(*RubricCriterion)(nil),                      // 108: GoEdu.RubricCriterion
+	(*Assignment)(nil),                           // 109: GoEdu.Assignment
+	(*CreateAssignmentRequest)(nil),              // 110: GoEdu.CreateAssignmentRequest
+	(*UpdateAssignmentRequest)(nil),              // 111: GoEdu.UpdateAssignmentRequest
+	(*AssignmentIDRequest)(nil),                  // 112: GoEdu.AssignmentIDRequest
+	(*AssignmentList)(nil),                       // 113: GoEdu.AssignmentList
+	(*UploadedFile)(nil),                         // 114: GoEdu.UploadedFile
+	(*SubmitAssignmentRequest)(nil),              // 115: GoEdu.SubmitAssignmentRequest
+	(*SubmissionFile)(nil),                       // 116: GoEdu.SubmissionFile
+	(*RubricScore)(nil),                          // 117: GoEdu.RubricScore
+	(*Submission)(nil),                           // 118: GoEdu.Submission
+	(*SubmissionIDRequest)(nil),                  // 119: GoEdu.SubmissionIDRequest
+	(*ListSubmissionsRequest)(nil),               // 120: GoEdu.ListSubmissionsRequest
+	(*SubmissionList)(nil),                       // 121: GoEdu.SubmissionList
+	(*GradeSubmissionRequest)(nil),               // 122: GoEdu.GradeSubmissionRequest
+	(*SubmissionFileRequest)(nil),                // 123: GoEdu.SubmissionFileRequest
+	(*SubmissionFileContent)(nil),                // 124: GoEdu.SubmissionFileContent
+	(*PeerReview)(nil),                           // 125: GoEdu.PeerReview
+	(*PeerReviewTasksRequest)(nil),               // 126: GoEdu.PeerReviewTasksRequest
+	(*PeerReviewTask)(nil),                       // 127: GoEdu.PeerReviewTask
+	(*PeerReviewTaskList)(nil),                   // 128: GoEdu.PeerReviewTaskList
+	(*SubmitPeerReviewRequest)(nil),              // 129: GoEdu.SubmitPeerReviewRequest
+	(*PeerReviewList)(nil),                       // 130: GoEdu.PeerReviewList
+	(*GradeCategory)(nil),                        // 131: GoEdu.GradeCategory
+	(*CreateGradeCategoryRequest)(nil),           // 132: GoEdu.CreateGradeCategoryRequest
+	(*UpdateGradeCategoryRequest)(nil),           // 133: GoEdu.UpdateGradeCategoryRequest
+	(*GradeCategoryIDRequest)(nil),               // 134: GoEdu.GradeCategoryIDRequest
+	(*GradeCategoryList)(nil),                    // 135: GoEdu.GradeCategoryList
+	(*SetGradeItemCategoryRequest)(nil),          // 136: GoEdu.SetGradeItemCategoryRequest
+	(*GradeItem)(nil),                            // 137: GoEdu.GradeItem
+	(*LetterGrade)(nil),                          // 138: GoEdu.LetterGrade
+	(*LetterScheme)(nil),                         // 139: GoEdu.LetterScheme
+	(*SetLetterSchemeRequest)(nil),               // 140: GoEdu.SetLetterSchemeRequest
+	(*ItemGrade)(nil),                            // 141: GoEdu.ItemGrade
+	(*CategoryGrade)(nil),                        // 142: GoEdu.CategoryGrade
+	(*StudentGrades)(nil),                        // 143: GoEdu.StudentGrades
+	(*Gradebook)(nil),                            // 144: GoEdu.Gradebook
+	(*StudentGradesRequest)(nil),                 // 145: GoEdu.StudentGradesRequest
+	(*AdjustGradeRequest)(nil),                   // 146: GoEdu.AdjustGradeRequest
+	(*GradeAdjustment)(nil),                      // 147: GoEdu.GradeAdjustment
+	(*ListGradeAdjustmentsRequest)(nil),          // 148: GoEdu.ListGradeAdjustmentsRequest
+	(*GradeAdjustmentList)(nil),                  // 149: GoEdu.GradeAdjustmentList
+	(*ExportGradebookRequest)(nil),               // 150: GoEdu.ExportGradebookRequest
+	(*GradebookFile)(nil),                        // 151: GoEdu.GradebookFile
+	(*ReportReviewRequest)(nil),                  // 152: GoEdu.ReportReviewRequest
+	(*ModerateReviewRequest)(nil),                // 153: GoEdu.ModerateReviewRequest
+	(*ModerationItem)(nil),                       // 154: GoEdu.ModerationItem
+	(*ModerationQueue)(nil),                      // 155: GoEdu.ModerationQueue
+	(*LearningPathItem)(nil),                     // 156: GoEdu.LearningPathItem
+	(*LearningPath)(nil),                         // 157: GoEdu.LearningPath
+	(*LearningPathList)(nil),                     // 158: GoEdu.LearningPathList
+	(*LearningPathItemRequest)(nil),              // 159: GoEdu.LearningPathItemRequest
+	(*NewLearningPathRequest)(nil),               // 160: GoEdu.NewLearningPathRequest
+	(*UpdateLearningPathRequest)(nil),            // 161: GoEdu.UpdateLearningPathRequest
+	(*LearningPathIDRequest)(nil),                // 162: GoEdu.LearningPathIDRequest
+	(*LearningPathStudentRequest)(nil),           // 163: GoEdu.LearningPathStudentRequest
+	(*LearningPathEnrollment)(nil),               // 164: GoEdu.LearningPathEnrollment
+	(*LearningPathCourseProgress)(nil),           // 165: GoEdu.LearningPathCourseProgress
+	(*LearningPathProgress)(nil),                 // 166: GoEdu.LearningPathProgress
+	(*Webhook)(nil),                              // 167: GoEdu.Webhook
+	(*WebhookList)(nil),                          // 168: GoEdu.WebhookList
+	(*NewWebhookRequest)(nil),                    // 169: GoEdu.NewWebhookRequest
+	(*UpdateWebhookRequest)(nil),                 // 170: GoEdu.UpdateWebhookRequest
+	(*WebhookIDRequest)(nil),                     // 171: GoEdu.WebhookIDRequest
+	(*WebhookDeliveriesRequest)(nil),             // 172: GoEdu.WebhookDeliveriesRequest
+	(*WebhookDeliveryIDRequest)(nil),             // 173: GoEdu.WebhookDeliveryIDRequest
+	(*WebhookDelivery)(nil),                      // 174: GoEdu.WebhookDelivery
+	(*WebhookDeliveryList)(nil),                  // 175: GoEdu.WebhookDeliveryList
+	(*SubscribeEventsRequest)(nil),               // 176: GoEdu.SubscribeEventsRequest
+	(*DomainEvent)(nil),                          // 177: GoEdu.DomainEvent
+	(*NotificationStudentRequest)(nil),           // 178: GoEdu.NotificationStudentRequest
+	(*ListNotificationsRequest)(nil),             // 179: GoEdu.ListNotificationsRequest
+	(*Notification)(nil),                         // 180: GoEdu.Notification
+	(*NotificationList)(nil),                     // 181: GoEdu.NotificationList
+	(*MarkNotificationsReadRequest)(nil),         // 182: GoEdu.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil),        // 183: GoEdu.MarkNotificationsReadResponse
+	(*NotificationPreference)(nil),               // 184: GoEdu.NotificationPreference
+	(*NotificationPreferences)(nil),              // 185: GoEdu.NotificationPreferences
+	(*UpdateNotificationPreferencesRequest)(nil), // 186: GoEdu.UpdateNotificationPreferencesRequest
+	(*UnsubscribeRequest)(nil),                   // 187: GoEdu.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),                  // 188: GoEdu.UnsubscribeResponse
+	(*Announcement)(nil),                         // 189: GoEdu.Announcement
+	(*CreateAnnouncementRequest)(nil),            // 190: GoEdu.CreateAnnouncementRequest
+	(*ListAnnouncementsRequest)(nil),             // 191: GoEdu.ListAnnouncementsRequest
+	(*AnnouncementList)(nil),                     // 192: GoEdu.AnnouncementList
+	(*AnnouncementIDRequest)(nil),                // 193: GoEdu.AnnouncementIDRequest
 }
 var file_proto_education_proto_depIdxs = []int32{
-	3,  // 0: GoEdu.CourseList.courses:type_name -> GoEdu.Course
-	9,  // 1: GoEdu.StudentList.students:type_name -> GoEdu.Student
-	17, // 2: GoEdu.LectureList.lectures:type_name -> GoEdu.Lecture
-	29, // 3: GoEdu.ReviewList.reviews:type_name -> GoEdu.Review
-	0,  // 4: GoEdu.EducationService.GetCourses:input_type -> GoEdu.Empty
-	5,  // 5: GoEdu.EducationService.GetCourseByID:input_type -> GoEdu.CourseIDRequest
-	6,  // 6: GoEdu.EducationService.CreateCourse:input_type -> GoEdu.NewCourseRequest
-	7,  // 7: GoEdu.EducationService.UpdateCourse:input_type -> GoEdu.UpdateCourseRequest
-	5,  // 8: GoEdu.EducationService.DeleteCourse:input_type -> GoEdu.CourseIDRequest
-	31, // 9: GoEdu.EducationService.SearchCourses:input_type -> GoEdu.SearchRequest
-	8,  // 10: GoEdu.StudentService.RegisterStudent:input_type -> GoEdu.RegisterStudentRequest
-	10, // 11: GoEdu.StudentService.LoginStudent:input_type -> GoEdu.LoginRequest
-	12, // 12: GoEdu.StudentService.GetStudentProfile:input_type -> GoEdu.StudentIDRequest
-	13, // 13: GoEdu.StudentService.UpdateStudentProfile:input_type -> GoEdu.UpdateStudentRequest
-	14, // 14: GoEdu.EnrollmentService.EnrollStudent:input_type -> GoEdu.EnrollmentRequest
-	5,  // 15: GoEdu.EnrollmentService.GetStudentsByCourse:input_type -> GoEdu.CourseIDRequest
-	12, // 16: GoEdu.EnrollmentService.GetCoursesByStudent:input_type -> GoEdu.StudentIDRequest
-	1,  // 17: GoEdu.EnrollmentService.UnEnrollStudent:input_type -> GoEdu.UnEnrollRequest
-	16, // 18: GoEdu.LectureService.AddLectureToCourse:input_type -> GoEdu.LectureRequest
-	5,  // 19: GoEdu.LectureService.GetLecturesByCourse:input_type -> GoEdu.CourseIDRequest
-	19, // 20: GoEdu.LectureService.GetLectureContent:input_type -> GoEdu.LectureIDRequest
-	21, // 21: GoEdu.LectureService.UpdateLecture:input_type -> GoEdu.UpdateLectureRequest
-	19, // 22: GoEdu.LectureService.DeleteLecture:input_type -> GoEdu.LectureIDRequest
-	22, // 23: GoEdu.LectureService.MarkLectureAsCompleted:input_type -> GoEdu.LectureCompletionRequest
-	23, // 24: GoEdu.LectureService.GetCourseProgress:input_type -> GoEdu.CourseProgressRequest
-	12, // 25: GoEdu.LectureService.GetRecommendedCourses:input_type -> GoEdu.StudentIDRequest
-	34, // 26: GoEdu.InstructorService.GetInstructorByID:input_type -> GoEdu.GetInstructorRequest
-	32, // 27: GoEdu.InstructorService.UpdateInstructor:input_type -> GoEdu.UpdateInstructorRequest
-	25, // 28: GoEdu.InstructorService.RegisterInstructor:input_type -> GoEdu.RegisterInstructorRequest
-	10, // 29: GoEdu.InstructorService.LoginInstructor:input_type -> GoEdu.LoginRequest
-	27, // 30: GoEdu.InstructorService.GetCoursesByInstructor:input_type -> GoEdu.InstructorIDRequest
-	28, // 31: GoEdu.ReviewService.AddReviewToCourse:input_type -> GoEdu.ReviewRequest
-	5,  // 32: GoEdu.ReviewService.GetReviewsByCourse:input_type -> GoEdu.CourseIDRequest
-	0,  // 33: GoEdu.HealthService.Check:input_type -> GoEdu.Empty
-	4,  // 34: GoEdu.EducationService.GetCourses:output_type -> GoEdu.CourseList
-	3,  // 35: GoEdu.EducationService.GetCourseByID:output_type -> GoEdu.Course
-	3,  // 36: GoEdu.EducationService.CreateCourse:output_type -> GoEdu.Course
-	3,  // 37: GoEdu.EducationService.UpdateCourse:output_type -> GoEdu.Course
-	0,  // 38: GoEdu.EducationService.DeleteCourse:output_type -> GoEdu.Empty
-	4,  // 39: GoEdu.EducationService.SearchCourses:output_type -> GoEdu.CourseList
-	9,  // 40: GoEdu.StudentService.RegisterStudent:output_type -> GoEdu.Student
-	11, // 41: GoEdu.StudentService.LoginStudent:output_type -> GoEdu.AuthResponse
-	9,  // 42: GoEdu.StudentService.GetStudentProfile:output_type -> GoEdu.Student
-	9,  // 43: GoEdu.StudentService.UpdateStudentProfile:output_type -> GoEdu.Student
-	0,  // 44: GoEdu.EnrollmentService.EnrollStudent:output_type -> GoEdu.Empty
-	15, // 45: GoEdu.EnrollmentService.GetStudentsByCourse:output_type -> GoEdu.StudentList
-	4,  // 46: GoEdu.EnrollmentService.GetCoursesByStudent:output_type -> GoEdu.CourseList
-	0,  // 47: GoEdu.EnrollmentService.UnEnrollStudent:output_type -> GoEdu.Empty
-	17, // 48: GoEdu.LectureService.AddLectureToCourse:output_type -> GoEdu.Lecture
-	18, // 49: GoEdu.LectureService.GetLecturesByCourse:output_type -> GoEdu.LectureList
-	20, // 50: GoEdu.LectureService.GetLectureContent:output_type -> GoEdu.LectureContent
-	17, // 51: GoEdu.LectureService.UpdateLecture:output_type -> GoEdu.Lecture
-	0,  // 52: GoEdu.LectureService.DeleteLecture:output_type -> GoEdu.Empty
-	0,  // 53: GoEdu.LectureService.MarkLectureAsCompleted:output_type -> GoEdu.Empty
-	24, // 54: GoEdu.LectureService.GetCourseProgress:output_type -> GoEdu.CourseProgress
-	4,  // 55: GoEdu.LectureService.GetRecommendedCourses:output_type -> GoEdu.CourseList
-	26, // 56: GoEdu.InstructorService.GetInstructorByID:output_type -> GoEdu.Instructor
-	26, // 57: GoEdu.InstructorService.UpdateInstructor:output_type -> GoEdu.Instructor
-	26, // 58: GoEdu.InstructorService.RegisterInstructor:output_type -> GoEdu.Instructor
-	11, // 59: GoEdu.InstructorService.LoginInstructor:output_type -> GoEdu.AuthResponse
-	4,  // 60: GoEdu.InstructorService.GetCoursesByInstructor:output_type -> GoEdu.CourseList
-	0,  // 61: GoEdu.ReviewService.AddReviewToCourse:output_type -> GoEdu.Empty
-	30, // 62: GoEdu.ReviewService.GetReviewsByCourse:output_type -> GoEdu.ReviewList
-	2,  // 63: GoEdu.HealthService.Check:output_type -> GoEdu.HealthCheckResponse
-	34, // [34:64] is the sub-list for method output_type
-	4,  // [4:34] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	17,  // 0: GoEdu.Course.rating:type_name -> GoEdu.CourseRating
+	13,  // 1: GoEdu.CourseRecommendation.course:type_name -> GoEdu.Course
+	15,  // 2: GoEdu.RecommendationList.recommendations:type_name -> GoEdu.CourseRecommendation
+	0,   // 3: GoEdu.CoursesRequest.sort:type_name -> GoEdu.CourseSort
+	13,  // 4: GoEdu.CourseList.courses:type_name -> GoEdu.Course
+	13,  // 5: GoEdu.SimilarCourse.course:type_name -> GoEdu.Course
+	21,  // 6: GoEdu.SimilarCourseList.courses:type_name -> GoEdu.SimilarCourse
+	13,  // 7: GoEdu.TrendingCourse.course:type_name -> GoEdu.Course
+	24,  // 8: GoEdu.TrendingCourseList.courses:type_name -> GoEdu.TrendingCourse
+	14,  // 9: GoEdu.UpdateCourseRequest.tags:type_name -> GoEdu.CourseTags
+	30,  // 10: GoEdu.StudentList.students:type_name -> GoEdu.Student
+	38,  // 11: GoEdu.BulkEnrollResponse.rows:type_name -> GoEdu.BulkEnrollRowResult
+	42,  // 12: GoEdu.LectureList.lectures:type_name -> GoEdu.Lecture
+	1,   // 13: GoEdu.LectureProgressEvent.event:type_name -> GoEdu.LectureProgressEventType
+	2,   // 14: GoEdu.LectureProgress.status:type_name -> GoEdu.LectureProgressStatus
+	51,  // 15: GoEdu.DetailedCourseProgress.lectures:type_name -> GoEdu.LectureProgress
+	53,  // 16: GoEdu.StudentDashboard.courses:type_name -> GoEdu.CourseProgressSummary
+	60,  // 17: GoEdu.Review.reply:type_name -> GoEdu.ReviewReply
+	3,   // 18: GoEdu.ReviewsRequest.sort:type_name -> GoEdu.ReviewSort
+	59,  // 19: GoEdu.ReviewList.reviews:type_name -> GoEdu.Review
+	0,   // 20: GoEdu.SearchRequest.sort:type_name -> GoEdu.CourseSort
+	71,  // 21: GoEdu.CohortList.cohorts:type_name -> GoEdu.Cohort
+	77,  // 22: GoEdu.CohortProgress.students:type_name -> GoEdu.StudentProgress
+	79,  // 23: GoEdu.CohortSchedule.lectures:type_name -> GoEdu.LectureRelease
+	81,  // 24: GoEdu.CertificateList.certificates:type_name -> GoEdu.Certificate
+	81,  // 25: GoEdu.CertificateVerification.certificate:type_name -> GoEdu.Certificate
+	4,   // 26: GoEdu.QuizQuestion.type:type_name -> GoEdu.QuizQuestionType
+	5,   // 27: GoEdu.QuizQuestion.difficulty:type_name -> GoEdu.QuestionDifficulty
+	5,   // 28: GoEdu.QuizDrawRule.difficulty:type_name -> GoEdu.QuestionDifficulty
+	86,  // 29: GoEdu.Quiz.questions:type_name -> GoEdu.QuizQuestion
+	87,  // 30: GoEdu.Quiz.draw_rules:type_name -> GoEdu.QuizDrawRule
+	88,  // 31: GoEdu.QuizList.quizzes:type_name -> GoEdu.Quiz
+	86,  // 32: GoEdu.CreateQuizRequest.questions:type_name -> GoEdu.QuizQuestion
+	87,  // 33: GoEdu.CreateQuizRequest.draw_rules:type_name -> GoEdu.QuizDrawRule
+	86,  // 34: GoEdu.UpdateQuizRequest.questions:type_name -> GoEdu.QuizQuestion
+	87,  // 35: GoEdu.UpdateQuizRequest.draw_rules:type_name -> GoEdu.QuizDrawRule
+	94,  // 36: GoEdu.SubmitQuizAttemptRequest.answers:type_name -> GoEdu.QuizAnswer
+	86,  // 37: GoEdu.QuizAttempt.questions:type_name -> GoEdu.QuizQuestion
+	95,  // 38: GoEdu.QuizAttempt.results:type_name -> GoEdu.QuizAnswerResult
+	97,  // 39: GoEdu.QuizAttemptList.attempts:type_name -> GoEdu.QuizAttempt
+	86,  // 40: GoEdu.BankQuestionRequest.question:type_name -> GoEdu.QuizQuestion
+	5,   // 41: GoEdu.ListBankQuestionsRequest.difficulty:type_name -> GoEdu.QuestionDifficulty
+	86,  // 42: GoEdu.BankQuestionList.questions:type_name -> GoEdu.QuizQuestion
+	6,   // 43: GoEdu.ImportQuestionBankRequest.format:type_name -> GoEdu.QuestionBankFormat
+	86,  // 44: GoEdu.ImportQuestionBankResponse.questions:type_name -> GoEdu.QuizQuestion
+	6,   // 45: GoEdu.ExportQuestionBankRequest.format:type_name -> GoEdu.QuestionBankFormat
+	5,   // 46: GoEdu.ExportQuestionBankRequest.difficulty:type_name -> GoEdu.QuestionDifficulty
+	6,   // 47: GoEdu.QuestionBankFile.format:type_name -> GoEdu.QuestionBankFormat
+	7,   // 48: GoEdu.Assignment.late_policy:type_name -> GoEdu.LatePolicy
+	108, // 49: GoEdu.Assignment.rubric:type_name -> GoEdu.RubricCriterion
+	7,   // 50: GoEdu.CreateAssignmentRequest.late_policy:type_name -> GoEdu.LatePolicy
+	108, // 51: GoEdu.CreateAssignmentRequest.rubric:type_name -> GoEdu.RubricCriterion
+	7,   // 52: GoEdu.UpdateAssignmentRequest.late_policy:type_name -> GoEdu.LatePolicy
+	108, // 53: GoEdu.UpdateAssignmentRequest.rubric:type_name -> GoEdu.RubricCriterion
+	109, // 54: GoEdu.AssignmentList.assignments:type_name -> GoEdu.Assignment
+	114, // 55: GoEdu.SubmitAssignmentRequest.files:type_name -> GoEdu.UploadedFile
+	116, // 56: GoEdu.Submission.files:type_name -> GoEdu.SubmissionFile
+	117, // 57: GoEdu.Submission.rubric_scores:type_name -> GoEdu.RubricScore
+	118, // 58: GoEdu.SubmissionList.submissions:type_name -> GoEdu.Submission
+	117, // 59: GoEdu.GradeSubmissionRequest.rubric_scores:type_name -> GoEdu.RubricScore
+	117, // 60: GoEdu.PeerReview.scores:type_name -> GoEdu.RubricScore
+	125, // 61: GoEdu.PeerReviewTask.review:type_name -> GoEdu.PeerReview
+	108, // 62: GoEdu.PeerReviewTask.rubric:type_name -> GoEdu.RubricCriterion
+	116, // 63: GoEdu.PeerReviewTask.files:type_name -> GoEdu.SubmissionFile
+	127, // 64: GoEdu.PeerReviewTaskList.tasks:type_name -> GoEdu.PeerReviewTask
+	117, // 65: GoEdu.SubmitPeerReviewRequest.scores:type_name -> GoEdu.RubricScore
+	125, // 66: GoEdu.PeerReviewList.reviews:type_name -> GoEdu.PeerReview
+	131, // 67: GoEdu.GradeCategoryList.categories:type_name -> GoEdu.GradeCategory
+	8,   // 68: GoEdu.SetGradeItemCategoryRequest.item_type:type_name -> GoEdu.GradeItemType
+	8,   // 69: GoEdu.GradeItem.type:type_name -> GoEdu.GradeItemType
+	138, // 70: GoEdu.LetterScheme.letters:type_name -> GoEdu.LetterGrade
+	138, // 71: GoEdu.SetLetterSchemeRequest.letters:type_name -> GoEdu.LetterGrade
+	8,   // 72: GoEdu.ItemGrade.item_type:type_name -> GoEdu.GradeItemType
+	141, // 73: GoEdu.StudentGrades.items:type_name -> GoEdu.ItemGrade
+	142, // 74: GoEdu.StudentGrades.categories:type_name -> GoEdu.CategoryGrade
+	131, // 75: GoEdu.Gradebook.categories:type_name -> GoEdu.GradeCategory
+	137, // 76: GoEdu.Gradebook.items:type_name -> GoEdu.GradeItem
+	138, // 77: GoEdu.Gradebook.letters:type_name -> GoEdu.LetterGrade
+	143, // 78: GoEdu.Gradebook.students:type_name -> GoEdu.StudentGrades
+	8,   // 79: GoEdu.AdjustGradeRequest.item_type:type_name -> GoEdu.GradeItemType
+	8,   // 80: GoEdu.GradeAdjustment.item_type:type_name -> GoEdu.GradeItemType
+	147, // 81: GoEdu.GradeAdjustmentList.adjustments:type_name -> GoEdu.GradeAdjustment
+	9,   // 82: GoEdu.ExportGradebookRequest.format:type_name -> GoEdu.GradebookFormat
+	59,  // 83: GoEdu.ModerationItem.review:type_name -> GoEdu.Review
+	154, // 84: GoEdu.ModerationQueue.items:type_name -> GoEdu.ModerationItem
+	13,  // 85: GoEdu.LearningPathItem.course:type_name -> GoEdu.Course
+	156, // 86: GoEdu.LearningPath.items:type_name -> GoEdu.LearningPathItem
+	157, // 87: GoEdu.LearningPathList.paths:type_name -> GoEdu.LearningPath
+	159, // 88: GoEdu.NewLearningPathRequest.items:type_name -> GoEdu.LearningPathItemRequest
+	159, // 89: GoEdu.UpdateLearningPathRequest.items:type_name -> GoEdu.LearningPathItemRequest
+	165, // 90: GoEdu.LearningPathProgress.courses:type_name -> GoEdu.LearningPathCourseProgress
+	81,  // 91: GoEdu.LearningPathProgress.certificate:type_name -> GoEdu.Certificate
+	167, // 92: GoEdu.WebhookList.webhooks:type_name -> GoEdu.Webhook
+	174, // 93: GoEdu.WebhookDeliveryList.deliveries:type_name -> GoEdu.WebhookDelivery
+	180, // 94: GoEdu.NotificationList.notifications:type_name -> GoEdu.Notification
+	184, // 95: GoEdu.NotificationPreferences.preferences:type_name -> GoEdu.NotificationPreference
+	184, // 96: GoEdu.UpdateNotificationPreferencesRequest.preferences:type_name -> GoEdu.NotificationPreference
+	189, // 97: GoEdu.AnnouncementList.announcements:type_name -> GoEdu.Announcement
+	18,  // 98: GoEdu.EducationService.GetCourses:input_type -> GoEdu.CoursesRequest
+	26,  // 99: GoEdu.EducationService.GetCourseByID:input_type -> GoEdu.CourseIDRequest
+	27,  // 100: GoEdu.EducationService.CreateCourse:input_type -> GoEdu.NewCourseRequest
+	28,  // 101: GoEdu.EducationService.UpdateCourse:input_type -> GoEdu.UpdateCourseRequest
+	26,  // 102: GoEdu.EducationService.DeleteCourse:input_type -> GoEdu.CourseIDRequest
+	67,  // 103: GoEdu.EducationService.SearchCourses:input_type -> GoEdu.SearchRequest
+	20,  // 104: GoEdu.EducationService.GetSimilarCourses:input_type -> GoEdu.SimilarCoursesRequest
+	23,  // 105: GoEdu.EducationService.GetTrendingCourses:input_type -> GoEdu.TrendingRequest
+	29,  // 106: GoEdu.StudentService.RegisterStudent:input_type -> GoEdu.RegisterStudentRequest
+	31,  // 107: GoEdu.StudentService.LoginStudent:input_type -> GoEdu.LoginRequest
+	33,  // 108: GoEdu.StudentService.GetStudentProfile:input_type -> GoEdu.StudentIDRequest
+	34,  // 109: GoEdu.StudentService.UpdateStudentProfile:input_type -> GoEdu.UpdateStudentRequest
+	40,  // 110: GoEdu.StudentService.AcceptInvitation:input_type -> GoEdu.AcceptInvitationRequest
+	35,  // 111: GoEdu.EnrollmentService.EnrollStudent:input_type -> GoEdu.EnrollmentRequest
+	26,  // 112: GoEdu.EnrollmentService.GetStudentsByCourse:input_type -> GoEdu.CourseIDRequest
+	33,  // 113: GoEdu.EnrollmentService.GetCoursesByStudent:input_type -> GoEdu.StudentIDRequest
+	11,  // 114: GoEdu.EnrollmentService.UnEnrollStudent:input_type -> GoEdu.UnEnrollRequest
+	37,  // 115: GoEdu.EnrollmentService.BulkEnroll:input_type -> GoEdu.BulkEnrollRequest
+	41,  // 116: GoEdu.LectureService.AddLectureToCourse:input_type -> GoEdu.LectureRequest
+	26,  // 117: GoEdu.LectureService.GetLecturesByCourse:input_type -> GoEdu.CourseIDRequest
+	44,  // 118: GoEdu.LectureService.GetLectureContent:input_type -> GoEdu.LectureIDRequest
+	46,  // 119: GoEdu.LectureService.UpdateLecture:input_type -> GoEdu.UpdateLectureRequest
+	44,  // 120: GoEdu.LectureService.DeleteLecture:input_type -> GoEdu.LectureIDRequest
+	47,  // 121: GoEdu.LectureService.MarkLectureAsCompleted:input_type -> GoEdu.LectureCompletionRequest
+	48,  // 122: GoEdu.LectureService.GetCourseProgress:input_type -> GoEdu.CourseProgressRequest
+	33,  // 123: GoEdu.LectureService.GetRecommendedCourses:input_type -> GoEdu.StudentIDRequest
+	33,  // 124: GoEdu.LectureService.GetCourseRecommendations:input_type -> GoEdu.StudentIDRequest
+	50,  // 125: GoEdu.LectureService.RecordLectureProgress:input_type -> GoEdu.LectureProgressEvent
+	48,  // 126: GoEdu.LectureService.GetDetailedCourseProgress:input_type -> GoEdu.CourseProgressRequest
+	33,  // 127: GoEdu.LectureService.GetStudentDashboard:input_type -> GoEdu.StudentIDRequest
+	70,  // 128: GoEdu.InstructorService.GetInstructorByID:input_type -> GoEdu.GetInstructorRequest
+	68,  // 129: GoEdu.InstructorService.UpdateInstructor:input_type -> GoEdu.UpdateInstructorRequest
+	55,  // 130: GoEdu.InstructorService.RegisterInstructor:input_type -> GoEdu.RegisterInstructorRequest
+	31,  // 131: GoEdu.InstructorService.LoginInstructor:input_type -> GoEdu.LoginRequest
+	57,  // 132: GoEdu.InstructorService.GetCoursesByInstructor:input_type -> GoEdu.InstructorIDRequest
+	58,  // 133: GoEdu.ReviewService.AddReviewToCourse:input_type -> GoEdu.ReviewRequest
+	58,  // 134: GoEdu.ReviewService.CreateReview:input_type -> GoEdu.ReviewRequest
+	65,  // 135: GoEdu.ReviewService.UpdateReview:input_type -> GoEdu.UpdateReviewRequest
+	66,  // 136: GoEdu.ReviewService.DeleteReview:input_type -> GoEdu.ReviewIDRequest
+	61,  // 137: GoEdu.ReviewService.GetReviewsByCourse:input_type -> GoEdu.ReviewsRequest
+	62,  // 138: GoEdu.ReviewService.ReplyToReview:input_type -> GoEdu.ReviewReplyRequest
+	66,  // 139: GoEdu.ReviewService.DeleteReviewReply:input_type -> GoEdu.ReviewIDRequest
+	63,  // 140: GoEdu.ReviewService.VoteReview:input_type -> GoEdu.ReviewVoteRequest
+	66,  // 141: GoEdu.ReviewService.DeleteReviewVote:input_type -> GoEdu.ReviewIDRequest
+	152, // 142: GoEdu.ReviewService.ReportReview:input_type -> GoEdu.ReportReviewRequest
+	74,  // 143: GoEdu.CohortService.CreateCohort:input_type -> GoEdu.CreateCohortRequest
+	75,  // 144: GoEdu.CohortService.UpdateCohort:input_type -> GoEdu.UpdateCohortRequest
+	73,  // 145: GoEdu.CohortService.DeleteCohort:input_type -> GoEdu.CohortIDRequest
+	26,  // 146: GoEdu.CohortService.ListCohorts:input_type -> GoEdu.CourseIDRequest
+	76,  // 147: GoEdu.CohortService.AssignStudentsToCohort:input_type -> GoEdu.CohortStudentsRequest
+	76,  // 148: GoEdu.CohortService.RemoveStudentsFromCohort:input_type -> GoEdu.CohortStudentsRequest
+	73,  // 149: GoEdu.CohortService.GetCohortStudents:input_type -> GoEdu.CohortIDRequest
+	73,  // 150: GoEdu.CohortService.GetCohortProgress:input_type -> GoEdu.CohortIDRequest
+	73,  // 151: GoEdu.CohortService.GetCohortSchedule:input_type -> GoEdu.CohortIDRequest
+	83,  // 152: GoEdu.CertificateService.GetCertificate:input_type -> GoEdu.CertificateIDRequest
+	10,  // 153: GoEdu.CertificateService.ListMyCertificates:input_type -> GoEdu.Empty
+	84,  // 154: GoEdu.CertificateService.VerifyCertificate:input_type -> GoEdu.VerifyCertificateRequest
+	91,  // 155: GoEdu.QuizService.CreateQuiz:input_type -> GoEdu.CreateQuizRequest
+	92,  // 156: GoEdu.QuizService.UpdateQuiz:input_type -> GoEdu.UpdateQuizRequest
+	90,  // 157: GoEdu.QuizService.DeleteQuiz:input_type -> GoEdu.QuizIDRequest
+	90,  // 158: GoEdu.QuizService.GetQuiz:input_type -> GoEdu.QuizIDRequest
+	26,  // 159: GoEdu.QuizService.ListQuizzes:input_type -> GoEdu.CourseIDRequest
+	93,  // 160: GoEdu.QuizService.StartQuizAttempt:input_type -> GoEdu.StartQuizAttemptRequest
+	96,  // 161: GoEdu.QuizService.SubmitQuizAttempt:input_type -> GoEdu.SubmitQuizAttemptRequest
+	98,  // 162: GoEdu.QuizService.ListQuizAttempts:input_type -> GoEdu.QuizAttemptsRequest
+	100, // 163: GoEdu.QuestionBankService.CreateBankQuestion:input_type -> GoEdu.BankQuestionRequest
+	100, // 164: GoEdu.QuestionBankService.UpdateBankQuestion:input_type -> GoEdu.BankQuestionRequest
+	101, // 165: GoEdu.QuestionBankService.DeleteBankQuestion:input_type -> GoEdu.BankQuestionIDRequest
+	102, // 166: GoEdu.QuestionBankService.ListBankQuestions:input_type -> GoEdu.ListBankQuestionsRequest
+	104, // 167: GoEdu.QuestionBankService.ImportQuestionBank:input_type -> GoEdu.ImportQuestionBankRequest
+	106, // 168: GoEdu.QuestionBankService.ExportQuestionBank:input_type -> GoEdu.ExportQuestionBankRequest
+	110, // 169: GoEdu.AssignmentService.CreateAssignment:input_type -> GoEdu.CreateAssignmentRequest
+	111, // 170: GoEdu.AssignmentService.UpdateAssignment:input_type -> GoEdu.UpdateAssignmentRequest
+	112, // 171: GoEdu.AssignmentService.DeleteAssignment:input_type -> GoEdu.AssignmentIDRequest
+	112, // 172: GoEdu.AssignmentService.GetAssignment:input_type -> GoEdu.AssignmentIDRequest
+	26,  // 173: GoEdu.AssignmentService.ListAssignments:input_type -> GoEdu.CourseIDRequest
+	115, // 174: GoEdu.AssignmentService.SubmitAssignment:input_type -> GoEdu.SubmitAssignmentRequest
+	119, // 175: GoEdu.AssignmentService.GetSubmission:input_type -> GoEdu.SubmissionIDRequest
+	120, // 176: GoEdu.AssignmentService.ListSubmissions:input_type -> GoEdu.ListSubmissionsRequest
+	122, // 177: GoEdu.AssignmentService.GradeSubmission:input_type -> GoEdu.GradeSubmissionRequest
+	123, // 178: GoEdu.AssignmentService.GetSubmissionFile:input_type -> GoEdu.SubmissionFileRequest
+	126, // 179: GoEdu.PeerReviewService.ListPeerReviewTasks:input_type -> GoEdu.PeerReviewTasksRequest
+	129, // 180: GoEdu.PeerReviewService.SubmitPeerReview:input_type -> GoEdu.SubmitPeerReviewRequest
+	119, // 181: GoEdu.PeerReviewService.ListSubmissionPeerReviews:input_type -> GoEdu.SubmissionIDRequest
+	132, // 182: GoEdu.GradebookService.CreateGradeCategory:input_type -> GoEdu.CreateGradeCategoryRequest
+	133, // 183: GoEdu.GradebookService.UpdateGradeCategory:input_type -> GoEdu.UpdateGradeCategoryRequest
+	134, // 184: GoEdu.GradebookService.DeleteGradeCategory:input_type -> GoEdu.GradeCategoryIDRequest
+	26,  // 185: GoEdu.GradebookService.ListGradeCategories:input_type -> GoEdu.CourseIDRequest
+	136, // 186: GoEdu.GradebookService.SetGradeItemCategory:input_type -> GoEdu.SetGradeItemCategoryRequest
+	26,  // 187: GoEdu.GradebookService.GetLetterScheme:input_type -> GoEdu.CourseIDRequest
+	140, // 188: GoEdu.GradebookService.SetLetterScheme:input_type -> GoEdu.SetLetterSchemeRequest
+	26,  // 189: GoEdu.GradebookService.GetGradebook:input_type -> GoEdu.CourseIDRequest
+	145, // 190: GoEdu.GradebookService.GetStudentGrades:input_type -> GoEdu.StudentGradesRequest
+	146, // 191: GoEdu.GradebookService.AdjustGrade:input_type -> GoEdu.AdjustGradeRequest
+	148, // 192: GoEdu.GradebookService.ListGradeAdjustments:input_type -> GoEdu.ListGradeAdjustmentsRequest
+	150, // 193: GoEdu.GradebookService.ExportGradebook:input_type -> GoEdu.ExportGradebookRequest
+	31,  // 194: GoEdu.ModerationService.LoginAdmin:input_type -> GoEdu.LoginRequest
+	10,  // 195: GoEdu.ModerationService.GetModerationQueue:input_type -> GoEdu.Empty
+	153, // 196: GoEdu.ModerationService.ApproveReview:input_type -> GoEdu.ModerateReviewRequest
+	153, // 197: GoEdu.ModerationService.RejectReview:input_type -> GoEdu.ModerateReviewRequest
+	160, // 198: GoEdu.LearningPathService.CreateLearningPath:input_type -> GoEdu.NewLearningPathRequest
+	161, // 199: GoEdu.LearningPathService.UpdateLearningPath:input_type -> GoEdu.UpdateLearningPathRequest
+	162, // 200: GoEdu.LearningPathService.DeleteLearningPath:input_type -> GoEdu.LearningPathIDRequest
+	162, // 201: GoEdu.LearningPathService.GetLearningPath:input_type -> GoEdu.LearningPathIDRequest
+	10,  // 202: GoEdu.LearningPathService.ListLearningPaths:input_type -> GoEdu.Empty
+	163, // 203: GoEdu.LearningPathService.EnrollInLearningPath:input_type -> GoEdu.LearningPathStudentRequest
+	163, // 204: GoEdu.LearningPathService.GetLearningPathProgress:input_type -> GoEdu.LearningPathStudentRequest
+	169, // 205: GoEdu.WebhookService.CreateWebhook:input_type -> GoEdu.NewWebhookRequest
+	170, // 206: GoEdu.WebhookService.UpdateWebhook:input_type -> GoEdu.UpdateWebhookRequest
+	171, // 207: GoEdu.WebhookService.DeleteWebhook:input_type -> GoEdu.WebhookIDRequest
+	171, // 208: GoEdu.WebhookService.GetWebhook:input_type -> GoEdu.WebhookIDRequest
+	10,  // 209: GoEdu.WebhookService.ListWebhooks:input_type -> GoEdu.Empty
+	172, // 210: GoEdu.WebhookService.ListWebhookDeliveries:input_type -> GoEdu.WebhookDeliveriesRequest
+	173, // 211: GoEdu.WebhookService.RedeliverWebhook:input_type -> GoEdu.WebhookDeliveryIDRequest
+	176, // 212: GoEdu.EventService.SubscribeEvents:input_type -> GoEdu.SubscribeEventsRequest
+	179, // 213: GoEdu.NotificationService.ListNotifications:input_type -> GoEdu.ListNotificationsRequest
+	182, // 214: GoEdu.NotificationService.MarkRead:input_type -> GoEdu.MarkNotificationsReadRequest
+	178, // 215: GoEdu.NotificationService.MarkAllRead:input_type -> GoEdu.NotificationStudentRequest
+	178, // 216: GoEdu.NotificationService.GetNotificationPreferences:input_type -> GoEdu.NotificationStudentRequest
+	186, // 217: GoEdu.NotificationService.UpdateNotificationPreferences:input_type -> GoEdu.UpdateNotificationPreferencesRequest
+	187, // 218: GoEdu.NotificationService.Unsubscribe:input_type -> GoEdu.UnsubscribeRequest
+	190, // 219: GoEdu.AnnouncementService.CreateAnnouncement:input_type -> GoEdu.CreateAnnouncementRequest
+	191, // 220: GoEdu.AnnouncementService.ListAnnouncements:input_type -> GoEdu.ListAnnouncementsRequest
+	193, // 221: GoEdu.AnnouncementService.DeleteAnnouncement:input_type -> GoEdu.AnnouncementIDRequest
+	10,  // 222: GoEdu.HealthService.Check:input_type -> GoEdu.Empty
+	19,  // 223: GoEdu.EducationService.GetCourses:output_type -> GoEdu.CourseList
+	13,  // 224: GoEdu.EducationService.GetCourseByID:output_type -> GoEdu.Course
+	13,  // 225: GoEdu.EducationService.CreateCourse:output_type -> GoEdu.Course
+	13,  // 226: GoEdu.EducationService.UpdateCourse:output_type -> GoEdu.Course
+	10,  // 227: GoEdu.EducationService.DeleteCourse:output_type -> GoEdu.Empty
+	19,  // 228: GoEdu.EducationService.SearchCourses:output_type -> GoEdu.CourseList
+	22,  // 229: GoEdu.EducationService.GetSimilarCourses:output_type -> GoEdu.SimilarCourseList
+	25,  // 230: GoEdu.EducationService.GetTrendingCourses:output_type -> GoEdu.TrendingCourseList
+	30,  // 231: GoEdu.StudentService.RegisterStudent:output_type -> GoEdu.Student
+	32,  // 232: GoEdu.StudentService.LoginStudent:output_type -> GoEdu.AuthResponse
+	30,  // 233: GoEdu.StudentService.GetStudentProfile:output_type -> GoEdu.Student
+	30,  // 234: GoEdu.StudentService.UpdateStudentProfile:output_type -> GoEdu.Student
+	32,  // 235: GoEdu.StudentService.AcceptInvitation:output_type -> GoEdu.AuthResponse
+	10,  // 236: GoEdu.EnrollmentService.EnrollStudent:output_type -> GoEdu.Empty
+	36,  // 237: GoEdu.EnrollmentService.GetStudentsByCourse:output_type -> GoEdu.StudentList
+	19,  // 238: GoEdu.EnrollmentService.GetCoursesByStudent:output_type -> GoEdu.CourseList
+	10,  // 239: GoEdu.EnrollmentService.UnEnrollStudent:output_type -> GoEdu.Empty
+	39,  // 240: GoEdu.EnrollmentService.BulkEnroll:output_type -> GoEdu.BulkEnrollResponse
+	42,  // 241: GoEdu.LectureService.AddLectureToCourse:output_type -> GoEdu.Lecture
+	43,  // 242: GoEdu.LectureService.GetLecturesByCourse:output_type -> GoEdu.LectureList
+	45,  // 243: GoEdu.LectureService.GetLectureContent:output_type -> GoEdu.LectureContent
+	42,  // 244: GoEdu.LectureService.UpdateLecture:output_type -> GoEdu.Lecture
+	10,  // 245: GoEdu.LectureService.DeleteLecture:output_type -> GoEdu.Empty
+	10,  // 246: GoEdu.LectureService.MarkLectureAsCompleted:output_type -> GoEdu.Empty
+	49,  // 247: GoEdu.LectureService.GetCourseProgress:output_type -> GoEdu.CourseProgress
+	19,  // 248: GoEdu.LectureService.GetRecommendedCourses:output_type -> GoEdu.CourseList
+	16,  // 249: GoEdu.LectureService.GetCourseRecommendations:output_type -> GoEdu.RecommendationList
+	51,  // 250: GoEdu.LectureService.RecordLectureProgress:output_type -> GoEdu.LectureProgress
+	52,  // 251: GoEdu.LectureService.GetDetailedCourseProgress:output_type -> GoEdu.DetailedCourseProgress
+	54,  // 252: GoEdu.LectureService.GetStudentDashboard:output_type -> GoEdu.StudentDashboard
+	56,  // 253: GoEdu.InstructorService.GetInstructorByID:output_type -> GoEdu.Instructor
+	56,  // 254: GoEdu.InstructorService.UpdateInstructor:output_type -> GoEdu.Instructor
+	56,  // 255: GoEdu.InstructorService.RegisterInstructor:output_type -> GoEdu.Instructor
+	32,  // 256: GoEdu.InstructorService.LoginInstructor:output_type -> GoEdu.AuthResponse
+	19,  // 257: GoEdu.InstructorService.GetCoursesByInstructor:output_type -> GoEdu.CourseList
+	10,  // 258: GoEdu.ReviewService.AddReviewToCourse:output_type -> GoEdu.Empty
+	59,  // 259: GoEdu.ReviewService.CreateReview:output_type -> GoEdu.Review
+	59,  // 260: GoEdu.ReviewService.UpdateReview:output_type -> GoEdu.Review
+	10,  // 261: GoEdu.ReviewService.DeleteReview:output_type -> GoEdu.Empty
+	64,  // 262: GoEdu.ReviewService.GetReviewsByCourse:output_type -> GoEdu.ReviewList
+	59,  // 263: GoEdu.ReviewService.ReplyToReview:output_type -> GoEdu.Review
+	59,  // 264: GoEdu.ReviewService.DeleteReviewReply:output_type -> GoEdu.Review
+	59,  // 265: GoEdu.ReviewService.VoteReview:output_type -> GoEdu.Review
+	59,  // 266: GoEdu.ReviewService.DeleteReviewVote:output_type -> GoEdu.Review
+	10,  // 267: GoEdu.ReviewService.ReportReview:output_type -> GoEdu.Empty
+	71,  // 268: GoEdu.CohortService.CreateCohort:output_type -> GoEdu.Cohort
+	71,  // 269: GoEdu.CohortService.UpdateCohort:output_type -> GoEdu.Cohort
+	10,  // 270: GoEdu.CohortService.DeleteCohort:output_type -> GoEdu.Empty
+	72,  // 271: GoEdu.CohortService.ListCohorts:output_type -> GoEdu.CohortList
+	10,  // 272: GoEdu.CohortService.AssignStudentsToCohort:output_type -> GoEdu.Empty
+	10,  // 273: GoEdu.CohortService.RemoveStudentsFromCohort:output_type -> GoEdu.Empty
+	36,  // 274: GoEdu.CohortService.GetCohortStudents:output_type -> GoEdu.StudentList
+	78,  // 275: GoEdu.CohortService.GetCohortProgress:output_type -> GoEdu.CohortProgress
+	80,  // 276: GoEdu.CohortService.GetCohortSchedule:output_type -> GoEdu.CohortSchedule
+	81,  // 277: GoEdu.CertificateService.GetCertificate:output_type -> GoEdu.Certificate
+	82,  // 278: GoEdu.CertificateService.ListMyCertificates:output_type -> GoEdu.CertificateList
+	85,  // 279: GoEdu.CertificateService.VerifyCertificate:output_type -> GoEdu.CertificateVerification
+	88,  // 280: GoEdu.QuizService.CreateQuiz:output_type -> GoEdu.Quiz
+	88,  // 281: GoEdu.QuizService.UpdateQuiz:output_type -> GoEdu.Quiz
+	10,  // 282: GoEdu.QuizService.DeleteQuiz:output_type -> GoEdu.Empty
+	88,  // 283: GoEdu.QuizService.GetQuiz:output_type -> GoEdu.Quiz
+	89,  // 284: GoEdu.QuizService.ListQuizzes:output_type -> GoEdu.QuizList
+	97,  // 285: GoEdu.QuizService.StartQuizAttempt:output_type -> GoEdu.QuizAttempt
+	97,  // 286: GoEdu.QuizService.SubmitQuizAttempt:output_type -> GoEdu.QuizAttempt
+	99,  // 287: GoEdu.QuizService.ListQuizAttempts:output_type -> GoEdu.QuizAttemptList
+	86,  // 288: GoEdu.QuestionBankService.CreateBankQuestion:output_type -> GoEdu.QuizQuestion
+	86,  // 289: GoEdu.QuestionBankService.UpdateBankQuestion:output_type -> GoEdu.QuizQuestion
+	10,  // 290: GoEdu.QuestionBankService.DeleteBankQuestion:output_type -> GoEdu.Empty
+	103, // 291: GoEdu.QuestionBankService.ListBankQuestions:output_type -> GoEdu.BankQuestionList
+	105, // 292: GoEdu.QuestionBankService.ImportQuestionBank:output_type -> GoEdu.ImportQuestionBankResponse
+	107, // 293: GoEdu.QuestionBankService.ExportQuestionBank:output_type -> GoEdu.QuestionBankFile
+	109, // 294: GoEdu.AssignmentService.CreateAssignment:output_type -> GoEdu.Assignment
+	109, // 295: GoEdu.AssignmentService.UpdateAssignment:output_type -> GoEdu.Assignment
+	10,  // 296: GoEdu.AssignmentService.DeleteAssignment:output_type -> GoEdu.Empty
+	109, // 297: GoEdu.AssignmentService.GetAssignment:output_type -> GoEdu.Assignment
+	113, // 298: GoEdu.AssignmentService.ListAssignments:output_type -> GoEdu.AssignmentList
+	118, // 299: GoEdu.AssignmentService.SubmitAssignment:output_type -> GoEdu.Submission
+	118, // 300: GoEdu.AssignmentService.GetSubmission:output_type -> GoEdu.Submission
+	121, // 301: GoEdu.AssignmentService.ListSubmissions:output_type -> GoEdu.SubmissionList
+	118, // 302: GoEdu.AssignmentService.GradeSubmission:output_type -> GoEdu.Submission
+	124, // 303: GoEdu.AssignmentService.GetSubmissionFile:output_type -> GoEdu.SubmissionFileContent
+	128, // 304: GoEdu.PeerReviewService.ListPeerReviewTasks:output_type -> GoEdu.PeerReviewTaskList
+	125, // 305: GoEdu.PeerReviewService.SubmitPeerReview:output_type -> GoEdu.PeerReview
+	130, // 306: GoEdu.PeerReviewService.ListSubmissionPeerReviews:output_type -> GoEdu.PeerReviewList
+	131, // 307: GoEdu.GradebookService.CreateGradeCategory:output_type -> GoEdu.GradeCategory
+	131, // 308: GoEdu.GradebookService.UpdateGradeCategory:output_type -> GoEdu.GradeCategory
+	10,  // 309: GoEdu.GradebookService.DeleteGradeCategory:output_type -> GoEdu.Empty
+	135, // 310: GoEdu.GradebookService.ListGradeCategories:output_type -> GoEdu.GradeCategoryList
+	137, // 311: GoEdu.GradebookService.SetGradeItemCategory:output_type -> GoEdu.GradeItem
+	139, // 312: GoEdu.GradebookService.GetLetterScheme:output_type -> GoEdu.LetterScheme
+	139, // 313: GoEdu.GradebookService.SetLetterScheme:output_type -> GoEdu.LetterScheme
+	144, // 314: GoEdu.GradebookService.GetGradebook:output_type -> GoEdu.Gradebook
+	143, // 315: GoEdu.GradebookService.GetStudentGrades:output_type -> GoEdu.StudentGrades
+	147, // 316: GoEdu.GradebookService.AdjustGrade:output_type -> GoEdu.GradeAdjustment
+	149, // 317: GoEdu.GradebookService.ListGradeAdjustments:output_type -> GoEdu.GradeAdjustmentList
+	151, // 318: GoEdu.GradebookService.ExportGradebook:output_type -> GoEdu.GradebookFile
+	32,  // 319: GoEdu.ModerationService.LoginAdmin:output_type -> GoEdu.AuthResponse
+	155, // 320: GoEdu.ModerationService.GetModerationQueue:output_type -> GoEdu.ModerationQueue
+	59,  // 321: GoEdu.ModerationService.ApproveReview:output_type -> GoEdu.Review
+	59,  // 322: GoEdu.ModerationService.RejectReview:output_type -> GoEdu.Review
+	157, // 323: GoEdu.LearningPathService.CreateLearningPath:output_type -> GoEdu.LearningPath
+	157, // 324: GoEdu.LearningPathService.UpdateLearningPath:output_type -> GoEdu.LearningPath
+	10,  // 325: GoEdu.LearningPathService.DeleteLearningPath:output_type -> GoEdu.Empty
+	157, // 326: GoEdu.LearningPathService.GetLearningPath:output_type -> GoEdu.LearningPath
+	158, // 327: GoEdu.LearningPathService.ListLearningPaths:output_type -> GoEdu.LearningPathList
+	164, // 328: GoEdu.LearningPathService.EnrollInLearningPath:output_type -> GoEdu.LearningPathEnrollment
+	166, // 329: GoEdu.LearningPathService.GetLearningPathProgress:output_type -> GoEdu.LearningPathProgress
+	167, // 330: GoEdu.WebhookService.CreateWebhook:output_type -> GoEdu.Webhook
+	167, // 331: GoEdu.WebhookService.UpdateWebhook:output_type -> GoEdu.Webhook
+	10,  // 332: GoEdu.WebhookService.DeleteWebhook:output_type -> GoEdu.Empty
+	167, // 333: GoEdu.WebhookService.GetWebhook:output_type -> GoEdu.Webhook
+	168, // 334: GoEdu.WebhookService.ListWebhooks:output_type -> GoEdu.WebhookList
+	175, // 335: GoEdu.WebhookService.ListWebhookDeliveries:output_type -> GoEdu.WebhookDeliveryList
+	174, // 336: GoEdu.WebhookService.RedeliverWebhook:output_type -> GoEdu.WebhookDelivery
+	177, // 337: GoEdu.EventService.SubscribeEvents:output_type -> GoEdu.DomainEvent
+	181, // 338: GoEdu.NotificationService.ListNotifications:output_type -> GoEdu.NotificationList
+	183, // 339: GoEdu.NotificationService.MarkRead:output_type -> GoEdu.MarkNotificationsReadResponse
+	183, // 340: GoEdu.NotificationService.MarkAllRead:output_type -> GoEdu.MarkNotificationsReadResponse
+	185, // 341: GoEdu.NotificationService.GetNotificationPreferences:output_type -> GoEdu.NotificationPreferences
+	185, // 342: GoEdu.NotificationService.UpdateNotificationPreferences:output_type -> GoEdu.NotificationPreferences
+	188, // 343: GoEdu.NotificationService.Unsubscribe:output_type -> GoEdu.UnsubscribeResponse
+	189, // 344: GoEdu.AnnouncementService.CreateAnnouncement:output_type -> GoEdu.Announcement
+	192, // 345: GoEdu.AnnouncementService.ListAnnouncements:output_type -> GoEdu.AnnouncementList
+	10,  // 346: GoEdu.AnnouncementService.DeleteAnnouncement:output_type -> GoEdu.Empty
+	12,  // 347: GoEdu.HealthService.Check:output_type -> GoEdu.HealthCheckResponse
+	223, // [223:348] is the sub-list for method output_type
+	98,  // [98:223] is the sub-list for method input_type
+	98,  // [98:98] is the sub-list for extension type_name
+	98,  // [98:98] is the sub-list for extension extendee
+	0,   // [0:98] is the sub-list for field type_name
 }
 
 func init() { file_proto_education_proto_init() }
@@ -2493,18 +16174,30 @@ func file_proto_education_proto_init() {
 	if File_proto_education_proto != nil {
 		return
 	}
+	file_proto_education_proto_msgTypes[18].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[31].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[32].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[36].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[76].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[84].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[136].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[137].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[149].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[160].OneofWrappers = []any{}
+	file_proto_education_proto_msgTypes[176].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_education_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   35,
+			NumEnums:      10,
+			NumMessages:   184,
 			NumExtensions: 0,
-			NumServices:   7,
+			NumServices:   20,
 		},
 		GoTypes:           file_proto_education_proto_goTypes,
 		DependencyIndexes: file_proto_education_proto_depIdxs,
+		EnumInfos:         file_proto_education_proto_enumTypes,
 		MessageInfos:      file_proto_education_proto_msgTypes,
 	}.Build()
 	File_proto_education_proto = out.File
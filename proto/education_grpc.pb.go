@@ -19,12 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EducationService_GetCourses_FullMethodName    = "/GoEdu.EducationService/GetCourses"
-	EducationService_GetCourseByID_FullMethodName = "/GoEdu.EducationService/GetCourseByID"
-	EducationService_CreateCourse_FullMethodName  = "/GoEdu.EducationService/CreateCourse"
-	EducationService_UpdateCourse_FullMethodName  = "/GoEdu.EducationService/UpdateCourse"
-	EducationService_DeleteCourse_FullMethodName  = "/GoEdu.EducationService/DeleteCourse"
-	EducationService_SearchCourses_FullMethodName = "/GoEdu.EducationService/SearchCourses"
+	EducationService_GetCourses_FullMethodName         = "/GoEdu.EducationService/GetCourses"
+	EducationService_GetCourseByID_FullMethodName      = "/GoEdu.EducationService/GetCourseByID"
+	EducationService_CreateCourse_FullMethodName       = "/GoEdu.EducationService/CreateCourse"
+	EducationService_UpdateCourse_FullMethodName       = "/GoEdu.EducationService/UpdateCourse"
+	EducationService_DeleteCourse_FullMethodName       = "/GoEdu.EducationService/DeleteCourse"
+	EducationService_SearchCourses_FullMethodName      = "/GoEdu.EducationService/SearchCourses"
+	EducationService_GetSimilarCourses_FullMethodName  = "/GoEdu.EducationService/GetSimilarCourses"
+	EducationService_GetTrendingCourses_FullMethodName = "/GoEdu.EducationService/GetTrendingCourses"
 )
 
 // EducationServiceClient is the client API for EducationService service.
@@ -34,7 +36,7 @@ const (
 // Сервис для управления курсами в системе образования.
 type EducationServiceClient interface {
 	// Получить список всех курсов.
-	GetCourses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CourseList, error)
+	GetCourses(ctx context.Context, in *CoursesRequest, opts ...grpc.CallOption) (*CourseList, error)
 	// Получить информацию о курсе по ID.
 	GetCourseByID(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*Course, error)
 	// Создать новый курс.
@@ -45,6 +47,10 @@ type EducationServiceClient interface {
 	DeleteCourse(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Поиск курсов по ключевому слову.
 	SearchCourses(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*CourseList, error)
+	// Похожие курсы по названию, описанию и названиям лекций (TF-IDF).
+	GetSimilarCourses(ctx context.Context, in *SimilarCoursesRequest, opts ...grpc.CallOption) (*SimilarCourseList, error)
+	// Популярные курсы за окно: записи, завершения и оценки с затуханием по давности.
+	GetTrendingCourses(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*TrendingCourseList, error)
 }
 
 type educationServiceClient struct {
@@ -55,7 +61,7 @@ func NewEducationServiceClient(cc grpc.ClientConnInterface) EducationServiceClie
 	return &educationServiceClient{cc}
 }
 
-func (c *educationServiceClient) GetCourses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CourseList, error) {
+func (c *educationServiceClient) GetCourses(ctx context.Context, in *CoursesRequest, opts ...grpc.CallOption) (*CourseList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CourseList)
 	err := c.cc.Invoke(ctx, EducationService_GetCourses_FullMethodName, in, out, cOpts...)
@@ -115,6 +121,26 @@ func (c *educationServiceClient) SearchCourses(ctx context.Context, in *SearchRe
 	return out, nil
 }
 
+func (c *educationServiceClient) GetSimilarCourses(ctx context.Context, in *SimilarCoursesRequest, opts ...grpc.CallOption) (*SimilarCourseList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimilarCourseList)
+	err := c.cc.Invoke(ctx, EducationService_GetSimilarCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *educationServiceClient) GetTrendingCourses(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*TrendingCourseList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingCourseList)
+	err := c.cc.Invoke(ctx, EducationService_GetTrendingCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EducationServiceServer is the server API for EducationService service.
 // All implementations must embed UnimplementedEducationServiceServer
 // for forward compatibility.
@@ -122,7 +148,7 @@ func (c *educationServiceClient) SearchCourses(ctx context.Context, in *SearchRe
 // Сервис для управления курсами в системе образования.
 type EducationServiceServer interface {
 	// Получить список всех курсов.
-	GetCourses(context.Context, *Empty) (*CourseList, error)
+	GetCourses(context.Context, *CoursesRequest) (*CourseList, error)
 	// Получить информацию о курсе по ID.
 	GetCourseByID(context.Context, *CourseIDRequest) (*Course, error)
 	// Создать новый курс.
@@ -133,6 +159,10 @@ type EducationServiceServer interface {
 	DeleteCourse(context.Context, *CourseIDRequest) (*Empty, error)
 	// Поиск курсов по ключевому слову.
 	SearchCourses(context.Context, *SearchRequest) (*CourseList, error)
+	// Похожие курсы по названию, описанию и названиям лекций (TF-IDF).
+	GetSimilarCourses(context.Context, *SimilarCoursesRequest) (*SimilarCourseList, error)
+	// Популярные курсы за окно: записи, завершения и оценки с затуханием по давности.
+	GetTrendingCourses(context.Context, *TrendingRequest) (*TrendingCourseList, error)
 	mustEmbedUnimplementedEducationServiceServer()
 }
 
@@ -143,7 +173,7 @@ type EducationServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedEducationServiceServer struct{}
 
-func (UnimplementedEducationServiceServer) GetCourses(context.Context, *Empty) (*CourseList, error) {
+func (UnimplementedEducationServiceServer) GetCourses(context.Context, *CoursesRequest) (*CourseList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
 }
 func (UnimplementedEducationServiceServer) GetCourseByID(context.Context, *CourseIDRequest) (*Course, error) {
@@ -161,6 +191,12 @@ func (UnimplementedEducationServiceServer) DeleteCourse(context.Context, *Course
 func (UnimplementedEducationServiceServer) SearchCourses(context.Context, *SearchRequest) (*CourseList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchCourses not implemented")
 }
+func (UnimplementedEducationServiceServer) GetSimilarCourses(context.Context, *SimilarCoursesRequest) (*SimilarCourseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarCourses not implemented")
+}
+func (UnimplementedEducationServiceServer) GetTrendingCourses(context.Context, *TrendingRequest) (*TrendingCourseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingCourses not implemented")
+}
 func (UnimplementedEducationServiceServer) mustEmbedUnimplementedEducationServiceServer() {}
 func (UnimplementedEducationServiceServer) testEmbeddedByValue()                          {}
 
@@ -183,7 +219,7 @@ func RegisterEducationServiceServer(s grpc.ServiceRegistrar, srv EducationServic
 }
 
 func _EducationService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(CoursesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -195,7 +231,7 @@ func _EducationService_GetCourses_Handler(srv interface{}, ctx context.Context,
 		FullMethod: EducationService_GetCourses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EducationServiceServer).GetCourses(ctx, req.(*Empty))
+		return srv.(EducationServiceServer).GetCourses(ctx, req.(*CoursesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -290,6 +326,42 @@ func _EducationService_SearchCourses_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EducationService_GetSimilarCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetSimilarCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetSimilarCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetSimilarCourses(ctx, req.(*SimilarCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EducationService_GetTrendingCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EducationServiceServer).GetTrendingCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EducationService_GetTrendingCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EducationServiceServer).GetTrendingCourses(ctx, req.(*TrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EducationService_ServiceDesc is the grpc.ServiceDesc for EducationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,6 +393,14 @@ var EducationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchCourses",
 			Handler:    _EducationService_SearchCourses_Handler,
 		},
+		{
+			MethodName: "GetSimilarCourses",
+			Handler:    _EducationService_GetSimilarCourses_Handler,
+		},
+		{
+			MethodName: "GetTrendingCourses",
+			Handler:    _EducationService_GetTrendingCourses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/education.proto",
@@ -331,6 +411,7 @@ const (
 	StudentService_LoginStudent_FullMethodName         = "/GoEdu.StudentService/LoginStudent"
 	StudentService_GetStudentProfile_FullMethodName    = "/GoEdu.StudentService/GetStudentProfile"
 	StudentService_UpdateStudentProfile_FullMethodName = "/GoEdu.StudentService/UpdateStudentProfile"
+	StudentService_AcceptInvitation_FullMethodName     = "/GoEdu.StudentService/AcceptInvitation"
 )
 
 // StudentServiceClient is the client API for StudentService service.
@@ -347,6 +428,8 @@ type StudentServiceClient interface {
 	GetStudentProfile(ctx context.Context, in *StudentIDRequest, opts ...grpc.CallOption) (*Student, error)
 	// Обновить профиль студента.
 	UpdateStudentProfile(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*Student, error)
+	// Принять приглашение и установить пароль.
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 }
 
 type studentServiceClient struct {
@@ -397,6 +480,16 @@ func (c *studentServiceClient) UpdateStudentProfile(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *studentServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, StudentService_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StudentServiceServer is the server API for StudentService service.
 // All implementations must embed UnimplementedStudentServiceServer
 // for forward compatibility.
@@ -411,6 +504,8 @@ type StudentServiceServer interface {
 	GetStudentProfile(context.Context, *StudentIDRequest) (*Student, error)
 	// Обновить профиль студента.
 	UpdateStudentProfile(context.Context, *UpdateStudentRequest) (*Student, error)
+	// Принять приглашение и установить пароль.
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AuthResponse, error)
 	mustEmbedUnimplementedStudentServiceServer()
 }
 
@@ -433,6 +528,9 @@ func (UnimplementedStudentServiceServer) GetStudentProfile(context.Context, *Stu
 func (UnimplementedStudentServiceServer) UpdateStudentProfile(context.Context, *UpdateStudentRequest) (*Student, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStudentProfile not implemented")
 }
+func (UnimplementedStudentServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
 func (UnimplementedStudentServiceServer) mustEmbedUnimplementedStudentServiceServer() {}
 func (UnimplementedStudentServiceServer) testEmbeddedByValue()                        {}
 
@@ -526,6 +624,24 @@ func _StudentService_UpdateStudentProfile_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StudentService_ServiceDesc is the grpc.ServiceDesc for StudentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -549,6 +665,10 @@ var StudentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStudentProfile",
 			Handler:    _StudentService_UpdateStudentProfile_Handler,
 		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _StudentService_AcceptInvitation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/education.proto",
@@ -559,6 +679,7 @@ const (
 	EnrollmentService_GetStudentsByCourse_FullMethodName = "/GoEdu.EnrollmentService/GetStudentsByCourse"
 	EnrollmentService_GetCoursesByStudent_FullMethodName = "/GoEdu.EnrollmentService/GetCoursesByStudent"
 	EnrollmentService_UnEnrollStudent_FullMethodName     = "/GoEdu.EnrollmentService/UnEnrollStudent"
+	EnrollmentService_BulkEnroll_FullMethodName          = "/GoEdu.EnrollmentService/BulkEnroll"
 )
 
 // EnrollmentServiceClient is the client API for EnrollmentService service.
@@ -575,6 +696,8 @@ type EnrollmentServiceClient interface {
 	GetCoursesByStudent(ctx context.Context, in *StudentIDRequest, opts ...grpc.CallOption) (*CourseList, error)
 	// Отписать студента от курса.
 	UnEnrollStudent(ctx context.Context, in *UnEnrollRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Массовая запись студентов на курс из CSV (email, имя).
+	BulkEnroll(ctx context.Context, in *BulkEnrollRequest, opts ...grpc.CallOption) (*BulkEnrollResponse, error)
 }
 
 type enrollmentServiceClient struct {
@@ -625,6 +748,16 @@ func (c *enrollmentServiceClient) UnEnrollStudent(ctx context.Context, in *UnEnr
 	return out, nil
 }
 
+func (c *enrollmentServiceClient) BulkEnroll(ctx context.Context, in *BulkEnrollRequest, opts ...grpc.CallOption) (*BulkEnrollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkEnrollResponse)
+	err := c.cc.Invoke(ctx, EnrollmentService_BulkEnroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnrollmentServiceServer is the server API for EnrollmentService service.
 // All implementations must embed UnimplementedEnrollmentServiceServer
 // for forward compatibility.
@@ -639,6 +772,8 @@ type EnrollmentServiceServer interface {
 	GetCoursesByStudent(context.Context, *StudentIDRequest) (*CourseList, error)
 	// Отписать студента от курса.
 	UnEnrollStudent(context.Context, *UnEnrollRequest) (*Empty, error)
+	// Массовая запись студентов на курс из CSV (email, имя).
+	BulkEnroll(context.Context, *BulkEnrollRequest) (*BulkEnrollResponse, error)
 	mustEmbedUnimplementedEnrollmentServiceServer()
 }
 
@@ -661,6 +796,9 @@ func (UnimplementedEnrollmentServiceServer) GetCoursesByStudent(context.Context,
 func (UnimplementedEnrollmentServiceServer) UnEnrollStudent(context.Context, *UnEnrollRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnEnrollStudent not implemented")
 }
+func (UnimplementedEnrollmentServiceServer) BulkEnroll(context.Context, *BulkEnrollRequest) (*BulkEnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkEnroll not implemented")
+}
 func (UnimplementedEnrollmentServiceServer) mustEmbedUnimplementedEnrollmentServiceServer() {}
 func (UnimplementedEnrollmentServiceServer) testEmbeddedByValue()                           {}
 
@@ -754,6 +892,24 @@ func _EnrollmentService_UnEnrollStudent_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnrollmentService_BulkEnroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkEnrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnrollmentServiceServer).BulkEnroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnrollmentService_BulkEnroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnrollmentServiceServer).BulkEnroll(ctx, req.(*BulkEnrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnrollmentService_ServiceDesc is the grpc.ServiceDesc for EnrollmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -777,20 +933,28 @@ var EnrollmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnEnrollStudent",
 			Handler:    _EnrollmentService_UnEnrollStudent_Handler,
 		},
+		{
+			MethodName: "BulkEnroll",
+			Handler:    _EnrollmentService_BulkEnroll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/education.proto",
 }
 
 const (
-	LectureService_AddLectureToCourse_FullMethodName     = "/GoEdu.LectureService/AddLectureToCourse"
-	LectureService_GetLecturesByCourse_FullMethodName    = "/GoEdu.LectureService/GetLecturesByCourse"
-	LectureService_GetLectureContent_FullMethodName      = "/GoEdu.LectureService/GetLectureContent"
-	LectureService_UpdateLecture_FullMethodName          = "/GoEdu.LectureService/UpdateLecture"
-	LectureService_DeleteLecture_FullMethodName          = "/GoEdu.LectureService/DeleteLecture"
-	LectureService_MarkLectureAsCompleted_FullMethodName = "/GoEdu.LectureService/MarkLectureAsCompleted"
-	LectureService_GetCourseProgress_FullMethodName      = "/GoEdu.LectureService/GetCourseProgress"
-	LectureService_GetRecommendedCourses_FullMethodName  = "/GoEdu.LectureService/GetRecommendedCourses"
+	LectureService_AddLectureToCourse_FullMethodName        = "/GoEdu.LectureService/AddLectureToCourse"
+	LectureService_GetLecturesByCourse_FullMethodName       = "/GoEdu.LectureService/GetLecturesByCourse"
+	LectureService_GetLectureContent_FullMethodName         = "/GoEdu.LectureService/GetLectureContent"
+	LectureService_UpdateLecture_FullMethodName             = "/GoEdu.LectureService/UpdateLecture"
+	LectureService_DeleteLecture_FullMethodName             = "/GoEdu.LectureService/DeleteLecture"
+	LectureService_MarkLectureAsCompleted_FullMethodName    = "/GoEdu.LectureService/MarkLectureAsCompleted"
+	LectureService_GetCourseProgress_FullMethodName         = "/GoEdu.LectureService/GetCourseProgress"
+	LectureService_GetRecommendedCourses_FullMethodName     = "/GoEdu.LectureService/GetRecommendedCourses"
+	LectureService_GetCourseRecommendations_FullMethodName  = "/GoEdu.LectureService/GetCourseRecommendations"
+	LectureService_RecordLectureProgress_FullMethodName     = "/GoEdu.LectureService/RecordLectureProgress"
+	LectureService_GetDetailedCourseProgress_FullMethodName = "/GoEdu.LectureService/GetDetailedCourseProgress"
+	LectureService_GetStudentDashboard_FullMethodName       = "/GoEdu.LectureService/GetStudentDashboard"
 )
 
 // LectureServiceClient is the client API for LectureService service.
@@ -815,6 +979,14 @@ type LectureServiceClient interface {
 	GetCourseProgress(ctx context.Context, in *CourseProgressRequest, opts ...grpc.CallOption) (*CourseProgress, error)
 	// Получить рекомендованные курсы для студента.
 	GetRecommendedCourses(ctx context.Context, in *StudentIDRequest, opts ...grpc.CallOption) (*CourseList, error)
+	// Получить персональные рекомендации курсов для студента с пояснением к каждой.
+	GetCourseRecommendations(ctx context.Context, in *StudentIDRequest, opts ...grpc.CallOption) (*RecommendationList, error)
+	// Записать событие просмотра лекции: начало, heartbeat или завершение.
+	RecordLectureProgress(ctx context.Context, in *LectureProgressEvent, opts ...grpc.CallOption) (*LectureProgress, error)
+	// Получить подробный прогресс студента по курсу с разбивкой по лекциям.
+	GetDetailedCourseProgress(ctx context.Context, in *CourseProgressRequest, opts ...grpc.CallOption) (*DetailedCourseProgress, error)
+	// Получить сводку прогресса студента по всем курсам, на которые он записан.
+	GetStudentDashboard(ctx context.Context, in *StudentIDRequest, opts ...grpc.CallOption) (*StudentDashboard, error)
 }
 
 type lectureServiceClient struct {
@@ -905,6 +1077,46 @@ func (c *lectureServiceClient) GetRecommendedCourses(ctx context.Context, in *St
 	return out, nil
 }
 
+func (c *lectureServiceClient) GetCourseRecommendations(ctx context.Context, in *StudentIDRequest, opts ...grpc.CallOption) (*RecommendationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendationList)
+	err := c.cc.Invoke(ctx, LectureService_GetCourseRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lectureServiceClient) RecordLectureProgress(ctx context.Context, in *LectureProgressEvent, opts ...grpc.CallOption) (*LectureProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LectureProgress)
+	err := c.cc.Invoke(ctx, LectureService_RecordLectureProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lectureServiceClient) GetDetailedCourseProgress(ctx context.Context, in *CourseProgressRequest, opts ...grpc.CallOption) (*DetailedCourseProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailedCourseProgress)
+	err := c.cc.Invoke(ctx, LectureService_GetDetailedCourseProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lectureServiceClient) GetStudentDashboard(ctx context.Context, in *StudentIDRequest, opts ...grpc.CallOption) (*StudentDashboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StudentDashboard)
+	err := c.cc.Invoke(ctx, LectureService_GetStudentDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LectureServiceServer is the server API for LectureService service.
 // All implementations must embed UnimplementedLectureServiceServer
 // for forward compatibility.
@@ -927,6 +1139,14 @@ type LectureServiceServer interface {
 	GetCourseProgress(context.Context, *CourseProgressRequest) (*CourseProgress, error)
 	// Получить рекомендованные курсы для студента.
 	GetRecommendedCourses(context.Context, *StudentIDRequest) (*CourseList, error)
+	// Получить персональные рекомендации курсов для студента с пояснением к каждой.
+	GetCourseRecommendations(context.Context, *StudentIDRequest) (*RecommendationList, error)
+	// Записать событие просмотра лекции: начало, heartbeat или завершение.
+	RecordLectureProgress(context.Context, *LectureProgressEvent) (*LectureProgress, error)
+	// Получить подробный прогресс студента по курсу с разбивкой по лекциям.
+	GetDetailedCourseProgress(context.Context, *CourseProgressRequest) (*DetailedCourseProgress, error)
+	// Получить сводку прогресса студента по всем курсам, на которые он записан.
+	GetStudentDashboard(context.Context, *StudentIDRequest) (*StudentDashboard, error)
 	mustEmbedUnimplementedLectureServiceServer()
 }
 
@@ -961,6 +1181,18 @@ func (UnimplementedLectureServiceServer) GetCourseProgress(context.Context, *Cou
 func (UnimplementedLectureServiceServer) GetRecommendedCourses(context.Context, *StudentIDRequest) (*CourseList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendedCourses not implemented")
 }
+func (UnimplementedLectureServiceServer) GetCourseRecommendations(context.Context, *StudentIDRequest) (*RecommendationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseRecommendations not implemented")
+}
+func (UnimplementedLectureServiceServer) RecordLectureProgress(context.Context, *LectureProgressEvent) (*LectureProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordLectureProgress not implemented")
+}
+func (UnimplementedLectureServiceServer) GetDetailedCourseProgress(context.Context, *CourseProgressRequest) (*DetailedCourseProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDetailedCourseProgress not implemented")
+}
+func (UnimplementedLectureServiceServer) GetStudentDashboard(context.Context, *StudentIDRequest) (*StudentDashboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentDashboard not implemented")
+}
 func (UnimplementedLectureServiceServer) mustEmbedUnimplementedLectureServiceServer() {}
 func (UnimplementedLectureServiceServer) testEmbeddedByValue()                        {}
 
@@ -1126,6 +1358,78 @@ func _LectureService_GetRecommendedCourses_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LectureService_GetCourseRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LectureServiceServer).GetCourseRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LectureService_GetCourseRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LectureServiceServer).GetCourseRecommendations(ctx, req.(*StudentIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LectureService_RecordLectureProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LectureProgressEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LectureServiceServer).RecordLectureProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LectureService_RecordLectureProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LectureServiceServer).RecordLectureProgress(ctx, req.(*LectureProgressEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LectureService_GetDetailedCourseProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LectureServiceServer).GetDetailedCourseProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LectureService_GetDetailedCourseProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LectureServiceServer).GetDetailedCourseProgress(ctx, req.(*CourseProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LectureService_GetStudentDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LectureServiceServer).GetStudentDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LectureService_GetStudentDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LectureServiceServer).GetStudentDashboard(ctx, req.(*StudentIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LectureService_ServiceDesc is the grpc.ServiceDesc for LectureService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1165,6 +1469,22 @@ var LectureService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecommendedCourses",
 			Handler:    _LectureService_GetRecommendedCourses_Handler,
 		},
+		{
+			MethodName: "GetCourseRecommendations",
+			Handler:    _LectureService_GetCourseRecommendations_Handler,
+		},
+		{
+			MethodName: "RecordLectureProgress",
+			Handler:    _LectureService_RecordLectureProgress_Handler,
+		},
+		{
+			MethodName: "GetDetailedCourseProgress",
+			Handler:    _LectureService_GetDetailedCourseProgress_Handler,
+		},
+		{
+			MethodName: "GetStudentDashboard",
+			Handler:    _LectureService_GetStudentDashboard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/education.proto",
@@ -1440,7 +1760,15 @@ var InstructorService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	ReviewService_AddReviewToCourse_FullMethodName  = "/GoEdu.ReviewService/AddReviewToCourse"
+	ReviewService_CreateReview_FullMethodName       = "/GoEdu.ReviewService/CreateReview"
+	ReviewService_UpdateReview_FullMethodName       = "/GoEdu.ReviewService/UpdateReview"
+	ReviewService_DeleteReview_FullMethodName       = "/GoEdu.ReviewService/DeleteReview"
 	ReviewService_GetReviewsByCourse_FullMethodName = "/GoEdu.ReviewService/GetReviewsByCourse"
+	ReviewService_ReplyToReview_FullMethodName      = "/GoEdu.ReviewService/ReplyToReview"
+	ReviewService_DeleteReviewReply_FullMethodName  = "/GoEdu.ReviewService/DeleteReviewReply"
+	ReviewService_VoteReview_FullMethodName         = "/GoEdu.ReviewService/VoteReview"
+	ReviewService_DeleteReviewVote_FullMethodName   = "/GoEdu.ReviewService/DeleteReviewVote"
+	ReviewService_ReportReview_FullMethodName       = "/GoEdu.ReviewService/ReportReview"
 )
 
 // ReviewServiceClient is the client API for ReviewService service.
@@ -1449,10 +1777,27 @@ const (
 //
 // Сервис для управления отзывами к курсам.
 type ReviewServiceClient interface {
-	// Добавить отзыв к курсу.
+	// Добавить отзыв к курсу. Студент может оставить один отзыв к курсу, на который записан,
+	// пройдя необходимую часть лекций. Чтобы получить созданный отзыв, используйте CreateReview.
 	AddReviewToCourse(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Empty, error)
-	// Получить список отзывов к курсу по ID.
-	GetReviewsByCourse(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*ReviewList, error)
+	// Добавить отзыв к курсу и вернуть его; правила те же, что у AddReviewToCourse.
+	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// Изменить свой отзыв.
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// Удалить свой отзыв.
+	DeleteReview(ctx context.Context, in *ReviewIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить список одобренных отзывов к курсу по ID.
+	GetReviewsByCourse(ctx context.Context, in *ReviewsRequest, opts ...grpc.CallOption) (*ReviewList, error)
+	// Ответить на отзыв от имени преподавателя курса. Повторный вызов заменяет ответ.
+	ReplyToReview(ctx context.Context, in *ReviewReplyRequest, opts ...grpc.CallOption) (*Review, error)
+	// Удалить ответ преподавателя на отзыв.
+	DeleteReviewReply(ctx context.Context, in *ReviewIDRequest, opts ...grpc.CallOption) (*Review, error)
+	// Отметить отзыв как полезный или бесполезный. Повторный голос заменяет предыдущий.
+	VoteReview(ctx context.Context, in *ReviewVoteRequest, opts ...grpc.CallOption) (*Review, error)
+	// Отозвать свой голос за отзыв.
+	DeleteReviewVote(ctx context.Context, in *ReviewIDRequest, opts ...grpc.CallOption) (*Review, error)
+	// Пожаловаться на отзыв. Отзыв, набравший достаточно жалоб, скрывается до решения модератора.
+	ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type reviewServiceClient struct {
@@ -1473,7 +1818,37 @@ func (c *reviewServiceClient) AddReviewToCourse(ctx context.Context, in *ReviewR
 	return out, nil
 }
 
-func (c *reviewServiceClient) GetReviewsByCourse(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*ReviewList, error) {
+func (c *reviewServiceClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ReviewService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ReviewService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) DeleteReview(ctx context.Context, in *ReviewIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ReviewService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetReviewsByCourse(ctx context.Context, in *ReviewsRequest, opts ...grpc.CallOption) (*ReviewList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReviewList)
 	err := c.cc.Invoke(ctx, ReviewService_GetReviewsByCourse_FullMethodName, in, out, cOpts...)
@@ -1483,16 +1858,83 @@ func (c *reviewServiceClient) GetReviewsByCourse(ctx context.Context, in *Course
 	return out, nil
 }
 
+func (c *reviewServiceClient) ReplyToReview(ctx context.Context, in *ReviewReplyRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ReviewService_ReplyToReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) DeleteReviewReply(ctx context.Context, in *ReviewIDRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ReviewService_DeleteReviewReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) VoteReview(ctx context.Context, in *ReviewVoteRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ReviewService_VoteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) DeleteReviewVote(ctx context.Context, in *ReviewIDRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ReviewService_DeleteReviewVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ReviewService_ReportReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServiceServer is the server API for ReviewService service.
 // All implementations must embed UnimplementedReviewServiceServer
 // for forward compatibility.
 //
 // Сервис для управления отзывами к курсам.
 type ReviewServiceServer interface {
-	// Добавить отзыв к курсу.
+	// Добавить отзыв к курсу. Студент может оставить один отзыв к курсу, на который записан,
+	// пройдя необходимую часть лекций. Чтобы получить созданный отзыв, используйте CreateReview.
 	AddReviewToCourse(context.Context, *ReviewRequest) (*Empty, error)
-	// Получить список отзывов к курсу по ID.
-	GetReviewsByCourse(context.Context, *CourseIDRequest) (*ReviewList, error)
+	// Добавить отзыв к курсу и вернуть его; правила те же, что у AddReviewToCourse.
+	CreateReview(context.Context, *ReviewRequest) (*Review, error)
+	// Изменить свой отзыв.
+	UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error)
+	// Удалить свой отзыв.
+	DeleteReview(context.Context, *ReviewIDRequest) (*Empty, error)
+	// Получить список одобренных отзывов к курсу по ID.
+	GetReviewsByCourse(context.Context, *ReviewsRequest) (*ReviewList, error)
+	// Ответить на отзыв от имени преподавателя курса. Повторный вызов заменяет ответ.
+	ReplyToReview(context.Context, *ReviewReplyRequest) (*Review, error)
+	// Удалить ответ преподавателя на отзыв.
+	DeleteReviewReply(context.Context, *ReviewIDRequest) (*Review, error)
+	// Отметить отзыв как полезный или бесполезный. Повторный голос заменяет предыдущий.
+	VoteReview(context.Context, *ReviewVoteRequest) (*Review, error)
+	// Отозвать свой голос за отзыв.
+	DeleteReviewVote(context.Context, *ReviewIDRequest) (*Review, error)
+	// Пожаловаться на отзыв. Отзыв, набравший достаточно жалоб, скрывается до решения модератора.
+	ReportReview(context.Context, *ReportReviewRequest) (*Empty, error)
 	mustEmbedUnimplementedReviewServiceServer()
 }
 
@@ -1506,9 +1948,33 @@ type UnimplementedReviewServiceServer struct{}
 func (UnimplementedReviewServiceServer) AddReviewToCourse(context.Context, *ReviewRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddReviewToCourse not implemented")
 }
-func (UnimplementedReviewServiceServer) GetReviewsByCourse(context.Context, *CourseIDRequest) (*ReviewList, error) {
+func (UnimplementedReviewServiceServer) CreateReview(context.Context, *ReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedReviewServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewServiceServer) DeleteReview(context.Context, *ReviewIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedReviewServiceServer) GetReviewsByCourse(context.Context, *ReviewsRequest) (*ReviewList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReviewsByCourse not implemented")
 }
+func (UnimplementedReviewServiceServer) ReplyToReview(context.Context, *ReviewReplyRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToReview not implemented")
+}
+func (UnimplementedReviewServiceServer) DeleteReviewReply(context.Context, *ReviewIDRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviewReply not implemented")
+}
+func (UnimplementedReviewServiceServer) VoteReview(context.Context, *ReviewVoteRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReview not implemented")
+}
+func (UnimplementedReviewServiceServer) DeleteReviewVote(context.Context, *ReviewIDRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviewVote not implemented")
+}
+func (UnimplementedReviewServiceServer) ReportReview(context.Context, *ReportReviewRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportReview not implemented")
+}
 func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
 func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
 
@@ -1548,8 +2014,62 @@ func _ReviewService_AddReviewToCourse_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ReviewService_GetReviewsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CourseIDRequest)
+func _ReviewService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).CreateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).DeleteReview(ctx, req.(*ReviewIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetReviewsByCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1561,7 +2081,97 @@ func _ReviewService_GetReviewsByCourse_Handler(srv interface{}, ctx context.Cont
 		FullMethod: ReviewService_GetReviewsByCourse_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServiceServer).GetReviewsByCourse(ctx, req.(*CourseIDRequest))
+		return srv.(ReviewServiceServer).GetReviewsByCourse(ctx, req.(*ReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ReplyToReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ReplyToReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ReplyToReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ReplyToReview(ctx, req.(*ReviewReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_DeleteReviewReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).DeleteReviewReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_DeleteReviewReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).DeleteReviewReply(ctx, req.(*ReviewIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_VoteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).VoteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_VoteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).VoteReview(ctx, req.(*ReviewVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_DeleteReviewVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).DeleteReviewVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_DeleteReviewVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).DeleteReviewVote(ctx, req.(*ReviewIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ReportReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ReportReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ReportReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ReportReview(ctx, req.(*ReportReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1577,10 +2187,4095 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddReviewToCourse",
 			Handler:    _ReviewService_AddReviewToCourse_Handler,
 		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _ReviewService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ReviewService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ReviewService_DeleteReview_Handler,
+		},
 		{
 			MethodName: "GetReviewsByCourse",
 			Handler:    _ReviewService_GetReviewsByCourse_Handler,
 		},
+		{
+			MethodName: "ReplyToReview",
+			Handler:    _ReviewService_ReplyToReview_Handler,
+		},
+		{
+			MethodName: "DeleteReviewReply",
+			Handler:    _ReviewService_DeleteReviewReply_Handler,
+		},
+		{
+			MethodName: "VoteReview",
+			Handler:    _ReviewService_VoteReview_Handler,
+		},
+		{
+			MethodName: "DeleteReviewVote",
+			Handler:    _ReviewService_DeleteReviewVote_Handler,
+		},
+		{
+			MethodName: "ReportReview",
+			Handler:    _ReviewService_ReportReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	CohortService_CreateCohort_FullMethodName             = "/GoEdu.CohortService/CreateCohort"
+	CohortService_UpdateCohort_FullMethodName             = "/GoEdu.CohortService/UpdateCohort"
+	CohortService_DeleteCohort_FullMethodName             = "/GoEdu.CohortService/DeleteCohort"
+	CohortService_ListCohorts_FullMethodName              = "/GoEdu.CohortService/ListCohorts"
+	CohortService_AssignStudentsToCohort_FullMethodName   = "/GoEdu.CohortService/AssignStudentsToCohort"
+	CohortService_RemoveStudentsFromCohort_FullMethodName = "/GoEdu.CohortService/RemoveStudentsFromCohort"
+	CohortService_GetCohortStudents_FullMethodName        = "/GoEdu.CohortService/GetCohortStudents"
+	CohortService_GetCohortProgress_FullMethodName        = "/GoEdu.CohortService/GetCohortProgress"
+	CohortService_GetCohortSchedule_FullMethodName        = "/GoEdu.CohortService/GetCohortSchedule"
+)
+
+// CohortServiceClient is the client API for CohortService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис для управления потоками (когортами) внутри курса.
+type CohortServiceClient interface {
+	// Создать поток курса.
+	CreateCohort(ctx context.Context, in *CreateCohortRequest, opts ...grpc.CallOption) (*Cohort, error)
+	// Обновить поток.
+	UpdateCohort(ctx context.Context, in *UpdateCohortRequest, opts ...grpc.CallOption) (*Cohort, error)
+	// Удалить поток. Записи студентов на курс сохраняются.
+	DeleteCohort(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить список потоков курса.
+	ListCohorts(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*CohortList, error)
+	// Распределить записанных на курс студентов в поток.
+	AssignStudentsToCohort(ctx context.Context, in *CohortStudentsRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Убрать студентов из потока (запись на курс сохраняется).
+	RemoveStudentsFromCohort(ctx context.Context, in *CohortStudentsRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить список студентов потока.
+	GetCohortStudents(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*StudentList, error)
+	// Получить прогресс студентов потока.
+	GetCohortProgress(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*CohortProgress, error)
+	// Получить расписание открытия лекций для потока.
+	GetCohortSchedule(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*CohortSchedule, error)
+}
+
+type cohortServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCohortServiceClient(cc grpc.ClientConnInterface) CohortServiceClient {
+	return &cohortServiceClient{cc}
+}
+
+func (c *cohortServiceClient) CreateCohort(ctx context.Context, in *CreateCohortRequest, opts ...grpc.CallOption) (*Cohort, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cohort)
+	err := c.cc.Invoke(ctx, CohortService_CreateCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) UpdateCohort(ctx context.Context, in *UpdateCohortRequest, opts ...grpc.CallOption) (*Cohort, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cohort)
+	err := c.cc.Invoke(ctx, CohortService_UpdateCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) DeleteCohort(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CohortService_DeleteCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) ListCohorts(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*CohortList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CohortList)
+	err := c.cc.Invoke(ctx, CohortService_ListCohorts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) AssignStudentsToCohort(ctx context.Context, in *CohortStudentsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CohortService_AssignStudentsToCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) RemoveStudentsFromCohort(ctx context.Context, in *CohortStudentsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CohortService_RemoveStudentsFromCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) GetCohortStudents(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*StudentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StudentList)
+	err := c.cc.Invoke(ctx, CohortService_GetCohortStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) GetCohortProgress(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*CohortProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CohortProgress)
+	err := c.cc.Invoke(ctx, CohortService_GetCohortProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) GetCohortSchedule(ctx context.Context, in *CohortIDRequest, opts ...grpc.CallOption) (*CohortSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CohortSchedule)
+	err := c.cc.Invoke(ctx, CohortService_GetCohortSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CohortServiceServer is the server API for CohortService service.
+// All implementations must embed UnimplementedCohortServiceServer
+// for forward compatibility.
+//
+// Сервис для управления потоками (когортами) внутри курса.
+type CohortServiceServer interface {
+	// Создать поток курса.
+	CreateCohort(context.Context, *CreateCohortRequest) (*Cohort, error)
+	// Обновить поток.
+	UpdateCohort(context.Context, *UpdateCohortRequest) (*Cohort, error)
+	// Удалить поток. Записи студентов на курс сохраняются.
+	DeleteCohort(context.Context, *CohortIDRequest) (*Empty, error)
+	// Получить список потоков курса.
+	ListCohorts(context.Context, *CourseIDRequest) (*CohortList, error)
+	// Распределить записанных на курс студентов в поток.
+	AssignStudentsToCohort(context.Context, *CohortStudentsRequest) (*Empty, error)
+	// Убрать студентов из потока (запись на курс сохраняется).
+	RemoveStudentsFromCohort(context.Context, *CohortStudentsRequest) (*Empty, error)
+	// Получить список студентов потока.
+	GetCohortStudents(context.Context, *CohortIDRequest) (*StudentList, error)
+	// Получить прогресс студентов потока.
+	GetCohortProgress(context.Context, *CohortIDRequest) (*CohortProgress, error)
+	// Получить расписание открытия лекций для потока.
+	GetCohortSchedule(context.Context, *CohortIDRequest) (*CohortSchedule, error)
+	mustEmbedUnimplementedCohortServiceServer()
+}
+
+// UnimplementedCohortServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCohortServiceServer struct{}
+
+func (UnimplementedCohortServiceServer) CreateCohort(context.Context, *CreateCohortRequest) (*Cohort, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) UpdateCohort(context.Context, *UpdateCohortRequest) (*Cohort, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) DeleteCohort(context.Context, *CohortIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) ListCohorts(context.Context, *CourseIDRequest) (*CohortList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCohorts not implemented")
+}
+func (UnimplementedCohortServiceServer) AssignStudentsToCohort(context.Context, *CohortStudentsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignStudentsToCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) RemoveStudentsFromCohort(context.Context, *CohortStudentsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStudentsFromCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) GetCohortStudents(context.Context, *CohortIDRequest) (*StudentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCohortStudents not implemented")
+}
+func (UnimplementedCohortServiceServer) GetCohortProgress(context.Context, *CohortIDRequest) (*CohortProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCohortProgress not implemented")
+}
+func (UnimplementedCohortServiceServer) GetCohortSchedule(context.Context, *CohortIDRequest) (*CohortSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCohortSchedule not implemented")
+}
+func (UnimplementedCohortServiceServer) mustEmbedUnimplementedCohortServiceServer() {}
+func (UnimplementedCohortServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCohortServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CohortServiceServer will
+// result in compilation errors.
+type UnsafeCohortServiceServer interface {
+	mustEmbedUnimplementedCohortServiceServer()
+}
+
+func RegisterCohortServiceServer(s grpc.ServiceRegistrar, srv CohortServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCohortServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CohortService_ServiceDesc, srv)
+}
+
+func _CohortService_CreateCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCohortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).CreateCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_CreateCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).CreateCohort(ctx, req.(*CreateCohortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_UpdateCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCohortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).UpdateCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_UpdateCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).UpdateCohort(ctx, req.(*UpdateCohortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_DeleteCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CohortIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).DeleteCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_DeleteCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).DeleteCohort(ctx, req.(*CohortIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_ListCohorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).ListCohorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_ListCohorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).ListCohorts(ctx, req.(*CourseIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_AssignStudentsToCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CohortStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).AssignStudentsToCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_AssignStudentsToCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).AssignStudentsToCohort(ctx, req.(*CohortStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_RemoveStudentsFromCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CohortStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).RemoveStudentsFromCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_RemoveStudentsFromCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).RemoveStudentsFromCohort(ctx, req.(*CohortStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_GetCohortStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CohortIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).GetCohortStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_GetCohortStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).GetCohortStudents(ctx, req.(*CohortIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_GetCohortProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CohortIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).GetCohortProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_GetCohortProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).GetCohortProgress(ctx, req.(*CohortIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_GetCohortSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CohortIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).GetCohortSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_GetCohortSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).GetCohortSchedule(ctx, req.(*CohortIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CohortService_ServiceDesc is the grpc.ServiceDesc for CohortService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CohortService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.CohortService",
+	HandlerType: (*CohortServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCohort",
+			Handler:    _CohortService_CreateCohort_Handler,
+		},
+		{
+			MethodName: "UpdateCohort",
+			Handler:    _CohortService_UpdateCohort_Handler,
+		},
+		{
+			MethodName: "DeleteCohort",
+			Handler:    _CohortService_DeleteCohort_Handler,
+		},
+		{
+			MethodName: "ListCohorts",
+			Handler:    _CohortService_ListCohorts_Handler,
+		},
+		{
+			MethodName: "AssignStudentsToCohort",
+			Handler:    _CohortService_AssignStudentsToCohort_Handler,
+		},
+		{
+			MethodName: "RemoveStudentsFromCohort",
+			Handler:    _CohortService_RemoveStudentsFromCohort_Handler,
+		},
+		{
+			MethodName: "GetCohortStudents",
+			Handler:    _CohortService_GetCohortStudents_Handler,
+		},
+		{
+			MethodName: "GetCohortProgress",
+			Handler:    _CohortService_GetCohortProgress_Handler,
+		},
+		{
+			MethodName: "GetCohortSchedule",
+			Handler:    _CohortService_GetCohortSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	CertificateService_GetCertificate_FullMethodName     = "/GoEdu.CertificateService/GetCertificate"
+	CertificateService_ListMyCertificates_FullMethodName = "/GoEdu.CertificateService/ListMyCertificates"
+	CertificateService_VerifyCertificate_FullMethodName  = "/GoEdu.CertificateService/VerifyCertificate"
+)
+
+// CertificateServiceClient is the client API for CertificateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис сертификатов об окончании курсов.
+type CertificateServiceClient interface {
+	// Получить сертификат по ID вместе с PDF.
+	GetCertificate(ctx context.Context, in *CertificateIDRequest, opts ...grpc.CallOption) (*Certificate, error)
+	// Получить сертификаты текущего студента.
+	ListMyCertificates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CertificateList, error)
+	// Проверить подлинность сертификата по серийному номеру и подписи (без авторизации).
+	VerifyCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*CertificateVerification, error)
+}
+
+type certificateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCertificateServiceClient(cc grpc.ClientConnInterface) CertificateServiceClient {
+	return &certificateServiceClient{cc}
+}
+
+func (c *certificateServiceClient) GetCertificate(ctx context.Context, in *CertificateIDRequest, opts ...grpc.CallOption) (*Certificate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Certificate)
+	err := c.cc.Invoke(ctx, CertificateService_GetCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateServiceClient) ListMyCertificates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CertificateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateList)
+	err := c.cc.Invoke(ctx, CertificateService_ListMyCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateServiceClient) VerifyCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*CertificateVerification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateVerification)
+	err := c.cc.Invoke(ctx, CertificateService_VerifyCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CertificateServiceServer is the server API for CertificateService service.
+// All implementations must embed UnimplementedCertificateServiceServer
+// for forward compatibility.
+//
+// Сервис сертификатов об окончании курсов.
+type CertificateServiceServer interface {
+	// Получить сертификат по ID вместе с PDF.
+	GetCertificate(context.Context, *CertificateIDRequest) (*Certificate, error)
+	// Получить сертификаты текущего студента.
+	ListMyCertificates(context.Context, *Empty) (*CertificateList, error)
+	// Проверить подлинность сертификата по серийному номеру и подписи (без авторизации).
+	VerifyCertificate(context.Context, *VerifyCertificateRequest) (*CertificateVerification, error)
+	mustEmbedUnimplementedCertificateServiceServer()
+}
+
+// UnimplementedCertificateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCertificateServiceServer struct{}
+
+func (UnimplementedCertificateServiceServer) GetCertificate(context.Context, *CertificateIDRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
+}
+func (UnimplementedCertificateServiceServer) ListMyCertificates(context.Context, *Empty) (*CertificateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyCertificates not implemented")
+}
+func (UnimplementedCertificateServiceServer) VerifyCertificate(context.Context, *VerifyCertificateRequest) (*CertificateVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCertificate not implemented")
+}
+func (UnimplementedCertificateServiceServer) mustEmbedUnimplementedCertificateServiceServer() {}
+func (UnimplementedCertificateServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeCertificateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CertificateServiceServer will
+// result in compilation errors.
+type UnsafeCertificateServiceServer interface {
+	mustEmbedUnimplementedCertificateServiceServer()
+}
+
+func RegisterCertificateServiceServer(s grpc.ServiceRegistrar, srv CertificateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCertificateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CertificateService_ServiceDesc, srv)
+}
+
+func _CertificateService_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).GetCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_GetCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).GetCertificate(ctx, req.(*CertificateIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateService_ListMyCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).ListMyCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_ListMyCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).ListMyCertificates(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateService_VerifyCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).VerifyCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_VerifyCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).VerifyCertificate(ctx, req.(*VerifyCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CertificateService_ServiceDesc is the grpc.ServiceDesc for CertificateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CertificateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.CertificateService",
+	HandlerType: (*CertificateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCertificate",
+			Handler:    _CertificateService_GetCertificate_Handler,
+		},
+		{
+			MethodName: "ListMyCertificates",
+			Handler:    _CertificateService_ListMyCertificates_Handler,
+		},
+		{
+			MethodName: "VerifyCertificate",
+			Handler:    _CertificateService_VerifyCertificate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	QuizService_CreateQuiz_FullMethodName        = "/GoEdu.QuizService/CreateQuiz"
+	QuizService_UpdateQuiz_FullMethodName        = "/GoEdu.QuizService/UpdateQuiz"
+	QuizService_DeleteQuiz_FullMethodName        = "/GoEdu.QuizService/DeleteQuiz"
+	QuizService_GetQuiz_FullMethodName           = "/GoEdu.QuizService/GetQuiz"
+	QuizService_ListQuizzes_FullMethodName       = "/GoEdu.QuizService/ListQuizzes"
+	QuizService_StartQuizAttempt_FullMethodName  = "/GoEdu.QuizService/StartQuizAttempt"
+	QuizService_SubmitQuizAttempt_FullMethodName = "/GoEdu.QuizService/SubmitQuizAttempt"
+	QuizService_ListQuizAttempts_FullMethodName  = "/GoEdu.QuizService/ListQuizAttempts"
+)
+
+// QuizServiceClient is the client API for QuizService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис тестов с автоматической проверкой.
+type QuizServiceClient interface {
+	// Создать тест курса или лекции.
+	CreateQuiz(ctx context.Context, in *CreateQuizRequest, opts ...grpc.CallOption) (*Quiz, error)
+	// Обновить тест. Вопросы заменяются целиком.
+	UpdateQuiz(ctx context.Context, in *UpdateQuizRequest, opts ...grpc.CallOption) (*Quiz, error)
+	// Удалить тест вместе с попытками.
+	DeleteQuiz(ctx context.Context, in *QuizIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить тест с правильными ответами (для преподавателя курса).
+	GetQuiz(ctx context.Context, in *QuizIDRequest, opts ...grpc.CallOption) (*Quiz, error)
+	// Получить список тестов курса без вопросов.
+	ListQuizzes(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*QuizList, error)
+	// Начать попытку. Если есть незавершённая попытка, возвращается она.
+	StartQuizAttempt(ctx context.Context, in *StartQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttempt, error)
+	// Отправить ответы и получить результат проверки.
+	SubmitQuizAttempt(ctx context.Context, in *SubmitQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttempt, error)
+	// Получить попытки прохождения теста.
+	ListQuizAttempts(ctx context.Context, in *QuizAttemptsRequest, opts ...grpc.CallOption) (*QuizAttemptList, error)
+}
+
+type quizServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuizServiceClient(cc grpc.ClientConnInterface) QuizServiceClient {
+	return &quizServiceClient{cc}
+}
+
+func (c *quizServiceClient) CreateQuiz(ctx context.Context, in *CreateQuizRequest, opts ...grpc.CallOption) (*Quiz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quiz)
+	err := c.cc.Invoke(ctx, QuizService_CreateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) UpdateQuiz(ctx context.Context, in *UpdateQuizRequest, opts ...grpc.CallOption) (*Quiz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quiz)
+	err := c.cc.Invoke(ctx, QuizService_UpdateQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) DeleteQuiz(ctx context.Context, in *QuizIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, QuizService_DeleteQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetQuiz(ctx context.Context, in *QuizIDRequest, opts ...grpc.CallOption) (*Quiz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quiz)
+	err := c.cc.Invoke(ctx, QuizService_GetQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ListQuizzes(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*QuizList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizList)
+	err := c.cc.Invoke(ctx, QuizService_ListQuizzes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) StartQuizAttempt(ctx context.Context, in *StartQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizAttempt)
+	err := c.cc.Invoke(ctx, QuizService_StartQuizAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) SubmitQuizAttempt(ctx context.Context, in *SubmitQuizAttemptRequest, opts ...grpc.CallOption) (*QuizAttempt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizAttempt)
+	err := c.cc.Invoke(ctx, QuizService_SubmitQuizAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ListQuizAttempts(ctx context.Context, in *QuizAttemptsRequest, opts ...grpc.CallOption) (*QuizAttemptList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizAttemptList)
+	err := c.cc.Invoke(ctx, QuizService_ListQuizAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuizServiceServer is the server API for QuizService service.
+// All implementations must embed UnimplementedQuizServiceServer
+// for forward compatibility.
+//
+// Сервис тестов с автоматической проверкой.
+type QuizServiceServer interface {
+	// Создать тест курса или лекции.
+	CreateQuiz(context.Context, *CreateQuizRequest) (*Quiz, error)
+	// Обновить тест. Вопросы заменяются целиком.
+	UpdateQuiz(context.Context, *UpdateQuizRequest) (*Quiz, error)
+	// Удалить тест вместе с попытками.
+	DeleteQuiz(context.Context, *QuizIDRequest) (*Empty, error)
+	// Получить тест с правильными ответами (для преподавателя курса).
+	GetQuiz(context.Context, *QuizIDRequest) (*Quiz, error)
+	// Получить список тестов курса без вопросов.
+	ListQuizzes(context.Context, *CourseIDRequest) (*QuizList, error)
+	// Начать попытку. Если есть незавершённая попытка, возвращается она.
+	StartQuizAttempt(context.Context, *StartQuizAttemptRequest) (*QuizAttempt, error)
+	// Отправить ответы и получить результат проверки.
+	SubmitQuizAttempt(context.Context, *SubmitQuizAttemptRequest) (*QuizAttempt, error)
+	// Получить попытки прохождения теста.
+	ListQuizAttempts(context.Context, *QuizAttemptsRequest) (*QuizAttemptList, error)
+	mustEmbedUnimplementedQuizServiceServer()
+}
+
+// UnimplementedQuizServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuizServiceServer struct{}
+
+func (UnimplementedQuizServiceServer) CreateQuiz(context.Context, *CreateQuizRequest) (*Quiz, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) UpdateQuiz(context.Context, *UpdateQuizRequest) (*Quiz, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) DeleteQuiz(context.Context, *QuizIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) GetQuiz(context.Context, *QuizIDRequest) (*Quiz, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuiz not implemented")
+}
+func (UnimplementedQuizServiceServer) ListQuizzes(context.Context, *CourseIDRequest) (*QuizList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizzes not implemented")
+}
+func (UnimplementedQuizServiceServer) StartQuizAttempt(context.Context, *StartQuizAttemptRequest) (*QuizAttempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartQuizAttempt not implemented")
+}
+func (UnimplementedQuizServiceServer) SubmitQuizAttempt(context.Context, *SubmitQuizAttemptRequest) (*QuizAttempt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuizAttempt not implemented")
+}
+func (UnimplementedQuizServiceServer) ListQuizAttempts(context.Context, *QuizAttemptsRequest) (*QuizAttemptList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuizAttempts not implemented")
+}
+func (UnimplementedQuizServiceServer) mustEmbedUnimplementedQuizServiceServer() {}
+func (UnimplementedQuizServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeQuizServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuizServiceServer will
+// result in compilation errors.
+type UnsafeQuizServiceServer interface {
+	mustEmbedUnimplementedQuizServiceServer()
+}
+
+func RegisterQuizServiceServer(s grpc.ServiceRegistrar, srv QuizServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQuizServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuizService_ServiceDesc, srv)
+}
+
+func _QuizService_CreateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).CreateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_CreateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).CreateQuiz(ctx, req.(*CreateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_UpdateQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).UpdateQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_UpdateQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).UpdateQuiz(ctx, req.(*UpdateQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_DeleteQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_DeleteQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).DeleteQuiz(ctx, req.(*QuizIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_GetQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetQuiz(ctx, req.(*QuizIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ListQuizzes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ListQuizzes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ListQuizzes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ListQuizzes(ctx, req.(*CourseIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_StartQuizAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartQuizAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).StartQuizAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_StartQuizAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).StartQuizAttempt(ctx, req.(*StartQuizAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_SubmitQuizAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQuizAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).SubmitQuizAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_SubmitQuizAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).SubmitQuizAttempt(ctx, req.(*SubmitQuizAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ListQuizAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ListQuizAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizService_ListQuizAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ListQuizAttempts(ctx, req.(*QuizAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuizService_ServiceDesc is the grpc.ServiceDesc for QuizService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuizService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.QuizService",
+	HandlerType: (*QuizServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateQuiz",
+			Handler:    _QuizService_CreateQuiz_Handler,
+		},
+		{
+			MethodName: "UpdateQuiz",
+			Handler:    _QuizService_UpdateQuiz_Handler,
+		},
+		{
+			MethodName: "DeleteQuiz",
+			Handler:    _QuizService_DeleteQuiz_Handler,
+		},
+		{
+			MethodName: "GetQuiz",
+			Handler:    _QuizService_GetQuiz_Handler,
+		},
+		{
+			MethodName: "ListQuizzes",
+			Handler:    _QuizService_ListQuizzes_Handler,
+		},
+		{
+			MethodName: "StartQuizAttempt",
+			Handler:    _QuizService_StartQuizAttempt_Handler,
+		},
+		{
+			MethodName: "SubmitQuizAttempt",
+			Handler:    _QuizService_SubmitQuizAttempt_Handler,
+		},
+		{
+			MethodName: "ListQuizAttempts",
+			Handler:    _QuizService_ListQuizAttempts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	QuestionBankService_CreateBankQuestion_FullMethodName = "/GoEdu.QuestionBankService/CreateBankQuestion"
+	QuestionBankService_UpdateBankQuestion_FullMethodName = "/GoEdu.QuestionBankService/UpdateBankQuestion"
+	QuestionBankService_DeleteBankQuestion_FullMethodName = "/GoEdu.QuestionBankService/DeleteBankQuestion"
+	QuestionBankService_ListBankQuestions_FullMethodName  = "/GoEdu.QuestionBankService/ListBankQuestions"
+	QuestionBankService_ImportQuestionBank_FullMethodName = "/GoEdu.QuestionBankService/ImportQuestionBank"
+	QuestionBankService_ExportQuestionBank_FullMethodName = "/GoEdu.QuestionBankService/ExportQuestionBank"
+)
+
+// QuestionBankServiceClient is the client API for QuestionBankService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис для банка вопросов преподавателя.
+type QuestionBankServiceClient interface {
+	// Добавить вопрос в банк.
+	CreateBankQuestion(ctx context.Context, in *BankQuestionRequest, opts ...grpc.CallOption) (*QuizQuestion, error)
+	// Обновить вопрос банка. Вопрос, уже выпадавший в попытках, сохраняется под новым ID.
+	UpdateBankQuestion(ctx context.Context, in *BankQuestionRequest, opts ...grpc.CallOption) (*QuizQuestion, error)
+	// Удалить вопрос из банка. Завершённые попытки продолжают его показывать.
+	DeleteBankQuestion(ctx context.Context, in *BankQuestionIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить вопросы банка с фильтрами по тегам и сложности.
+	ListBankQuestions(ctx context.Context, in *ListBankQuestionsRequest, opts ...grpc.CallOption) (*BankQuestionList, error)
+	// Импортировать вопросы из файла GIFT или QTI.
+	ImportQuestionBank(ctx context.Context, in *ImportQuestionBankRequest, opts ...grpc.CallOption) (*ImportQuestionBankResponse, error)
+	// Выгрузить вопросы банка в файл GIFT или QTI.
+	ExportQuestionBank(ctx context.Context, in *ExportQuestionBankRequest, opts ...grpc.CallOption) (*QuestionBankFile, error)
+}
+
+type questionBankServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuestionBankServiceClient(cc grpc.ClientConnInterface) QuestionBankServiceClient {
+	return &questionBankServiceClient{cc}
+}
+
+func (c *questionBankServiceClient) CreateBankQuestion(ctx context.Context, in *BankQuestionRequest, opts ...grpc.CallOption) (*QuizQuestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizQuestion)
+	err := c.cc.Invoke(ctx, QuestionBankService_CreateBankQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionBankServiceClient) UpdateBankQuestion(ctx context.Context, in *BankQuestionRequest, opts ...grpc.CallOption) (*QuizQuestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizQuestion)
+	err := c.cc.Invoke(ctx, QuestionBankService_UpdateBankQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionBankServiceClient) DeleteBankQuestion(ctx context.Context, in *BankQuestionIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, QuestionBankService_DeleteBankQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionBankServiceClient) ListBankQuestions(ctx context.Context, in *ListBankQuestionsRequest, opts ...grpc.CallOption) (*BankQuestionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankQuestionList)
+	err := c.cc.Invoke(ctx, QuestionBankService_ListBankQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionBankServiceClient) ImportQuestionBank(ctx context.Context, in *ImportQuestionBankRequest, opts ...grpc.CallOption) (*ImportQuestionBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportQuestionBankResponse)
+	err := c.cc.Invoke(ctx, QuestionBankService_ImportQuestionBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionBankServiceClient) ExportQuestionBank(ctx context.Context, in *ExportQuestionBankRequest, opts ...grpc.CallOption) (*QuestionBankFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuestionBankFile)
+	err := c.cc.Invoke(ctx, QuestionBankService_ExportQuestionBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuestionBankServiceServer is the server API for QuestionBankService service.
+// All implementations must embed UnimplementedQuestionBankServiceServer
+// for forward compatibility.
+//
+// Сервис для банка вопросов преподавателя.
+type QuestionBankServiceServer interface {
+	// Добавить вопрос в банк.
+	CreateBankQuestion(context.Context, *BankQuestionRequest) (*QuizQuestion, error)
+	// Обновить вопрос банка. Вопрос, уже выпадавший в попытках, сохраняется под новым ID.
+	UpdateBankQuestion(context.Context, *BankQuestionRequest) (*QuizQuestion, error)
+	// Удалить вопрос из банка. Завершённые попытки продолжают его показывать.
+	DeleteBankQuestion(context.Context, *BankQuestionIDRequest) (*Empty, error)
+	// Получить вопросы банка с фильтрами по тегам и сложности.
+	ListBankQuestions(context.Context, *ListBankQuestionsRequest) (*BankQuestionList, error)
+	// Импортировать вопросы из файла GIFT или QTI.
+	ImportQuestionBank(context.Context, *ImportQuestionBankRequest) (*ImportQuestionBankResponse, error)
+	// Выгрузить вопросы банка в файл GIFT или QTI.
+	ExportQuestionBank(context.Context, *ExportQuestionBankRequest) (*QuestionBankFile, error)
+	mustEmbedUnimplementedQuestionBankServiceServer()
+}
+
+// UnimplementedQuestionBankServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuestionBankServiceServer struct{}
+
+func (UnimplementedQuestionBankServiceServer) CreateBankQuestion(context.Context, *BankQuestionRequest) (*QuizQuestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBankQuestion not implemented")
+}
+func (UnimplementedQuestionBankServiceServer) UpdateBankQuestion(context.Context, *BankQuestionRequest) (*QuizQuestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankQuestion not implemented")
+}
+func (UnimplementedQuestionBankServiceServer) DeleteBankQuestion(context.Context, *BankQuestionIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankQuestion not implemented")
+}
+func (UnimplementedQuestionBankServiceServer) ListBankQuestions(context.Context, *ListBankQuestionsRequest) (*BankQuestionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankQuestions not implemented")
+}
+func (UnimplementedQuestionBankServiceServer) ImportQuestionBank(context.Context, *ImportQuestionBankRequest) (*ImportQuestionBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportQuestionBank not implemented")
+}
+func (UnimplementedQuestionBankServiceServer) ExportQuestionBank(context.Context, *ExportQuestionBankRequest) (*QuestionBankFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportQuestionBank not implemented")
+}
+func (UnimplementedQuestionBankServiceServer) mustEmbedUnimplementedQuestionBankServiceServer() {}
+func (UnimplementedQuestionBankServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeQuestionBankServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuestionBankServiceServer will
+// result in compilation errors.
+type UnsafeQuestionBankServiceServer interface {
+	mustEmbedUnimplementedQuestionBankServiceServer()
+}
+
+func RegisterQuestionBankServiceServer(s grpc.ServiceRegistrar, srv QuestionBankServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQuestionBankServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuestionBankService_ServiceDesc, srv)
+}
+
+func _QuestionBankService_CreateBankQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BankQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionBankServiceServer).CreateBankQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionBankService_CreateBankQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionBankServiceServer).CreateBankQuestion(ctx, req.(*BankQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionBankService_UpdateBankQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BankQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionBankServiceServer).UpdateBankQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionBankService_UpdateBankQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionBankServiceServer).UpdateBankQuestion(ctx, req.(*BankQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionBankService_DeleteBankQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BankQuestionIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionBankServiceServer).DeleteBankQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionBankService_DeleteBankQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionBankServiceServer).DeleteBankQuestion(ctx, req.(*BankQuestionIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionBankService_ListBankQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionBankServiceServer).ListBankQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionBankService_ListBankQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionBankServiceServer).ListBankQuestions(ctx, req.(*ListBankQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionBankService_ImportQuestionBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQuestionBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionBankServiceServer).ImportQuestionBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionBankService_ImportQuestionBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionBankServiceServer).ImportQuestionBank(ctx, req.(*ImportQuestionBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionBankService_ExportQuestionBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportQuestionBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionBankServiceServer).ExportQuestionBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionBankService_ExportQuestionBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionBankServiceServer).ExportQuestionBank(ctx, req.(*ExportQuestionBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuestionBankService_ServiceDesc is the grpc.ServiceDesc for QuestionBankService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuestionBankService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.QuestionBankService",
+	HandlerType: (*QuestionBankServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBankQuestion",
+			Handler:    _QuestionBankService_CreateBankQuestion_Handler,
+		},
+		{
+			MethodName: "UpdateBankQuestion",
+			Handler:    _QuestionBankService_UpdateBankQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteBankQuestion",
+			Handler:    _QuestionBankService_DeleteBankQuestion_Handler,
+		},
+		{
+			MethodName: "ListBankQuestions",
+			Handler:    _QuestionBankService_ListBankQuestions_Handler,
+		},
+		{
+			MethodName: "ImportQuestionBank",
+			Handler:    _QuestionBankService_ImportQuestionBank_Handler,
+		},
+		{
+			MethodName: "ExportQuestionBank",
+			Handler:    _QuestionBankService_ExportQuestionBank_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	AssignmentService_CreateAssignment_FullMethodName  = "/GoEdu.AssignmentService/CreateAssignment"
+	AssignmentService_UpdateAssignment_FullMethodName  = "/GoEdu.AssignmentService/UpdateAssignment"
+	AssignmentService_DeleteAssignment_FullMethodName  = "/GoEdu.AssignmentService/DeleteAssignment"
+	AssignmentService_GetAssignment_FullMethodName     = "/GoEdu.AssignmentService/GetAssignment"
+	AssignmentService_ListAssignments_FullMethodName   = "/GoEdu.AssignmentService/ListAssignments"
+	AssignmentService_SubmitAssignment_FullMethodName  = "/GoEdu.AssignmentService/SubmitAssignment"
+	AssignmentService_GetSubmission_FullMethodName     = "/GoEdu.AssignmentService/GetSubmission"
+	AssignmentService_ListSubmissions_FullMethodName   = "/GoEdu.AssignmentService/ListSubmissions"
+	AssignmentService_GradeSubmission_FullMethodName   = "/GoEdu.AssignmentService/GradeSubmission"
+	AssignmentService_GetSubmissionFile_FullMethodName = "/GoEdu.AssignmentService/GetSubmissionFile"
+)
+
+// AssignmentServiceClient is the client API for AssignmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис заданий с отправкой решений и проверкой преподавателем.
+type AssignmentServiceClient interface {
+	// Создать задание курса.
+	CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	// Обновить задание. Рубрику нельзя изменить после начала проверки.
+	UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	// Удалить задание вместе с решениями и их файлами.
+	DeleteAssignment(ctx context.Context, in *AssignmentIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить задание с рубрикой.
+	GetAssignment(ctx context.Context, in *AssignmentIDRequest, opts ...grpc.CallOption) (*Assignment, error)
+	// Получить задания курса.
+	ListAssignments(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*AssignmentList, error)
+	// Отправить решение. Повторная отправка создаёт новую версию решения.
+	SubmitAssignment(ctx context.Context, in *SubmitAssignmentRequest, opts ...grpc.CallOption) (*Submission, error)
+	// Получить решение с оценкой (студенту — своё, преподавателю — решения своего курса).
+	GetSubmission(ctx context.Context, in *SubmissionIDRequest, opts ...grpc.CallOption) (*Submission, error)
+	// Получить решения задания с фильтрами (для преподавателя).
+	ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*SubmissionList, error)
+	// Оценить решение. Повторная проверка заменяет оценку, в том числе выставленную по рецензиям студентов.
+	GradeSubmission(ctx context.Context, in *GradeSubmissionRequest, opts ...grpc.CallOption) (*Submission, error)
+	// Получить файл решения.
+	GetSubmissionFile(ctx context.Context, in *SubmissionFileRequest, opts ...grpc.CallOption) (*SubmissionFileContent, error)
+}
+
+type assignmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignmentServiceClient(cc grpc.ClientConnInterface) AssignmentServiceClient {
+	return &assignmentServiceClient{cc}
+}
+
+func (c *assignmentServiceClient) CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_CreateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_UpdateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) DeleteAssignment(ctx context.Context, in *AssignmentIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AssignmentService_DeleteAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GetAssignment(ctx context.Context, in *AssignmentIDRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_GetAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListAssignments(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*AssignmentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentList)
+	err := c.cc.Invoke(ctx, AssignmentService_ListAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) SubmitAssignment(ctx context.Context, in *SubmitAssignmentRequest, opts ...grpc.CallOption) (*Submission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, AssignmentService_SubmitAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GetSubmission(ctx context.Context, in *SubmissionIDRequest, opts ...grpc.CallOption) (*Submission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, AssignmentService_GetSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*SubmissionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmissionList)
+	err := c.cc.Invoke(ctx, AssignmentService_ListSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GradeSubmission(ctx context.Context, in *GradeSubmissionRequest, opts ...grpc.CallOption) (*Submission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, AssignmentService_GradeSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GetSubmissionFile(ctx context.Context, in *SubmissionFileRequest, opts ...grpc.CallOption) (*SubmissionFileContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmissionFileContent)
+	err := c.cc.Invoke(ctx, AssignmentService_GetSubmissionFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignmentServiceServer is the server API for AssignmentService service.
+// All implementations must embed UnimplementedAssignmentServiceServer
+// for forward compatibility.
+//
+// Сервис заданий с отправкой решений и проверкой преподавателем.
+type AssignmentServiceServer interface {
+	// Создать задание курса.
+	CreateAssignment(context.Context, *CreateAssignmentRequest) (*Assignment, error)
+	// Обновить задание. Рубрику нельзя изменить после начала проверки.
+	UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*Assignment, error)
+	// Удалить задание вместе с решениями и их файлами.
+	DeleteAssignment(context.Context, *AssignmentIDRequest) (*Empty, error)
+	// Получить задание с рубрикой.
+	GetAssignment(context.Context, *AssignmentIDRequest) (*Assignment, error)
+	// Получить задания курса.
+	ListAssignments(context.Context, *CourseIDRequest) (*AssignmentList, error)
+	// Отправить решение. Повторная отправка создаёт новую версию решения.
+	SubmitAssignment(context.Context, *SubmitAssignmentRequest) (*Submission, error)
+	// Получить решение с оценкой (студенту — своё, преподавателю — решения своего курса).
+	GetSubmission(context.Context, *SubmissionIDRequest) (*Submission, error)
+	// Получить решения задания с фильтрами (для преподавателя).
+	ListSubmissions(context.Context, *ListSubmissionsRequest) (*SubmissionList, error)
+	// Оценить решение. Повторная проверка заменяет оценку, в том числе выставленную по рецензиям студентов.
+	GradeSubmission(context.Context, *GradeSubmissionRequest) (*Submission, error)
+	// Получить файл решения.
+	GetSubmissionFile(context.Context, *SubmissionFileRequest) (*SubmissionFileContent, error)
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+// UnimplementedAssignmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssignmentServiceServer struct{}
+
+func (UnimplementedAssignmentServiceServer) CreateAssignment(context.Context, *CreateAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) DeleteAssignment(context.Context, *AssignmentIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GetAssignment(context.Context, *AssignmentIDRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListAssignments(context.Context, *CourseIDRequest) (*AssignmentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignments not implemented")
+}
+func (UnimplementedAssignmentServiceServer) SubmitAssignment(context.Context, *SubmitAssignmentRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GetSubmission(context.Context, *SubmissionIDRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmission not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListSubmissions(context.Context, *ListSubmissionsRequest) (*SubmissionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissions not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GradeSubmission(context.Context, *GradeSubmissionRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeSubmission not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GetSubmissionFile(context.Context, *SubmissionFileRequest) (*SubmissionFileContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionFile not implemented")
+}
+func (UnimplementedAssignmentServiceServer) mustEmbedUnimplementedAssignmentServiceServer() {}
+func (UnimplementedAssignmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignmentServiceServer will
+// result in compilation errors.
+type UnsafeAssignmentServiceServer interface {
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+func RegisterAssignmentServiceServer(s grpc.ServiceRegistrar, srv AssignmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAssignmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssignmentService_ServiceDesc, srv)
+}
+
+func _AssignmentService_CreateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_CreateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, req.(*CreateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_UpdateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).UpdateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_UpdateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).UpdateAssignment(ctx, req.(*UpdateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_DeleteAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_DeleteAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, req.(*AssignmentIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GetAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GetAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, req.(*AssignmentIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, req.(*CourseIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_SubmitAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).SubmitAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_SubmitAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).SubmitAssignment(ctx, req.(*SubmitAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GetSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GetSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GetSubmission(ctx, req.(*SubmissionIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListSubmissions(ctx, req.(*ListSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GradeSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GradeSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GradeSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GradeSubmission(ctx, req.(*GradeSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GetSubmissionFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GetSubmissionFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GetSubmissionFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GetSubmissionFile(ctx, req.(*SubmissionFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignmentService_ServiceDesc is the grpc.ServiceDesc for AssignmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.AssignmentService",
+	HandlerType: (*AssignmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAssignment",
+			Handler:    _AssignmentService_CreateAssignment_Handler,
+		},
+		{
+			MethodName: "UpdateAssignment",
+			Handler:    _AssignmentService_UpdateAssignment_Handler,
+		},
+		{
+			MethodName: "DeleteAssignment",
+			Handler:    _AssignmentService_DeleteAssignment_Handler,
+		},
+		{
+			MethodName: "GetAssignment",
+			Handler:    _AssignmentService_GetAssignment_Handler,
+		},
+		{
+			MethodName: "ListAssignments",
+			Handler:    _AssignmentService_ListAssignments_Handler,
+		},
+		{
+			MethodName: "SubmitAssignment",
+			Handler:    _AssignmentService_SubmitAssignment_Handler,
+		},
+		{
+			MethodName: "GetSubmission",
+			Handler:    _AssignmentService_GetSubmission_Handler,
+		},
+		{
+			MethodName: "ListSubmissions",
+			Handler:    _AssignmentService_ListSubmissions_Handler,
+		},
+		{
+			MethodName: "GradeSubmission",
+			Handler:    _AssignmentService_GradeSubmission_Handler,
+		},
+		{
+			MethodName: "GetSubmissionFile",
+			Handler:    _AssignmentService_GetSubmissionFile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	PeerReviewService_ListPeerReviewTasks_FullMethodName       = "/GoEdu.PeerReviewService/ListPeerReviewTasks"
+	PeerReviewService_SubmitPeerReview_FullMethodName          = "/GoEdu.PeerReviewService/SubmitPeerReview"
+	PeerReviewService_ListSubmissionPeerReviews_FullMethodName = "/GoEdu.PeerReviewService/ListSubmissionPeerReviews"
+)
+
+// PeerReviewServiceClient is the client API for PeerReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис взаимной проверки решений студентами.
+type PeerReviewServiceClient interface {
+	// Получить решения, назначенные студенту на взаимную проверку.
+	ListPeerReviewTasks(ctx context.Context, in *PeerReviewTasksRequest, opts ...grpc.CallOption) (*PeerReviewTaskList, error)
+	// Отправить рецензию по рубрике. До окончания срока рецензирования её можно исправить.
+	SubmitPeerReview(ctx context.Context, in *SubmitPeerReviewRequest, opts ...grpc.CallOption) (*PeerReview, error)
+	// Получить рецензии на решение (автору — без имён рецензентов, преподавателю — все).
+	ListSubmissionPeerReviews(ctx context.Context, in *SubmissionIDRequest, opts ...grpc.CallOption) (*PeerReviewList, error)
+}
+
+type peerReviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPeerReviewServiceClient(cc grpc.ClientConnInterface) PeerReviewServiceClient {
+	return &peerReviewServiceClient{cc}
+}
+
+func (c *peerReviewServiceClient) ListPeerReviewTasks(ctx context.Context, in *PeerReviewTasksRequest, opts ...grpc.CallOption) (*PeerReviewTaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerReviewTaskList)
+	err := c.cc.Invoke(ctx, PeerReviewService_ListPeerReviewTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerReviewServiceClient) SubmitPeerReview(ctx context.Context, in *SubmitPeerReviewRequest, opts ...grpc.CallOption) (*PeerReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerReview)
+	err := c.cc.Invoke(ctx, PeerReviewService_SubmitPeerReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerReviewServiceClient) ListSubmissionPeerReviews(ctx context.Context, in *SubmissionIDRequest, opts ...grpc.CallOption) (*PeerReviewList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerReviewList)
+	err := c.cc.Invoke(ctx, PeerReviewService_ListSubmissionPeerReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeerReviewServiceServer is the server API for PeerReviewService service.
+// All implementations must embed UnimplementedPeerReviewServiceServer
+// for forward compatibility.
+//
+// Сервис взаимной проверки решений студентами.
+type PeerReviewServiceServer interface {
+	// Получить решения, назначенные студенту на взаимную проверку.
+	ListPeerReviewTasks(context.Context, *PeerReviewTasksRequest) (*PeerReviewTaskList, error)
+	// Отправить рецензию по рубрике. До окончания срока рецензирования её можно исправить.
+	SubmitPeerReview(context.Context, *SubmitPeerReviewRequest) (*PeerReview, error)
+	// Получить рецензии на решение (автору — без имён рецензентов, преподавателю — все).
+	ListSubmissionPeerReviews(context.Context, *SubmissionIDRequest) (*PeerReviewList, error)
+	mustEmbedUnimplementedPeerReviewServiceServer()
+}
+
+// UnimplementedPeerReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPeerReviewServiceServer struct{}
+
+func (UnimplementedPeerReviewServiceServer) ListPeerReviewTasks(context.Context, *PeerReviewTasksRequest) (*PeerReviewTaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerReviewTasks not implemented")
+}
+func (UnimplementedPeerReviewServiceServer) SubmitPeerReview(context.Context, *SubmitPeerReviewRequest) (*PeerReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPeerReview not implemented")
+}
+func (UnimplementedPeerReviewServiceServer) ListSubmissionPeerReviews(context.Context, *SubmissionIDRequest) (*PeerReviewList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissionPeerReviews not implemented")
+}
+func (UnimplementedPeerReviewServiceServer) mustEmbedUnimplementedPeerReviewServiceServer() {}
+func (UnimplementedPeerReviewServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePeerReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PeerReviewServiceServer will
+// result in compilation errors.
+type UnsafePeerReviewServiceServer interface {
+	mustEmbedUnimplementedPeerReviewServiceServer()
+}
+
+func RegisterPeerReviewServiceServer(s grpc.ServiceRegistrar, srv PeerReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPeerReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PeerReviewService_ServiceDesc, srv)
+}
+
+func _PeerReviewService_ListPeerReviewTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReviewTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerReviewServiceServer).ListPeerReviewTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerReviewService_ListPeerReviewTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerReviewServiceServer).ListPeerReviewTasks(ctx, req.(*PeerReviewTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerReviewService_SubmitPeerReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPeerReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerReviewServiceServer).SubmitPeerReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerReviewService_SubmitPeerReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerReviewServiceServer).SubmitPeerReview(ctx, req.(*SubmitPeerReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerReviewService_ListSubmissionPeerReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerReviewServiceServer).ListSubmissionPeerReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerReviewService_ListSubmissionPeerReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerReviewServiceServer).ListSubmissionPeerReviews(ctx, req.(*SubmissionIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PeerReviewService_ServiceDesc is the grpc.ServiceDesc for PeerReviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PeerReviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.PeerReviewService",
+	HandlerType: (*PeerReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPeerReviewTasks",
+			Handler:    _PeerReviewService_ListPeerReviewTasks_Handler,
+		},
+		{
+			MethodName: "SubmitPeerReview",
+			Handler:    _PeerReviewService_SubmitPeerReview_Handler,
+		},
+		{
+			MethodName: "ListSubmissionPeerReviews",
+			Handler:    _PeerReviewService_ListSubmissionPeerReviews_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	GradebookService_CreateGradeCategory_FullMethodName  = "/GoEdu.GradebookService/CreateGradeCategory"
+	GradebookService_UpdateGradeCategory_FullMethodName  = "/GoEdu.GradebookService/UpdateGradeCategory"
+	GradebookService_DeleteGradeCategory_FullMethodName  = "/GoEdu.GradebookService/DeleteGradeCategory"
+	GradebookService_ListGradeCategories_FullMethodName  = "/GoEdu.GradebookService/ListGradeCategories"
+	GradebookService_SetGradeItemCategory_FullMethodName = "/GoEdu.GradebookService/SetGradeItemCategory"
+	GradebookService_GetLetterScheme_FullMethodName      = "/GoEdu.GradebookService/GetLetterScheme"
+	GradebookService_SetLetterScheme_FullMethodName      = "/GoEdu.GradebookService/SetLetterScheme"
+	GradebookService_GetGradebook_FullMethodName         = "/GoEdu.GradebookService/GetGradebook"
+	GradebookService_GetStudentGrades_FullMethodName     = "/GoEdu.GradebookService/GetStudentGrades"
+	GradebookService_AdjustGrade_FullMethodName          = "/GoEdu.GradebookService/AdjustGrade"
+	GradebookService_ListGradeAdjustments_FullMethodName = "/GoEdu.GradebookService/ListGradeAdjustments"
+	GradebookService_ExportGradebook_FullMethodName      = "/GoEdu.GradebookService/ExportGradebook"
+)
+
+// GradebookServiceClient is the client API for GradebookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис журнала оценок курса.
+type GradebookServiceClient interface {
+	// Создать категорию оценок с весом.
+	CreateGradeCategory(ctx context.Context, in *CreateGradeCategoryRequest, opts ...grpc.CallOption) (*GradeCategory, error)
+	// Изменить название или вес категории.
+	UpdateGradeCategory(ctx context.Context, in *UpdateGradeCategoryRequest, opts ...grpc.CallOption) (*GradeCategory, error)
+	// Удалить категорию; её тесты и задания остаются без категории.
+	DeleteGradeCategory(ctx context.Context, in *GradeCategoryIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить категории оценок курса.
+	ListGradeCategories(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*GradeCategoryList, error)
+	// Отнести тест или задание к категории.
+	SetGradeItemCategory(ctx context.Context, in *SetGradeItemCategoryRequest, opts ...grpc.CallOption) (*GradeItem, error)
+	// Получить шкалу буквенных оценок курса (если не задана — шкалу по умолчанию).
+	GetLetterScheme(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*LetterScheme, error)
+	// Задать шкалу буквенных оценок курса; пустой список возвращает шкалу по умолчанию.
+	SetLetterScheme(ctx context.Context, in *SetLetterSchemeRequest, opts ...grpc.CallOption) (*LetterScheme, error)
+	// Получить журнал оценок курса по всем студентам.
+	GetGradebook(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*Gradebook, error)
+	// Получить оценки одного студента (студенту — только свои).
+	GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*StudentGrades, error)
+	// Вручную изменить оценку за тест, задание или итоговую оценку. Каждое изменение сохраняется в истории.
+	AdjustGrade(ctx context.Context, in *AdjustGradeRequest, opts ...grpc.CallOption) (*GradeAdjustment, error)
+	// Получить историю ручных изменений оценок.
+	ListGradeAdjustments(ctx context.Context, in *ListGradeAdjustmentsRequest, opts ...grpc.CallOption) (*GradeAdjustmentList, error)
+	// Выгрузить журнал оценок в CSV или XLSX.
+	ExportGradebook(ctx context.Context, in *ExportGradebookRequest, opts ...grpc.CallOption) (*GradebookFile, error)
+}
+
+type gradebookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradebookServiceClient(cc grpc.ClientConnInterface) GradebookServiceClient {
+	return &gradebookServiceClient{cc}
+}
+
+func (c *gradebookServiceClient) CreateGradeCategory(ctx context.Context, in *CreateGradeCategoryRequest, opts ...grpc.CallOption) (*GradeCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeCategory)
+	err := c.cc.Invoke(ctx, GradebookService_CreateGradeCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) UpdateGradeCategory(ctx context.Context, in *UpdateGradeCategoryRequest, opts ...grpc.CallOption) (*GradeCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeCategory)
+	err := c.cc.Invoke(ctx, GradebookService_UpdateGradeCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) DeleteGradeCategory(ctx context.Context, in *GradeCategoryIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, GradebookService_DeleteGradeCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) ListGradeCategories(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*GradeCategoryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeCategoryList)
+	err := c.cc.Invoke(ctx, GradebookService_ListGradeCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) SetGradeItemCategory(ctx context.Context, in *SetGradeItemCategoryRequest, opts ...grpc.CallOption) (*GradeItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeItem)
+	err := c.cc.Invoke(ctx, GradebookService_SetGradeItemCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) GetLetterScheme(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*LetterScheme, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LetterScheme)
+	err := c.cc.Invoke(ctx, GradebookService_GetLetterScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) SetLetterScheme(ctx context.Context, in *SetLetterSchemeRequest, opts ...grpc.CallOption) (*LetterScheme, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LetterScheme)
+	err := c.cc.Invoke(ctx, GradebookService_SetLetterScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) GetGradebook(ctx context.Context, in *CourseIDRequest, opts ...grpc.CallOption) (*Gradebook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Gradebook)
+	err := c.cc.Invoke(ctx, GradebookService_GetGradebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*StudentGrades, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StudentGrades)
+	err := c.cc.Invoke(ctx, GradebookService_GetStudentGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) AdjustGrade(ctx context.Context, in *AdjustGradeRequest, opts ...grpc.CallOption) (*GradeAdjustment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeAdjustment)
+	err := c.cc.Invoke(ctx, GradebookService_AdjustGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) ListGradeAdjustments(ctx context.Context, in *ListGradeAdjustmentsRequest, opts ...grpc.CallOption) (*GradeAdjustmentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeAdjustmentList)
+	err := c.cc.Invoke(ctx, GradebookService_ListGradeAdjustments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) ExportGradebook(ctx context.Context, in *ExportGradebookRequest, opts ...grpc.CallOption) (*GradebookFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradebookFile)
+	err := c.cc.Invoke(ctx, GradebookService_ExportGradebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradebookServiceServer is the server API for GradebookService service.
+// All implementations must embed UnimplementedGradebookServiceServer
+// for forward compatibility.
+//
+// Сервис журнала оценок курса.
+type GradebookServiceServer interface {
+	// Создать категорию оценок с весом.
+	CreateGradeCategory(context.Context, *CreateGradeCategoryRequest) (*GradeCategory, error)
+	// Изменить название или вес категории.
+	UpdateGradeCategory(context.Context, *UpdateGradeCategoryRequest) (*GradeCategory, error)
+	// Удалить категорию; её тесты и задания остаются без категории.
+	DeleteGradeCategory(context.Context, *GradeCategoryIDRequest) (*Empty, error)
+	// Получить категории оценок курса.
+	ListGradeCategories(context.Context, *CourseIDRequest) (*GradeCategoryList, error)
+	// Отнести тест или задание к категории.
+	SetGradeItemCategory(context.Context, *SetGradeItemCategoryRequest) (*GradeItem, error)
+	// Получить шкалу буквенных оценок курса (если не задана — шкалу по умолчанию).
+	GetLetterScheme(context.Context, *CourseIDRequest) (*LetterScheme, error)
+	// Задать шкалу буквенных оценок курса; пустой список возвращает шкалу по умолчанию.
+	SetLetterScheme(context.Context, *SetLetterSchemeRequest) (*LetterScheme, error)
+	// Получить журнал оценок курса по всем студентам.
+	GetGradebook(context.Context, *CourseIDRequest) (*Gradebook, error)
+	// Получить оценки одного студента (студенту — только свои).
+	GetStudentGrades(context.Context, *StudentGradesRequest) (*StudentGrades, error)
+	// Вручную изменить оценку за тест, задание или итоговую оценку. Каждое изменение сохраняется в истории.
+	AdjustGrade(context.Context, *AdjustGradeRequest) (*GradeAdjustment, error)
+	// Получить историю ручных изменений оценок.
+	ListGradeAdjustments(context.Context, *ListGradeAdjustmentsRequest) (*GradeAdjustmentList, error)
+	// Выгрузить журнал оценок в CSV или XLSX.
+	ExportGradebook(context.Context, *ExportGradebookRequest) (*GradebookFile, error)
+	mustEmbedUnimplementedGradebookServiceServer()
+}
+
+// UnimplementedGradebookServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGradebookServiceServer struct{}
+
+func (UnimplementedGradebookServiceServer) CreateGradeCategory(context.Context, *CreateGradeCategoryRequest) (*GradeCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGradeCategory not implemented")
+}
+func (UnimplementedGradebookServiceServer) UpdateGradeCategory(context.Context, *UpdateGradeCategoryRequest) (*GradeCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGradeCategory not implemented")
+}
+func (UnimplementedGradebookServiceServer) DeleteGradeCategory(context.Context, *GradeCategoryIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGradeCategory not implemented")
+}
+func (UnimplementedGradebookServiceServer) ListGradeCategories(context.Context, *CourseIDRequest) (*GradeCategoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradeCategories not implemented")
+}
+func (UnimplementedGradebookServiceServer) SetGradeItemCategory(context.Context, *SetGradeItemCategoryRequest) (*GradeItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradeItemCategory not implemented")
+}
+func (UnimplementedGradebookServiceServer) GetLetterScheme(context.Context, *CourseIDRequest) (*LetterScheme, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLetterScheme not implemented")
+}
+func (UnimplementedGradebookServiceServer) SetLetterScheme(context.Context, *SetLetterSchemeRequest) (*LetterScheme, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLetterScheme not implemented")
+}
+func (UnimplementedGradebookServiceServer) GetGradebook(context.Context, *CourseIDRequest) (*Gradebook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradebook not implemented")
+}
+func (UnimplementedGradebookServiceServer) GetStudentGrades(context.Context, *StudentGradesRequest) (*StudentGrades, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentGrades not implemented")
+}
+func (UnimplementedGradebookServiceServer) AdjustGrade(context.Context, *AdjustGradeRequest) (*GradeAdjustment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustGrade not implemented")
+}
+func (UnimplementedGradebookServiceServer) ListGradeAdjustments(context.Context, *ListGradeAdjustmentsRequest) (*GradeAdjustmentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradeAdjustments not implemented")
+}
+func (UnimplementedGradebookServiceServer) ExportGradebook(context.Context, *ExportGradebookRequest) (*GradebookFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGradebook not implemented")
+}
+func (UnimplementedGradebookServiceServer) mustEmbedUnimplementedGradebookServiceServer() {}
+func (UnimplementedGradebookServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeGradebookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradebookServiceServer will
+// result in compilation errors.
+type UnsafeGradebookServiceServer interface {
+	mustEmbedUnimplementedGradebookServiceServer()
+}
+
+func RegisterGradebookServiceServer(s grpc.ServiceRegistrar, srv GradebookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGradebookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GradebookService_ServiceDesc, srv)
+}
+
+func _GradebookService_CreateGradeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGradeCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).CreateGradeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_CreateGradeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).CreateGradeCategory(ctx, req.(*CreateGradeCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_UpdateGradeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGradeCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).UpdateGradeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_UpdateGradeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).UpdateGradeCategory(ctx, req.(*UpdateGradeCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_DeleteGradeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeCategoryIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).DeleteGradeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_DeleteGradeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).DeleteGradeCategory(ctx, req.(*GradeCategoryIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_ListGradeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).ListGradeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_ListGradeCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).ListGradeCategories(ctx, req.(*CourseIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_SetGradeItemCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradeItemCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).SetGradeItemCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_SetGradeItemCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).SetGradeItemCategory(ctx, req.(*SetGradeItemCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_GetLetterScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).GetLetterScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_GetLetterScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).GetLetterScheme(ctx, req.(*CourseIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_SetLetterScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLetterSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).SetLetterScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_SetLetterScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).SetLetterScheme(ctx, req.(*SetLetterSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_GetGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).GetGradebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_GetGradebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).GetGradebook(ctx, req.(*CourseIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_GetStudentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).GetStudentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_GetStudentGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).GetStudentGrades(ctx, req.(*StudentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_AdjustGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).AdjustGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_AdjustGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).AdjustGrade(ctx, req.(*AdjustGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_ListGradeAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradeAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).ListGradeAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_ListGradeAdjustments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).ListGradeAdjustments(ctx, req.(*ListGradeAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_ExportGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGradebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).ExportGradebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_ExportGradebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).ExportGradebook(ctx, req.(*ExportGradebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradebookService_ServiceDesc is the grpc.ServiceDesc for GradebookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradebookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.GradebookService",
+	HandlerType: (*GradebookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGradeCategory",
+			Handler:    _GradebookService_CreateGradeCategory_Handler,
+		},
+		{
+			MethodName: "UpdateGradeCategory",
+			Handler:    _GradebookService_UpdateGradeCategory_Handler,
+		},
+		{
+			MethodName: "DeleteGradeCategory",
+			Handler:    _GradebookService_DeleteGradeCategory_Handler,
+		},
+		{
+			MethodName: "ListGradeCategories",
+			Handler:    _GradebookService_ListGradeCategories_Handler,
+		},
+		{
+			MethodName: "SetGradeItemCategory",
+			Handler:    _GradebookService_SetGradeItemCategory_Handler,
+		},
+		{
+			MethodName: "GetLetterScheme",
+			Handler:    _GradebookService_GetLetterScheme_Handler,
+		},
+		{
+			MethodName: "SetLetterScheme",
+			Handler:    _GradebookService_SetLetterScheme_Handler,
+		},
+		{
+			MethodName: "GetGradebook",
+			Handler:    _GradebookService_GetGradebook_Handler,
+		},
+		{
+			MethodName: "GetStudentGrades",
+			Handler:    _GradebookService_GetStudentGrades_Handler,
+		},
+		{
+			MethodName: "AdjustGrade",
+			Handler:    _GradebookService_AdjustGrade_Handler,
+		},
+		{
+			MethodName: "ListGradeAdjustments",
+			Handler:    _GradebookService_ListGradeAdjustments_Handler,
+		},
+		{
+			MethodName: "ExportGradebook",
+			Handler:    _GradebookService_ExportGradebook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	ModerationService_LoginAdmin_FullMethodName         = "/GoEdu.ModerationService/LoginAdmin"
+	ModerationService_GetModerationQueue_FullMethodName = "/GoEdu.ModerationService/GetModerationQueue"
+	ModerationService_ApproveReview_FullMethodName      = "/GoEdu.ModerationService/ApproveReview"
+	ModerationService_RejectReview_FullMethodName       = "/GoEdu.ModerationService/RejectReview"
+)
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис модерации отзывов для администраторов.
+type ModerationServiceClient interface {
+	// Вход администратора.
+	LoginAdmin(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// Очередь модерации: задержанные фильтром или жалобами отзывы и отзывы с открытыми жалобами.
+	GetModerationQueue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ModerationQueue, error)
+	// Одобрить отзыв: он публикуется, открытые жалобы закрываются.
+	ApproveReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// Отклонить отзыв: он скрывается из курса и не учитывается в рейтинге.
+	RejectReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) LoginAdmin(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, ModerationService_LoginAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) GetModerationQueue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ModerationQueue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationQueue)
+	err := c.cc.Invoke(ctx, ModerationService_GetModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ApproveReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ModerationService_ApproveReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) RejectReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ModerationService_RejectReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility.
+//
+// Сервис модерации отзывов для администраторов.
+type ModerationServiceServer interface {
+	// Вход администратора.
+	LoginAdmin(context.Context, *LoginRequest) (*AuthResponse, error)
+	// Очередь модерации: задержанные фильтром или жалобами отзывы и отзывы с открытыми жалобами.
+	GetModerationQueue(context.Context, *Empty) (*ModerationQueue, error)
+	// Одобрить отзыв: он публикуется, открытые жалобы закрываются.
+	ApproveReview(context.Context, *ModerateReviewRequest) (*Review, error)
+	// Отклонить отзыв: он скрывается из курса и не учитывается в рейтинге.
+	RejectReview(context.Context, *ModerateReviewRequest) (*Review, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedModerationServiceServer struct{}
+
+func (UnimplementedModerationServiceServer) LoginAdmin(context.Context, *LoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginAdmin not implemented")
+}
+func (UnimplementedModerationServiceServer) GetModerationQueue(context.Context, *Empty) (*ModerationQueue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationQueue not implemented")
+}
+func (UnimplementedModerationServiceServer) ApproveReview(context.Context, *ModerateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReview not implemented")
+}
+func (UnimplementedModerationServiceServer) RejectReview(context.Context, *ModerateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReview not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+func (UnimplementedModerationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_LoginAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).LoginAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_LoginAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).LoginAdmin(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_GetModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).GetModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_GetModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).GetModerationQueue(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ApproveReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ApproveReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ApproveReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ApproveReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_RejectReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).RejectReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_RejectReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).RejectReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LoginAdmin",
+			Handler:    _ModerationService_LoginAdmin_Handler,
+		},
+		{
+			MethodName: "GetModerationQueue",
+			Handler:    _ModerationService_GetModerationQueue_Handler,
+		},
+		{
+			MethodName: "ApproveReview",
+			Handler:    _ModerationService_ApproveReview_Handler,
+		},
+		{
+			MethodName: "RejectReview",
+			Handler:    _ModerationService_RejectReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	LearningPathService_CreateLearningPath_FullMethodName      = "/GoEdu.LearningPathService/CreateLearningPath"
+	LearningPathService_UpdateLearningPath_FullMethodName      = "/GoEdu.LearningPathService/UpdateLearningPath"
+	LearningPathService_DeleteLearningPath_FullMethodName      = "/GoEdu.LearningPathService/DeleteLearningPath"
+	LearningPathService_GetLearningPath_FullMethodName         = "/GoEdu.LearningPathService/GetLearningPath"
+	LearningPathService_ListLearningPaths_FullMethodName       = "/GoEdu.LearningPathService/ListLearningPaths"
+	LearningPathService_EnrollInLearningPath_FullMethodName    = "/GoEdu.LearningPathService/EnrollInLearningPath"
+	LearningPathService_GetLearningPathProgress_FullMethodName = "/GoEdu.LearningPathService/GetLearningPathProgress"
+)
+
+// LearningPathServiceClient is the client API for LearningPathService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис учебных программ: последовательностей из нескольких курсов.
+type LearningPathServiceClient interface {
+	// Создать учебную программу.
+	CreateLearningPath(ctx context.Context, in *NewLearningPathRequest, opts ...grpc.CallOption) (*LearningPath, error)
+	// Обновить программу; список курсов заменяется целиком.
+	UpdateLearningPath(ctx context.Context, in *UpdateLearningPathRequest, opts ...grpc.CallOption) (*LearningPath, error)
+	// Удалить программу. Записи студентов на её курсы сохраняются.
+	DeleteLearningPath(ctx context.Context, in *LearningPathIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить программу с курсами.
+	GetLearningPath(ctx context.Context, in *LearningPathIDRequest, opts ...grpc.CallOption) (*LearningPath, error)
+	// Получить список программ.
+	ListLearningPaths(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LearningPathList, error)
+	// Записать студента на программу и на все её курсы.
+	EnrollInLearningPath(ctx context.Context, in *LearningPathStudentRequest, opts ...grpc.CallOption) (*LearningPathEnrollment, error)
+	// Прогресс студента по программе и сертификат программы, если он уже выдан.
+	GetLearningPathProgress(ctx context.Context, in *LearningPathStudentRequest, opts ...grpc.CallOption) (*LearningPathProgress, error)
+}
+
+type learningPathServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLearningPathServiceClient(cc grpc.ClientConnInterface) LearningPathServiceClient {
+	return &learningPathServiceClient{cc}
+}
+
+func (c *learningPathServiceClient) CreateLearningPath(ctx context.Context, in *NewLearningPathRequest, opts ...grpc.CallOption) (*LearningPath, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPath)
+	err := c.cc.Invoke(ctx, LearningPathService_CreateLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPathServiceClient) UpdateLearningPath(ctx context.Context, in *UpdateLearningPathRequest, opts ...grpc.CallOption) (*LearningPath, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPath)
+	err := c.cc.Invoke(ctx, LearningPathService_UpdateLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPathServiceClient) DeleteLearningPath(ctx context.Context, in *LearningPathIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, LearningPathService_DeleteLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPathServiceClient) GetLearningPath(ctx context.Context, in *LearningPathIDRequest, opts ...grpc.CallOption) (*LearningPath, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPath)
+	err := c.cc.Invoke(ctx, LearningPathService_GetLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPathServiceClient) ListLearningPaths(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LearningPathList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPathList)
+	err := c.cc.Invoke(ctx, LearningPathService_ListLearningPaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPathServiceClient) EnrollInLearningPath(ctx context.Context, in *LearningPathStudentRequest, opts ...grpc.CallOption) (*LearningPathEnrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPathEnrollment)
+	err := c.cc.Invoke(ctx, LearningPathService_EnrollInLearningPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPathServiceClient) GetLearningPathProgress(ctx context.Context, in *LearningPathStudentRequest, opts ...grpc.CallOption) (*LearningPathProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LearningPathProgress)
+	err := c.cc.Invoke(ctx, LearningPathService_GetLearningPathProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LearningPathServiceServer is the server API for LearningPathService service.
+// All implementations must embed UnimplementedLearningPathServiceServer
+// for forward compatibility.
+//
+// Сервис учебных программ: последовательностей из нескольких курсов.
+type LearningPathServiceServer interface {
+	// Создать учебную программу.
+	CreateLearningPath(context.Context, *NewLearningPathRequest) (*LearningPath, error)
+	// Обновить программу; список курсов заменяется целиком.
+	UpdateLearningPath(context.Context, *UpdateLearningPathRequest) (*LearningPath, error)
+	// Удалить программу. Записи студентов на её курсы сохраняются.
+	DeleteLearningPath(context.Context, *LearningPathIDRequest) (*Empty, error)
+	// Получить программу с курсами.
+	GetLearningPath(context.Context, *LearningPathIDRequest) (*LearningPath, error)
+	// Получить список программ.
+	ListLearningPaths(context.Context, *Empty) (*LearningPathList, error)
+	// Записать студента на программу и на все её курсы.
+	EnrollInLearningPath(context.Context, *LearningPathStudentRequest) (*LearningPathEnrollment, error)
+	// Прогресс студента по программе и сертификат программы, если он уже выдан.
+	GetLearningPathProgress(context.Context, *LearningPathStudentRequest) (*LearningPathProgress, error)
+	mustEmbedUnimplementedLearningPathServiceServer()
+}
+
+// UnimplementedLearningPathServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLearningPathServiceServer struct{}
+
+func (UnimplementedLearningPathServiceServer) CreateLearningPath(context.Context, *NewLearningPathRequest) (*LearningPath, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLearningPath not implemented")
+}
+func (UnimplementedLearningPathServiceServer) UpdateLearningPath(context.Context, *UpdateLearningPathRequest) (*LearningPath, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLearningPath not implemented")
+}
+func (UnimplementedLearningPathServiceServer) DeleteLearningPath(context.Context, *LearningPathIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLearningPath not implemented")
+}
+func (UnimplementedLearningPathServiceServer) GetLearningPath(context.Context, *LearningPathIDRequest) (*LearningPath, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningPath not implemented")
+}
+func (UnimplementedLearningPathServiceServer) ListLearningPaths(context.Context, *Empty) (*LearningPathList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLearningPaths not implemented")
+}
+func (UnimplementedLearningPathServiceServer) EnrollInLearningPath(context.Context, *LearningPathStudentRequest) (*LearningPathEnrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollInLearningPath not implemented")
+}
+func (UnimplementedLearningPathServiceServer) GetLearningPathProgress(context.Context, *LearningPathStudentRequest) (*LearningPathProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningPathProgress not implemented")
+}
+func (UnimplementedLearningPathServiceServer) mustEmbedUnimplementedLearningPathServiceServer() {}
+func (UnimplementedLearningPathServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeLearningPathServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LearningPathServiceServer will
+// result in compilation errors.
+type UnsafeLearningPathServiceServer interface {
+	mustEmbedUnimplementedLearningPathServiceServer()
+}
+
+func RegisterLearningPathServiceServer(s grpc.ServiceRegistrar, srv LearningPathServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLearningPathServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LearningPathService_ServiceDesc, srv)
+}
+
+func _LearningPathService_CreateLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewLearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPathServiceServer).CreateLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPathService_CreateLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPathServiceServer).CreateLearningPath(ctx, req.(*NewLearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPathService_UpdateLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLearningPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPathServiceServer).UpdateLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPathService_UpdateLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPathServiceServer).UpdateLearningPath(ctx, req.(*UpdateLearningPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPathService_DeleteLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LearningPathIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPathServiceServer).DeleteLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPathService_DeleteLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPathServiceServer).DeleteLearningPath(ctx, req.(*LearningPathIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPathService_GetLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LearningPathIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPathServiceServer).GetLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPathService_GetLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPathServiceServer).GetLearningPath(ctx, req.(*LearningPathIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPathService_ListLearningPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPathServiceServer).ListLearningPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPathService_ListLearningPaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPathServiceServer).ListLearningPaths(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPathService_EnrollInLearningPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LearningPathStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPathServiceServer).EnrollInLearningPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPathService_EnrollInLearningPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPathServiceServer).EnrollInLearningPath(ctx, req.(*LearningPathStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPathService_GetLearningPathProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LearningPathStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPathServiceServer).GetLearningPathProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPathService_GetLearningPathProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPathServiceServer).GetLearningPathProgress(ctx, req.(*LearningPathStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LearningPathService_ServiceDesc is the grpc.ServiceDesc for LearningPathService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LearningPathService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.LearningPathService",
+	HandlerType: (*LearningPathServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLearningPath",
+			Handler:    _LearningPathService_CreateLearningPath_Handler,
+		},
+		{
+			MethodName: "UpdateLearningPath",
+			Handler:    _LearningPathService_UpdateLearningPath_Handler,
+		},
+		{
+			MethodName: "DeleteLearningPath",
+			Handler:    _LearningPathService_DeleteLearningPath_Handler,
+		},
+		{
+			MethodName: "GetLearningPath",
+			Handler:    _LearningPathService_GetLearningPath_Handler,
+		},
+		{
+			MethodName: "ListLearningPaths",
+			Handler:    _LearningPathService_ListLearningPaths_Handler,
+		},
+		{
+			MethodName: "EnrollInLearningPath",
+			Handler:    _LearningPathService_EnrollInLearningPath_Handler,
+		},
+		{
+			MethodName: "GetLearningPathProgress",
+			Handler:    _LearningPathService_GetLearningPathProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	WebhookService_CreateWebhook_FullMethodName         = "/GoEdu.WebhookService/CreateWebhook"
+	WebhookService_UpdateWebhook_FullMethodName         = "/GoEdu.WebhookService/UpdateWebhook"
+	WebhookService_DeleteWebhook_FullMethodName         = "/GoEdu.WebhookService/DeleteWebhook"
+	WebhookService_GetWebhook_FullMethodName            = "/GoEdu.WebhookService/GetWebhook"
+	WebhookService_ListWebhooks_FullMethodName          = "/GoEdu.WebhookService/ListWebhooks"
+	WebhookService_ListWebhookDeliveries_FullMethodName = "/GoEdu.WebhookService/ListWebhookDeliveries"
+	WebhookService_RedeliverWebhook_FullMethodName      = "/GoEdu.WebhookService/RedeliverWebhook"
+)
+
+// WebhookServiceClient is the client API for WebhookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис исходящих вебхуков: подписки преподавателей и администраторов на доменные события.
+type WebhookServiceClient interface {
+	// Создать подписку. Если секрет не задан, он генерируется; секрет возвращается только в этом ответе.
+	CreateWebhook(ctx context.Context, in *NewWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Изменить адрес, типы событий или включить и выключить подписку. Включение сбрасывает счётчик неудачных доставок.
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Удалить подписку вместе с журналом доставок.
+	DeleteWebhook(ctx context.Context, in *WebhookIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получить подписку.
+	GetWebhook(ctx context.Context, in *WebhookIDRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Получить подписки текущего пользователя.
+	ListWebhooks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WebhookList, error)
+	// Журнал доставок подписки, от новых к старым.
+	ListWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveryList, error)
+	// Повторно отправить событие из журнала доставок с тем же ключом идемпотентности.
+	RedeliverWebhook(ctx context.Context, in *WebhookDeliveryIDRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+}
+
+type webhookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookServiceClient(cc grpc.ClientConnInterface) WebhookServiceClient {
+	return &webhookServiceClient{cc}
+}
+
+func (c *webhookServiceClient) CreateWebhook(ctx context.Context, in *NewWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, WebhookService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, WebhookService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, in *WebhookIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, WebhookService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetWebhook(ctx context.Context, in *WebhookIDRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, WebhookService_GetWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListWebhooks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WebhookList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookList)
+	err := c.cc.Invoke(ctx, WebhookService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveryList)
+	err := c.cc.Invoke(ctx, WebhookService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) RedeliverWebhook(ctx context.Context, in *WebhookDeliveryIDRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, WebhookService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookServiceServer is the server API for WebhookService service.
+// All implementations must embed UnimplementedWebhookServiceServer
+// for forward compatibility.
+//
+// Сервис исходящих вебхуков: подписки преподавателей и администраторов на доменные события.
+type WebhookServiceServer interface {
+	// Создать подписку. Если секрет не задан, он генерируется; секрет возвращается только в этом ответе.
+	CreateWebhook(context.Context, *NewWebhookRequest) (*Webhook, error)
+	// Изменить адрес, типы событий или включить и выключить подписку. Включение сбрасывает счётчик неудачных доставок.
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error)
+	// Удалить подписку вместе с журналом доставок.
+	DeleteWebhook(context.Context, *WebhookIDRequest) (*Empty, error)
+	// Получить подписку.
+	GetWebhook(context.Context, *WebhookIDRequest) (*Webhook, error)
+	// Получить подписки текущего пользователя.
+	ListWebhooks(context.Context, *Empty) (*WebhookList, error)
+	// Журнал доставок подписки, от новых к старым.
+	ListWebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveryList, error)
+	// Повторно отправить событие из журнала доставок с тем же ключом идемпотентности.
+	RedeliverWebhook(context.Context, *WebhookDeliveryIDRequest) (*WebhookDelivery, error)
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+// UnimplementedWebhookServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWebhookServiceServer struct{}
+
+func (UnimplementedWebhookServiceServer) CreateWebhook(context.Context, *NewWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) DeleteWebhook(context.Context, *WebhookIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetWebhook(context.Context, *WebhookIDRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListWebhooks(context.Context, *Empty) (*WebhookList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListWebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedWebhookServiceServer) RedeliverWebhook(context.Context, *WebhookDeliveryIDRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {}
+func (UnimplementedWebhookServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeWebhookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookServiceServer will
+// result in compilation errors.
+type UnsafeWebhookServiceServer interface {
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+func RegisterWebhookServiceServer(s grpc.ServiceRegistrar, srv WebhookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWebhookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WebhookService_ServiceDesc, srv)
+}
+
+func _WebhookService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, req.(*NewWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, req.(*WebhookIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetWebhook(ctx, req.(*WebhookIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListWebhookDeliveries(ctx, req.(*WebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveryIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).RedeliverWebhook(ctx, req.(*WebhookDeliveryIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _WebhookService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _WebhookService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _WebhookService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _WebhookService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _WebhookService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _WebhookService_RedeliverWebhook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	EventService_SubscribeEvents_FullMethodName = "/GoEdu.EventService/SubscribeEvents"
+)
+
+// EventServiceClient is the client API for EventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис ленты доменных событий для обновления интерфейсов без опроса.
+type EventServiceClient interface {
+	// Поток событий по курсу или студенту. Если задан last_event_id, сначала передаются сохранённые события
+	// после него, затем новые. Через HTTP доступен как Server-Sent Events (Accept: text/event-stream).
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DomainEvent], error)
+}
+
+type eventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
+	return &eventServiceClient{cc}
+}
+
+func (c *eventServiceClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DomainEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_SubscribeEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeEventsRequest, DomainEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_SubscribeEventsClient = grpc.ServerStreamingClient[DomainEvent]
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility.
+//
+// Сервис ленты доменных событий для обновления интерфейсов без опроса.
+type EventServiceServer interface {
+	// Поток событий по курсу или студенту. Если задан last_event_id, сначала передаются сохранённые события
+	// после него, затем новые. Через HTTP доступен как Server-Sent Events (Accept: text/event-stream).
+	SubscribeEvents(*SubscribeEventsRequest, grpc.ServerStreamingServer[DomainEvent]) error
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServiceServer struct{}
+
+func (UnimplementedEventServiceServer) SubscribeEvents(*SubscribeEventsRequest, grpc.ServerStreamingServer[DomainEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).SubscribeEvents(m, &grpc.GenericServerStream[SubscribeEventsRequest, DomainEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_SubscribeEventsServer = grpc.ServerStreamingServer[DomainEvent]
+
+// EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.EventService",
+	HandlerType: (*EventServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _EventService_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	NotificationService_ListNotifications_FullMethodName             = "/GoEdu.NotificationService/ListNotifications"
+	NotificationService_MarkRead_FullMethodName                      = "/GoEdu.NotificationService/MarkRead"
+	NotificationService_MarkAllRead_FullMethodName                   = "/GoEdu.NotificationService/MarkAllRead"
+	NotificationService_GetNotificationPreferences_FullMethodName    = "/GoEdu.NotificationService/GetNotificationPreferences"
+	NotificationService_UpdateNotificationPreferences_FullMethodName = "/GoEdu.NotificationService/UpdateNotificationPreferences"
+	NotificationService_Unsubscribe_FullMethodName                   = "/GoEdu.NotificationService/Unsubscribe"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис уведомлений студентов о новых лекциях, ответах на отзывы, записи на курсы, проверке заданий и объявлениях.
+type NotificationServiceClient interface {
+	// Уведомления студента от новых к старым вместе с числом непрочитанных.
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error)
+	// Отметить уведомления прочитанными.
+	MarkRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error)
+	// Отметить прочитанными все уведомления студента.
+	MarkAllRead(ctx context.Context, in *NotificationStudentRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error)
+	// Получить, какие уведомления студент получает.
+	GetNotificationPreferences(ctx context.Context, in *NotificationStudentRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	// Включить или отключить типы уведомлений и изменить частоту и язык писем; не переданные настройки не меняются.
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	// Отписаться от писем по ссылке из письма. Доступно без авторизации: студента определяет подписанный токен.
+	// Ссылка из письма открывает страницу подтверждения (GET), а отписка выполняется только POST-запросом,
+	// чтобы почтовые сканеры и предзагрузка ссылок не отписывали студентов.
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationList)
+	err := c.cc.Invoke(ctx, NotificationService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkAllRead(ctx context.Context, in *NotificationStudentRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkAllRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetNotificationPreferences(ctx context.Context, in *NotificationStudentRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, NotificationService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, NotificationService_Unsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// Сервис уведомлений студентов о новых лекциях, ответах на отзывы, записи на курсы, проверке заданий и объявлениях.
+type NotificationServiceServer interface {
+	// Уведомления студента от новых к старым вместе с числом непрочитанных.
+	ListNotifications(context.Context, *ListNotificationsRequest) (*NotificationList, error)
+	// Отметить уведомления прочитанными.
+	MarkRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadResponse, error)
+	// Отметить прочитанными все уведомления студента.
+	MarkAllRead(context.Context, *NotificationStudentRequest) (*MarkNotificationsReadResponse, error)
+	// Получить, какие уведомления студент получает.
+	GetNotificationPreferences(context.Context, *NotificationStudentRequest) (*NotificationPreferences, error)
+	// Включить или отключить типы уведомлений и изменить частоту и язык писем; не переданные настройки не меняются.
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error)
+	// Отписаться от писем по ссылке из письма. Доступно без авторизации: студента определяет подписанный токен.
+	// Ссылка из письма открывает страницу подтверждения (GET), а отписка выполняется только POST-запросом,
+	// чтобы почтовые сканеры и предзагрузка ссылок не отписывали студентов.
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*NotificationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkAllRead(context.Context, *NotificationStudentRequest) (*MarkNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllRead not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetNotificationPreferences(context.Context, *NotificationStudentRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationServiceServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkAllRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkAllRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkAllRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkAllRead(ctx, req.(*NotificationStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetNotificationPreferences(ctx, req.(*NotificationStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListNotifications",
+			Handler:    _NotificationService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _NotificationService_MarkRead_Handler,
+		},
+		{
+			MethodName: "MarkAllRead",
+			Handler:    _NotificationService_MarkAllRead_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _NotificationService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _NotificationService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _NotificationService_Unsubscribe_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/education.proto",
+}
+
+const (
+	AnnouncementService_CreateAnnouncement_FullMethodName = "/GoEdu.AnnouncementService/CreateAnnouncement"
+	AnnouncementService_ListAnnouncements_FullMethodName  = "/GoEdu.AnnouncementService/ListAnnouncements"
+	AnnouncementService_DeleteAnnouncement_FullMethodName = "/GoEdu.AnnouncementService/DeleteAnnouncement"
+)
+
+// AnnouncementServiceClient is the client API for AnnouncementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис объявлений преподавателей для студентов курса или потока.
+type AnnouncementServiceClient interface {
+	// Создать объявление; с publish_at в будущем объявление будет опубликовано в указанное время.
+	CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	// Объявления курса от новых к старым. Студент видит только опубликованные объявления для всего курса и своего потока.
+	ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*AnnouncementList, error)
+	// Удалить объявление; запланированное объявление не будет опубликовано.
+	DeleteAnnouncement(ctx context.Context, in *AnnouncementIDRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type announcementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnnouncementServiceClient(cc grpc.ClientConnInterface) AnnouncementServiceClient {
+	return &announcementServiceClient{cc}
+}
+
+func (c *announcementServiceClient) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, AnnouncementService_CreateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*AnnouncementList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnouncementList)
+	err := c.cc.Invoke(ctx, AnnouncementService_ListAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) DeleteAnnouncement(ctx context.Context, in *AnnouncementIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AnnouncementService_DeleteAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnnouncementServiceServer is the server API for AnnouncementService service.
+// All implementations must embed UnimplementedAnnouncementServiceServer
+// for forward compatibility.
+//
+// Сервис объявлений преподавателей для студентов курса или потока.
+type AnnouncementServiceServer interface {
+	// Создать объявление; с publish_at в будущем объявление будет опубликовано в указанное время.
+	CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error)
+	// Объявления курса от новых к старым. Студент видит только опубликованные объявления для всего курса и своего потока.
+	ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*AnnouncementList, error)
+	// Удалить объявление; запланированное объявление не будет опубликовано.
+	DeleteAnnouncement(context.Context, *AnnouncementIDRequest) (*Empty, error)
+	mustEmbedUnimplementedAnnouncementServiceServer()
+}
+
+// UnimplementedAnnouncementServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnnouncementServiceServer struct{}
+
+func (UnimplementedAnnouncementServiceServer) CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*AnnouncementList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) DeleteAnnouncement(context.Context, *AnnouncementIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) mustEmbedUnimplementedAnnouncementServiceServer() {}
+func (UnimplementedAnnouncementServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAnnouncementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnnouncementServiceServer will
+// result in compilation errors.
+type UnsafeAnnouncementServiceServer interface {
+	mustEmbedUnimplementedAnnouncementServiceServer()
+}
+
+func RegisterAnnouncementServiceServer(s grpc.ServiceRegistrar, srv AnnouncementServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnnouncementServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnnouncementService_ServiceDesc, srv)
+}
+
+func _AnnouncementService_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).CreateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_CreateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).CreateAnnouncement(ctx, req.(*CreateAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_ListAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).ListAnnouncements(ctx, req.(*ListAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_DeleteAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnouncementIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).DeleteAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_DeleteAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).DeleteAnnouncement(ctx, req.(*AnnouncementIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnnouncementService_ServiceDesc is the grpc.ServiceDesc for AnnouncementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnnouncementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GoEdu.AnnouncementService",
+	HandlerType: (*AnnouncementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _AnnouncementService_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _AnnouncementService_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncement",
+			Handler:    _AnnouncementService_DeleteAnnouncement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/education.proto",